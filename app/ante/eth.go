@@ -16,6 +16,7 @@
 package ante
 
 import (
+	"errors"
 	"math"
 	"math/big"
 
@@ -51,6 +52,8 @@ func NewEthAccountVerificationDecorator(ak evmtypes.AccountKeeper, ek EVMKeeper)
 // - any of the msgs is not a MsgHandleTx
 // - from address is empty
 // - account balance is lower than the transaction cost
+// - for fee delegated transaction, account balance is lower than the transaction value or
+// fee payer balance is lower than the transaction fee
 func (avd EthAccountVerificationDecorator) AnteHandle(
 	ctx sdk.Context,
 	tx sdk.Tx,
@@ -91,8 +94,20 @@ func (avd EthAccountVerificationDecorator) AnteHandle(
 				"the sender is not EOA: address %s, codeHash <%s>", fromAddr, acct.CodeHash)
 		}
 
-		if err := keeper.CheckSenderBalance(sdkmath.NewIntFromBigInt(acct.Balance), txData); err != nil {
-			return ctx, errorsmod.Wrap(err, "failed to check sender balance")
+		if !msgEthTx.IsFeeDelegated() {
+			if err := keeper.CheckSenderBalance(sdkmath.NewIntFromBigInt(acct.Balance), txData); err != nil {
+				return ctx, errorsmod.Wrap(err, "failed to check sender balance")
+			}
+			continue
+		}
+
+		// fees of delegated transaction are paid by the fee payer, so sender pays only for the value
+		feePayerBalance := sdkmath.ZeroInt()
+		if feePayerAcct := avd.evmKeeper.GetAccount(ctx, common.BytesToAddress(msgEthTx.GetFeePayer())); feePayerAcct != nil {
+			feePayerBalance = sdkmath.NewIntFromBigInt(feePayerAcct.Balance)
+		}
+		if err := keeper.CheckFeeDelegatedBalances(sdkmath.NewIntFromBigInt(acct.Balance), feePayerBalance, txData); err != nil {
+			return ctx, errorsmod.Wrap(err, "failed to check sender and fee payer balances")
 		}
 	}
 	return next(ctx, tx, simulate)
@@ -101,17 +116,20 @@ func (avd EthAccountVerificationDecorator) AnteHandle(
 // EthGasConsumeDecorator validates enough intrinsic gas for the transaction and
 // gas consumption.
 type EthGasConsumeDecorator struct {
-	evmKeeper    EVMKeeper
-	maxGasWanted uint64
+	evmKeeper      EVMKeeper
+	feegrantKeeper FeegrantKeeper
+	maxGasWanted   uint64
 }
 
 // NewEthGasConsumeDecorator creates a new EthGasConsumeDecorator
 func NewEthGasConsumeDecorator(
 	evmKeeper EVMKeeper,
+	feegrantKeeper FeegrantKeeper,
 	maxGasWanted uint64,
 ) EthGasConsumeDecorator {
 	return EthGasConsumeDecorator{
 		evmKeeper,
+		feegrantKeeper,
 		maxGasWanted,
 	}
 }
@@ -128,6 +146,8 @@ func NewEthGasConsumeDecorator(
 // - sender account cannot be found
 // - transaction's gas limit is lower than the intrinsic gas
 // - user doesn't have enough balance to deduct the transaction fees (gas_limit * gas_price)
+// - fee payer of fee delegated transaction doesn't have enough balance or allowance, granted to the user,
+// to deduct the transaction fees
 // - transaction or block gas meter runs out of gas
 // - sets the gas meter limit
 // - gas limit is greater than the block gas meter limit
@@ -188,7 +208,14 @@ func (egcd EthGasConsumeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simula
 			return ctx, errorsmod.Wrapf(err, "failed to verify the fees")
 		}
 
-		err = egcd.evmKeeper.DeductTxCostsFromUserBalance(ctx, fees, common.HexToAddress(msgEthTx.From))
+		if msgEthTx.IsFeeDelegated() {
+			if err := egcd.useFeeAllowance(ctx, msgEthTx, fees); err != nil {
+				return ctx, errorsmod.Wrapf(err, "failed to use fee allowance of fee payer %s", msgEthTx.FeePayer)
+			}
+		}
+
+		feePayer := msgEthTx.GetFeePayer()
+		err = egcd.evmKeeper.DeductTxCostsFromUserBalance(ctx, fees, common.BytesToAddress(feePayer))
 		if err != nil {
 			return ctx, errorsmod.Wrapf(err, "failed to deduct transaction costs from user balance")
 		}
@@ -197,6 +224,7 @@ func (egcd EthGasConsumeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simula
 			sdk.NewEvent(
 				sdk.EventTypeTx,
 				sdk.NewAttribute(sdk.AttributeKeyFee, fees.String()),
+				sdk.NewAttribute(sdk.AttributeKeyFeePayer, feePayer.String()),
 			),
 		)
 
@@ -239,6 +267,27 @@ func (egcd EthGasConsumeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simula
 	return next(newCtx, tx, simulate)
 }
 
+// useFeeAllowance spends fees of fee delegated transaction from the allowance, granted by the fee payer to the
+// sender through x/feegrant module. Fee payers may limit fees, which they pay for each sender, by granting such
+// allowances. Fees for the whole gas limit are spent, fees for unused gas are restored to the allowance by
+// the EVM keeper together with the refund. If there is no allowance, fees are authorized only by the signature
+// of the fee payer
+func (egcd EthGasConsumeDecorator) useFeeAllowance(ctx sdk.Context, msg *evmtypes.MsgHandleTx, fees sdk.Coins) error {
+	if egcd.feegrantKeeper == nil {
+		return nil
+	}
+
+	granter, grantee := msg.GetFeePayer(), msg.GetFrom()
+	if _, err := egcd.feegrantKeeper.GetAllowance(ctx, granter, grantee); err != nil {
+		if errors.Is(err, errortypes.ErrNotFound) {
+			return nil
+		}
+		return err
+	}
+
+	return egcd.feegrantKeeper.UseGrantedFees(ctx, granter, grantee, fees, []sdk.Msg{msg})
+}
+
 // CanTransferDecorator checks if the sender is allowed to transfer funds according to the EVM block
 // context rules.
 type CanTransferDecorator struct {
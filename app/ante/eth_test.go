@@ -4,7 +4,9 @@ import (
 	"math"
 	"math/big"
 
+	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feegrant"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 
 	"swisstronik/app/ante"
@@ -165,7 +167,7 @@ func (suite *AnteTestSuite) TestEthNonceVerificationDecorator() {
 }
 
 func (suite *AnteTestSuite) TestEthGasConsumeDecorator() {
-	dec := ante.NewEthGasConsumeDecorator(suite.app.EvmKeeper, config.DefaultMaxTxGasWanted)
+	dec := ante.NewEthGasConsumeDecorator(suite.app.EvmKeeper, suite.app.FeeGrantKeeper, config.DefaultMaxTxGasWanted)
 
 	addr := tests.RandomEthAddress()
 
@@ -330,6 +332,163 @@ func (suite *AnteTestSuite) TestEthGasConsumeDecorator() {
 	}
 }
 
+func (suite *AnteTestSuite) TestEthAccountVerificationDecoratorFeeDelegation() {
+	addr := tests.RandomEthAddress()
+	feePayer := tests.RandomEthAddress()
+
+	// tx costs 1000 for fees and 10 for the value
+	tx := evmtypes.NewTxContract(suite.app.EvmKeeper.ChainID(), 1, big.NewInt(10), 1000, big.NewInt(1), nil, nil, nil, nil)
+	tx.From = addr.Hex()
+	tx.FeePayer = feePayer.Hex()
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"not enough sender balance to cover tx value",
+			func() {
+				_ = suite.app.EvmKeeper.SetBalance(suite.ctx, feePayer, big.NewInt(1000))
+			},
+			false,
+		},
+		{
+			"not enough fee payer balance to cover tx fees",
+			func() {
+				_ = suite.app.EvmKeeper.SetBalance(suite.ctx, addr, big.NewInt(1000000))
+				_ = suite.app.EvmKeeper.SetBalance(suite.ctx, feePayer, big.NewInt(999))
+			},
+			false,
+		},
+		{
+			"success, sender covers only tx value",
+			func() {
+				_ = suite.app.EvmKeeper.SetBalance(suite.ctx, addr, big.NewInt(10))
+				_ = suite.app.EvmKeeper.SetBalance(suite.ctx, feePayer, big.NewInt(1000))
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			tc.malleate()
+
+			dec := ante.NewEthAccountVerificationDecorator(suite.app.AccountKeeper, suite.app.EvmKeeper)
+			_, err := dec.AnteHandle(suite.ctx.WithIsCheckTx(true), tx, false, NextFn)
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *AnteTestSuite) TestEthGasConsumeDecoratorFeeDelegation() {
+	addr := tests.RandomEthAddress()
+	feePayer := tests.RandomEthAddress()
+	initialBalance := big.NewInt(1001000000000000)
+
+	ethCfg := suite.app.EvmKeeper.GetParams(suite.ctx).
+		ChainConfig.EthereumConfig(suite.app.EvmKeeper.ChainID())
+	baseFee := suite.app.EvmKeeper.GetBaseFee(suite.ctx, ethCfg)
+	gasPrice := new(big.Int).Add(baseFee, evmtypes.DefaultPriorityReduction.BigInt())
+
+	txGasLimit := uint64(1000000)
+	tx := evmtypes.NewTxContract(suite.app.EvmKeeper.ChainID(), 1, big.NewInt(10), txGasLimit, gasPrice, nil, nil, nil, nil)
+	tx.From = addr.Hex()
+	tx.FeePayer = feePayer.Hex()
+
+	evmDenom := suite.app.EvmKeeper.GetParams(suite.ctx).EvmDenom
+	fee := new(big.Int).Mul(gasPrice, new(big.Int).SetUint64(txGasLimit))
+	feeCoins := func(amount *big.Int) sdk.Coins {
+		return sdk.NewCoins(sdk.NewCoin(evmDenom, sdkmath.NewIntFromBigInt(amount)))
+	}
+	grantAllowance := func(spendLimit *big.Int) {
+		err := suite.app.FeeGrantKeeper.GrantAllowance(suite.ctx, feePayer.Bytes(), addr.Bytes(), &feegrant.BasicAllowance{
+			SpendLimit: feeCoins(spendLimit),
+		})
+		suite.Require().NoError(err)
+	}
+
+	testCases := []struct {
+		name      string
+		malleate  func()
+		expPass   bool
+		postCheck func()
+	}{
+		{
+			"not enough fee payer balance for fees",
+			func() {
+				_ = suite.app.EvmKeeper.SetBalance(suite.ctx, addr, initialBalance)
+			},
+			false,
+			func() {
+				suite.Require().Equal(initialBalance, suite.app.EvmKeeper.GetBalance(suite.ctx, addr))
+			},
+		},
+		{
+			"success - fees are deducted from fee payer",
+			func() {
+				_ = suite.app.EvmKeeper.SetBalance(suite.ctx, feePayer, initialBalance)
+			},
+			true,
+			func() {
+				suite.Require().Equal(0, suite.app.EvmKeeper.GetBalance(suite.ctx, addr).Sign())
+				suite.Require().Equal(new(big.Int).Sub(initialBalance, fee).String(), suite.app.EvmKeeper.GetBalance(suite.ctx, feePayer).String())
+			},
+		},
+		{
+			"success - fees are spent from allowance, granted by fee payer",
+			func() {
+				_ = suite.app.EvmKeeper.SetBalance(suite.ctx, feePayer, initialBalance)
+				grantAllowance(new(big.Int).Mul(fee, big.NewInt(2)))
+			},
+			true,
+			func() {
+				suite.Require().Equal(new(big.Int).Sub(initialBalance, fee).String(), suite.app.EvmKeeper.GetBalance(suite.ctx, feePayer).String())
+
+				allowance, err := suite.app.FeeGrantKeeper.GetAllowance(suite.ctx, feePayer.Bytes(), addr.Bytes())
+				suite.Require().NoError(err)
+				suite.Require().Equal(feeCoins(fee), allowance.(*feegrant.BasicAllowance).SpendLimit)
+			},
+		},
+		{
+			"fees exceed allowance, granted by fee payer",
+			func() {
+				_ = suite.app.EvmKeeper.SetBalance(suite.ctx, feePayer, initialBalance)
+				grantAllowance(new(big.Int).Sub(fee, big.NewInt(1)))
+			},
+			false,
+			func() {
+				suite.Require().Equal(initialBalance, suite.app.EvmKeeper.GetBalance(suite.ctx, feePayer))
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			tc.malleate()
+			suite.ctx = suite.ctx.WithBlockGasMeter(sdk.NewGasMeter(10000000000000000000))
+
+			dec := ante.NewEthGasConsumeDecorator(suite.app.EvmKeeper, suite.app.FeeGrantKeeper, config.DefaultMaxTxGasWanted)
+			cacheCtx, _ := suite.ctx.CacheContext()
+			_, err := dec.AnteHandle(cacheCtx.WithIsCheckTx(true).WithGasMeter(sdk.NewInfiniteGasMeter()), tx, false, NextFn)
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.ctx = cacheCtx
+			} else {
+				suite.Require().Error(err)
+			}
+			tc.postCheck()
+		})
+	}
+}
+
 func (suite *AnteTestSuite) TestCanTransferDecorator() {
 	dec := ante.NewCanTransferDecorator(suite.app.EvmKeeper)
 
@@ -20,6 +20,8 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	tx "github.com/cosmos/cosmos-sdk/types/tx"
+	authante "github.com/cosmos/cosmos-sdk/x/auth/ante"
+	"github.com/cosmos/cosmos-sdk/x/feegrant"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/params"
 
@@ -49,6 +51,13 @@ type EVMKeeper interface {
 	GetAccount(ctx sdk.Context, addr common.Address) *evmtypes.Account
 }
 
+// FeegrantKeeper defines the expected feegrant keeper interface used to check and spend allowances, granted
+// by fee payers of fee delegated Ethereum transactions
+type FeegrantKeeper interface {
+	authante.FeegrantKeeper
+	GetAllowance(ctx sdk.Context, granter, grantee sdk.AccAddress) (feegrant.FeeAllowanceI, error)
+}
+
 type protoTxProvider interface {
 	GetProtoTx() *tx.Tx
 }
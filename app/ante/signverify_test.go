@@ -3,7 +3,9 @@ package ante_test
 import (
 	"math/big"
 
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 
 	"swisstronik/app/ante"
@@ -24,6 +26,20 @@ func (suite *AnteTestSuite) TestEthSigVerificationDecorator() {
 	err = unprotectedTx.Sign(ethtypes.HomesteadSigner{}, tests.NewTestSigner(privKey))
 	suite.Require().NoError(err)
 
+	feePayer, feePayerKey := tests.RandomEthAddressWithPrivateKey()
+	newFeeDelegatedTx := func(feePayer common.Address, feePayerKey cryptotypes.PrivKey) *evmtypes.MsgHandleTx {
+		tx := evmtypes.NewTxContract(suite.app.EvmKeeper.ChainID(), 1, big.NewInt(10), 1000, big.NewInt(1), nil, nil, nil, nil)
+		tx.From = addr.Hex()
+		suite.Require().NoError(tx.Sign(suite.ethSigner, tests.NewTestSigner(privKey)))
+		suite.Require().NoError(tx.SignAsFeePayer(feePayer, tests.NewTestSigner(feePayerKey)))
+		return tx
+	}
+
+	feeDelegatedTx := newFeeDelegatedTx(feePayer, feePayerKey)
+	invalidFeePayerTx := newFeeDelegatedTx(feePayer, feePayerKey)
+	invalidFeePayerTx.FeePayer = tests.RandomEthAddress().Hex()
+	senderFeePayerTx := newFeeDelegatedTx(addr, privKey)
+
 	testCases := []struct {
 		name                string
 		tx                  sdk.Tx
@@ -43,6 +59,9 @@ func (suite *AnteTestSuite) TestEthSigVerificationDecorator() {
 		{"successful signature verification", signedTx, false, false, true},
 		{"invalid, reject unprotected txs", unprotectedTx, false, false, false},
 		{"successful, allow unprotected txs", unprotectedTx, true, false, true},
+		{"successful fee payer signature verification", feeDelegatedTx, false, false, true},
+		{"invalid, fee payer doesn't match signature", invalidFeePayerTx, false, false, false},
+		{"invalid, fee payer is the sender", senderFeePayerTx, false, false, false},
 	}
 
 	for _, tc := range testCases {
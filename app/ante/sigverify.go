@@ -21,6 +21,7 @@ import (
 	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/common"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 
 	evmtypes "swisstronik/x/evm/types"
@@ -76,6 +77,15 @@ func (esvd EthSigVerificationDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, s
 
 		// set up the sender to the transaction field if not already
 		msgEthTx.From = sender.Hex()
+
+		if msgEthTx.IsFeeDelegated() {
+			if common.HexToAddress(msgEthTx.FeePayer) == sender {
+				return ctx, errorsmod.Wrap(evmtypes.ErrInvalidFeePayer, "fee payer must differ from the sender")
+			}
+			if err := msgEthTx.VerifyFeePayerSignature(); err != nil {
+				return ctx, errorsmod.Wrap(err, "failed to verify fee payer signature")
+			}
+		}
 	}
 
 	return next(ctx, tx, simulate)
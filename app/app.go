@@ -23,6 +23,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/runtime"
 	runtimeservices "github.com/cosmos/cosmos-sdk/runtime/services"
+	"github.com/cosmos/cosmos-sdk/server"
 	"github.com/cosmos/cosmos-sdk/server/api"
 	"github.com/cosmos/cosmos-sdk/server/config"
 	servertypes "github.com/cosmos/cosmos-sdk/server/types"
@@ -116,6 +117,7 @@ import (
 
 	evmante "swisstronik/app/ante"
 	"swisstronik/app/upgrades/v1_0_3"
+	"swisstronik/app/upgrades/v1_0_4"
 	"swisstronik/docs"
 	"swisstronik/encoding"
 	"swisstronik/ethereum/eip712"
@@ -125,6 +127,9 @@ import (
 	compliancemoduleclient "swisstronik/x/compliance/client"
 	compliancemodulekeeper "swisstronik/x/compliance/keeper"
 	compliancemoduletypes "swisstronik/x/compliance/types"
+	"swisstronik/x/erc20"
+	erc20keeper "swisstronik/x/erc20/keeper"
+	erc20types "swisstronik/x/erc20/types"
 	"swisstronik/x/evm"
 	evmkeeper "swisstronik/x/evm/keeper"
 	evmtypes "swisstronik/x/evm/types"
@@ -193,6 +198,7 @@ var (
 		// groupmodule.AppModuleBasic{},
 		consensus.AppModuleBasic{},
 		compliancemodule.AppModuleBasic{},
+		erc20.AppModuleBasic{},
 		// this line is used by starport scaffolding # stargate/app/moduleBasic
 	)
 
@@ -207,6 +213,7 @@ var (
 		govtypes.ModuleName:            {authtypes.Burner},
 		ibctransfertypes.ModuleName:    {authtypes.Minter, authtypes.Burner},
 		evmtypes.ModuleName:            {authtypes.Minter, authtypes.Burner}, // used for secure addition and subtraction of balance using module account
+		erc20types.ModuleName:          {authtypes.Minter, authtypes.Burner}, // used for conversion between ERC-20 tokens and native coins
 		// this line is used by starport scaffolding # stargate/app/maccPerms
 	}
 )
@@ -277,8 +284,12 @@ type App struct {
 
 	VestingKeeper    vestingmodulekeeper.Keeper
 	ComplianceKeeper compliancemodulekeeper.Keeper
+	Erc20Keeper      erc20keeper.Keeper
 	// this line is used by starport scaffolding # stargate/app/keeperDeclaration
 
+	// verifies submitted contract sources, nil if solc binary is not configured
+	contractVerifier *evmkeeper.ContractVerifier
+
 	// mm is the module manager
 	mm *module.Manager
 
@@ -334,7 +345,7 @@ func New(
 		ibcexported.StoreKey, ibctransfertypes.StoreKey, icahosttypes.StoreKey, capabilitytypes.StoreKey,
 		consensusparamtypes.StoreKey, group.StoreKey, icacontrollertypes.StoreKey, crisistypes.StoreKey,
 		evmtypes.StoreKey, feemarkettypes.StoreKey,
-		vestingmoduletypes.StoreKey, compliancemoduletypes.StoreKey,
+		vestingmoduletypes.StoreKey, compliancemoduletypes.StoreKey, erc20types.StoreKey,
 		// this line is used by starport scaffolding # stargate/app/storeKey
 	)
 	tkeys := sdk.NewTransientStoreKeys(paramstypes.TStoreKey, evmtypes.TransientKey, feemarkettypes.TransientKey)
@@ -492,6 +503,11 @@ func New(
 		app.AccountKeeper, app.BankKeeper, app.StakingKeeper, app.FeeMarketKeeper, app.ComplianceKeeper, evmSs,
 	)
 
+	app.Erc20Keeper = erc20keeper.NewKeeper(
+		appCodec, keys[erc20types.StoreKey], authtypes.NewModuleAddress(govtypes.ModuleName),
+		app.AccountKeeper, app.BankKeeper, app.EvmKeeper,
+	)
+
 	// ... other modules keepers
 
 	// Create IBC Keeper
@@ -613,6 +629,33 @@ func New(
 		),
 	)
 
+	app.EvmKeeper.SetHooks(
+		evmkeeper.NewMultiEvmHooks(
+			app.Erc20Keeper.Hooks(),
+		),
+	)
+	app.EvmKeeper.SetStakingMsgServers(
+		stakingkeeper.NewMsgServerImpl(&app.StakingKeeper),
+		distrkeeper.NewMsgServerImpl(app.DistrKeeper),
+	)
+	app.EvmKeeper.SetTransferMsgServers(
+		bankkeeper.NewMsgServerImpl(app.BankKeeper),
+		app.TransferKeeper,
+	)
+	app.EvmKeeper.SetGovKeepers(
+		govkeeper.NewMsgServerImpl(&app.GovKeeper),
+		&app.GovKeeper,
+	)
+	app.EvmKeeper.SetFeegrantKeeper(app.FeeGrantKeeper)
+	if solcPath := cast.ToString(appOpts.Get(srvflags.EVMSolcPath)); solcPath != "" {
+		verifierDB, err := dbm.NewDB("contract_verifier", server.GetAppDBBackend(appOpts), filepath.Join(homePath, "data"))
+		if err != nil {
+			panic(fmt.Sprintf("failed to open contract verifier database: %s", err))
+		}
+		app.contractVerifier = evmkeeper.NewContractVerifier(solcPath, verifierDB, logger.With("module", "contract_verifier"))
+		app.EvmKeeper.SetContractVerifier(app.contractVerifier)
+	}
+
 	/**** Module Options ****/
 
 	// NOTE: we may consider parsing `appOpts` inside module constructors. For the moment
@@ -650,6 +693,7 @@ func New(
 		evm.NewAppModule(app.EvmKeeper, app.AccountKeeper, evmSs),
 		vestingModule,
 		complianceModule,
+		erc20.NewAppModule(app.Erc20Keeper),
 		// this line is used by starport scaffolding # stargate/app/appModule
 	)
 
@@ -682,6 +726,7 @@ func New(
 		paramstypes.ModuleName,
 		vestingmoduletypes.ModuleName,
 		compliancemoduletypes.ModuleName,
+		erc20types.ModuleName,
 		consensusparamtypes.ModuleName,
 
 		// this line is used by starport scaffolding # stargate/app/beginBlockers
@@ -711,6 +756,7 @@ func New(
 		upgradetypes.ModuleName,
 		vestingmoduletypes.ModuleName,
 		compliancemoduletypes.ModuleName,
+		erc20types.ModuleName,
 		consensusparamtypes.ModuleName,
 		// this line is used by starport scaffolding # stargate/app/endBlockers
 	)
@@ -746,6 +792,7 @@ func New(
 		crisistypes.ModuleName,
 		vestingmoduletypes.ModuleName,
 		compliancemoduletypes.ModuleName,
+		erc20types.ModuleName,
 		consensusparamtypes.ModuleName,
 		// this line is used by starport scaffolding # stargate/app/initGenesis
 	)
@@ -873,6 +920,16 @@ func (app *App) GetTxConfig() client.TxConfig {
 	return cfg.TxConfig
 }
 
+// Close stops the contract verifier and closes its database
+func (app *App) Close() error {
+	if app.contractVerifier != nil {
+		if err := app.contractVerifier.Close(); err != nil {
+			return err
+		}
+	}
+	return app.BaseApp.Close()
+}
+
 // Name returns the name of the App
 func (app *App) Name() string { return app.BaseApp.Name() }
 
@@ -1065,6 +1122,12 @@ func (app *App) setupUpgradeHandlers() {
 			app.mm, app.configurator,
 		),
 	)
+	app.UpgradeKeeper.SetUpgradeHandler(
+		v1_0_4.UpgradeName,
+		v1_0_4.CreateUpgradeHandler(
+			app.mm, app.configurator,
+		),
+	)
 
 	// When a planned update height is reached, the old binary will panic
 	// writing on disk the height and name of the update that triggered it
@@ -1085,6 +1148,13 @@ func (app *App) setupUpgradeHandlers() {
 		// instead the default which is the latest version that store last committed i.e 0 for new stores.
 		app.SetStoreLoader(upgradetypes.UpgradeStoreLoader(upgradeInfo.Height, &storetypes.StoreUpgrades{}))
 	}
+
+	if upgradeInfo.Name == v1_0_4.UpgradeName {
+		// erc20 module is added in v1.0.4, its store is created at the upgrade height
+		app.SetStoreLoader(upgradetypes.UpgradeStoreLoader(upgradeInfo.Height, &storetypes.StoreUpgrades{
+			Added: []string{erc20types.StoreKey},
+		}))
+	}
 }
 
 // SimulationManager implements runtime.AppI
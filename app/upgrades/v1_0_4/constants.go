@@ -0,0 +1,5 @@
+package v1_0_4
+
+const (
+	UpgradeName = "v1.0.4"
+)
@@ -0,0 +1,187 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+
+	dbm "github.com/cometbft/cometbft-db"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdkserver "github.com/cosmos/cosmos-sdk/server"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cobra"
+
+	"swisstronik/app"
+	"swisstronik/x/evm/statefile"
+	evmtypes "swisstronik/x/evm/types"
+)
+
+const (
+	flagStateHeight    = "height"
+	flagStateShardSize = "shard-size"
+	flagStateResume    = "resume"
+)
+
+// EVMStateCmd returns commands to export and import the EVM state
+func EVMStateCmd(appCreator servertypes.AppCreator, defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "evm",
+		Short: "Commands to export and import the EVM state",
+		RunE:  client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		ExportEVMStateCmd(appCreator, defaultNodeHome),
+		ImportEVMStateCmd(appCreator, defaultNodeHome),
+	)
+
+	return cmd
+}
+
+// ExportEVMStateCmd returns export-state cobra Command.
+func ExportEVMStateCmd(appCreator servertypes.AppCreator, defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export-state OUTPUT_DIR",
+		Short: "Exports the EVM state to sharded files",
+		Long: `Exports accounts, contract code, contract storage, contract deployers and contract sources at provided
+height to sharded, checksummed files. Storage is exported in the form, stored by the node, so encrypted storage
+remains encrypted. Records are streamed without keeping the state in memory, and export of the same state always
+produces the same files. Interrupted export can be continued with --resume flag from the last completed shard.
+The node must be stopped during export.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			serverCtx := sdkserver.GetServerContextFromCmd(cmd)
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			height, err := cmd.Flags().GetInt64(flagStateHeight)
+			if err != nil {
+				return err
+			}
+			shardSize, err := cmd.Flags().GetUint64(flagStateShardSize)
+			if err != nil {
+				return err
+			}
+			resume, err := cmd.Flags().GetBool(flagStateResume)
+			if err != nil {
+				return err
+			}
+
+			swissApp, db, err := openEVMStateApp(serverCtx, appCreator)
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+
+			outputDir := args[0]
+			var writer *statefile.Writer
+			if resume {
+				if writer, err = statefile.ResumeWriter(outputDir, shardSize); err != nil {
+					return err
+				}
+				manifest := writer.Manifest()
+				if cmd.Flags().Changed(flagStateHeight) && height != manifest.Height {
+					return fmt.Errorf("export in %s is made at height %d, requested height %d", outputDir, manifest.Height, height)
+				}
+				height = manifest.Height
+			} else {
+				if height <= 0 {
+					height = swissApp.LastBlockHeight()
+				}
+				if writer, err = statefile.NewWriter(outputDir, clientCtx.ChainID, height, shardSize); err != nil {
+					return err
+				}
+			}
+
+			cms, err := swissApp.CommitMultiStore().CacheMultiStoreWithVersion(height)
+			if err != nil {
+				return fmt.Errorf("failed to load state at height %d: %w", height, err)
+			}
+			ctx := sdk.NewContext(cms, tmproto.Header{Height: height, ChainID: clientCtx.ChainID}, false, serverCtx.Logger)
+
+			cursor := writer.Manifest().Cursor()
+			if err := swissApp.EvmKeeper.ExportState(ctx, cursor, writer.Write); err != nil {
+				return err
+			}
+			if err := writer.Close(); err != nil {
+				return err
+			}
+
+			manifest := writer.Manifest()
+			return clientCtx.PrintString(fmt.Sprintf(
+				"exported %d records in %d shards at height %d to %s\n", manifest.Records(), len(manifest.Shards), height, outputDir,
+			))
+		},
+	}
+
+	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+	cmd.Flags().Int64(flagStateHeight, 0, "Height of the exported state, latest height is used if not provided")
+	cmd.Flags().Uint64(flagStateShardSize, 100_000, "Maximum amount of records in a single shard")
+	cmd.Flags().Bool(flagStateResume, false, "Continue interrupted export in the output directory")
+	return cmd
+}
+
+// ImportEVMStateCmd returns import-state cobra Command.
+func ImportEVMStateCmd(appCreator servertypes.AppCreator, defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "import-state INPUT_DIR",
+		Short: "Imports the EVM state from sharded files",
+		Long: `Imports the EVM state, exported by export-state command, directly into the application store without
+JSON genesis. Shards are verified against their checksums before their records are imported. The store must not
+contain any contract code or storage. Account balances are minted to match the exported ones. Imported state is
+committed as the next version of the application store. The node must be stopped during import.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			serverCtx := sdkserver.GetServerContextFromCmd(cmd)
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			swissApp, db, err := openEVMStateApp(serverCtx, appCreator)
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+
+			cms := swissApp.CommitMultiStore()
+			ctx := sdk.NewContext(cms, tmproto.Header{Height: swissApp.LastBlockHeight(), ChainID: clientCtx.ChainID}, false, serverCtx.Logger)
+			if swissApp.EvmKeeper.HasContractState(ctx) {
+				return errors.New("the EVM state can only be imported into a store without contract code and storage")
+			}
+
+			var records uint64
+			manifest, err := statefile.ReadState(args[0], func(record evmtypes.StateRecord) error {
+				records++
+				return swissApp.EvmKeeper.ImportStateRecord(ctx, record)
+			})
+			if err != nil {
+				return err
+			}
+
+			commitID := cms.Commit()
+			return clientCtx.PrintString(fmt.Sprintf(
+				"imported %d records, exported at height %d, committed version %d with app hash %X\n",
+				records, manifest.Height, commitID.Version, commitID.Hash,
+			))
+		},
+	}
+
+	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+	return cmd
+}
+
+// openEVMStateApp opens application database of the node and creates the application with the latest state
+func openEVMStateApp(serverCtx *sdkserver.Context, appCreator servertypes.AppCreator) (*app.App, dbm.DB, error) {
+	dataDir := filepath.Join(serverCtx.Config.RootDir, "data")
+	db, err := dbm.NewDB("application", sdkserver.GetAppDBBackend(serverCtx.Viper), dataDir)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	swissApp, ok := appCreator(serverCtx.Logger, db, nil, serverCtx.Viper).(*app.App)
+	if !ok {
+		_ = db.Close()
+		return nil, nil, errors.New("unexpected application type")
+	}
+	return swissApp, db, nil
+}
@@ -119,6 +119,7 @@ func NewRootCmd() (*cobra.Command, simappparams.EncodingConfig) {
 		DebugCmd(),
 		pruning.PruningCmd(a.newApp),
 		snapshot.Cmd(a.newApp),
+		EVMStateCmd(a.newApp, app.DefaultNodeHome),
 	)
 
 	evmmoduleserver.AddCommands(
@@ -32,3 +32,19 @@ func createEIP712Domain(chainID uint64) apitypes.TypedDataDomain {
 
 	return domain
 }
+
+// swisstronikDomainType is the type of domain, used by typed data, which authorize RPC requests
+var swisstronikDomainType = []apitypes.Type{
+	{Name: "name", Type: "string"},
+	{Name: "version", Type: "string"},
+	{Name: "chainId", Type: "uint256"},
+}
+
+// createSwisstronikDomain creates domain of typed data, which authorize RPC requests
+func createSwisstronikDomain(chainID uint64) apitypes.TypedDataDomain {
+	return apitypes.TypedDataDomain{
+		Name:    "Swisstronik",
+		Version: "1",
+		ChainId: math.NewHexOrDecimal256(int64(chainID)), // #nosec G701
+	}
+}
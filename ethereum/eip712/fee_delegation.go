@@ -0,0 +1,48 @@
+package eip712
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+)
+
+// FeeDelegationType is the primary type of typed data, signed by the fee payer of the Ethereum transaction to
+// agree to pay its fees instead of the sender. Sender, nonce, gas limit and fee cap are shown to the fee payer
+// by the wallet, so it can see the maximal fee it pays
+const FeeDelegationType = "FeeDelegation"
+
+// WrapFeeDelegationToTypedData creates EIP-712 typed data, which authorizes the fee payer to pay fees of the
+// transaction with provided hash. Transaction hash binds the signature to the exact signed transaction
+func WrapFeeDelegationToTypedData(
+	chainID uint64,
+	feePayer, sender common.Address,
+	nonce, gas uint64,
+	gasFeeCap *big.Int,
+	txHash common.Hash,
+) apitypes.TypedData {
+	return apitypes.TypedData{
+		Types: apitypes.Types{
+			"EIP712Domain": swisstronikDomainType,
+			FeeDelegationType: {
+				{Name: "feePayer", Type: "address"},
+				{Name: "sender", Type: "address"},
+				{Name: "nonce", Type: "uint64"},
+				{Name: "gas", Type: "uint64"},
+				{Name: "gasFeeCap", Type: "uint256"},
+				{Name: "txHash", Type: "bytes32"},
+			},
+		},
+		PrimaryType: FeeDelegationType,
+		Domain:      createSwisstronikDomain(chainID),
+		Message: apitypes.TypedDataMessage{
+			"feePayer":  feePayer.Hex(),
+			"sender":    sender.Hex(),
+			"nonce":     (*math.HexOrDecimal256)(new(big.Int).SetUint64(nonce)),
+			"gas":       (*math.HexOrDecimal256)(new(big.Int).SetUint64(gas)),
+			"gasFeeCap": (*math.HexOrDecimal256)(gasFeeCap),
+			"txHash":    txHash.Hex(),
+		},
+	}
+}
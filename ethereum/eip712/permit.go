@@ -0,0 +1,36 @@
+package eip712
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+)
+
+// CallPermitType is the primary type of typed data, signed by the holder of the account to authenticate
+// itself as the sender of `eth_call` to the contract. Permit can be reused until it expires, so it serves
+// as a viewing key for private view functions of the contract
+const CallPermitType = "CallPermit"
+
+// WrapCallPermitToTypedData creates EIP-712 typed data of the permit, which authenticates holder as the sender
+// of calls to the provided contract until expiry, which is unix timestamp in seconds
+func WrapCallPermitToTypedData(chainID uint64, holder, contract common.Address, expiry uint64) apitypes.TypedData {
+	return apitypes.TypedData{
+		Types: apitypes.Types{
+			"EIP712Domain": swisstronikDomainType,
+			CallPermitType: {
+				{Name: "holder", Type: "address"},
+				{Name: "contract", Type: "address"},
+				{Name: "expiry", Type: "uint64"},
+			},
+		},
+		PrimaryType: CallPermitType,
+		Domain:      createSwisstronikDomain(chainID),
+		Message: apitypes.TypedDataMessage{
+			"holder":   holder.Hex(),
+			"contract": contract.Hex(),
+			"expiry":   (*math.HexOrDecimal256)(new(big.Int).SetUint64(expiry)),
+		},
+	}
+}
@@ -0,0 +1,36 @@
+package eip712
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+)
+
+// StorageAccessType is the primary type of typed data, signed by the contract deployer or by an address,
+// authorized by the contract, to read decrypted storage of the contract
+const StorageAccessType = "StorageAccess"
+
+// WrapStorageAccessToTypedData creates EIP-712 typed data, which authorizes reading of decrypted storage of
+// the contract until expiry. Decrypted values are encrypted to provided x25519 public key of the requester
+func WrapStorageAccessToTypedData(chainID uint64, contract common.Address, publicKey []byte, expiry uint64) apitypes.TypedData {
+	return apitypes.TypedData{
+		Types: apitypes.Types{
+			"EIP712Domain": swisstronikDomainType,
+			StorageAccessType: {
+				{Name: "contract", Type: "address"},
+				{Name: "publicKey", Type: "bytes32"},
+				{Name: "expiry", Type: "uint64"},
+			},
+		},
+		PrimaryType: StorageAccessType,
+		Domain:      createSwisstronikDomain(chainID),
+		Message: apitypes.TypedDataMessage{
+			"contract":  contract.Hex(),
+			"publicKey": hexutil.Encode(common.LeftPadBytes(publicKey, 32)),
+			"expiry":    (*math.HexOrDecimal256)(new(big.Int).SetUint64(expiry)),
+		},
+	}
+}
@@ -0,0 +1,28 @@
+package eip712
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+)
+
+// TraceTransactionType is the primary type of typed data, signed by transaction sender
+// to authorize tracing of decrypted execution of the transaction
+const TraceTransactionType = "TraceTransaction"
+
+// WrapTraceTransactionToTypedData creates EIP-712 typed data, which should be signed by the sender
+// of the transaction with provided hash to obtain its decrypted trace.
+func WrapTraceTransactionToTypedData(chainID uint64, txHash common.Hash) apitypes.TypedData {
+	return apitypes.TypedData{
+		Types: apitypes.Types{
+			"EIP712Domain": swisstronikDomainType,
+			TraceTransactionType: {
+				{Name: "txHash", Type: "bytes32"},
+			},
+		},
+		PrimaryType: TraceTransactionType,
+		Domain:      createSwisstronikDomain(chainID),
+		Message: apitypes.TypedDataMessage{
+			"txHash": txHash.Hex(),
+		},
+	}
+}
@@ -0,0 +1,67 @@
+//go:build nosgx
+// +build nosgx
+
+package api
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// Domain and typed data match `ethereum/eip712` package of the chain and `eip712` module of SGX enclave
+var (
+	domainTypeHash           = crypto.Keccak256([]byte("EIP712Domain(string name,string version,uint256 chainId)"))
+	domainNameHash           = crypto.Keccak256([]byte("Swisstronik"))
+	domainVersionHash        = crypto.Keccak256([]byte("1"))
+	traceTransactionTypeHash = crypto.Keccak256([]byte("TraceTransaction(bytes32 txHash)"))
+	storageAccessTypeHash    = crypto.Keccak256([]byte("StorageAccess(address contract,bytes32 publicKey,uint64 expiry)"))
+)
+
+// traceTransactionHash returns hash of `TraceTransaction` typed data, which should be signed by the sender of
+// the transaction
+func traceTransactionHash(chainID uint64, txHash common.Hash) common.Hash {
+	return typedDataHash(chainID, crypto.Keccak256(traceTransactionTypeHash, txHash.Bytes()))
+}
+
+// storageAccessHash returns hash of `StorageAccess` typed data, which authorizes reading of decrypted storage
+// of the contract until expiry
+func storageAccessHash(chainID uint64, contract common.Address, publicKey common.Hash, expiry uint64) common.Hash {
+	structHash := crypto.Keccak256(
+		storageAccessTypeHash,
+		common.BytesToHash(contract.Bytes()).Bytes(),
+		publicKey.Bytes(),
+		common.BigToHash(new(big.Int).SetUint64(expiry)).Bytes(),
+	)
+	return typedDataHash(chainID, structHash)
+}
+
+// recoverTypedDataSigner recovers address of the account, which signed provided typed data hash
+func recoverTypedDataSigner(hash common.Hash, signature []byte) (common.Address, error) {
+	if len(signature) != crypto.SignatureLength {
+		return common.Address{}, fmt.Errorf("invalid signature length. Expected %d, Got: %d", crypto.SignatureLength, len(signature))
+	}
+	sig := common.CopyBytes(signature)
+	// Remove the recovery offset if needed (ie. Metamask eip712 signature)
+	if sig[crypto.RecoveryIDOffset] == 27 || sig[crypto.RecoveryIDOffset] == 28 {
+		sig[crypto.RecoveryIDOffset] -= 27
+	}
+	pubKey, err := crypto.SigToPub(hash.Bytes(), sig)
+	if err != nil {
+		return common.Address{}, err
+	}
+	return crypto.PubkeyToAddress(*pubKey), nil
+}
+
+// typedDataHash returns hash of typed data with provided struct hash, which is signed according to EIP-712
+func typedDataHash(chainID uint64, structHash []byte) common.Hash {
+	domainSeparator := crypto.Keccak256(
+		domainTypeHash,
+		domainNameHash,
+		domainVersionHash,
+		common.BigToHash(new(big.Int).SetUint64(chainID)).Bytes(),
+	)
+	return crypto.Keccak256Hash([]byte{0x19, 0x01}, domainSeparator, structHash)
+}
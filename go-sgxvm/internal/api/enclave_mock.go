@@ -0,0 +1,331 @@
+//go:build nosgx
+// +build nosgx
+
+package api
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/SigmaGmbH/librustgo/types"
+	"github.com/oasisprotocol/deoxysii"
+	"golang.org/x/crypto/curve25519"
+)
+
+// Mock enclave is used in nosgx builds instead of SGX enclave. It keeps deterministic master key
+// and derives genesis epoch key from it, so nodes and tests without SGX can handle encrypted transactions
+// and state in the same way as SGX-enabled nodes do. Like SGX key manager, it creates keys of added
+// epochs from fresh randomness. It must never be used in production.
+
+const (
+	publicKeySize          = 32
+	functionSelectorLen    = 4
+	publicKeyOnlyDataLen   = 36
+	encryptedDataLen       = 79
+	prefixedEncryptedLen   = 81
+	minEncryptedValueLen   = deoxysii.NonceSize + deoxysii.TagSize + deoxysii.TagSize
+	txKeyPrefix            = "TransactionEncryptionKeyV1"
+	stateKeyPrefix         = "StateEncryptionKeyV1"
+	ioEncryptionKeyPrefix  = "IOEncryptionKeyV1"
+	mockEpochKeyPrefix     = "MockEpochKeyV1"
+	mockMasterKeyPreimage  = "swisstronik-mock-enclave-master-key"
+	mockGenesisEpochNumber = 0
+)
+
+var (
+	// mockMasterKey is deterministic test master key. Genesis epoch key is derived from it
+	mockMasterKey = sha256.Sum256([]byte(mockMasterKeyPreimage))
+
+	mockKeyManager = newMockEpochManager()
+
+	errDecryption = errors.New("DecryptionError { msg: \"Cannot decrypt value\" }")
+)
+
+// mockEpoch contains epoch data stored by mock enclave
+type mockEpoch struct {
+	epochNumber   uint16
+	startingBlock uint64
+	epochKey      [32]byte
+}
+
+// txKey returns x25519 private key used to decrypt incoming transactions and to encrypt enclave output
+func (e mockEpoch) txKey() []byte {
+	return deriveKey(e.epochKey[:], []byte(txKeyPrefix))
+}
+
+// stateKey returns symmetric key used to encrypt contract storage
+func (e mockEpoch) stateKey() []byte {
+	return deriveKey(e.epochKey[:], []byte(stateKeyPrefix))
+}
+
+// publicKey returns node public key for this epoch
+func (e mockEpoch) publicKey() []byte {
+	publicKey, _ := curve25519.X25519(e.txKey(), curve25519.Basepoint)
+	return publicKey
+}
+
+// mockEpochManager mirrors EpochManager from SGX enclave
+type mockEpochManager struct {
+	mtx    sync.RWMutex
+	epochs []mockEpoch
+}
+
+func newMockEpochManager() *mockEpochManager {
+	return &mockEpochManager{epochs: []mockEpoch{newMockGenesisEpoch()}}
+}
+
+// newMockGenesisEpoch derives genesis epoch key from the mock master key
+func newMockGenesisEpoch() mockEpoch {
+	salt := binary.BigEndian.AppendUint16([]byte(mockEpochKeyPrefix), mockGenesisEpochNumber)
+	epoch := mockEpoch{epochNumber: mockGenesisEpochNumber}
+	copy(epoch.epochKey[:], deriveKey(mockMasterKey[:], salt))
+	return epoch
+}
+
+// reset removes all epochs except genesis one
+func (m *mockEpochManager) reset() {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+	m.epochs = []mockEpoch{newMockGenesisEpoch()}
+}
+
+// addEpoch adds new epoch with random key, which starts from provided block. Mock enclave acts as
+// its own key manager, so the epoch is created instead of being provisioned. As in SGX enclave,
+// adding an existing epoch again is a no-op
+func (m *mockEpochManager) addEpoch(startingBlock uint64) error {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	latest := m.epochs[0]
+	for _, epoch := range m.epochs {
+		if epoch.startingBlock == startingBlock {
+			return nil
+		}
+		if epoch.startingBlock > startingBlock {
+			return fmt.Errorf("there is already existing epoch with greater starting block. Existing: %d, Provided: %d", epoch.startingBlock, startingBlock)
+		}
+		if epoch.epochNumber > latest.epochNumber {
+			latest = epoch
+		}
+	}
+
+	epoch := mockEpoch{epochNumber: latest.epochNumber + 1, startingBlock: startingBlock}
+	if _, err := rand.Read(epoch.epochKey[:]); err != nil {
+		return fmt.Errorf("cannot create random epoch key: %w", err)
+	}
+	m.epochs = append(m.epochs, epoch)
+	return nil
+}
+
+// removeLatestEpoch removes epoch with the greatest epoch number
+func (m *mockEpochManager) removeLatestEpoch() error {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	if len(m.epochs) <= 1 {
+		return errors.New("cannot remove last epoch")
+	}
+
+	latest := 0
+	for i, epoch := range m.epochs {
+		if epoch.epochNumber > m.epochs[latest].epochNumber {
+			latest = i
+		}
+	}
+	m.epochs = append(m.epochs[:latest], m.epochs[latest+1:]...)
+	return nil
+}
+
+// listEpochs returns epoch number, starting block and node public key of each stored epoch
+func (m *mockEpochManager) listEpochs() []*types.EpochData {
+	m.mtx.RLock()
+	defer m.mtx.RUnlock()
+
+	output := make([]*types.EpochData, 0, len(m.epochs))
+	for _, epoch := range m.epochs {
+		output = append(output, &types.EpochData{
+			EpochNumber:   uint32(epoch.epochNumber),
+			StartingBlock: epoch.startingBlock,
+			NodePublicKey: epoch.publicKey(),
+		})
+	}
+	return output
+}
+
+// currentEpoch returns epoch which is active at provided block
+func (m *mockEpochManager) currentEpoch(blockNumber uint64) mockEpoch {
+	m.mtx.RLock()
+	defer m.mtx.RUnlock()
+
+	current := m.epochs[0]
+	for _, epoch := range m.epochs {
+		if epoch.startingBlock > current.startingBlock && epoch.startingBlock <= blockNumber {
+			current = epoch
+		}
+	}
+	return current
+}
+
+// epoch returns epoch with provided number
+func (m *mockEpochManager) epoch(epochNumber uint16) (mockEpoch, bool) {
+	m.mtx.RLock()
+	defer m.mtx.RUnlock()
+
+	for _, epoch := range m.epochs {
+		if epoch.epochNumber == epochNumber {
+			return epoch, true
+		}
+	}
+	return mockEpoch{}, false
+}
+
+// encryptStorageCell encrypts storage value using state key of the epoch, active at provided block.
+// Output is prefixed with 2-byte epoch number, as SGX enclave does.
+func (m *mockEpochManager) encryptStorageCell(contractAddress []byte, blockNumber uint64, salt, value []byte) ([]byte, error) {
+	epoch := m.currentEpoch(blockNumber)
+	contractKey := deriveKey(epoch.stateKey(), contractAddress)
+	encryptedValue, err := encryptDeoxys(contractKey, value, salt)
+	if err != nil {
+		return nil, err
+	}
+	return append(binary.BigEndian.AppendUint16(nil, epoch.epochNumber), encryptedValue...), nil
+}
+
+// decryptStorageCell decrypts storage value, encrypted by `encryptStorageCell`. Values without epoch prefix
+// are handled as encrypted with genesis epoch key
+func (m *mockEpochManager) decryptStorageCell(contractAddress, encryptedValue []byte) ([]byte, error) {
+	// Zeroed value means that storage slot was not initialized
+	if len(encryptedValue) == 32 && isZero(encryptedValue) {
+		return encryptedValue, nil
+	}
+
+	var epochNumber uint16
+	switch len(encryptedValue) {
+	case encryptedDataLen:
+		epochNumber = mockGenesisEpochNumber
+	case prefixedEncryptedLen:
+		epochNumber = binary.BigEndian.Uint16(encryptedValue[:2])
+		encryptedValue = encryptedValue[2:]
+	default:
+		return nil, fmt.Errorf("invalid encrypted value length. Expected 79 or 81, Got: %d", len(encryptedValue))
+	}
+
+	epoch, found := m.epoch(epochNumber)
+	if !found {
+		return nil, errors.New("key for epoch not found")
+	}
+
+	contractKey := deriveKey(epoch.stateKey(), contractAddress)
+	return decryptDeoxys(contractKey, encryptedValue)
+}
+
+// decryptTransactionData decrypts transaction data using shared secret of user public key and
+// transaction key of the epoch, active at provided block
+func (m *mockEpochManager) decryptTransactionData(encryptedData, userPublicKey []byte, blockNumber uint64) ([]byte, error) {
+	encryptionKey, err := m.ioEncryptionKey(userPublicKey, blockNumber)
+	if err != nil {
+		return nil, err
+	}
+	plaintext, err := decryptDeoxys(encryptionKey, encryptedData)
+	if err != nil {
+		return nil, errDecryption
+	}
+	return plaintext, nil
+}
+
+// encryptTransactionData encrypts enclave output, so it can be decrypted by user with `DecryptECDH`
+func (m *mockEpochManager) encryptTransactionData(data, userPublicKey, salt []byte, blockNumber uint64) ([]byte, error) {
+	encryptionKey, err := m.ioEncryptionKey(userPublicKey, blockNumber)
+	if err != nil {
+		return nil, err
+	}
+	return encryptDeoxys(encryptionKey, data, salt)
+}
+
+func (m *mockEpochManager) ioEncryptionKey(userPublicKey []byte, blockNumber uint64) ([]byte, error) {
+	if len(userPublicKey) != publicKeySize {
+		return nil, errors.New("ECDHError { msg: \"Wrong public key size\" }")
+	}
+	epoch := m.currentEpoch(blockNumber)
+	// SGX enclave doesn't reject low order points, so shared secret is computed without such check
+	var privateKey, publicKey, sharedSecret [32]byte
+	copy(privateKey[:], epoch.txKey())
+	copy(publicKey[:], userPublicKey)
+	curve25519.ScalarMult(&sharedSecret, &privateKey, &publicKey)
+	return deriveKey(sharedSecret[:], []byte(ioEncryptionKeyPrefix)), nil
+}
+
+// extractPublicKeyAndData splits transaction data into user public key, encrypted data and nonce.
+// If data contains only zero function selector and public key, encrypted data and nonce are empty
+func extractPublicKeyAndData(txData []byte) ([]byte, []byte, []byte, error) {
+	if len(txData) == publicKeyOnlyDataLen && isZero(txData[:functionSelectorLen]) {
+		return txData[functionSelectorLen:], nil, nil, nil
+	}
+
+	if len(txData) < encryptedDataLen {
+		return nil, nil, nil, errors.New("ECDHError { msg: \"Wrong public key size\" }")
+	}
+
+	encryptedData := txData[publicKeySize:]
+	return txData[:publicKeySize], encryptedData, encryptedData[:deoxysii.NonceSize], nil
+}
+
+// encryptDeoxys encrypts plaintext using DEOXYS-II. If salt is provided, nonce is derived from it,
+// otherwise random nonce is used. Output contains nonce, additional data and ciphertext
+func encryptDeoxys(encryptionKey, plaintext, salt []byte) ([]byte, error) {
+	var nonce [deoxysii.NonceSize]byte
+	if salt != nil {
+		hashedSalt := sha256.Sum256(salt)
+		copy(nonce[:], hashedSalt[:])
+	} else if _, err := rand.Read(nonce[:]); err != nil {
+		return nil, fmt.Errorf("cannot generate nonce: %w", err)
+	}
+	ad := make([]byte, deoxysii.TagSize)
+
+	cipher, err := deoxysii.New(encryptionKey)
+	if err != nil {
+		return nil, err
+	}
+
+	ciphertext := cipher.Seal(nil, nonce[:], plaintext, ad)
+	result := append(nonce[:], ad...)
+	return append(result, ciphertext...), nil
+}
+
+// decryptDeoxys decrypts DEOXYS-II ciphertext produced by `encryptDeoxys`
+func decryptDeoxys(encryptionKey, encryptedValue []byte) ([]byte, error) {
+	if len(encryptedValue) < minEncryptedValueLen {
+		return nil, errors.New("corrupted ciphertext")
+	}
+
+	nonce := encryptedValue[:deoxysii.NonceSize]
+	ad := encryptedValue[deoxysii.NonceSize : deoxysii.NonceSize+deoxysii.TagSize]
+	ciphertext := encryptedValue[deoxysii.NonceSize+deoxysii.TagSize:]
+
+	cipher, err := deoxysii.New(encryptionKey)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.Open(nil, nonce, ciphertext, ad)
+}
+
+// deriveKey derives key from master key and salt using HMAC-SHA256, as `DeriveEncryptionKey` does
+func deriveKey(masterKey, salt []byte) []byte {
+	hash := hmac.New(sha256.New, salt)
+	hash.Write(masterKey)
+	return hash.Sum(nil)
+}
+
+func isZero(data []byte) bool {
+	for _, b := range data {
+		if b != 0 {
+			return false
+		}
+	}
+	return true
+}
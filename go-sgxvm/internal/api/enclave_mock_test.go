@@ -0,0 +1,165 @@
+//go:build nosgx
+// +build nosgx
+
+package api
+
+import (
+	"crypto/rand"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/SigmaGmbH/librustgo/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/curve25519"
+)
+
+func TestMockEpochManager(t *testing.T) {
+	manager := newMockEpochManager()
+
+	require.NoError(t, manager.addEpoch(10))
+	require.NoError(t, manager.addEpoch(20))
+	require.Error(t, manager.addEpoch(15))
+	// adding existing epoch again is a no-op
+	require.NoError(t, manager.addEpoch(20))
+
+	epochs := manager.listEpochs()
+	require.Len(t, epochs, 3)
+	require.Equal(t, uint32(2), epochs[2].EpochNumber)
+	require.Equal(t, uint64(20), epochs[2].StartingBlock)
+
+	require.Equal(t, uint16(0), manager.currentEpoch(9).epochNumber)
+	require.Equal(t, uint16(1), manager.currentEpoch(10).epochNumber)
+	require.Equal(t, uint16(2), manager.currentEpoch(100).epochNumber)
+
+	// Genesis epoch key is deterministic
+	require.Equal(t, newMockEpochManager().listEpochs()[0].NodePublicKey, epochs[0].NodePublicKey)
+	require.NotEqual(t, epochs[0].NodePublicKey, epochs[1].NodePublicKey)
+
+	// Keys of added epochs are random, so they cannot be derived from the genesis epoch and starting block
+	other := newMockEpochManager()
+	require.NoError(t, other.addEpoch(20))
+	require.NotEqual(t, epochs[2].NodePublicKey, other.listEpochs()[1].NodePublicKey)
+
+	require.NoError(t, manager.removeLatestEpoch())
+	require.NoError(t, manager.removeLatestEpoch())
+	require.Error(t, manager.removeLatestEpoch())
+	require.Len(t, manager.listEpochs(), 1)
+}
+
+func TestMockStorageEncryption(t *testing.T) {
+	manager := newMockEpochManager()
+	require.NoError(t, manager.addEpoch(10))
+
+	contract := []byte{0x01, 0x02, 0x03}
+	value := make([]byte, 32)
+	value[31] = 0x2a
+
+	encryptedGenesis, err := manager.encryptStorageCell(contract, 1, []byte{0x01}, value)
+	require.NoError(t, err)
+	require.Len(t, encryptedGenesis, prefixedEncryptedLen)
+
+	encryptedLatest, err := manager.encryptStorageCell(contract, 10, []byte{0x01}, value)
+	require.NoError(t, err)
+	require.NotEqual(t, encryptedGenesis[2:], encryptedLatest[2:])
+
+	// Values encrypted in previous epochs are still readable
+	for _, encrypted := range [][]byte{encryptedGenesis, encryptedLatest} {
+		decrypted, err := manager.decryptStorageCell(contract, encrypted)
+		require.NoError(t, err)
+		require.Equal(t, value, decrypted)
+	}
+
+	// Value without epoch prefix is treated as encrypted with genesis key
+	decrypted, err := manager.decryptStorageCell(contract, encryptedGenesis[2:])
+	require.NoError(t, err)
+	require.Equal(t, value, decrypted)
+
+	_, err = manager.decryptStorageCell([]byte{0x04}, encryptedGenesis)
+	require.Error(t, err)
+}
+
+func TestMockTransactionEncryption(t *testing.T) {
+	manager := newMockEpochManager()
+
+	userPrivateKey := make([]byte, 32)
+	_, err := rand.Read(userPrivateKey)
+	require.NoError(t, err)
+	userPublicKey, err := curve25519.X25519(userPrivateKey, curve25519.Basepoint)
+	require.NoError(t, err)
+
+	// Encrypt data in the same way as client does
+	sharedSecret, err := curve25519.X25519(userPrivateKey, manager.currentEpoch(1).publicKey())
+	require.NoError(t, err)
+	userKey := deriveKey(sharedSecret, []byte(ioEncryptionKeyPrefix))
+	encrypted, err := encryptDeoxys(userKey, []byte("calldata"), nil)
+	require.NoError(t, err)
+
+	publicKey, encryptedData, nonce, err := extractPublicKeyAndData(append(userPublicKey, encrypted...))
+	require.NoError(t, err)
+	require.Equal(t, userPublicKey, publicKey)
+
+	decrypted, err := manager.decryptTransactionData(encryptedData, publicKey, 1)
+	require.NoError(t, err)
+	require.Equal(t, []byte("calldata"), decrypted)
+
+	encryptedOutput, err := manager.encryptTransactionData([]byte("output"), publicKey, nonce, 1)
+	require.NoError(t, err)
+	output, err := decryptDeoxys(userKey, encryptedOutput)
+	require.NoError(t, err)
+	require.Equal(t, []byte("output"), output)
+
+	// Data encrypted for previous epoch cannot be decrypted after key rotation
+	require.NoError(t, manager.addEpoch(5))
+	_, err = manager.decryptTransactionData(encryptedData, publicKey, 5)
+	require.ErrorIs(t, err, errDecryption)
+}
+
+// failingConnector fails every request to the chain state
+type failingConnector struct{}
+
+func (failingConnector) Query([]byte) ([]byte, error) {
+	return nil, errors.New("state is not available")
+}
+
+func TestMockPrivateStorage(t *testing.T) {
+	mockKeyManager.reset()
+
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	contract := common.BigToAddress(big.NewInt(1))
+	userPublicKey := make([]byte, 32)
+	_, err = rand.Read(userPublicKey)
+	require.NoError(t, err)
+	txContext := &types.TransactionContext{ChainId: 1291, BlockNumber: 1, Timestamp: 100}
+
+	sign := func(contract common.Address, publicKey []byte, expiry uint64) []byte {
+		signature, err := crypto.Sign(storageAccessHash(txContext.ChainId, contract, common.BytesToHash(publicKey), expiry).Bytes(), key)
+		require.NoError(t, err)
+		return signature
+	}
+
+	testCases := []struct {
+		name     string
+		req      *types.PrivateStorageRequest
+		expError string
+	}{
+		{"empty context", &types.PrivateStorageRequest{ContractAddress: contract.Bytes(), UserPublicKey: userPublicKey, Expiry: 200, Signature: sign(contract, userPublicKey, 200)}, "empty transaction context"},
+		{"invalid contract address", &types.PrivateStorageRequest{ContractAddress: []byte{0x01}, UserPublicKey: userPublicKey, Expiry: 200, Signature: sign(contract, userPublicKey, 200), Context: txContext}, "invalid contract address"},
+		{"invalid public key", &types.PrivateStorageRequest{ContractAddress: contract.Bytes(), UserPublicKey: []byte{0x01}, Expiry: 200, Signature: sign(contract, userPublicKey, 200), Context: txContext}, "invalid public key"},
+		{"expired signature", &types.PrivateStorageRequest{ContractAddress: contract.Bytes(), UserPublicKey: userPublicKey, Expiry: 100, Signature: sign(contract, userPublicKey, 100), Context: txContext}, "signature expired"},
+		{"invalid signature", &types.PrivateStorageRequest{ContractAddress: contract.Bytes(), UserPublicKey: userPublicKey, Expiry: 200, Signature: []byte{0x01}, Context: txContext}, "invalid signature length"},
+		// Access of recovered signer is checked against chain state
+		{"state is not available", &types.PrivateStorageRequest{ContractAddress: contract.Bytes(), UserPublicKey: userPublicKey, Expiry: 200, Signature: sign(contract, userPublicKey, 200), Context: txContext}, "state is not available"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := PrivateStorage(failingConnector{}, tc.req)
+			require.NoError(t, err)
+			require.Empty(t, res.EncryptedValues)
+			require.Contains(t, res.Error, tc.expError)
+		})
+	}
+}
@@ -0,0 +1,1007 @@
+//go:build nosgx
+// +build nosgx
+
+package api
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+
+	"github.com/SigmaGmbH/librustgo/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/params"
+	"google.golang.org/protobuf/proto"
+)
+
+// minimalGasUsed is reported if transaction failed before execution, as SGX enclave does
+const minimalGasUsed = 21000
+
+// storageViewerGasLimit is gas limit of `isStorageViewer(address)` call, which checks access to contract storage
+const storageViewerGasLimit = 100_000
+
+// isStorageViewerSelector is selector of `isStorageViewer(address)` function
+var isStorageViewerSelector = []byte{0x80, 0x06, 0xe1, 0x77}
+
+var _ vm.StateDB = &mockStateDB{}
+
+// mockAccount contains account state loaded through connector and changes made during execution
+type mockAccount struct {
+	exists    bool
+	balance   *big.Int
+	nonce     uint64
+	code      []byte
+	codeDirty bool
+	storage   map[common.Hash]common.Hash
+	suicided  bool
+	dirty     bool
+}
+
+func (a *mockAccount) copy() *mockAccount {
+	cpy := *a
+	cpy.balance = new(big.Int).Set(a.balance)
+	cpy.storage = make(map[common.Hash]common.Hash, len(a.storage))
+	for key, value := range a.storage {
+		cpy.storage[key] = value
+	}
+	return &cpy
+}
+
+// mockSnapshot contains copy of journaled state, which is restored on revert
+type mockSnapshot struct {
+	accounts   map[common.Address]*mockAccount
+	accessList map[common.Address]map[common.Hash]struct{}
+	refund     uint64
+	logsLen    int
+}
+
+// mockStateDB implements go-ethereum StateDB on top of the Connector. All reads go through the same
+// protobuf requests which SGX enclave uses, storage values are decrypted / encrypted by mock key manager.
+// Changes are kept in memory and written back only if transaction should be committed.
+type mockStateDB struct {
+	connector Connector
+	context   *types.TransactionContext
+
+	accounts   map[common.Address]*mockAccount
+	committed  map[common.Address]map[common.Hash]common.Hash
+	accessList map[common.Address]map[common.Hash]struct{}
+	refund     uint64
+	logs       []*ethtypes.Log
+	snapshots  []mockSnapshot
+
+	// err contains first error returned by connector
+	err error
+}
+
+func newMockStateDB(connector Connector, context *types.TransactionContext) *mockStateDB {
+	return &mockStateDB{
+		connector:  connector,
+		context:    context,
+		accounts:   make(map[common.Address]*mockAccount),
+		committed:  make(map[common.Address]map[common.Hash]common.Hash),
+		accessList: make(map[common.Address]map[common.Hash]struct{}),
+	}
+}
+
+// query passes request to connector and decodes response. Panics in Go callbacks are converted
+// into errors, as it is done for SGX enclave in `recoverPanic`
+func (s *mockStateDB) query(request *types.CosmosRequest, response proto.Message) (err error) {
+	defer func() {
+		if rec := recover(); rec != nil {
+			err = fmt.Errorf("panic in Go callback: %v", rec)
+		}
+	}()
+
+	reqBytes, err := proto.Marshal(request)
+	if err != nil {
+		return err
+	}
+	result, err := s.connector.Query(reqBytes)
+	if err != nil {
+		return err
+	}
+	return proto.Unmarshal(result, response)
+}
+
+// write passes state-changing request to connector. As in SGX enclave, failed write is reported
+// with provided message
+func (s *mockStateDB) write(failureMessage string, request *types.CosmosRequest, response proto.Message) error {
+	if err := s.query(request, response); err != nil {
+		return fmt.Errorf("%s. Empty response", failureMessage)
+	}
+	return nil
+}
+
+// batch passes requests to connector in a single batch request and decodes responses in the same order
+func (s *mockStateDB) batch(requests []*types.CosmosRequest, responses []proto.Message) error {
+	encodedRequests := make([][]byte, 0, len(requests))
+	for _, request := range requests {
+		encoded, err := proto.Marshal(request)
+		if err != nil {
+			return err
+		}
+		encodedRequests = append(encodedRequests, encoded)
+	}
+
+	batchResponse := &types.QueryBatchResponse{}
+	if err := s.query(&types.CosmosRequest{Req: &types.CosmosRequest_Batch{
+		Batch: &types.QueryBatch{Requests: encodedRequests},
+	}}, batchResponse); err != nil {
+		return err
+	}
+	if len(batchResponse.Responses) != len(requests) {
+		return fmt.Errorf("expected %d responses in batch, got %d", len(requests), len(batchResponse.Responses))
+	}
+
+	for i, response := range responses {
+		if response == nil {
+			continue
+		}
+		if err := proto.Unmarshal(batchResponse.Responses[i], response); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *mockStateDB) setError(err error) {
+	if s.err == nil && err != nil {
+		s.err = err
+	}
+}
+
+func (s *mockStateDB) getAccount(addr common.Address) *mockAccount {
+	if acct, found := s.accounts[addr]; found {
+		return acct
+	}
+	s.prefetch(map[common.Address][]common.Hash{addr: nil})
+	return s.accounts[addr]
+}
+
+// prefetch loads provided accounts and their storage cells in a single batch request, as SGX enclave
+// does for transaction recipient and access list before execution. Already loaded state is skipped
+func (s *mockStateDB) prefetch(state map[common.Address][]common.Hash) {
+	addresses := make([]common.Address, 0, len(state))
+	for addr := range state {
+		addresses = append(addresses, addr)
+	}
+	sort.Slice(addresses, func(i, j int) bool {
+		return bytes.Compare(addresses[i].Bytes(), addresses[j].Bytes()) < 0
+	})
+
+	var (
+		requests  []*types.CosmosRequest
+		responses []proto.Message
+		loaders   []func()
+	)
+	for _, addr := range addresses {
+		addr := addr
+		if _, found := s.accounts[addr]; !found {
+			containsResponse := &types.QueryContainsKeyResponse{}
+			accountResponse := &types.QueryGetAccountResponse{}
+			codeResponse := &types.QueryGetAccountCodeResponse{}
+			requests = append(requests,
+				&types.CosmosRequest{Req: &types.CosmosRequest_ContainsKey{
+					ContainsKey: &types.QueryContainsKey{Key: addr.Bytes()},
+				}},
+				&types.CosmosRequest{Req: &types.CosmosRequest_GetAccount{
+					GetAccount: &types.QueryGetAccount{Address: addr.Bytes()},
+				}},
+				&types.CosmosRequest{Req: &types.CosmosRequest_AccountCode{
+					AccountCode: &types.QueryGetAccountCode{Address: addr.Bytes()},
+				}},
+			)
+			responses = append(responses, containsResponse, accountResponse, codeResponse)
+			loaders = append(loaders, func() {
+				s.accounts[addr] = &mockAccount{
+					exists:  containsResponse.Contains,
+					balance: new(big.Int).SetBytes(accountResponse.Balance),
+					nonce:   accountResponse.Nonce,
+					code:    codeResponse.Code,
+					storage: make(map[common.Hash]common.Hash),
+				}
+			})
+		}
+
+		for _, key := range state[addr] {
+			key := key
+			if _, found := s.committed[addr][key]; found {
+				continue
+			}
+			cellResponse := &types.QueryGetAccountStorageCellResponse{}
+			requests = append(requests, &types.CosmosRequest{Req: &types.CosmosRequest_StorageCell{
+				StorageCell: &types.QueryGetAccountStorageCell{Address: addr.Bytes(), Index: key.Bytes()},
+			}})
+			responses = append(responses, cellResponse)
+			loaders = append(loaders, func() {
+				s.setCommittedState(addr, key, cellResponse.Value)
+			})
+		}
+	}
+	if len(requests) == 0 {
+		return
+	}
+
+	// As for separate requests, state is loaded even if connector failed, so execution can proceed
+	// and the error is reported after it
+	s.setError(s.batch(requests, responses))
+	for _, load := range loaders {
+		load()
+	}
+}
+
+func (s *mockStateDB) CreateAccount(addr common.Address) {
+	acct := s.getAccount(addr)
+	acct.exists = true
+	acct.dirty = true
+}
+
+func (s *mockStateDB) SubBalance(addr common.Address, amount *big.Int) {
+	acct := s.getAccount(addr)
+	acct.balance = new(big.Int).Sub(acct.balance, amount)
+	acct.dirty = true
+}
+
+func (s *mockStateDB) AddBalance(addr common.Address, amount *big.Int) {
+	acct := s.getAccount(addr)
+	acct.balance = new(big.Int).Add(acct.balance, amount)
+	acct.dirty = true
+}
+
+func (s *mockStateDB) GetBalance(addr common.Address) *big.Int {
+	return new(big.Int).Set(s.getAccount(addr).balance)
+}
+
+func (s *mockStateDB) GetNonce(addr common.Address) uint64 {
+	return s.getAccount(addr).nonce
+}
+
+func (s *mockStateDB) SetNonce(addr common.Address, nonce uint64) {
+	acct := s.getAccount(addr)
+	acct.nonce = nonce
+	acct.dirty = true
+}
+
+func (s *mockStateDB) GetCodeHash(addr common.Address) common.Hash {
+	acct := s.getAccount(addr)
+	if !acct.exists && acct.balance.Sign() == 0 && acct.nonce == 0 && len(acct.code) == 0 {
+		return common.Hash{}
+	}
+	return crypto.Keccak256Hash(acct.code)
+}
+
+func (s *mockStateDB) GetCode(addr common.Address) []byte {
+	return s.getAccount(addr).code
+}
+
+func (s *mockStateDB) SetCode(addr common.Address, code []byte) {
+	acct := s.getAccount(addr)
+	acct.code = code
+	acct.codeDirty = true
+	acct.dirty = true
+}
+
+func (s *mockStateDB) GetCodeSize(addr common.Address) int {
+	return len(s.getAccount(addr).code)
+}
+
+func (s *mockStateDB) AddRefund(gas uint64) {
+	s.refund += gas
+}
+
+func (s *mockStateDB) SubRefund(gas uint64) {
+	if gas > s.refund {
+		panic(fmt.Sprintf("refund counter below zero (gas: %d > refund: %d)", gas, s.refund))
+	}
+	s.refund -= gas
+}
+
+func (s *mockStateDB) GetRefund() uint64 {
+	return s.refund
+}
+
+// GetCommittedState returns original value of storage slot. SGXVM backend doesn't provide original
+// storage values, so SSTORE is always charged as for dirty slot. Mock returns empty value to keep gas
+// consumption the same as in SGX enclave
+func (s *mockStateDB) GetCommittedState(common.Address, common.Hash) common.Hash {
+	return common.Hash{}
+}
+
+func (s *mockStateDB) GetState(addr common.Address, key common.Hash) common.Hash {
+	if value, found := s.getAccount(addr).storage[key]; found {
+		return value
+	}
+	return s.loadState(addr, key)
+}
+
+// loadState returns decrypted storage value, stored outside of enclave
+func (s *mockStateDB) loadState(addr common.Address, key common.Hash) common.Hash {
+	if value, found := s.committed[addr][key]; found {
+		return value
+	}
+
+	response := &types.QueryGetAccountStorageCellResponse{}
+	err := s.query(&types.CosmosRequest{Req: &types.CosmosRequest_StorageCell{
+		StorageCell: &types.QueryGetAccountStorageCell{Address: addr.Bytes(), Index: key.Bytes()},
+	}}, response)
+	s.setError(err)
+
+	return s.setCommittedState(addr, key, response.Value)
+}
+
+// setCommittedState decrypts storage value, stored outside of enclave, and keeps it for further reads
+func (s *mockStateDB) setCommittedState(addr common.Address, key common.Hash, encryptedValue []byte) common.Hash {
+	storage, found := s.committed[addr]
+	if !found {
+		storage = make(map[common.Hash]common.Hash)
+		s.committed[addr] = storage
+	}
+
+	var value common.Hash
+	if len(encryptedValue) != 0 {
+		decrypted, err := mockKeyManager.decryptStorageCell(addr.Bytes(), encryptedValue)
+		s.setError(err)
+		value = common.BytesToHash(decrypted)
+	}
+
+	storage[key] = value
+	return value
+}
+
+func (s *mockStateDB) SetState(addr common.Address, key, value common.Hash) {
+	acct := s.getAccount(addr)
+	acct.storage[key] = value
+	acct.dirty = true
+}
+
+func (s *mockStateDB) Suicide(addr common.Address) bool {
+	acct := s.getAccount(addr)
+	if !acct.exists {
+		return false
+	}
+	acct.suicided = true
+	acct.balance = new(big.Int)
+	acct.dirty = true
+	return true
+}
+
+func (s *mockStateDB) HasSuicided(addr common.Address) bool {
+	return s.getAccount(addr).suicided
+}
+
+func (s *mockStateDB) Exist(addr common.Address) bool {
+	return s.getAccount(addr).exists
+}
+
+func (s *mockStateDB) Empty(addr common.Address) bool {
+	acct := s.getAccount(addr)
+	return acct.nonce == 0 && acct.balance.Sign() == 0 && len(acct.code) == 0
+}
+
+func (s *mockStateDB) PrepareAccessList(sender common.Address, dest *common.Address, precompiles []common.Address, txAccesses ethtypes.AccessList) {
+	s.AddAddressToAccessList(sender)
+	if dest != nil {
+		s.AddAddressToAccessList(*dest)
+	}
+	for _, addr := range precompiles {
+		s.AddAddressToAccessList(addr)
+	}
+	for _, el := range txAccesses {
+		s.AddAddressToAccessList(el.Address)
+		for _, key := range el.StorageKeys {
+			s.AddSlotToAccessList(el.Address, key)
+		}
+	}
+}
+
+func (s *mockStateDB) AddressInAccessList(addr common.Address) bool {
+	_, found := s.accessList[addr]
+	return found
+}
+
+func (s *mockStateDB) SlotInAccessList(addr common.Address, slot common.Hash) (bool, bool) {
+	slots, addressOk := s.accessList[addr]
+	if !addressOk {
+		return false, false
+	}
+	_, slotOk := slots[slot]
+	return true, slotOk
+}
+
+func (s *mockStateDB) AddAddressToAccessList(addr common.Address) {
+	if _, found := s.accessList[addr]; !found {
+		s.accessList[addr] = make(map[common.Hash]struct{})
+	}
+}
+
+func (s *mockStateDB) AddSlotToAccessList(addr common.Address, slot common.Hash) {
+	s.AddAddressToAccessList(addr)
+	s.accessList[addr][slot] = struct{}{}
+}
+
+// accessedList returns addresses and storage slots, accessed during execution, in deterministic order.
+// As in SGX enclave, precompiles are not included
+func (s *mockStateDB) accessedList(precompiles []common.Address) []*types.AccessListItem {
+	excluded := make(map[common.Address]struct{}, len(precompiles))
+	for _, addr := range precompiles {
+		excluded[addr] = struct{}{}
+	}
+
+	addresses := make([]common.Address, 0, len(s.accessList))
+	for addr := range s.accessList {
+		if _, found := excluded[addr]; found {
+			continue
+		}
+		addresses = append(addresses, addr)
+	}
+	sort.Slice(addresses, func(i, j int) bool {
+		return bytes.Compare(addresses[i].Bytes(), addresses[j].Bytes()) < 0
+	})
+
+	items := make([]*types.AccessListItem, 0, len(addresses))
+	for _, addr := range addresses {
+		slots := make([]common.Hash, 0, len(s.accessList[addr]))
+		for slot := range s.accessList[addr] {
+			slots = append(slots, slot)
+		}
+		sort.Slice(slots, func(i, j int) bool {
+			return bytes.Compare(slots[i].Bytes(), slots[j].Bytes()) < 0
+		})
+
+		item := &types.AccessListItem{Address: addr.Bytes()}
+		for _, slot := range slots {
+			item.StorageSlot = append(item.StorageSlot, slot.Bytes())
+		}
+		items = append(items, item)
+	}
+	return items
+}
+
+func (s *mockStateDB) Snapshot() int {
+	snapshot := mockSnapshot{
+		accounts:   make(map[common.Address]*mockAccount, len(s.accounts)),
+		accessList: make(map[common.Address]map[common.Hash]struct{}, len(s.accessList)),
+		refund:     s.refund,
+		logsLen:    len(s.logs),
+	}
+	for addr, acct := range s.accounts {
+		snapshot.accounts[addr] = acct.copy()
+	}
+	for addr, slots := range s.accessList {
+		slotsCopy := make(map[common.Hash]struct{}, len(slots))
+		for slot := range slots {
+			slotsCopy[slot] = struct{}{}
+		}
+		snapshot.accessList[addr] = slotsCopy
+	}
+
+	s.snapshots = append(s.snapshots, snapshot)
+	return len(s.snapshots) - 1
+}
+
+func (s *mockStateDB) RevertToSnapshot(id int) {
+	snapshot := s.snapshots[id]
+	s.accounts = snapshot.accounts
+	s.accessList = snapshot.accessList
+	s.refund = snapshot.refund
+	s.logs = s.logs[:snapshot.logsLen]
+	s.snapshots = s.snapshots[:id]
+}
+
+func (s *mockStateDB) AddLog(log *ethtypes.Log) {
+	s.logs = append(s.logs, log)
+}
+
+func (s *mockStateDB) AddPreimage(common.Hash, []byte) {}
+
+func (s *mockStateDB) ForEachStorage(common.Address, func(common.Hash, common.Hash) bool) error {
+	return errors.New("ForEachStorage is not supported by mock enclave")
+}
+
+// commit writes all changes made during execution through the connector. As in SGX enclave, accounts are
+// updated one by one, while code and storage updates are sent in a single batch after them
+func (s *mockStateDB) commit() error {
+	addresses := make([]common.Address, 0, len(s.accounts))
+	for addr, acct := range s.accounts {
+		if acct.dirty {
+			addresses = append(addresses, addr)
+		}
+	}
+	sort.Slice(addresses, func(i, j int) bool {
+		return bytes.Compare(addresses[i].Bytes(), addresses[j].Bytes()) < 0
+	})
+
+	var writes []*types.CosmosRequest
+	for _, addr := range addresses {
+		acct := s.accounts[addr]
+		if acct.suicided {
+			writes = append(writes, &types.CosmosRequest{Req: &types.CosmosRequest_Remove{
+				Remove: &types.QueryRemove{Address: addr.Bytes()},
+			}})
+			continue
+		}
+
+		if err := s.write("Insert account failed", &types.CosmosRequest{Req: &types.CosmosRequest_InsertAccount{
+			InsertAccount: &types.QueryInsertAccount{
+				Address: addr.Bytes(),
+				Balance: acct.balance.Bytes(),
+				Nonce:   acct.nonce,
+			},
+		}}, &types.QueryInsertAccountResponse{}); err != nil {
+			return err
+		}
+
+		if acct.codeDirty {
+			writes = append(writes, &types.CosmosRequest{Req: &types.CosmosRequest_InsertAccountCode{
+				InsertAccountCode: &types.QueryInsertAccountCode{Address: addr.Bytes(), Code: acct.code},
+			}})
+		}
+
+		keys := make([]common.Hash, 0, len(acct.storage))
+		for key := range acct.storage {
+			keys = append(keys, key)
+		}
+		sort.Slice(keys, func(i, j int) bool {
+			return bytes.Compare(keys[i].Bytes(), keys[j].Bytes()) < 0
+		})
+
+		for _, key := range keys {
+			write, err := s.storageCellWrite(addr, key, acct.storage[key])
+			if err != nil {
+				return err
+			}
+			writes = append(writes, write)
+		}
+	}
+
+	if len(writes) == 0 {
+		return nil
+	}
+	if err := s.batch(writes, make([]proto.Message, len(writes))); err != nil {
+		return errors.New("Batch write failed. Empty response")
+	}
+	return nil
+}
+
+// storageCellWrite returns request to update storage cell. Cells with zero value are removed, other
+// values are encrypted
+func (s *mockStateDB) storageCellWrite(addr common.Address, key, value common.Hash) (*types.CosmosRequest, error) {
+	if value == (common.Hash{}) {
+		return &types.CosmosRequest{Req: &types.CosmosRequest_RemoveStorageCell{
+			RemoveStorageCell: &types.QueryRemoveStorageCell{Address: addr.Bytes(), Index: key.Bytes()},
+		}}, nil
+	}
+
+	salt := new(big.Int).SetUint64(s.context.Timestamp).FillBytes(make([]byte, 8))
+	encryptedValue, err := mockKeyManager.encryptStorageCell(addr.Bytes(), s.context.BlockNumber, salt, value.Bytes())
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.CosmosRequest{Req: &types.CosmosRequest_InsertStorageCell{
+		InsertStorageCell: &types.QueryInsertStorageCell{Address: addr.Bytes(), Index: key.Bytes(), Value: encryptedValue},
+	}}, nil
+}
+
+// mockChainConfig returns chain config which matches London gasometer config of SGXVM
+func mockChainConfig(chainID uint64) *params.ChainConfig {
+	zero := big.NewInt(0)
+	return &params.ChainConfig{
+		ChainID:             new(big.Int).SetUint64(chainID),
+		HomesteadBlock:      zero,
+		EIP150Block:         zero,
+		EIP155Block:         zero,
+		EIP158Block:         zero,
+		ByzantiumBlock:      zero,
+		ConstantinopleBlock: zero,
+		PetersburgBlock:     zero,
+		IstanbulBlock:       zero,
+		MuirGlacierBlock:    zero,
+		BerlinBlock:         zero,
+		LondonBlock:         zero,
+	}
+}
+
+// mockPrecompiles returns addresses of precompiles available since Istanbul (0x01 - 0x09)
+func mockPrecompiles() []common.Address {
+	precompiles := make([]common.Address, 0, 9)
+	for i := byte(1); i <= 9; i++ {
+		precompiles = append(precompiles, common.BytesToAddress([]byte{i}))
+	}
+	return precompiles
+}
+
+// newMockEVM constructs go-ethereum EVM which uses provided state and transaction context
+func newMockEVM(stateDB *mockStateDB, from common.Address, txContext *types.TransactionContext, config vm.Config) *vm.EVM {
+	blockContext := vm.BlockContext{
+		CanTransfer: func(db vm.StateDB, addr common.Address, amount *big.Int) bool {
+			return db.GetBalance(addr).Cmp(amount) >= 0
+		},
+		Transfer: func(db vm.StateDB, sender, recipient common.Address, amount *big.Int) {
+			db.SubBalance(sender, amount)
+			db.AddBalance(recipient, amount)
+		},
+		GetHash: func(number uint64) common.Hash {
+			response := &types.QueryBlockHashResponse{}
+			err := stateDB.query(&types.CosmosRequest{Req: &types.CosmosRequest_BlockHash{
+				BlockHash: &types.QueryBlockHash{Number: new(big.Int).SetUint64(number).Bytes()},
+			}}, response)
+			if err != nil {
+				return common.Hash{}
+			}
+			return common.BytesToHash(response.Hash)
+		},
+		Coinbase:    common.BytesToAddress(txContext.BlockCoinbase),
+		GasLimit:    txContext.BlockGasLimit,
+		BlockNumber: new(big.Int).SetUint64(txContext.BlockNumber),
+		Time:        new(big.Int).SetUint64(txContext.Timestamp),
+		Difficulty:  big.NewInt(0),
+		BaseFee:     types.BytesToBig(txContext.BlockBaseFeePerGas),
+	}
+
+	evmTxContext := vm.TxContext{
+		Origin:   from,
+		GasPrice: types.BytesToBig(txContext.GasPrice),
+	}
+
+	return vm.NewEVM(blockContext, evmTxContext, stateDB, mockChainConfig(txContext.ChainId), config)
+}
+
+// executeMock executes call or contract creation using go-ethereum EVM. It follows semantics
+// of SGXVM executor: intrinsic gas is included into used gas, refund counter is returned
+// separately and state is written through connector only if commit flag is set. If trace flag is set,
+// executed opcodes and nested call frames are reported through connector. Sender is exposed to executed
+// contracts only if it was authenticated, otherwise zero address is used
+func executeMock(
+	connector Connector,
+	from common.Address,
+	to *common.Address,
+	data, value []byte,
+	accessList ethtypes.AccessList,
+	gasLimit uint64,
+	txContext *types.TransactionContext,
+	commit bool,
+	trace bool,
+	callTracer *mockCallTracer,
+) *types.HandleTransactionResponse {
+	if !txContext.AuthenticatedSender {
+		from = common.Address{}
+	}
+
+	stateDB := newMockStateDB(connector, txContext)
+	config := vm.Config{NoBaseFee: true}
+	var tracer *mockTracer
+	if trace {
+		tracer = newMockTracer(stateDB)
+		config.Debug = true
+		config.Tracer = tracer
+	} else if callTracer != nil {
+		config.Debug = true
+		config.Tracer = callTracer
+	}
+	evm := newMockEVM(stateDB, from, txContext, config)
+	rules := evm.ChainConfig().Rules(evm.Context.BlockNumber, false)
+
+	intrinsicGas, err := core.IntrinsicGas(data, accessList, to == nil, rules.IsHomestead, rules.IsIstanbul)
+	if err != nil {
+		return &types.HandleTransactionResponse{VmError: err.Error(), GasUsed: minimalGasUsed}
+	}
+	if gasLimit < intrinsicGas {
+		return &types.HandleTransactionResponse{VmError: convertVMError(vm.ErrOutOfGas), GasUsed: gasLimit}
+	}
+
+	stateDB.PrepareAccessList(from, to, mockPrecompiles(), accessList)
+	stateDB.prefetch(prefetchedState(to, accessList))
+
+	var (
+		ret         []byte
+		leftoverGas uint64
+		vmErr       error
+		amount      = types.BytesToBig(value)
+		sender      = vm.AccountRef(from)
+	)
+	if to == nil {
+		var createRet []byte
+		createRet, _, leftoverGas, vmErr = evm.Create(sender, data, gasLimit-intrinsicGas, amount)
+		// SGXVM returns data of contract creation only in case of revert
+		if errors.Is(vmErr, vm.ErrExecutionReverted) {
+			ret = createRet
+		}
+	} else {
+		stateDB.SetNonce(from, stateDB.GetNonce(from)+1)
+		ret, leftoverGas, vmErr = evm.Call(sender, *to, data, gasLimit-intrinsicGas, amount)
+	}
+
+	if tracer != nil {
+		tracer.flush()
+	}
+
+	if stateDB.err != nil {
+		return &types.HandleTransactionResponse{VmError: stateDB.err.Error(), GasUsed: minimalGasUsed}
+	}
+
+	gasUsed := gasLimit - leftoverGas
+	accessed := stateDB.accessedList(mockPrecompiles())
+	if vmErr != nil {
+		return &types.HandleTransactionResponse{VmError: convertVMError(vmErr), Ret: ret, GasUsed: gasUsed, AccessList: accessed}
+	}
+
+	response := &types.HandleTransactionResponse{
+		Ret:        ret,
+		GasUsed:    gasUsed,
+		GasRefund:  stateDB.GetRefund(),
+		AccessList: accessed,
+	}
+	if commit {
+		if err := stateDB.commit(); err != nil {
+			return &types.HandleTransactionResponse{VmError: err.Error(), GasUsed: minimalGasUsed}
+		}
+		response.Logs = convertMockLogs(stateDB.logs)
+	}
+
+	return response
+}
+
+// applyStorageOverrideMock encrypts overridden storage cells and writes them through the connector
+// in a single batch, as SGX enclave does
+func applyStorageOverrideMock(connector Connector, overrides []*types.StorageOverride, blockNumber, timestamp uint64) error {
+	stateDB := newMockStateDB(connector, &types.TransactionContext{BlockNumber: blockNumber, Timestamp: timestamp})
+
+	var writes []*types.CosmosRequest
+	for _, override := range overrides {
+		if len(override.Address) != common.AddressLength {
+			return fmt.Errorf("invalid address length: %d", len(override.Address))
+		}
+		for _, cell := range override.Cells {
+			if len(cell.Index) != common.HashLength || len(cell.Value) != common.HashLength {
+				return errors.New("invalid storage cell override")
+			}
+			write, err := stateDB.storageCellWrite(common.BytesToAddress(override.Address), common.BytesToHash(cell.Index), common.BytesToHash(cell.Value))
+			if err != nil {
+				return err
+			}
+			writes = append(writes, write)
+		}
+	}
+	if len(writes) == 0 {
+		return nil
+	}
+	return stateDB.batch(writes, make([]proto.Message, len(writes)))
+}
+
+// privateStorageMock verifies that request was signed by the account, which is allowed to read storage of
+// the contract, and returns requested storage cells, encrypted to the user public key, as SGX enclave does
+func privateStorageMock(connector Connector, req *types.PrivateStorageRequest) *types.PrivateStorageResponse {
+	values, err := readPrivateStorageMock(connector, req)
+	if err != nil {
+		return &types.PrivateStorageResponse{Error: err.Error()}
+	}
+	return &types.PrivateStorageResponse{EncryptedValues: values}
+}
+
+func readPrivateStorageMock(connector Connector, req *types.PrivateStorageRequest) ([][]byte, error) {
+	txContext := req.Context
+	if txContext == nil {
+		return nil, errors.New("empty transaction context")
+	}
+	if len(req.ContractAddress) != common.AddressLength {
+		return nil, errors.New("invalid contract address")
+	}
+	if len(req.UserPublicKey) != publicKeySize {
+		return nil, errors.New("invalid public key")
+	}
+	if req.Expiry <= txContext.Timestamp {
+		return nil, errors.New("signature expired")
+	}
+
+	contract := common.BytesToAddress(req.ContractAddress)
+	hash := storageAccessHash(txContext.ChainId, contract, common.BytesToHash(req.UserPublicKey), req.Expiry)
+	signer, err := recoverTypedDataSigner(hash, req.Signature)
+	if err != nil {
+		return nil, err
+	}
+	allowed, err := isStorageViewerMock(connector, txContext, contract, signer)
+	if err != nil {
+		return nil, err
+	}
+	if !allowed {
+		return nil, errors.New("signer is not allowed to read storage of the contract")
+	}
+
+	stateDB := newMockStateDB(connector, txContext)
+	values := make([][]byte, 0, len(req.Keys))
+	for _, key := range req.Keys {
+		if len(key) != common.HashLength {
+			return nil, errors.New("invalid storage key")
+		}
+		value := stateDB.GetState(contract, common.BytesToHash(key))
+		if stateDB.err != nil {
+			return nil, stateDB.err
+		}
+		encrypted, err := mockKeyManager.encryptTransactionData(value.Bytes(), req.UserPublicKey, nil, txContext.BlockNumber)
+		if err != nil {
+			return nil, err
+		}
+		values = append(values, encrypted)
+	}
+	return values, nil
+}
+
+// isStorageViewerMock checks if account is allowed to read decrypted storage of the contract. Access is granted
+// to the deployer of the contract and to accounts, for which contract `isStorageViewer(address)` returns true
+func isStorageViewerMock(connector Connector, txContext *types.TransactionContext, contract, account common.Address) (bool, error) {
+	stateDB := newMockStateDB(connector, txContext)
+	if len(stateDB.GetCode(contract)) == 0 {
+		return false, stateDB.err
+	}
+
+	deployer := &types.QueryContractDeployerResponse{}
+	request := &types.CosmosRequest{Req: &types.CosmosRequest_ContractDeployer{
+		ContractDeployer: &types.QueryContractDeployer{Contract: contract.Bytes()},
+	}}
+	if err := stateDB.query(request, deployer); err != nil {
+		return false, err
+	}
+	if len(deployer.Deployer) == common.AddressLength && common.BytesToAddress(deployer.Deployer) == account {
+		return true, nil
+	}
+
+	data := append(common.CopyBytes(isStorageViewerSelector), common.LeftPadBytes(account.Bytes(), common.HashLength)...)
+	res := executeMock(connector, common.Address{}, &contract, data, nil, nil, storageViewerGasLimit, txContext, false, false, nil)
+	return res.VmError == "" && len(res.Ret) == common.HashLength && new(big.Int).SetBytes(res.Ret).Cmp(common.Big1) == 0, nil
+}
+
+// prefetchedState returns transaction recipient and accounts and storage slots from access list, which
+// are loaded before execution
+func prefetchedState(to *common.Address, accessList ethtypes.AccessList) map[common.Address][]common.Hash {
+	state := make(map[common.Address][]common.Hash, len(accessList)+1)
+	if to != nil {
+		state[*to] = nil
+	}
+	for _, tuple := range accessList {
+		state[tuple.Address] = append(state[tuple.Address], tuple.StorageKeys...)
+	}
+	return state
+}
+
+// convertVMError converts go-ethereum execution error into error message, returned by SGXVM
+func convertVMError(err error) string {
+	var (
+		invalidOpCode  *vm.ErrInvalidOpCode
+		stackUnderflow *vm.ErrStackUnderflow
+		stackOverflow  *vm.ErrStackOverflow
+	)
+
+	switch {
+	case errors.Is(err, vm.ErrExecutionReverted):
+		return "execution reverted: Reverted"
+	case errors.Is(err, vm.ErrOutOfGas), errors.Is(err, vm.ErrCodeStoreOutOfGas), errors.Is(err, vm.ErrGasUintOverflow):
+		return "evm error: OutOfGas"
+	case errors.Is(err, vm.ErrInsufficientBalance):
+		return "evm error: OutOfFund"
+	case errors.Is(err, vm.ErrDepth):
+		return "evm error: CallTooDeep"
+	case errors.Is(err, vm.ErrContractAddressCollision):
+		return "evm error: CreateCollision"
+	case errors.Is(err, vm.ErrMaxCodeSizeExceeded):
+		return "evm error: CreateContractLimit"
+	case errors.Is(err, vm.ErrInvalidJump):
+		return "evm error: InvalidJump"
+	case errors.Is(err, vm.ErrReturnDataOutOfBounds):
+		return "evm error: OutOfOffset"
+	case errors.Is(err, vm.ErrInvalidCode):
+		return fmt.Sprintf("evm error: InvalidCode(Opcode(%d))", 0xef)
+	case errors.As(err, &stackUnderflow):
+		return "evm error: StackUnderflow"
+	case errors.As(err, &stackOverflow):
+		return "evm error: StackOverflow"
+	case errors.As(err, &invalidOpCode):
+		return fmt.Sprintf("evm error: InvalidCode(Opcode(%d))", parseInvalidOpCode(invalidOpCode))
+	default:
+		return fmt.Sprintf("evm error: Other(%q)", err.Error())
+	}
+}
+
+// parseInvalidOpCode extracts opcode from go-ethereum error, since opcode field is not exported
+func parseInvalidOpCode(err *vm.ErrInvalidOpCode) byte {
+	var opcode byte
+	if _, scanErr := fmt.Sscanf(err.Error(), "invalid opcode: opcode %v not defined", &opcode); scanErr == nil {
+		return opcode
+	}
+	var name string
+	if _, scanErr := fmt.Sscanf(err.Error(), "invalid opcode: %s", &name); scanErr == nil {
+		return byte(vm.StringToOp(name))
+	}
+	return 0xfe
+}
+
+// convertMockLogs converts go-ethereum logs into protobuf-compatible logs
+func convertMockLogs(logs []*ethtypes.Log) []*types.Log {
+	converted := make([]*types.Log, 0, len(logs))
+	for _, log := range logs {
+		topics := make([]*types.Topic, 0, len(log.Topics))
+		for _, topic := range log.Topics {
+			topics = append(topics, &types.Topic{Inner: topic.Bytes()})
+		}
+		converted = append(converted, &types.Log{
+			Address: log.Address.Bytes(),
+			Topics:  topics,
+			Data:    log.Data,
+		})
+	}
+	return converted
+}
+
+// callMock handles call request. If transaction data is encrypted, it is decrypted before execution
+// and output is encrypted using the same user public key. Execution of encrypted transaction is never
+// streamed through connector. If tracing was authorized by the sender, call tree is collected and
+// returned encrypted with the key of the sender
+func callMock(
+	connector Connector,
+	from, to, data, value []byte,
+	accessList ethtypes.AccessList,
+	gasLimit uint64,
+	txContext *types.TransactionContext,
+	commit bool,
+	isUnencrypted bool,
+	trace bool,
+	traceAuthorization *types.TraceAuthorization,
+) *types.HandleTransactionResponse {
+	fromAddress := common.BytesToAddress(from)
+	toAddress := common.BytesToAddress(to)
+
+	// Decrypted execution is traced only if the sender of the transaction authorized it
+	var callTracer *mockCallTracer
+	if traceAuthorization != nil {
+		if err := verifyTraceAuthorization(traceAuthorization, from, to, data, value, txContext.ChainId); err != nil {
+			return &types.HandleTransactionResponse{VmError: err.Error(), GasUsed: minimalGasUsed}
+		}
+		callTracer = newMockCallTracer()
+	}
+
+	if len(data) == 0 || isUnencrypted {
+		return executeMock(connector, fromAddress, &toAddress, data, value, accessList, gasLimit, txContext, commit, trace, nil)
+	}
+
+	userPublicKey, encryptedData, nonce, err := extractPublicKeyAndData(data)
+	if err != nil {
+		return &types.HandleTransactionResponse{VmError: err.Error(), GasUsed: minimalGasUsed}
+	}
+
+	var decryptedData []byte
+	if len(encryptedData) != 0 {
+		decryptedData, err = mockKeyManager.decryptTransactionData(encryptedData, userPublicKey, txContext.BlockNumber)
+		if err != nil {
+			return &types.HandleTransactionResponse{VmError: err.Error(), GasUsed: minimalGasUsed}
+		}
+	}
+
+	response := executeMock(connector, fromAddress, &toAddress, decryptedData, value, accessList, gasLimit, txContext, commit, false, callTracer)
+
+	// Encrypt collected call tree, including reverted one, since it contains decrypted data
+	if callTracer != nil {
+		encryptedTrace, err := encryptCallTree(callTracer.callTree(gasLimit, response.GasUsed), userPublicKey, txContext.BlockNumber)
+		if err != nil {
+			return &types.HandleTransactionResponse{VmError: err.Error(), GasUsed: minimalGasUsed}
+		}
+		response.EncryptedTrace = encryptedTrace
+	}
+
+	// Return unencrypted transaction response in case of revert
+	if response.VmError != "" {
+		return response
+	}
+
+	encryptedRet, err := mockKeyManager.encryptTransactionData(response.Ret, userPublicKey, nonce, txContext.BlockNumber)
+	if err != nil {
+		return &types.HandleTransactionResponse{VmError: err.Error(), GasUsed: minimalGasUsed}
+	}
+	response.Ret = encryptedRet
+	return response
+}
@@ -210,6 +210,87 @@ func GetNodePublicKey(blockNumber uint64) (*types.NodePublicKeyResponse, error)
 	return &response, nil
 }
 
+// ApplyStorageOverride encrypts overridden storage cells with state key of the epoch, active at provided block,
+// and writes them through the connector
+func ApplyStorageOverride(connector Connector, overrides []*types.StorageOverride, blockNumber, timestamp uint64) error {
+	// Construct mocked querier
+	c := BuildConnector(connector)
+
+	// Create protobuf-encoded request
+	req := &types.FFIRequest{Req: &types.FFIRequest_ApplyStorageOverrideRequest{
+		ApplyStorageOverrideRequest: &types.ApplyStorageOverrideRequest{
+			Overrides:   overrides,
+			BlockNumber: blockNumber,
+			Timestamp:   timestamp,
+		},
+	}}
+	reqBytes, err := proto.Marshal(req)
+	if err != nil {
+		log.Fatalln("Failed to encode req:", err)
+		return err
+	}
+
+	// Pass request to Rust
+	d := MakeView(reqBytes)
+	defer runtime.KeepAlive(reqBytes)
+
+	errmsg := NewUnmanagedVector(nil)
+	ptr, err := C.make_pb_request(c, d, &errmsg)
+	if err != nil {
+		return ErrorWithMessage(err, errmsg)
+	}
+
+	// Recover returned value
+	executionResult := CopyAndDestroyUnmanagedVector(ptr)
+	response := types.ApplyStorageOverrideResponse{}
+	if err := proto.Unmarshal(executionResult, &response); err != nil {
+		log.Fatalln("Failed to decode storage override result:", err)
+		return err
+	}
+
+	return nil
+}
+
+// PrivateStorage verifies that request was signed by the account, which is allowed to read storage
+// of the contract, and returns requested storage cells, encrypted to the user public key
+func PrivateStorage(connector Connector, privateStorageRequest *types.PrivateStorageRequest) (*types.PrivateStorageResponse, error) {
+	// Construct mocked querier
+	c := BuildConnector(connector)
+
+	// Create protobuf-encoded request
+	req := &types.FFIRequest{Req: &types.FFIRequest_PrivateStorageRequest{
+		PrivateStorageRequest: privateStorageRequest,
+	}}
+	reqBytes, err := proto.Marshal(req)
+	if err != nil {
+		log.Fatalln("Failed to encode req:", err)
+		return nil, err
+	}
+
+	// Pass request to Rust
+	d := MakeView(reqBytes)
+	defer runtime.KeepAlive(reqBytes)
+
+	errmsg := NewUnmanagedVector(nil)
+	ptr, err := C.make_pb_request(c, d, &errmsg)
+	if err != nil {
+		return nil, ErrorWithMessage(err, errmsg)
+	}
+
+	// Recover returned value
+	executionResult := CopyAndDestroyUnmanagedVector(ptr)
+	response := types.PrivateStorageResponse{}
+	if err := proto.Unmarshal(executionResult, &response); err != nil {
+		log.Fatalln("Failed to decode private storage response:", err)
+		return nil, err
+	}
+	if response.Error == "" && len(response.EncryptedValues) != len(privateStorageRequest.Keys) {
+		return nil, fmt.Errorf("cannot read private storage: expected %d values, got %d", len(privateStorageRequest.Keys), len(response.EncryptedValues))
+	}
+
+	return &response, nil
+}
+
 // DumpDCAPQuote generates DCAP quote for the enclave and writes it to the disk
 func DumpDCAPQuote(filepath string) error {
 	// Create protobuf encoded request
@@ -269,21 +350,25 @@ func Call(
 	txContext *types.TransactionContext,
 	commit bool,
 	isUnencrypted bool,
+	trace bool,
+	traceAuthorization *types.TraceAuthorization,
 ) (*types.HandleTransactionResponse, error) {
 	// Construct mocked querier
 	c := BuildConnector(connector)
 
 	// Create protobuf-encoded transaction data
 	params := &types.SGXVMCallParams{
-		From:        from,
-		To:          to,
-		Data:        data,
-		GasLimit:    gasLimit,
-		Value:       value,
-		AccessList:  convertAccessList(accessList),
-		Commit:      commit,
-		Nonce:       nonce,
-		Unencrypted: isUnencrypted,
+		From:               from,
+		To:                 to,
+		Data:               data,
+		GasLimit:           gasLimit,
+		Value:              value,
+		AccessList:         convertAccessList(accessList),
+		Commit:             commit,
+		Nonce:              nonce,
+		Unencrypted:        isUnencrypted,
+		Trace:              trace,
+		TraceAuthorization: traceAuthorization,
 	}
 
 	// Create protobuf encoded request
@@ -328,6 +413,7 @@ func Create(
 	gasLimit, nonce uint64,
 	txContext *types.TransactionContext,
 	commit bool,
+	trace bool,
 ) (*types.HandleTransactionResponse, error) {
 	// Construct mocked querier
 	c := BuildConnector(connector)
@@ -341,6 +427,7 @@ func Create(
 		AccessList: convertAccessList(accessList),
 		Commit:     commit,
 		Nonce:      nonce,
+		Trace:      trace,
 	}
 
 	// Create protobuf encoded request
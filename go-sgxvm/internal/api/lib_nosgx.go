@@ -9,8 +9,8 @@ import "C"
 
 import (
 	"github.com/SigmaGmbH/librustgo/types"
+	"github.com/ethereum/go-ethereum/common"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
-	"math/rand"
 	"net"
 )
 
@@ -42,11 +42,14 @@ func RequestEpochKeys(host string, port int, isDCAP bool) error {
 
 // IsNodeInitialized checks if node was initialized and key manager state was sealed
 func IsNodeInitialized() (bool, error) {
-	return false, nil
+	return true, nil
 }
 
 // SetupSeedNode handles initialization of attestation server node which will share epoch keys with other nodes
 func InitializeEnclave(shouldReset bool) error {
+	if shouldReset {
+		mockKeyManager.reset()
+	}
 	return nil
 }
 
@@ -66,9 +69,19 @@ func RequestSeed(hostname string, port int) error {
 
 // GetNodePublicKey handles request for node public key
 func GetNodePublicKey(blockNumber uint64) (*types.NodePublicKeyResponse, error) {
-	key := make([]byte, 32)
-	rand.Read(key)
-	return &types.NodePublicKeyResponse{PublicKey: key}, nil
+	return &types.NodePublicKeyResponse{PublicKey: mockKeyManager.currentEpoch(blockNumber).publicKey()}, nil
+}
+
+// ApplyStorageOverride encrypts overridden storage cells with state key of the epoch, active at provided block,
+// and writes them through the connector
+func ApplyStorageOverride(connector Connector, overrides []*types.StorageOverride, blockNumber, timestamp uint64) error {
+	return applyStorageOverrideMock(connector, overrides, blockNumber, timestamp)
+}
+
+// PrivateStorage verifies that request was signed by the account, which is allowed to read storage of the
+// contract, and returns requested storage cells encrypted to the user public key
+func PrivateStorage(connector Connector, req *types.PrivateStorageRequest) (*types.PrivateStorageResponse, error) {
+	return privateStorageMock(connector, req), nil
 }
 
 // Call handles incoming call to contract or transfer of value
@@ -80,8 +93,10 @@ func Call(
 	txContext *types.TransactionContext,
 	commit bool,
 	isUnencrypted bool,
+	trace bool,
+	traceAuthorization *types.TraceAuthorization,
 ) (*types.HandleTransactionResponse, error) {
-	return nil, nil
+	return callMock(connector, from, to, data, value, accessList, gasLimit, txContext, commit, isUnencrypted, trace, traceAuthorization), nil
 }
 
 // Create handles incoming request for creation of new contract
@@ -92,8 +107,9 @@ func Create(
 	gasLimit, nonce uint64,
 	txContext *types.TransactionContext,
 	commit bool,
+	trace bool,
 ) (*types.HandleTransactionResponse, error) {
-	return nil, nil
+	return executeMock(connector, common.BytesToAddress(from), nil, data, value, accessList, gasLimit, txContext, commit, trace, nil), nil
 }
 
 // StartAttestationServer starts attestation server with 2 port (EPID and DCAP attestation)
@@ -102,13 +118,13 @@ func StartAttestationServer(epidAddress, dcapAddress string) error {
 }
 
 func AddEpoch(startingBlock uint64) error {
-	return nil
+	return mockKeyManager.addEpoch(startingBlock)
 }
 
 func RemoveLatestEpoch() error {
-	return nil
+	return mockKeyManager.removeLatestEpoch()
 }
 
 func ListEpochs() ([]*types.EpochData, error) {
-	return nil, nil
+	return mockKeyManager.listEpochs(), nil
 }
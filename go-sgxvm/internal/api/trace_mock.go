@@ -0,0 +1,271 @@
+//go:build nosgx
+// +build nosgx
+
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/SigmaGmbH/librustgo/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+)
+
+// traceFlushThreshold is amount of collected events, after which they are sent through connector
+const traceFlushThreshold = 256
+
+var _ vm.EVMLogger = &mockTracer{}
+
+// mockTracer collects executed opcodes and nested call frames and reports them through connector
+// in the same way as SGX enclave does. Top-level frame is not reported
+type mockTracer struct {
+	stateDB *mockStateDB
+	events  []*types.TraceEvent
+}
+
+func newMockTracer(stateDB *mockStateDB) *mockTracer {
+	return &mockTracer{stateDB: stateDB}
+}
+
+func (t *mockTracer) push(event *types.TraceEvent) {
+	t.events = append(t.events, event)
+	if len(t.events) >= traceFlushThreshold {
+		t.flush()
+	}
+}
+
+// flush sends collected events through connector
+func (t *mockTracer) flush() {
+	if len(t.events) == 0 {
+		return
+	}
+	err := t.stateDB.query(&types.CosmosRequest{Req: &types.CosmosRequest_TraceEvents{
+		TraceEvents: &types.QueryTraceEvents{Events: t.events},
+	}}, &types.QueryTraceEventsResponse{})
+	t.stateDB.setError(err)
+	t.events = nil
+}
+
+func (t *mockTracer) CaptureTxStart(uint64) {}
+
+func (t *mockTracer) CaptureTxEnd(uint64) {}
+
+func (t *mockTracer) CaptureStart(*vm.EVM, common.Address, common.Address, bool, []byte, uint64, *big.Int) {
+}
+
+func (t *mockTracer) CaptureEnd([]byte, uint64, time.Duration, error) {}
+
+func (t *mockTracer) CaptureEnter(typ vm.OpCode, from common.Address, to common.Address, input []byte, gas uint64, value *big.Int) {
+	enter := &types.TraceEnter{
+		Opcode: uint32(typ),
+		From:   from.Bytes(),
+		To:     to.Bytes(),
+		Input:  common.CopyBytes(input),
+		Gas:    gas,
+	}
+	if value != nil {
+		enter.Value = common.BigToHash(value).Bytes()
+	}
+	t.push(&types.TraceEvent{Event: &types.TraceEvent_Enter{Enter: enter}})
+}
+
+func (t *mockTracer) CaptureExit(output []byte, gasUsed uint64, err error) {
+	exit := &types.TraceExit{
+		Output:  common.CopyBytes(output),
+		GasUsed: gasUsed,
+	}
+	if err != nil {
+		exit.Error = convertVMError(err)
+	}
+	t.push(&types.TraceEvent{Event: &types.TraceEvent_Exit{Exit: exit}})
+}
+
+// CaptureState reports executed opcode. Like SGXVM, it doesn't report stack, memory and loaded storage
+// values, since they can be derived from encrypted contract storage
+func (t *mockTracer) CaptureState(pc uint64, op vm.OpCode, gas, cost uint64, scope *vm.ScopeContext, _ []byte, depth int, _ error) {
+	step := &types.TraceStep{
+		Pc:       pc,
+		Opcode:   uint32(op),
+		Gas:      gas,
+		Cost:     cost,
+		Depth:    uint32(depth),
+		Contract: scope.Contract.Address().Bytes(),
+		Refund:   t.stateDB.GetRefund(),
+	}
+	t.push(&types.TraceEvent{Event: &types.TraceEvent_Step{Step: step}})
+}
+
+func (t *mockTracer) CaptureFault(uint64, vm.OpCode, uint64, uint64, *vm.ScopeContext, int, error) {}
+
+var _ vm.EVMLogger = &mockCallTracer{}
+
+// mockCallFrame is a call frame in the format of go-ethereum `callTracer`, extended with decoded revert reason.
+// It matches call tree, which is collected inside SGX enclave
+type mockCallFrame struct {
+	Type         string          `json:"type"`
+	From         string          `json:"from"`
+	To           string          `json:"to,omitempty"`
+	Value        string          `json:"value,omitempty"`
+	Gas          string          `json:"gas"`
+	GasUsed      string          `json:"gasUsed"`
+	Input        string          `json:"input"`
+	Output       string          `json:"output,omitempty"`
+	Error        string          `json:"error,omitempty"`
+	RevertReason string          `json:"revertReason,omitempty"`
+	Calls        []mockCallFrame `json:"calls,omitempty"`
+}
+
+// mockCallTracer collects call tree of executed frames, including the top-level one. Since call tree contains
+// decrypted data, it is returned only in encrypted form
+type mockCallTracer struct {
+	frames []mockCallFrame
+	root   *mockCallFrame
+}
+
+func newMockCallTracer() *mockCallTracer {
+	return &mockCallTracer{}
+}
+
+// callTree returns collected call tree, where gas of the top-level frame is replaced with gas limit and gas usage
+// of the transaction
+func (t *mockCallTracer) callTree(gasLimit, gasUsed uint64) *mockCallFrame {
+	if t.root == nil {
+		return nil
+	}
+	t.root.Gas = hexutil.EncodeUint64(gasLimit)
+	t.root.GasUsed = hexutil.EncodeUint64(gasUsed)
+	return t.root
+}
+
+func (t *mockCallTracer) enter(typ vm.OpCode, from common.Address, to common.Address, input []byte, gas uint64, value *big.Int) {
+	frame := mockCallFrame{
+		Type:  typ.String(),
+		From:  hexutil.Encode(from.Bytes()),
+		To:    hexutil.Encode(to.Bytes()),
+		Gas:   hexutil.EncodeUint64(gas),
+		Input: hexutil.Encode(input),
+	}
+	if value != nil && typ != vm.STATICCALL {
+		frame.Value = hexutil.EncodeBig(value)
+	}
+	t.frames = append(t.frames, frame)
+}
+
+func (t *mockCallTracer) exit(output []byte, gasUsed uint64, err error) {
+	if len(t.frames) == 0 {
+		return
+	}
+	frame := t.frames[len(t.frames)-1]
+	t.frames = t.frames[:len(t.frames)-1]
+
+	frame.GasUsed = hexutil.EncodeUint64(gasUsed)
+	if len(output) != 0 {
+		frame.Output = hexutil.Encode(output)
+	}
+	// frame errors are reported in the same format as SGXVM reports them for nested frames
+	if errors.Is(err, vm.ErrExecutionReverted) {
+		frame.Error = vm.ErrExecutionReverted.Error()
+		if reason, unpackErr := abi.UnpackRevert(output); unpackErr == nil {
+			frame.RevertReason = reason
+		}
+	} else if err != nil {
+		frame.Error = convertVMError(err)
+	}
+
+	if len(t.frames) == 0 {
+		t.root = &frame
+		return
+	}
+	parent := &t.frames[len(t.frames)-1]
+	parent.Calls = append(parent.Calls, frame)
+}
+
+func (t *mockCallTracer) CaptureTxStart(uint64) {}
+
+func (t *mockCallTracer) CaptureTxEnd(uint64) {}
+
+func (t *mockCallTracer) CaptureStart(_ *vm.EVM, from common.Address, to common.Address, create bool, input []byte, gas uint64, value *big.Int) {
+	typ := vm.CALL
+	if create {
+		typ = vm.CREATE
+	}
+	t.enter(typ, from, to, input, gas, value)
+}
+
+func (t *mockCallTracer) CaptureEnd(output []byte, gasUsed uint64, _ time.Duration, err error) {
+	t.exit(output, gasUsed, err)
+}
+
+func (t *mockCallTracer) CaptureEnter(typ vm.OpCode, from common.Address, to common.Address, input []byte, gas uint64, value *big.Int) {
+	t.enter(typ, from, to, input, gas, value)
+}
+
+func (t *mockCallTracer) CaptureExit(output []byte, gasUsed uint64, err error) {
+	t.exit(output, gasUsed, err)
+}
+
+func (t *mockCallTracer) CaptureState(uint64, vm.OpCode, uint64, uint64, *vm.ScopeContext, []byte, int, error) {
+}
+
+func (t *mockCallTracer) CaptureFault(uint64, vm.OpCode, uint64, uint64, *vm.ScopeContext, int, error) {
+}
+
+// encryptCallTree encrypts collected call tree with the key of the transaction sender using random nonce
+func encryptCallTree(callTree *mockCallFrame, userPublicKey []byte, blockNumber uint64) ([]byte, error) {
+	if callTree == nil {
+		return nil, nil
+	}
+	encoded, err := json.Marshal(callTree)
+	if err != nil {
+		return nil, fmt.Errorf("cannot encode trace: %w", err)
+	}
+	return mockKeyManager.encryptTransactionData(encoded, userPublicKey, nil, blockNumber)
+}
+
+// verifyTraceAuthorization verifies that tracing of decrypted execution was authorized by the sender of the
+// transaction. Authorization contains signed transaction, which should match executed message, and EIP-712
+// signature of its hash by the same account
+func verifyTraceAuthorization(
+	authorization *types.TraceAuthorization,
+	from, to, data, value []byte,
+	chainID uint64,
+) error {
+	tx := new(ethtypes.Transaction)
+	if err := tx.UnmarshalBinary(authorization.Transaction); err != nil {
+		return fmt.Errorf("cannot decode transaction: %w", err)
+	}
+
+	// Transaction should match executed message
+	signer := ethtypes.LatestSignerForChainID(new(big.Int).SetUint64(chainID))
+	sender, err := ethtypes.Sender(signer, tx)
+	if err != nil {
+		return err
+	}
+	if sender != common.BytesToAddress(from) {
+		return errors.New("transaction was not sent by the message sender")
+	}
+	if tx.To() == nil || *tx.To() != common.BytesToAddress(to) {
+		return errors.New("transaction recipient doesn't match the message")
+	}
+	if tx.Value().Cmp(types.BytesToBig(value)) != 0 || !bytes.Equal(tx.Data(), data) {
+		return errors.New("transaction doesn't match the message")
+	}
+
+	// Tracing should be authorized by the sender of the transaction
+	authorizer, err := recoverTypedDataSigner(traceTransactionHash(chainID, tx.Hash()), authorization.Signature)
+	if err != nil {
+		return err
+	}
+	if authorizer != sender {
+		return errors.New("tracing was not authorized by the sender of the transaction")
+	}
+	return nil
+}
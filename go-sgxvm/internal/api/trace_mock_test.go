@@ -0,0 +1,131 @@
+//go:build nosgx
+// +build nosgx
+
+package api
+
+import (
+	"crypto/rand"
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/SigmaGmbH/librustgo/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/curve25519"
+)
+
+func TestMockCallTracer(t *testing.T) {
+	stringType, err := abi.NewType("string", "", nil)
+	require.NoError(t, err)
+	reason, err := abi.Arguments{{Type: stringType}}.Pack("insufficient balance")
+	require.NoError(t, err)
+	revertData := append(crypto.Keccak256([]byte("Error(string)"))[:4], reason...)
+
+	from := common.BigToAddress(big.NewInt(1))
+	to := common.BigToAddress(big.NewInt(2))
+	tracer := newMockCallTracer()
+	tracer.CaptureStart(nil, from, to, false, []byte{0x01}, 90_000, big.NewInt(0))
+	tracer.CaptureEnter(vm.STATICCALL, to, from, nil, 1000, nil)
+	tracer.CaptureExit([]byte{0x01}, 100, nil)
+	tracer.CaptureEnter(vm.CALL, to, from, nil, 1000, big.NewInt(0))
+	tracer.CaptureExit(revertData, 200, vm.ErrExecutionReverted)
+	tracer.CaptureEnter(vm.CALL, to, from, nil, 1000, big.NewInt(0))
+	tracer.CaptureExit(nil, 300, vm.ErrExecutionReverted)
+	tracer.CaptureEnd(revertData, 5000, 0, vm.ErrExecutionReverted)
+
+	callTree := tracer.callTree(100_000, 30_000)
+	require.NotNil(t, callTree)
+	require.Equal(t, "CALL", callTree.Type)
+	require.Equal(t, hexutil.EncodeUint64(100_000), callTree.Gas)
+	require.Equal(t, hexutil.EncodeUint64(30_000), callTree.GasUsed)
+	require.Equal(t, "insufficient balance", callTree.RevertReason)
+	require.Len(t, callTree.Calls, 3)
+	require.Equal(t, "STATICCALL", callTree.Calls[0].Type)
+	require.Empty(t, callTree.Calls[0].Value)
+	require.Empty(t, callTree.Calls[0].RevertReason)
+	require.Equal(t, "insufficient balance", callTree.Calls[1].RevertReason)
+	require.Equal(t, "execution reverted", callTree.Calls[2].Error)
+	require.Empty(t, callTree.Calls[2].RevertReason)
+
+	// call tree is returned encrypted with the key of the sender
+	userPrivateKey := make([]byte, 32)
+	_, err = rand.Read(userPrivateKey)
+	require.NoError(t, err)
+	userPublicKey, err := curve25519.X25519(userPrivateKey, curve25519.Basepoint)
+	require.NoError(t, err)
+	encrypted, err := encryptCallTree(callTree, userPublicKey, 1)
+	require.NoError(t, err)
+	require.NotContains(t, string(encrypted), "STATICCALL")
+
+	sharedSecret, err := curve25519.X25519(userPrivateKey, mockKeyManager.currentEpoch(1).publicKey())
+	require.NoError(t, err)
+	decrypted, err := decryptDeoxys(deriveKey(sharedSecret, []byte(ioEncryptionKeyPrefix)), encrypted)
+	require.NoError(t, err)
+	var decoded mockCallFrame
+	require.NoError(t, json.Unmarshal(decrypted, &decoded))
+	require.Equal(t, *callTree, decoded)
+}
+
+func TestMockVerifyTraceAuthorization(t *testing.T) {
+	const chainID = uint64(1291)
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	otherKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	from := crypto.PubkeyToAddress(key.PublicKey)
+	to := common.BigToAddress(big.NewInt(2))
+	data := []byte{0x01, 0x02}
+	value := big.NewInt(10)
+
+	signer := ethtypes.LatestSignerForChainID(new(big.Int).SetUint64(chainID))
+	tx, err := ethtypes.SignNewTx(key, signer, &ethtypes.LegacyTx{To: &to, Gas: 100_000, GasPrice: big.NewInt(1), Value: value, Data: data})
+	require.NoError(t, err)
+	txBytes, err := tx.MarshalBinary()
+	require.NoError(t, err)
+
+	sign := func(hash common.Hash, signer []byte) []byte {
+		privateKey, err := crypto.ToECDSA(signer)
+		require.NoError(t, err)
+		signature, err := crypto.Sign(hash.Bytes(), privateKey)
+		require.NoError(t, err)
+		// signature with Metamask recovery offset
+		signature[crypto.RecoveryIDOffset] += 27
+		return signature
+	}
+	traceHash := traceTransactionHash(chainID, tx.Hash())
+
+	testCases := []struct {
+		name          string
+		authorization *types.TraceAuthorization
+		from, to      common.Address
+		data          []byte
+		value         *big.Int
+		expPass       bool
+	}{
+		{"authorized by the sender", &types.TraceAuthorization{Transaction: txBytes, Signature: sign(traceHash, crypto.FromECDSA(key))}, from, to, data, value, true},
+		{"invalid transaction", &types.TraceAuthorization{Transaction: []byte{0x01}, Signature: sign(traceHash, crypto.FromECDSA(key))}, from, to, data, value, false},
+		{"invalid signature", &types.TraceAuthorization{Transaction: txBytes, Signature: []byte{0x01}}, from, to, data, value, false},
+		{"authorized not by the sender", &types.TraceAuthorization{Transaction: txBytes, Signature: sign(traceHash, crypto.FromECDSA(otherKey))}, from, to, data, value, false},
+		{"other transaction hash", &types.TraceAuthorization{Transaction: txBytes, Signature: sign(traceTransactionHash(chainID, common.Hash{}), crypto.FromECDSA(key))}, from, to, data, value, false},
+		{"other message sender", &types.TraceAuthorization{Transaction: txBytes, Signature: sign(traceHash, crypto.FromECDSA(key))}, to, to, data, value, false},
+		{"other message recipient", &types.TraceAuthorization{Transaction: txBytes, Signature: sign(traceHash, crypto.FromECDSA(key))}, from, from, data, value, false},
+		{"other message data", &types.TraceAuthorization{Transaction: txBytes, Signature: sign(traceHash, crypto.FromECDSA(key))}, from, to, []byte{0x01}, value, false},
+		{"other message value", &types.TraceAuthorization{Transaction: txBytes, Signature: sign(traceHash, crypto.FromECDSA(key))}, from, to, data, big.NewInt(1), false},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := verifyTraceAuthorization(tc.authorization, tc.from.Bytes(), tc.to.Bytes(), tc.data, common.BigToHash(tc.value).Bytes(), chainID)
+			if tc.expPass {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
@@ -17,6 +17,9 @@ type TransactionContext = types.TransactionContext
 // TransactionData contains data which is necessary to handle the transaction
 type TransactionData = types.TransactionData
 
+// AccessListItem contains address and storage slots, accessed during execution
+type AccessListItem = types.AccessListItem
+
 // Export protobuf messages for FFI
 type QueryGetAccount = types.QueryGetAccount
 type QueryGetAccountResponse = types.QueryGetAccountResponse
@@ -46,6 +49,58 @@ type QueryHasVerificationResponse = types.QueryHasVerificationResponse
 type QueryGetVerificationData = types.QueryGetVerificationData
 type VerificationDetails = types.VerificationDetails
 type QueryGetVerificationDataResponse = types.QueryGetVerificationDataResponse
+type QueryBatch = types.QueryBatch
+type QueryBatchResponse = types.QueryBatchResponse
+type QueryMetered = types.QueryMetered
+type QueryMeteredResponse = types.QueryMeteredResponse
+type BalanceChange = types.BalanceChange
+type QueryTraceEvents = types.QueryTraceEvents
+type QueryTraceEventsResponse = types.QueryTraceEventsResponse
+type QueryCosmosSnapshot = types.QueryCosmosSnapshot
+type QueryCosmosSnapshotResponse = types.QueryCosmosSnapshotResponse
+type QueryRevertCosmosSnapshot = types.QueryRevertCosmosSnapshot
+type QueryRevertCosmosSnapshotResponse = types.QueryRevertCosmosSnapshotResponse
+type TraceEvent = types.TraceEvent
+type TraceStep = types.TraceStep
+type TraceEnter = types.TraceEnter
+type TraceExit = types.TraceExit
+type TraceEvent_Step = types.TraceEvent_Step
+type TraceEvent_Enter = types.TraceEvent_Enter
+type TraceEvent_Exit = types.TraceEvent_Exit
+type TraceAuthorization = types.TraceAuthorization
+type QueryDelegate = types.QueryDelegate
+type QueryDelegateResponse = types.QueryDelegateResponse
+type QueryUndelegate = types.QueryUndelegate
+type QueryUndelegateResponse = types.QueryUndelegateResponse
+type QueryRedelegate = types.QueryRedelegate
+type QueryRedelegateResponse = types.QueryRedelegateResponse
+type QueryDelegation = types.QueryDelegation
+type QueryDelegationResponse = types.QueryDelegationResponse
+type QueryWithdrawDelegatorReward = types.QueryWithdrawDelegatorReward
+type QueryWithdrawDelegatorRewardResponse = types.QueryWithdrawDelegatorRewardResponse
+type QueryBankBalance = types.QueryBankBalance
+type QueryBankBalanceResponse = types.QueryBankBalanceResponse
+type QueryBankSend = types.QueryBankSend
+type QueryBankSendResponse = types.QueryBankSendResponse
+type QueryIBCTransfer = types.QueryIBCTransfer
+type QueryIBCTransferResponse = types.QueryIBCTransferResponse
+type QueryGovVote = types.QueryGovVote
+type QueryGovVoteResponse = types.QueryGovVoteResponse
+type WeightedVoteOption = types.WeightedVoteOption
+type QueryGovVoteWeighted = types.QueryGovVoteWeighted
+type QueryGovVoteWeightedResponse = types.QueryGovVoteWeightedResponse
+type QueryGovDeposit = types.QueryGovDeposit
+type QueryGovDepositResponse = types.QueryGovDepositResponse
+type QueryGovProposal = types.QueryGovProposal
+type QueryGovProposalResponse = types.QueryGovProposalResponse
+type QueryGovTally = types.QueryGovTally
+type QueryGovTallyResponse = types.QueryGovTallyResponse
+type StorageOverride = types.StorageOverride
+type PrivateStorageRequest = types.PrivateStorageRequest
+type PrivateStorageResponse = types.PrivateStorageResponse
+type QueryContractDeployer = types.QueryContractDeployer
+type QueryContractDeployerResponse = types.QueryContractDeployerResponse
+type StorageCellOverride = types.StorageCellOverride
 
 // Storage requests
 type CosmosRequest_GetAccount = types.CosmosRequest_GetAccount
@@ -60,9 +115,34 @@ type CosmosRequest_RemoveStorageCell = types.CosmosRequest_RemoveStorageCell
 type CosmosRequest_AddVerificationDetails = types.CosmosRequest_AddVerificationDetails
 type CosmosRequest_HasVerification = types.CosmosRequest_HasVerification
 type CosmosRequest_GetVerificationData = types.CosmosRequest_GetVerificationData
+type CosmosRequest_ContractDeployer = types.CosmosRequest_ContractDeployer
+
+// Staking and distribution requests
+type CosmosRequest_Delegate = types.CosmosRequest_Delegate
+type CosmosRequest_Undelegate = types.CosmosRequest_Undelegate
+type CosmosRequest_Redelegate = types.CosmosRequest_Redelegate
+type CosmosRequest_Delegation = types.CosmosRequest_Delegation
+type CosmosRequest_WithdrawDelegatorReward = types.CosmosRequest_WithdrawDelegatorReward
+
+// Bank and IBC transfer requests
+type CosmosRequest_BankBalance = types.CosmosRequest_BankBalance
+type CosmosRequest_BankSend = types.CosmosRequest_BankSend
+type CosmosRequest_IbcTransfer = types.CosmosRequest_IbcTransfer
+
+// Governance requests
+type CosmosRequest_GovVote = types.CosmosRequest_GovVote
+type CosmosRequest_GovVoteWeighted = types.CosmosRequest_GovVoteWeighted
+type CosmosRequest_GovDeposit = types.CosmosRequest_GovDeposit
+type CosmosRequest_GovProposal = types.CosmosRequest_GovProposal
+type CosmosRequest_GovTally = types.CosmosRequest_GovTally
 
 // Backend requests
 type CosmosRequest_BlockHash = types.CosmosRequest_BlockHash
+type CosmosRequest_Batch = types.CosmosRequest_Batch
+type CosmosRequest_Metered = types.CosmosRequest_Metered
+type CosmosRequest_TraceEvents = types.CosmosRequest_TraceEvents
+type CosmosRequest_CosmosSnapshot = types.CosmosRequest_CosmosSnapshot
+type CosmosRequest_RevertCosmosSnapshot = types.CosmosRequest_RevertCosmosSnapshot
 
 type HandleTransactionResponse = types.HandleTransactionResponse
 type NodePublicKeyRequest = types.NodePublicKeyRequest
@@ -78,7 +158,10 @@ func IsNodeInitialized() (bool, error) {
 	return api.IsNodeInitialized()
 }
 
-// Call handles incoming transaction data to transfer value or call some contract
+// Call handles incoming transaction data to transfer value or call some contract.
+// If trace is set, executed opcodes and nested call frames of unencrypted execution are reported through querier.
+// Execution of encrypted transaction is never reported. If traceAuthorization is provided and verified by enclave,
+// call tree of encrypted transaction is returned in `EncryptedTrace`, encrypted with the key of the sender
 func Call(
 	querier types.Connector,
 	from, to, data, value []byte,
@@ -87,8 +170,10 @@ func Call(
 	txContext *TransactionContext,
 	commit bool,
 	isUnencrypted bool,
+	trace bool,
+	traceAuthorization *TraceAuthorization,
 ) (*types.HandleTransactionResponse, error) {
-	executionResult, err := api.Call(querier, from, to, data, value, accessList, gasLimit, nonce, txContext, commit, isUnencrypted)
+	executionResult, err := api.Call(querier, from, to, data, value, accessList, gasLimit, nonce, txContext, commit, isUnencrypted, trace, traceAuthorization)
 	if err != nil {
 		return &types.HandleTransactionResponse{}, err
 	}
@@ -96,7 +181,8 @@ func Call(
 	return executionResult, nil
 }
 
-// Create handles incoming transaction data and creates a new smart contract
+// Create handles incoming transaction data and creates a new smart contract.
+// If trace is set, executed opcodes and nested call frames are reported through querier
 func Create(
 	querier types.Connector,
 	from, data, value []byte,
@@ -104,8 +190,9 @@ func Create(
 	gasLimit, nonce uint64,
 	txContext *TransactionContext,
 	commit bool,
+	trace bool,
 ) (*types.HandleTransactionResponse, error) {
-	executionResult, err := api.Create(querier, from, data, value, accessList, gasLimit, nonce, txContext, commit)
+	executionResult, err := api.Create(querier, from, data, value, accessList, gasLimit, nonce, txContext, commit, trace)
 	if err != nil {
 		return &types.HandleTransactionResponse{}, err
 	}
@@ -138,6 +225,19 @@ func GetNodePublicKey(blockNumber uint64) (*types.NodePublicKeyResponse, error)
 	return result, nil
 }
 
+// ApplyStorageOverride writes storage cells, overridden in queries such as `eth_call`, through the querier.
+// Values are encrypted inside the enclave in the same way as during EVM execution and are never returned to the caller
+func ApplyStorageOverride(querier types.Connector, overrides []*types.StorageOverride, blockNumber, timestamp uint64) error {
+	return api.ApplyStorageOverride(querier, overrides, blockNumber, timestamp)
+}
+
+// PrivateStorage reads decrypted storage cells of the contract inside the enclave and returns them encrypted
+// to the user public key. Enclave verifies that request was signed by the account, which is allowed to read
+// storage of the contract. If access was denied, response contains the reason in `Error`
+func PrivateStorage(querier types.Connector, req *PrivateStorageRequest) (*PrivateStorageResponse, error) {
+	return api.PrivateStorage(querier, req)
+}
+
 // Libsgx_wrapperVersion returns the version of the loaded library
 // at runtime. This can be used for debugging to verify the loaded version
 // matches the expected version.
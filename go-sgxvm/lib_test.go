@@ -39,6 +39,7 @@ func TestCreate(t *testing.T) {
 		0,
 		txContext,
 		true,
+		false,
 	)
 	if err != nil {
 		t.Fatal(err)
@@ -93,6 +94,8 @@ func TestCoinTransfer(t *testing.T) {
 		txContext,
 		true,
 		false,
+		false,
+		nil,
 	)
 	if err != nil {
 		t.Fail()
@@ -174,6 +174,9 @@ type TransactionContext struct {
 	BlockBaseFeePerGas []byte `protobuf:"bytes,5,opt,name=block_base_fee_per_gas,json=blockBaseFeePerGas,proto3" json:"block_base_fee_per_gas,omitempty"`
 	BlockCoinbase      []byte `protobuf:"bytes,6,opt,name=block_coinbase,json=blockCoinbase,proto3" json:"block_coinbase,omitempty"`
 	BlockNumber        uint64 `protobuf:"varint,7,opt,name=block_number,json=blockNumber,proto3" json:"block_number,omitempty"`
+	// If not set, sender of the message is not exposed to executed contracts and zero address is used instead.
+	// Set only if sender was authenticated by transaction signature or EIP-712 permit
+	AuthenticatedSender bool `protobuf:"varint,8,opt,name=authenticated_sender,json=authenticatedSender,proto3" json:"authenticated_sender,omitempty"`
 }
 
 func (x *TransactionContext) Reset() {
@@ -257,6 +260,13 @@ func (x *TransactionContext) GetBlockNumber() uint64 {
 	return 0
 }
 
+func (x *TransactionContext) GetAuthenticatedSender() bool {
+	if x != nil {
+		return x.AuthenticatedSender
+	}
+	return false
+}
+
 type HandleTransactionRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -325,8 +335,18 @@ type HandleTransactionResponse struct {
 	Ret []byte `protobuf:"bytes,3,opt,name=ret,proto3" json:"ret,omitempty"`
 	// vm error is the error returned by vm execution
 	VmError string `protobuf:"bytes,4,opt,name=vm_error,json=vmError,proto3" json:"vm_error,omitempty"`
-	// gas consumed by the transaction
+	// gas consumed by the transaction. Refund is not applied
 	GasUsed uint64 `protobuf:"varint,5,opt,name=gas_used,json=gasUsed,proto3" json:"gas_used,omitempty"`
+	// refund counter, accumulated during execution. Refund is capped according
+	// to EIP-3529 and applied outside of the enclave
+	GasRefund uint64 `protobuf:"varint,6,opt,name=gas_refund,json=gasRefund,proto3" json:"gas_refund,omitempty"`
+	// addresses and storage slots accessed during execution. Precompiles are
+	// not included
+	AccessList []*AccessListItem `protobuf:"bytes,7,rep,name=access_list,json=accessList,proto3" json:"access_list,omitempty"`
+	// call tree of decrypted execution in `callTracer` format with decoded revert
+	// reasons, encrypted to the key, used by the sender to encrypt transaction
+	// data. Set only if tracing was authorized by the sender
+	EncryptedTrace []byte `protobuf:"bytes,8,opt,name=encrypted_trace,json=encryptedTrace,proto3" json:"encrypted_trace,omitempty"`
 }
 
 func (x *HandleTransactionResponse) Reset() {
@@ -389,6 +409,27 @@ func (x *HandleTransactionResponse) GetGasUsed() uint64 {
 	return 0
 }
 
+func (x *HandleTransactionResponse) GetGasRefund() uint64 {
+	if x != nil {
+		return x.GasRefund
+	}
+	return 0
+}
+
+func (x *HandleTransactionResponse) GetAccessList() []*AccessListItem {
+	if x != nil {
+		return x.AccessList
+	}
+	return nil
+}
+
+func (x *HandleTransactionResponse) GetEncryptedTrace() []byte {
+	if x != nil {
+		return x.EncryptedTrace
+	}
+	return nil
+}
+
 // Topic represents 32-byte words that is used to describe what’s going on in an
 // event
 type Topic struct {
@@ -2076,32 +2117,19 @@ func (x *QueryGetVerificationDataResponse) GetData() []*VerificationDetails {
 	return nil
 }
 
-type CosmosRequest struct {
+// Delegates coins of the delegator to the validator. Amount is denominated in staking bond denom
+type QueryDelegate struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Types that are assignable to Req:
-	//
-	//	*CosmosRequest_GetAccount
-	//	*CosmosRequest_InsertAccount
-	//	*CosmosRequest_ContainsKey
-	//	*CosmosRequest_AccountCode
-	//	*CosmosRequest_StorageCell
-	//	*CosmosRequest_InsertAccountCode
-	//	*CosmosRequest_InsertStorageCell
-	//	*CosmosRequest_Remove
-	//	*CosmosRequest_RemoveStorageCell
-	//	*CosmosRequest_RemoveStorage
-	//	*CosmosRequest_BlockHash
-	//	*CosmosRequest_AddVerificationDetails
-	//	*CosmosRequest_HasVerification
-	//	*CosmosRequest_GetVerificationData
-	Req isCosmosRequest_Req `protobuf_oneof:"req"`
+	Delegator        []byte `protobuf:"bytes,1,opt,name=delegator,proto3" json:"delegator,omitempty"`
+	ValidatorAddress string `protobuf:"bytes,2,opt,name=validatorAddress,proto3" json:"validatorAddress,omitempty"`
+	Amount           []byte `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
 }
 
-func (x *CosmosRequest) Reset() {
-	*x = CosmosRequest{}
+func (x *QueryDelegate) Reset() {
+	*x = QueryDelegate{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_ffi_proto_msgTypes[36]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2109,13 +2137,13 @@ func (x *CosmosRequest) Reset() {
 	}
 }
 
-func (x *CosmosRequest) String() string {
+func (x *QueryDelegate) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CosmosRequest) ProtoMessage() {}
+func (*QueryDelegate) ProtoMessage() {}
 
-func (x *CosmosRequest) ProtoReflect() protoreflect.Message {
+func (x *QueryDelegate) ProtoReflect() protoreflect.Message {
 	mi := &file_ffi_proto_msgTypes[36]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2127,238 +2155,299 @@ func (x *CosmosRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CosmosRequest.ProtoReflect.Descriptor instead.
-func (*CosmosRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use QueryDelegate.ProtoReflect.Descriptor instead.
+func (*QueryDelegate) Descriptor() ([]byte, []int) {
 	return file_ffi_proto_rawDescGZIP(), []int{36}
 }
 
-func (m *CosmosRequest) GetReq() isCosmosRequest_Req {
-	if m != nil {
-		return m.Req
+func (x *QueryDelegate) GetDelegator() []byte {
+	if x != nil {
+		return x.Delegator
 	}
 	return nil
 }
 
-func (x *CosmosRequest) GetGetAccount() *QueryGetAccount {
-	if x, ok := x.GetReq().(*CosmosRequest_GetAccount); ok {
-		return x.GetAccount
+func (x *QueryDelegate) GetValidatorAddress() string {
+	if x != nil {
+		return x.ValidatorAddress
 	}
-	return nil
+	return ""
 }
 
-func (x *CosmosRequest) GetInsertAccount() *QueryInsertAccount {
-	if x, ok := x.GetReq().(*CosmosRequest_InsertAccount); ok {
-		return x.InsertAccount
+func (x *QueryDelegate) GetAmount() []byte {
+	if x != nil {
+		return x.Amount
 	}
 	return nil
 }
 
-func (x *CosmosRequest) GetContainsKey() *QueryContainsKey {
-	if x, ok := x.GetReq().(*CosmosRequest_ContainsKey); ok {
-		return x.ContainsKey
-	}
-	return nil
+// Contains logs, converted from Cosmos events, emitted during delegation
+type QueryDelegateResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Logs []*Log `protobuf:"bytes,1,rep,name=logs,proto3" json:"logs,omitempty"`
 }
 
-func (x *CosmosRequest) GetAccountCode() *QueryGetAccountCode {
-	if x, ok := x.GetReq().(*CosmosRequest_AccountCode); ok {
-		return x.AccountCode
+func (x *QueryDelegateResponse) Reset() {
+	*x = QueryDelegateResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ffi_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return nil
 }
 
-func (x *CosmosRequest) GetStorageCell() *QueryGetAccountStorageCell {
-	if x, ok := x.GetReq().(*CosmosRequest_StorageCell); ok {
-		return x.StorageCell
-	}
-	return nil
+func (x *QueryDelegateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *CosmosRequest) GetInsertAccountCode() *QueryInsertAccountCode {
-	if x, ok := x.GetReq().(*CosmosRequest_InsertAccountCode); ok {
-		return x.InsertAccountCode
+func (*QueryDelegateResponse) ProtoMessage() {}
+
+func (x *QueryDelegateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ffi_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *CosmosRequest) GetInsertStorageCell() *QueryInsertStorageCell {
-	if x, ok := x.GetReq().(*CosmosRequest_InsertStorageCell); ok {
-		return x.InsertStorageCell
-	}
-	return nil
+// Deprecated: Use QueryDelegateResponse.ProtoReflect.Descriptor instead.
+func (*QueryDelegateResponse) Descriptor() ([]byte, []int) {
+	return file_ffi_proto_rawDescGZIP(), []int{37}
 }
 
-func (x *CosmosRequest) GetRemove() *QueryRemove {
-	if x, ok := x.GetReq().(*CosmosRequest_Remove); ok {
-		return x.Remove
+func (x *QueryDelegateResponse) GetLogs() []*Log {
+	if x != nil {
+		return x.Logs
 	}
 	return nil
 }
 
-func (x *CosmosRequest) GetRemoveStorageCell() *QueryRemoveStorageCell {
-	if x, ok := x.GetReq().(*CosmosRequest_RemoveStorageCell); ok {
-		return x.RemoveStorageCell
-	}
-	return nil
+// Starts unbonding of delegated coins
+type QueryUndelegate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Delegator        []byte `protobuf:"bytes,1,opt,name=delegator,proto3" json:"delegator,omitempty"`
+	ValidatorAddress string `protobuf:"bytes,2,opt,name=validatorAddress,proto3" json:"validatorAddress,omitempty"`
+	Amount           []byte `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
 }
 
-func (x *CosmosRequest) GetRemoveStorage() *QueryRemoveStorage {
-	if x, ok := x.GetReq().(*CosmosRequest_RemoveStorage); ok {
-		return x.RemoveStorage
+func (x *QueryUndelegate) Reset() {
+	*x = QueryUndelegate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ffi_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return nil
 }
 
-func (x *CosmosRequest) GetBlockHash() *QueryBlockHash {
-	if x, ok := x.GetReq().(*CosmosRequest_BlockHash); ok {
-		return x.BlockHash
+func (x *QueryUndelegate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryUndelegate) ProtoMessage() {}
+
+func (x *QueryUndelegate) ProtoReflect() protoreflect.Message {
+	mi := &file_ffi_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *CosmosRequest) GetAddVerificationDetails() *QueryAddVerificationDetails {
-	if x, ok := x.GetReq().(*CosmosRequest_AddVerificationDetails); ok {
-		return x.AddVerificationDetails
+// Deprecated: Use QueryUndelegate.ProtoReflect.Descriptor instead.
+func (*QueryUndelegate) Descriptor() ([]byte, []int) {
+	return file_ffi_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *QueryUndelegate) GetDelegator() []byte {
+	if x != nil {
+		return x.Delegator
 	}
 	return nil
 }
 
-func (x *CosmosRequest) GetHasVerification() *QueryHasVerification {
-	if x, ok := x.GetReq().(*CosmosRequest_HasVerification); ok {
-		return x.HasVerification
+func (x *QueryUndelegate) GetValidatorAddress() string {
+	if x != nil {
+		return x.ValidatorAddress
 	}
-	return nil
+	return ""
 }
 
-func (x *CosmosRequest) GetGetVerificationData() *QueryGetVerificationData {
-	if x, ok := x.GetReq().(*CosmosRequest_GetVerificationData); ok {
-		return x.GetVerificationData
+func (x *QueryUndelegate) GetAmount() []byte {
+	if x != nil {
+		return x.Amount
 	}
 	return nil
 }
 
-type isCosmosRequest_Req interface {
-	isCosmosRequest_Req()
+// Contains unix timestamp, when unbonding is completed, and logs, converted from Cosmos events
+type QueryUndelegateResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CompletionTime int64  `protobuf:"varint,1,opt,name=completionTime,proto3" json:"completionTime,omitempty"`
+	Logs           []*Log `protobuf:"bytes,2,rep,name=logs,proto3" json:"logs,omitempty"`
 }
 
-type CosmosRequest_GetAccount struct {
-	GetAccount *QueryGetAccount `protobuf:"bytes,1,opt,name=getAccount,proto3,oneof"`
+func (x *QueryUndelegateResponse) Reset() {
+	*x = QueryUndelegateResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ffi_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
 }
 
-type CosmosRequest_InsertAccount struct {
-	InsertAccount *QueryInsertAccount `protobuf:"bytes,2,opt,name=insertAccount,proto3,oneof"`
+func (x *QueryUndelegateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-type CosmosRequest_ContainsKey struct {
-	ContainsKey *QueryContainsKey `protobuf:"bytes,3,opt,name=containsKey,proto3,oneof"`
+func (*QueryUndelegateResponse) ProtoMessage() {}
+
+func (x *QueryUndelegateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ffi_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-type CosmosRequest_AccountCode struct {
-	AccountCode *QueryGetAccountCode `protobuf:"bytes,4,opt,name=accountCode,proto3,oneof"`
+// Deprecated: Use QueryUndelegateResponse.ProtoReflect.Descriptor instead.
+func (*QueryUndelegateResponse) Descriptor() ([]byte, []int) {
+	return file_ffi_proto_rawDescGZIP(), []int{39}
 }
 
-type CosmosRequest_StorageCell struct {
-	StorageCell *QueryGetAccountStorageCell `protobuf:"bytes,5,opt,name=storageCell,proto3,oneof"`
+func (x *QueryUndelegateResponse) GetCompletionTime() int64 {
+	if x != nil {
+		return x.CompletionTime
+	}
+	return 0
 }
 
-type CosmosRequest_InsertAccountCode struct {
-	InsertAccountCode *QueryInsertAccountCode `protobuf:"bytes,6,opt,name=insertAccountCode,proto3,oneof"`
+func (x *QueryUndelegateResponse) GetLogs() []*Log {
+	if x != nil {
+		return x.Logs
+	}
+	return nil
 }
 
-type CosmosRequest_InsertStorageCell struct {
-	InsertStorageCell *QueryInsertStorageCell `protobuf:"bytes,7,opt,name=insertStorageCell,proto3,oneof"`
+// Moves delegated coins from one validator to another
+type QueryRedelegate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Delegator           []byte `protobuf:"bytes,1,opt,name=delegator,proto3" json:"delegator,omitempty"`
+	ValidatorSrcAddress string `protobuf:"bytes,2,opt,name=validatorSrcAddress,proto3" json:"validatorSrcAddress,omitempty"`
+	ValidatorDstAddress string `protobuf:"bytes,3,opt,name=validatorDstAddress,proto3" json:"validatorDstAddress,omitempty"`
+	Amount              []byte `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"`
 }
 
-type CosmosRequest_Remove struct {
-	Remove *QueryRemove `protobuf:"bytes,8,opt,name=remove,proto3,oneof"`
+func (x *QueryRedelegate) Reset() {
+	*x = QueryRedelegate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ffi_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
 }
 
-type CosmosRequest_RemoveStorageCell struct {
-	RemoveStorageCell *QueryRemoveStorageCell `protobuf:"bytes,9,opt,name=removeStorageCell,proto3,oneof"`
+func (x *QueryRedelegate) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-type CosmosRequest_RemoveStorage struct {
-	RemoveStorage *QueryRemoveStorage `protobuf:"bytes,10,opt,name=removeStorage,proto3,oneof"`
+func (*QueryRedelegate) ProtoMessage() {}
+
+func (x *QueryRedelegate) ProtoReflect() protoreflect.Message {
+	mi := &file_ffi_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-type CosmosRequest_BlockHash struct {
-	BlockHash *QueryBlockHash `protobuf:"bytes,11,opt,name=blockHash,proto3,oneof"`
+// Deprecated: Use QueryRedelegate.ProtoReflect.Descriptor instead.
+func (*QueryRedelegate) Descriptor() ([]byte, []int) {
+	return file_ffi_proto_rawDescGZIP(), []int{40}
 }
 
-type CosmosRequest_AddVerificationDetails struct {
-	AddVerificationDetails *QueryAddVerificationDetails `protobuf:"bytes,12,opt,name=addVerificationDetails,proto3,oneof"`
+func (x *QueryRedelegate) GetDelegator() []byte {
+	if x != nil {
+		return x.Delegator
+	}
+	return nil
 }
 
-type CosmosRequest_HasVerification struct {
-	HasVerification *QueryHasVerification `protobuf:"bytes,13,opt,name=hasVerification,proto3,oneof"`
+func (x *QueryRedelegate) GetValidatorSrcAddress() string {
+	if x != nil {
+		return x.ValidatorSrcAddress
+	}
+	return ""
 }
 
-type CosmosRequest_GetVerificationData struct {
-	GetVerificationData *QueryGetVerificationData `protobuf:"bytes,14,opt,name=getVerificationData,proto3,oneof"`
+func (x *QueryRedelegate) GetValidatorDstAddress() string {
+	if x != nil {
+		return x.ValidatorDstAddress
+	}
+	return ""
 }
 
-func (*CosmosRequest_GetAccount) isCosmosRequest_Req() {}
+func (x *QueryRedelegate) GetAmount() []byte {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
 
-func (*CosmosRequest_InsertAccount) isCosmosRequest_Req() {}
+// Contains unix timestamp, when redelegation is completed, and logs, converted from Cosmos events
+type QueryRedelegateResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-func (*CosmosRequest_ContainsKey) isCosmosRequest_Req() {}
+	CompletionTime int64  `protobuf:"varint,1,opt,name=completionTime,proto3" json:"completionTime,omitempty"`
+	Logs           []*Log `protobuf:"bytes,2,rep,name=logs,proto3" json:"logs,omitempty"`
+}
 
-func (*CosmosRequest_AccountCode) isCosmosRequest_Req() {}
-
-func (*CosmosRequest_StorageCell) isCosmosRequest_Req() {}
-
-func (*CosmosRequest_InsertAccountCode) isCosmosRequest_Req() {}
-
-func (*CosmosRequest_InsertStorageCell) isCosmosRequest_Req() {}
-
-func (*CosmosRequest_Remove) isCosmosRequest_Req() {}
-
-func (*CosmosRequest_RemoveStorageCell) isCosmosRequest_Req() {}
-
-func (*CosmosRequest_RemoveStorage) isCosmosRequest_Req() {}
-
-func (*CosmosRequest_BlockHash) isCosmosRequest_Req() {}
-
-func (*CosmosRequest_AddVerificationDetails) isCosmosRequest_Req() {}
-
-func (*CosmosRequest_HasVerification) isCosmosRequest_Req() {}
-
-func (*CosmosRequest_GetVerificationData) isCosmosRequest_Req() {}
-
-// Message with data required to execute `call` operation
-type SGXVMCallParams struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	From        []byte            `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
-	To          []byte            `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
-	Data        []byte            `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
-	GasLimit    uint64            `protobuf:"varint,4,opt,name=gasLimit,proto3" json:"gasLimit,omitempty"`
-	Value       []byte            `protobuf:"bytes,5,opt,name=value,proto3" json:"value,omitempty"`
-	AccessList  []*AccessListItem `protobuf:"bytes,6,rep,name=accessList,proto3" json:"accessList,omitempty"`
-	Commit      bool              `protobuf:"varint,7,opt,name=commit,proto3" json:"commit,omitempty"`
-	Nonce       uint64            `protobuf:"varint,8,opt,name=nonce,proto3" json:"nonce,omitempty"`
-	Unencrypted bool              `protobuf:"varint,9,opt,name=unencrypted,proto3" json:"unencrypted,omitempty"`
-}
-
-func (x *SGXVMCallParams) Reset() {
-	*x = SGXVMCallParams{}
+func (x *QueryRedelegateResponse) Reset() {
+	*x = QueryRedelegateResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ffi_proto_msgTypes[37]
+		mi := &file_ffi_proto_msgTypes[41]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SGXVMCallParams) String() string {
+func (x *QueryRedelegateResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SGXVMCallParams) ProtoMessage() {}
+func (*QueryRedelegateResponse) ProtoMessage() {}
 
-func (x *SGXVMCallParams) ProtoReflect() protoreflect.Message {
-	mi := &file_ffi_proto_msgTypes[37]
+func (x *QueryRedelegateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ffi_proto_msgTypes[41]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2369,106 +2458,106 @@ func (x *SGXVMCallParams) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SGXVMCallParams.ProtoReflect.Descriptor instead.
-func (*SGXVMCallParams) Descriptor() ([]byte, []int) {
-	return file_ffi_proto_rawDescGZIP(), []int{37}
+// Deprecated: Use QueryRedelegateResponse.ProtoReflect.Descriptor instead.
+func (*QueryRedelegateResponse) Descriptor() ([]byte, []int) {
+	return file_ffi_proto_rawDescGZIP(), []int{41}
 }
 
-func (x *SGXVMCallParams) GetFrom() []byte {
+func (x *QueryRedelegateResponse) GetCompletionTime() int64 {
 	if x != nil {
-		return x.From
+		return x.CompletionTime
 	}
-	return nil
+	return 0
 }
 
-func (x *SGXVMCallParams) GetTo() []byte {
+func (x *QueryRedelegateResponse) GetLogs() []*Log {
 	if x != nil {
-		return x.To
+		return x.Logs
 	}
 	return nil
 }
 
-func (x *SGXVMCallParams) GetData() []byte {
-	if x != nil {
-		return x.Data
-	}
-	return nil
+// Returns amount of coins, delegated to the validator
+type QueryDelegation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Delegator        []byte `protobuf:"bytes,1,opt,name=delegator,proto3" json:"delegator,omitempty"`
+	ValidatorAddress string `protobuf:"bytes,2,opt,name=validatorAddress,proto3" json:"validatorAddress,omitempty"`
 }
 
-func (x *SGXVMCallParams) GetGasLimit() uint64 {
-	if x != nil {
-		return x.GasLimit
+func (x *QueryDelegation) Reset() {
+	*x = QueryDelegation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ffi_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return 0
 }
 
-func (x *SGXVMCallParams) GetValue() []byte {
-	if x != nil {
-		return x.Value
-	}
-	return nil
+func (x *QueryDelegation) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *SGXVMCallParams) GetAccessList() []*AccessListItem {
-	if x != nil {
-		return x.AccessList
+func (*QueryDelegation) ProtoMessage() {}
+
+func (x *QueryDelegation) ProtoReflect() protoreflect.Message {
+	mi := &file_ffi_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *SGXVMCallParams) GetCommit() bool {
-	if x != nil {
-		return x.Commit
-	}
-	return false
+// Deprecated: Use QueryDelegation.ProtoReflect.Descriptor instead.
+func (*QueryDelegation) Descriptor() ([]byte, []int) {
+	return file_ffi_proto_rawDescGZIP(), []int{42}
 }
 
-func (x *SGXVMCallParams) GetNonce() uint64 {
+func (x *QueryDelegation) GetDelegator() []byte {
 	if x != nil {
-		return x.Nonce
+		return x.Delegator
 	}
-	return 0
+	return nil
 }
 
-func (x *SGXVMCallParams) GetUnencrypted() bool {
+func (x *QueryDelegation) GetValidatorAddress() string {
 	if x != nil {
-		return x.Unencrypted
+		return x.ValidatorAddress
 	}
-	return false
+	return ""
 }
 
-// Message with data required to execute `create` operation
-type SGXVMCreateParams struct {
+type QueryDelegationResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	From       []byte            `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
-	Data       []byte            `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
-	GasLimit   uint64            `protobuf:"varint,3,opt,name=gasLimit,proto3" json:"gasLimit,omitempty"`
-	Value      []byte            `protobuf:"bytes,4,opt,name=value,proto3" json:"value,omitempty"`
-	AccessList []*AccessListItem `protobuf:"bytes,5,rep,name=accessList,proto3" json:"accessList,omitempty"`
-	Commit     bool              `protobuf:"varint,6,opt,name=commit,proto3" json:"commit,omitempty"`
-	Nonce      uint64            `protobuf:"varint,7,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	Amount []byte `protobuf:"bytes,1,opt,name=amount,proto3" json:"amount,omitempty"`
 }
 
-func (x *SGXVMCreateParams) Reset() {
-	*x = SGXVMCreateParams{}
+func (x *QueryDelegationResponse) Reset() {
+	*x = QueryDelegationResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ffi_proto_msgTypes[38]
+		mi := &file_ffi_proto_msgTypes[43]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SGXVMCreateParams) String() string {
+func (x *QueryDelegationResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SGXVMCreateParams) ProtoMessage() {}
+func (*QueryDelegationResponse) ProtoMessage() {}
 
-func (x *SGXVMCreateParams) ProtoReflect() protoreflect.Message {
-	mi := &file_ffi_proto_msgTypes[38]
+func (x *QueryDelegationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ffi_proto_msgTypes[43]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2479,87 +2568,101 @@ func (x *SGXVMCreateParams) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SGXVMCreateParams.ProtoReflect.Descriptor instead.
-func (*SGXVMCreateParams) Descriptor() ([]byte, []int) {
-	return file_ffi_proto_rawDescGZIP(), []int{38}
+// Deprecated: Use QueryDelegationResponse.ProtoReflect.Descriptor instead.
+func (*QueryDelegationResponse) Descriptor() ([]byte, []int) {
+	return file_ffi_proto_rawDescGZIP(), []int{43}
 }
 
-func (x *SGXVMCreateParams) GetFrom() []byte {
+func (x *QueryDelegationResponse) GetAmount() []byte {
 	if x != nil {
-		return x.From
+		return x.Amount
 	}
 	return nil
 }
 
-func (x *SGXVMCreateParams) GetData() []byte {
-	if x != nil {
-		return x.Data
-	}
-	return nil
+// Withdraws delegation rewards to the withdraw address of the delegator
+type QueryWithdrawDelegatorReward struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Delegator        []byte `protobuf:"bytes,1,opt,name=delegator,proto3" json:"delegator,omitempty"`
+	ValidatorAddress string `protobuf:"bytes,2,opt,name=validatorAddress,proto3" json:"validatorAddress,omitempty"`
 }
 
-func (x *SGXVMCreateParams) GetGasLimit() uint64 {
-	if x != nil {
-		return x.GasLimit
+func (x *QueryWithdrawDelegatorReward) Reset() {
+	*x = QueryWithdrawDelegatorReward{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ffi_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return 0
 }
 
-func (x *SGXVMCreateParams) GetValue() []byte {
-	if x != nil {
-		return x.Value
-	}
-	return nil
+func (x *QueryWithdrawDelegatorReward) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *SGXVMCreateParams) GetAccessList() []*AccessListItem {
-	if x != nil {
-		return x.AccessList
+func (*QueryWithdrawDelegatorReward) ProtoMessage() {}
+
+func (x *QueryWithdrawDelegatorReward) ProtoReflect() protoreflect.Message {
+	mi := &file_ffi_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *SGXVMCreateParams) GetCommit() bool {
+// Deprecated: Use QueryWithdrawDelegatorReward.ProtoReflect.Descriptor instead.
+func (*QueryWithdrawDelegatorReward) Descriptor() ([]byte, []int) {
+	return file_ffi_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *QueryWithdrawDelegatorReward) GetDelegator() []byte {
 	if x != nil {
-		return x.Commit
+		return x.Delegator
 	}
-	return false
+	return nil
 }
 
-func (x *SGXVMCreateParams) GetNonce() uint64 {
+func (x *QueryWithdrawDelegatorReward) GetValidatorAddress() string {
 	if x != nil {
-		return x.Nonce
+		return x.ValidatorAddress
 	}
-	return 0
+	return ""
 }
 
-// Request to execute `call` operation
-type SGXVMCallRequest struct {
+// Contains withdrawn amount, denominated in staking bond denom, and logs, converted from Cosmos events
+type QueryWithdrawDelegatorRewardResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Params  *SGXVMCallParams    `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"`
-	Context *TransactionContext `protobuf:"bytes,2,opt,name=context,proto3" json:"context,omitempty"`
+	Amount []byte `protobuf:"bytes,1,opt,name=amount,proto3" json:"amount,omitempty"`
+	Logs   []*Log `protobuf:"bytes,2,rep,name=logs,proto3" json:"logs,omitempty"`
 }
 
-func (x *SGXVMCallRequest) Reset() {
-	*x = SGXVMCallRequest{}
+func (x *QueryWithdrawDelegatorRewardResponse) Reset() {
+	*x = QueryWithdrawDelegatorRewardResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ffi_proto_msgTypes[39]
+		mi := &file_ffi_proto_msgTypes[45]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SGXVMCallRequest) String() string {
+func (x *QueryWithdrawDelegatorRewardResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SGXVMCallRequest) ProtoMessage() {}
+func (*QueryWithdrawDelegatorRewardResponse) ProtoMessage() {}
 
-func (x *SGXVMCallRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ffi_proto_msgTypes[39]
+func (x *QueryWithdrawDelegatorRewardResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ffi_proto_msgTypes[45]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2570,52 +2673,52 @@ func (x *SGXVMCallRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SGXVMCallRequest.ProtoReflect.Descriptor instead.
-func (*SGXVMCallRequest) Descriptor() ([]byte, []int) {
-	return file_ffi_proto_rawDescGZIP(), []int{39}
+// Deprecated: Use QueryWithdrawDelegatorRewardResponse.ProtoReflect.Descriptor instead.
+func (*QueryWithdrawDelegatorRewardResponse) Descriptor() ([]byte, []int) {
+	return file_ffi_proto_rawDescGZIP(), []int{45}
 }
 
-func (x *SGXVMCallRequest) GetParams() *SGXVMCallParams {
+func (x *QueryWithdrawDelegatorRewardResponse) GetAmount() []byte {
 	if x != nil {
-		return x.Params
+		return x.Amount
 	}
 	return nil
 }
 
-func (x *SGXVMCallRequest) GetContext() *TransactionContext {
+func (x *QueryWithdrawDelegatorRewardResponse) GetLogs() []*Log {
 	if x != nil {
-		return x.Context
+		return x.Logs
 	}
 	return nil
 }
 
-// Request to execute `create` operation
-type SGXVMCreateRequest struct {
+// Returns balance of the account in provided denom
+type QueryBankBalance struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Params  *SGXVMCreateParams  `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"`
-	Context *TransactionContext `protobuf:"bytes,2,opt,name=context,proto3" json:"context,omitempty"`
+	Address []byte `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Denom   string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
 }
 
-func (x *SGXVMCreateRequest) Reset() {
-	*x = SGXVMCreateRequest{}
+func (x *QueryBankBalance) Reset() {
+	*x = QueryBankBalance{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ffi_proto_msgTypes[40]
+		mi := &file_ffi_proto_msgTypes[46]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SGXVMCreateRequest) String() string {
+func (x *QueryBankBalance) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SGXVMCreateRequest) ProtoMessage() {}
+func (*QueryBankBalance) ProtoMessage() {}
 
-func (x *SGXVMCreateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ffi_proto_msgTypes[40]
+func (x *QueryBankBalance) ProtoReflect() protoreflect.Message {
+	mi := &file_ffi_proto_msgTypes[46]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2626,51 +2729,50 @@ func (x *SGXVMCreateRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SGXVMCreateRequest.ProtoReflect.Descriptor instead.
-func (*SGXVMCreateRequest) Descriptor() ([]byte, []int) {
-	return file_ffi_proto_rawDescGZIP(), []int{40}
+// Deprecated: Use QueryBankBalance.ProtoReflect.Descriptor instead.
+func (*QueryBankBalance) Descriptor() ([]byte, []int) {
+	return file_ffi_proto_rawDescGZIP(), []int{46}
 }
 
-func (x *SGXVMCreateRequest) GetParams() *SGXVMCreateParams {
+func (x *QueryBankBalance) GetAddress() []byte {
 	if x != nil {
-		return x.Params
+		return x.Address
 	}
 	return nil
 }
 
-func (x *SGXVMCreateRequest) GetContext() *TransactionContext {
+func (x *QueryBankBalance) GetDenom() string {
 	if x != nil {
-		return x.Context
+		return x.Denom
 	}
-	return nil
+	return ""
 }
 
-// Request to obtain node public key
-type NodePublicKeyRequest struct {
+type QueryBankBalanceResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	BlockNumber uint64 `protobuf:"varint,1,opt,name=blockNumber,proto3" json:"blockNumber,omitempty"`
+	Amount []byte `protobuf:"bytes,1,opt,name=amount,proto3" json:"amount,omitempty"`
 }
 
-func (x *NodePublicKeyRequest) Reset() {
-	*x = NodePublicKeyRequest{}
+func (x *QueryBankBalanceResponse) Reset() {
+	*x = QueryBankBalanceResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ffi_proto_msgTypes[41]
+		mi := &file_ffi_proto_msgTypes[47]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *NodePublicKeyRequest) String() string {
+func (x *QueryBankBalanceResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*NodePublicKeyRequest) ProtoMessage() {}
+func (*QueryBankBalanceResponse) ProtoMessage() {}
 
-func (x *NodePublicKeyRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ffi_proto_msgTypes[41]
+func (x *QueryBankBalanceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ffi_proto_msgTypes[47]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2681,44 +2783,47 @@ func (x *NodePublicKeyRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use NodePublicKeyRequest.ProtoReflect.Descriptor instead.
-func (*NodePublicKeyRequest) Descriptor() ([]byte, []int) {
-	return file_ffi_proto_rawDescGZIP(), []int{41}
+// Deprecated: Use QueryBankBalanceResponse.ProtoReflect.Descriptor instead.
+func (*QueryBankBalanceResponse) Descriptor() ([]byte, []int) {
+	return file_ffi_proto_rawDescGZIP(), []int{47}
 }
 
-func (x *NodePublicKeyRequest) GetBlockNumber() uint64 {
+func (x *QueryBankBalanceResponse) GetAmount() []byte {
 	if x != nil {
-		return x.BlockNumber
+		return x.Amount
 	}
-	return 0
+	return nil
 }
 
-// Response with node public key
-type NodePublicKeyResponse struct {
+// Sends coins of provided denom through x/bank module
+type QueryBankSend struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	PublicKey []byte `protobuf:"bytes,1,opt,name=publicKey,proto3" json:"publicKey,omitempty"`
+	From   []byte `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	To     []byte `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	Denom  string `protobuf:"bytes,3,opt,name=denom,proto3" json:"denom,omitempty"`
+	Amount []byte `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"`
 }
 
-func (x *NodePublicKeyResponse) Reset() {
-	*x = NodePublicKeyResponse{}
+func (x *QueryBankSend) Reset() {
+	*x = QueryBankSend{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ffi_proto_msgTypes[42]
+		mi := &file_ffi_proto_msgTypes[48]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *NodePublicKeyResponse) String() string {
+func (x *QueryBankSend) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*NodePublicKeyResponse) ProtoMessage() {}
+func (*QueryBankSend) ProtoMessage() {}
 
-func (x *NodePublicKeyResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ffi_proto_msgTypes[42]
+func (x *QueryBankSend) ProtoReflect() protoreflect.Message {
+	mi := &file_ffi_proto_msgTypes[48]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2729,45 +2834,65 @@ func (x *NodePublicKeyResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use NodePublicKeyResponse.ProtoReflect.Descriptor instead.
-func (*NodePublicKeyResponse) Descriptor() ([]byte, []int) {
-	return file_ffi_proto_rawDescGZIP(), []int{42}
+// Deprecated: Use QueryBankSend.ProtoReflect.Descriptor instead.
+func (*QueryBankSend) Descriptor() ([]byte, []int) {
+	return file_ffi_proto_rawDescGZIP(), []int{48}
 }
 
-func (x *NodePublicKeyResponse) GetPublicKey() []byte {
+func (x *QueryBankSend) GetFrom() []byte {
 	if x != nil {
-		return x.PublicKey
+		return x.From
 	}
 	return nil
 }
 
-type EpochData struct {
+func (x *QueryBankSend) GetTo() []byte {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *QueryBankSend) GetDenom() string {
+	if x != nil {
+		return x.Denom
+	}
+	return ""
+}
+
+func (x *QueryBankSend) GetAmount() []byte {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+// Contains logs, converted from Cosmos events, emitted during sending
+type QueryBankSendResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	EpochNumber   uint32 `protobuf:"varint,1,opt,name=epochNumber,proto3" json:"epochNumber,omitempty"`
-	StartingBlock uint64 `protobuf:"varint,2,opt,name=startingBlock,proto3" json:"startingBlock,omitempty"`
-	NodePublicKey []byte `protobuf:"bytes,3,opt,name=nodePublicKey,proto3" json:"nodePublicKey,omitempty"`
+	Logs []*Log `protobuf:"bytes,1,rep,name=logs,proto3" json:"logs,omitempty"`
 }
 
-func (x *EpochData) Reset() {
-	*x = EpochData{}
+func (x *QueryBankSendResponse) Reset() {
+	*x = QueryBankSendResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ffi_proto_msgTypes[43]
+		mi := &file_ffi_proto_msgTypes[49]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *EpochData) String() string {
+func (x *QueryBankSendResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EpochData) ProtoMessage() {}
+func (*QueryBankSendResponse) ProtoMessage() {}
 
-func (x *EpochData) ProtoReflect() protoreflect.Message {
-	mi := &file_ffi_proto_msgTypes[43]
+func (x *QueryBankSendResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ffi_proto_msgTypes[49]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2778,57 +2903,165 @@ func (x *EpochData) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EpochData.ProtoReflect.Descriptor instead.
-func (*EpochData) Descriptor() ([]byte, []int) {
-	return file_ffi_proto_rawDescGZIP(), []int{43}
+// Deprecated: Use QueryBankSendResponse.ProtoReflect.Descriptor instead.
+func (*QueryBankSendResponse) Descriptor() ([]byte, []int) {
+	return file_ffi_proto_rawDescGZIP(), []int{49}
 }
 
-func (x *EpochData) GetEpochNumber() uint32 {
+func (x *QueryBankSendResponse) GetLogs() []*Log {
 	if x != nil {
-		return x.EpochNumber
+		return x.Logs
+	}
+	return nil
+}
+
+// Starts ICS-20 transfer of coins to another chain
+type QueryIBCTransfer struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sender                []byte `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	SourcePort            string `protobuf:"bytes,2,opt,name=sourcePort,proto3" json:"sourcePort,omitempty"`
+	SourceChannel         string `protobuf:"bytes,3,opt,name=sourceChannel,proto3" json:"sourceChannel,omitempty"`
+	Denom                 string `protobuf:"bytes,4,opt,name=denom,proto3" json:"denom,omitempty"`
+	Amount                []byte `protobuf:"bytes,5,opt,name=amount,proto3" json:"amount,omitempty"`
+	Receiver              string `protobuf:"bytes,6,opt,name=receiver,proto3" json:"receiver,omitempty"`
+	TimeoutRevisionNumber uint64 `protobuf:"varint,7,opt,name=timeoutRevisionNumber,proto3" json:"timeoutRevisionNumber,omitempty"`
+	TimeoutRevisionHeight uint64 `protobuf:"varint,8,opt,name=timeoutRevisionHeight,proto3" json:"timeoutRevisionHeight,omitempty"`
+	TimeoutTimestamp      uint64 `protobuf:"varint,9,opt,name=timeoutTimestamp,proto3" json:"timeoutTimestamp,omitempty"`
+	Memo                  string `protobuf:"bytes,10,opt,name=memo,proto3" json:"memo,omitempty"`
+}
+
+func (x *QueryIBCTransfer) Reset() {
+	*x = QueryIBCTransfer{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ffi_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryIBCTransfer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryIBCTransfer) ProtoMessage() {}
+
+func (x *QueryIBCTransfer) ProtoReflect() protoreflect.Message {
+	mi := &file_ffi_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryIBCTransfer.ProtoReflect.Descriptor instead.
+func (*QueryIBCTransfer) Descriptor() ([]byte, []int) {
+	return file_ffi_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *QueryIBCTransfer) GetSender() []byte {
+	if x != nil {
+		return x.Sender
+	}
+	return nil
+}
+
+func (x *QueryIBCTransfer) GetSourcePort() string {
+	if x != nil {
+		return x.SourcePort
+	}
+	return ""
+}
+
+func (x *QueryIBCTransfer) GetSourceChannel() string {
+	if x != nil {
+		return x.SourceChannel
+	}
+	return ""
+}
+
+func (x *QueryIBCTransfer) GetDenom() string {
+	if x != nil {
+		return x.Denom
+	}
+	return ""
+}
+
+func (x *QueryIBCTransfer) GetAmount() []byte {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+func (x *QueryIBCTransfer) GetReceiver() string {
+	if x != nil {
+		return x.Receiver
+	}
+	return ""
+}
+
+func (x *QueryIBCTransfer) GetTimeoutRevisionNumber() uint64 {
+	if x != nil {
+		return x.TimeoutRevisionNumber
 	}
 	return 0
 }
 
-func (x *EpochData) GetStartingBlock() uint64 {
+func (x *QueryIBCTransfer) GetTimeoutRevisionHeight() uint64 {
 	if x != nil {
-		return x.StartingBlock
+		return x.TimeoutRevisionHeight
 	}
 	return 0
 }
 
-func (x *EpochData) GetNodePublicKey() []byte {
+func (x *QueryIBCTransfer) GetTimeoutTimestamp() uint64 {
 	if x != nil {
-		return x.NodePublicKey
+		return x.TimeoutTimestamp
 	}
-	return nil
+	return 0
 }
 
-type ListEpochsResponse struct {
+func (x *QueryIBCTransfer) GetMemo() string {
+	if x != nil {
+		return x.Memo
+	}
+	return ""
+}
+
+// Contains sequence of sent packet and logs, converted from Cosmos events, emitted during transfer
+type QueryIBCTransferResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Epochs []*EpochData `protobuf:"bytes,1,rep,name=epochs,proto3" json:"epochs,omitempty"`
+	Sequence uint64 `protobuf:"varint,1,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	Logs     []*Log `protobuf:"bytes,2,rep,name=logs,proto3" json:"logs,omitempty"`
 }
 
-func (x *ListEpochsResponse) Reset() {
-	*x = ListEpochsResponse{}
+func (x *QueryIBCTransferResponse) Reset() {
+	*x = QueryIBCTransferResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ffi_proto_msgTypes[44]
+		mi := &file_ffi_proto_msgTypes[51]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ListEpochsResponse) String() string {
+func (x *QueryIBCTransferResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListEpochsResponse) ProtoMessage() {}
+func (*QueryIBCTransferResponse) ProtoMessage() {}
 
-func (x *ListEpochsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ffi_proto_msgTypes[44]
+func (x *QueryIBCTransferResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ffi_proto_msgTypes[51]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2839,48 +3072,54 @@ func (x *ListEpochsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListEpochsResponse.ProtoReflect.Descriptor instead.
-func (*ListEpochsResponse) Descriptor() ([]byte, []int) {
-	return file_ffi_proto_rawDescGZIP(), []int{44}
+// Deprecated: Use QueryIBCTransferResponse.ProtoReflect.Descriptor instead.
+func (*QueryIBCTransferResponse) Descriptor() ([]byte, []int) {
+	return file_ffi_proto_rawDescGZIP(), []int{51}
 }
 
-func (x *ListEpochsResponse) GetEpochs() []*EpochData {
+func (x *QueryIBCTransferResponse) GetSequence() uint64 {
 	if x != nil {
-		return x.Epochs
+		return x.Sequence
+	}
+	return 0
+}
+
+func (x *QueryIBCTransferResponse) GetLogs() []*Log {
+	if x != nil {
+		return x.Logs
 	}
 	return nil
 }
 
-type FFIRequest struct {
+// Casts vote of the voter on governance proposal
+type QueryGovVote struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Types that are assignable to Req:
-	//
-	//	*FFIRequest_CallRequest
-	//	*FFIRequest_CreateRequest
-	//	*FFIRequest_PublicKeyRequest
-	Req isFFIRequest_Req `protobuf_oneof:"req"`
+	Voter      []byte `protobuf:"bytes,1,opt,name=voter,proto3" json:"voter,omitempty"`
+	ProposalId uint64 `protobuf:"varint,2,opt,name=proposalId,proto3" json:"proposalId,omitempty"`
+	Option     int32  `protobuf:"varint,3,opt,name=option,proto3" json:"option,omitempty"`
+	Metadata   string `protobuf:"bytes,4,opt,name=metadata,proto3" json:"metadata,omitempty"`
 }
 
-func (x *FFIRequest) Reset() {
-	*x = FFIRequest{}
+func (x *QueryGovVote) Reset() {
+	*x = QueryGovVote{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ffi_proto_msgTypes[45]
+		mi := &file_ffi_proto_msgTypes[52]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *FFIRequest) String() string {
+func (x *QueryGovVote) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FFIRequest) ProtoMessage() {}
+func (*QueryGovVote) ProtoMessage() {}
 
-func (x *FFIRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ffi_proto_msgTypes[45]
+func (x *QueryGovVote) ProtoReflect() protoreflect.Message {
+	mi := &file_ffi_proto_msgTypes[52]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2891,536 +3130,4676 @@ func (x *FFIRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FFIRequest.ProtoReflect.Descriptor instead.
-func (*FFIRequest) Descriptor() ([]byte, []int) {
-	return file_ffi_proto_rawDescGZIP(), []int{45}
+// Deprecated: Use QueryGovVote.ProtoReflect.Descriptor instead.
+func (*QueryGovVote) Descriptor() ([]byte, []int) {
+	return file_ffi_proto_rawDescGZIP(), []int{52}
 }
 
-func (m *FFIRequest) GetReq() isFFIRequest_Req {
-	if m != nil {
-		return m.Req
+func (x *QueryGovVote) GetVoter() []byte {
+	if x != nil {
+		return x.Voter
 	}
 	return nil
 }
 
-func (x *FFIRequest) GetCallRequest() *SGXVMCallRequest {
-	if x, ok := x.GetReq().(*FFIRequest_CallRequest); ok {
-		return x.CallRequest
+func (x *QueryGovVote) GetProposalId() uint64 {
+	if x != nil {
+		return x.ProposalId
 	}
-	return nil
+	return 0
 }
 
-func (x *FFIRequest) GetCreateRequest() *SGXVMCreateRequest {
-	if x, ok := x.GetReq().(*FFIRequest_CreateRequest); ok {
-		return x.CreateRequest
+func (x *QueryGovVote) GetOption() int32 {
+	if x != nil {
+		return x.Option
 	}
-	return nil
+	return 0
 }
 
-func (x *FFIRequest) GetPublicKeyRequest() *NodePublicKeyRequest {
-	if x, ok := x.GetReq().(*FFIRequest_PublicKeyRequest); ok {
-		return x.PublicKeyRequest
+func (x *QueryGovVote) GetMetadata() string {
+	if x != nil {
+		return x.Metadata
 	}
-	return nil
+	return ""
 }
 
-type isFFIRequest_Req interface {
-	isFFIRequest_Req()
-}
+// Contains logs, converted from Cosmos events, emitted during voting
+type QueryGovVoteResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-type FFIRequest_CallRequest struct {
-	CallRequest *SGXVMCallRequest `protobuf:"bytes,1,opt,name=callRequest,proto3,oneof"`
+	Logs []*Log `protobuf:"bytes,1,rep,name=logs,proto3" json:"logs,omitempty"`
 }
 
-type FFIRequest_CreateRequest struct {
-	CreateRequest *SGXVMCreateRequest `protobuf:"bytes,2,opt,name=createRequest,proto3,oneof"`
+func (x *QueryGovVoteResponse) Reset() {
+	*x = QueryGovVoteResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ffi_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
 }
 
-type FFIRequest_PublicKeyRequest struct {
-	PublicKeyRequest *NodePublicKeyRequest `protobuf:"bytes,3,opt,name=publicKeyRequest,proto3,oneof"`
+func (x *QueryGovVoteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FFIRequest_CallRequest) isFFIRequest_Req() {}
+func (*QueryGovVoteResponse) ProtoMessage() {}
 
-func (*FFIRequest_CreateRequest) isFFIRequest_Req() {}
+func (x *QueryGovVoteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ffi_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
 
-func (*FFIRequest_PublicKeyRequest) isFFIRequest_Req() {}
+// Deprecated: Use QueryGovVoteResponse.ProtoReflect.Descriptor instead.
+func (*QueryGovVoteResponse) Descriptor() ([]byte, []int) {
+	return file_ffi_proto_rawDescGZIP(), []int{53}
+}
 
-var File_ffi_proto protoreflect.FileDescriptor
+func (x *QueryGovVoteResponse) GetLogs() []*Log {
+	if x != nil {
+		return x.Logs
+	}
+	return nil
+}
 
-var file_ffi_proto_rawDesc = []byte{
-	0x0a, 0x09, 0x66, 0x66, 0x69, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x07, 0x66, 0x66, 0x69,
-	0x2e, 0x66, 0x66, 0x69, 0x22, 0x4c, 0x0a, 0x0e, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x4c, 0x69,
-	0x73, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x20, 0x0a, 0x0b, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67,
-	0x65, 0x53, 0x6c, 0x6f, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x0b, 0x73, 0x74, 0x6f,
-	0x72, 0x61, 0x67, 0x65, 0x53, 0x6c, 0x6f, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72,
-	0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65,
-	0x73, 0x73, 0x22, 0xb4, 0x01, 0x0a, 0x0f, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x74, 0x6f,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x02, 0x74, 0x6f, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61,
-	0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1a,
-	0x0a, 0x08, 0x67, 0x61, 0x73, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04,
-	0x52, 0x08, 0x67, 0x61, 0x73, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
-	0x12, 0x37, 0x0a, 0x0a, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x18, 0x06,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x66, 0x66, 0x69, 0x2e, 0x66, 0x66, 0x69, 0x2e, 0x41,
-	0x63, 0x63, 0x65, 0x73, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x0a, 0x61,
-	0x63, 0x63, 0x65, 0x73, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x22, 0x90, 0x02, 0x0a, 0x12, 0x54, 0x72,
-	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74,
-	0x12, 0x19, 0x0a, 0x08, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x07, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x67,
-	0x61, 0x73, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08,
-	0x67, 0x61, 0x73, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65,
-	0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x74, 0x69, 0x6d,
-	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x26, 0x0a, 0x0f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f,
-	0x67, 0x61, 0x73, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x0d, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x47, 0x61, 0x73, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x32,
-	0x0a, 0x16, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x66, 0x65, 0x65,
-	0x5f, 0x70, 0x65, 0x72, 0x5f, 0x67, 0x61, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x12,
-	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x42, 0x61, 0x73, 0x65, 0x46, 0x65, 0x65, 0x50, 0x65, 0x72, 0x47,
-	0x61, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x63, 0x6f, 0x69, 0x6e,
-	0x62, 0x61, 0x73, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0d, 0x62, 0x6c, 0x6f, 0x63,
-	0x6b, 0x43, 0x6f, 0x69, 0x6e, 0x62, 0x61, 0x73, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x6c, 0x6f,
-	0x63, 0x6b, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x22, 0x89, 0x01, 0x0a,
-	0x18, 0x48, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x31, 0x0a, 0x07, 0x74, 0x78, 0x5f,
-	0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x66, 0x66, 0x69,
-	0x2e, 0x66, 0x66, 0x69, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x44, 0x61, 0x74, 0x61, 0x52, 0x06, 0x74, 0x78, 0x44, 0x61, 0x74, 0x61, 0x12, 0x3a, 0x0a, 0x0a,
-	0x74, 0x78, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x1b, 0x2e, 0x66, 0x66, 0x69, 0x2e, 0x66, 0x66, 0x69, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73,
-	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x52, 0x09, 0x74,
-	0x78, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x22, 0x85, 0x01, 0x0a, 0x19, 0x48, 0x61, 0x6e,
-	0x64, 0x6c, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x20, 0x0a, 0x04, 0x6c, 0x6f, 0x67, 0x73, 0x18, 0x02,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x66, 0x66, 0x69, 0x2e, 0x66, 0x66, 0x69, 0x2e, 0x4c,
-	0x6f, 0x67, 0x52, 0x04, 0x6c, 0x6f, 0x67, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x72, 0x65, 0x74, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x72, 0x65, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x76, 0x6d,
-	0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x6d,
-	0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x19, 0x0a, 0x08, 0x67, 0x61, 0x73, 0x5f, 0x75, 0x73, 0x65,
-	0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x67, 0x61, 0x73, 0x55, 0x73, 0x65, 0x64,
-	0x22, 0x1d, 0x0a, 0x05, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x6e,
-	0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x22,
-	0x5b, 0x0a, 0x03, 0x4c, 0x6f, 0x67, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
-	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
-	0x12, 0x26, 0x0a, 0x06, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x0e, 0x2e, 0x66, 0x66, 0x69, 0x2e, 0x66, 0x66, 0x69, 0x2e, 0x54, 0x6f, 0x70, 0x69, 0x63,
-	0x52, 0x06, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x2b, 0x0a, 0x0f,
-	0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x65, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12,
-	0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c,
-	0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x49, 0x0a, 0x17, 0x51, 0x75, 0x65,
-	0x72, 0x79, 0x47, 0x65, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x14,
-	0x0a, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x6e,
-	0x6f, 0x6e, 0x63, 0x65, 0x22, 0x5e, 0x0a, 0x12, 0x51, 0x75, 0x65, 0x72, 0x79, 0x49, 0x6e, 0x73,
-	0x65, 0x72, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64,
-	0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x61, 0x64, 0x64,
-	0x72, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x14,
-	0x0a, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x6e,
-	0x6f, 0x6e, 0x63, 0x65, 0x22, 0x1c, 0x0a, 0x1a, 0x51, 0x75, 0x65, 0x72, 0x79, 0x49, 0x6e, 0x73,
-	0x65, 0x72, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x24, 0x0a, 0x10, 0x51, 0x75, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x6e, 0x74, 0x61,
-	0x69, 0x6e, 0x73, 0x4b, 0x65, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0c, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x22, 0x36, 0x0a, 0x18, 0x51, 0x75, 0x65, 0x72,
-	0x79, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x73, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x73,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x73,
-	0x22, 0x4c, 0x0a, 0x1a, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x65, 0x74, 0x41, 0x63, 0x63, 0x6f,
-	0x75, 0x6e, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x43, 0x65, 0x6c, 0x6c, 0x12, 0x18,
-	0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52,
-	0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65,
-	0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x22, 0x3a,
-	0x0a, 0x22, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x65, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
-	0x74, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x43, 0x65, 0x6c, 0x6c, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0c, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x2f, 0x0a, 0x13, 0x51, 0x75,
-	0x65, 0x72, 0x79, 0x47, 0x65, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x43, 0x6f, 0x64,
-	0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0c, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x31, 0x0a, 0x1b, 0x51,
-	0x75, 0x65, 0x72, 0x79, 0x47, 0x65, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x43, 0x6f,
-	0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f,
-	0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x22, 0x46,
-	0x0a, 0x16, 0x51, 0x75, 0x65, 0x72, 0x79, 0x49, 0x6e, 0x73, 0x65, 0x72, 0x74, 0x41, 0x63, 0x63,
-	0x6f, 0x75, 0x6e, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72,
-	0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65,
-	0x73, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c,
-	0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x22, 0x20, 0x0a, 0x1e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x49,
-	0x6e, 0x73, 0x65, 0x72, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x43, 0x6f, 0x64, 0x65,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x5e, 0x0a, 0x16, 0x51, 0x75, 0x65, 0x72,
-	0x79, 0x49, 0x6e, 0x73, 0x65, 0x72, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x43, 0x65,
-	0x6c, 0x6c, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0c, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x14, 0x0a, 0x05,
-	0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x69, 0x6e, 0x64,
-	0x65, 0x78, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x0c, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x20, 0x0a, 0x1e, 0x51, 0x75, 0x65, 0x72,
-	0x79, 0x49, 0x6e, 0x73, 0x65, 0x72, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x43, 0x65,
-	0x6c, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x27, 0x0a, 0x0b, 0x51, 0x75,
-	0x65, 0x72, 0x79, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64,
-	0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72,
-	0x65, 0x73, 0x73, 0x22, 0x15, 0x0a, 0x13, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x6d, 0x6f,
-	0x76, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x48, 0x0a, 0x16, 0x51, 0x75,
-	0x65, 0x72, 0x79, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65,
-	0x43, 0x65, 0x6c, 0x6c, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x14,
-	0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x69,
-	0x6e, 0x64, 0x65, 0x78, 0x22, 0x20, 0x0a, 0x1e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x6d,
-	0x6f, 0x76, 0x65, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x43, 0x65, 0x6c, 0x6c, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2e, 0x0a, 0x12, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52,
-	0x65, 0x6d, 0x6f, 0x76, 0x65, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07,
-	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x61,
-	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x1c, 0x0a, 0x1a, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52,
-	0x65, 0x6d, 0x6f, 0x76, 0x65, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x28, 0x0a, 0x0e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x42, 0x6c, 0x6f,
-	0x63, 0x6b, 0x48, 0x61, 0x73, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x22, 0x2c,
-	0x0a, 0x16, 0x51, 0x75, 0x65, 0x72, 0x79, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x61, 0x73, 0x68,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x22, 0x97, 0x03, 0x0a,
-	0x1b, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x64, 0x64, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x12, 0x20, 0x0a, 0x0b,
-	0x75, 0x73, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0c, 0x52, 0x0b, 0x75, 0x73, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x24,
-	0x0a, 0x0d, 0x69, 0x73, 0x73, 0x75, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0d, 0x69, 0x73, 0x73, 0x75, 0x65, 0x72, 0x41, 0x64, 0x64,
-	0x72, 0x65, 0x73, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x43, 0x68,
-	0x61, 0x69, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6f, 0x72, 0x69, 0x67, 0x69,
-	0x6e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x12, 0x2a, 0x0a, 0x10, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69,
-	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d,
-	0x52, 0x10, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79,
-	0x70, 0x65, 0x12, 0x2c, 0x0a, 0x11, 0x69, 0x73, 0x73, 0x75, 0x61, 0x6e, 0x63, 0x65, 0x54, 0x69,
-	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x11, 0x69,
-	0x73, 0x73, 0x75, 0x61, 0x6e, 0x63, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
-	0x12, 0x30, 0x0a, 0x13, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69,
-	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x13, 0x65,
-	0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
-	0x6d, 0x70, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x44, 0x61, 0x74, 0x61, 0x18,
-	0x07, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x44, 0x61, 0x74, 0x61,
-	0x12, 0x16, 0x0a, 0x06, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x06, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x12, 0x32, 0x0a, 0x14, 0x69, 0x73, 0x73, 0x75,
-	0x65, 0x72, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64,
-	0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x14, 0x69, 0x73, 0x73, 0x75, 0x65, 0x72, 0x56, 0x65,
-	0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07,
-	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x76,
-	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x4d, 0x0a, 0x23, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41,
-	0x64, 0x64, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65,
-	0x74, 0x61, 0x69, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a,
-	0x0e, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0e, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0xbe, 0x01, 0x0a, 0x14, 0x51, 0x75, 0x65, 0x72, 0x79, 0x48,
-	0x61, 0x73, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x20,
-	0x0a, 0x0b, 0x75, 0x73, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0c, 0x52, 0x0b, 0x75, 0x73, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
-	0x12, 0x2a, 0x0a, 0x10, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x54, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x10, 0x76, 0x65, 0x72, 0x69,
-	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x30, 0x0a, 0x13,
-	0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
-	0x61, 0x6d, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x13, 0x65, 0x78, 0x70, 0x69, 0x72,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x26,
-	0x0a, 0x0e, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x49, 0x73, 0x73, 0x75, 0x65, 0x72, 0x73,
-	0x18, 0x04, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x0e, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x49,
-	0x73, 0x73, 0x75, 0x65, 0x72, 0x73, 0x22, 0x48, 0x0a, 0x1c, 0x51, 0x75, 0x65, 0x72, 0x79, 0x48,
-	0x61, 0x73, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x0f, 0x68, 0x61, 0x73, 0x56, 0x65, 0x72,
-	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x0f, 0x68, 0x61, 0x73, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x22, 0x62, 0x0a, 0x18, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x65, 0x74, 0x56, 0x65, 0x72, 0x69,
-	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x12, 0x20, 0x0a, 0x0b,
-	0x75, 0x73, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0c, 0x52, 0x0b, 0x75, 0x73, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x24,
-	0x0a, 0x0d, 0x69, 0x73, 0x73, 0x75, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0d, 0x69, 0x73, 0x73, 0x75, 0x65, 0x72, 0x41, 0x64, 0x64,
-	0x72, 0x65, 0x73, 0x73, 0x22, 0x9b, 0x03, 0x0a, 0x13, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x12, 0x2a, 0x0a, 0x10,
-	0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x10, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x26, 0x0a, 0x0e, 0x76, 0x65, 0x72, 0x69,
-	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c,
-	0x52, 0x0e, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44,
-	0x12, 0x24, 0x0a, 0x0d, 0x69, 0x73, 0x73, 0x75, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
-	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0d, 0x69, 0x73, 0x73, 0x75, 0x65, 0x72, 0x41,
-	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e,
-	0x43, 0x68, 0x61, 0x69, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6f, 0x72, 0x69,
-	0x67, 0x69, 0x6e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x12, 0x2c, 0x0a, 0x11, 0x69, 0x73, 0x73, 0x75,
-	0x61, 0x6e, 0x63, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x0d, 0x52, 0x11, 0x69, 0x73, 0x73, 0x75, 0x61, 0x6e, 0x63, 0x65, 0x54, 0x69, 0x6d,
-	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x30, 0x0a, 0x13, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x06, 0x20,
-	0x01, 0x28, 0x0d, 0x52, 0x13, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54,
-	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x22, 0x0a, 0x0c, 0x6f, 0x72, 0x69, 0x67,
-	0x69, 0x6e, 0x61, 0x6c, 0x44, 0x61, 0x74, 0x61, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0c,
-	0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x44, 0x61, 0x74, 0x61, 0x12, 0x16, 0x0a, 0x06,
-	0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x63,
-	0x68, 0x65, 0x6d, 0x61, 0x12, 0x32, 0x0a, 0x14, 0x69, 0x73, 0x73, 0x75, 0x65, 0x72, 0x56, 0x65,
-	0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x18, 0x09, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x14, 0x69, 0x73, 0x73, 0x75, 0x65, 0x72, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69,
-	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73,
-	0x69, 0x6f, 0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69,
-	0x6f, 0x6e, 0x22, 0x54, 0x0a, 0x20, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x65, 0x74, 0x56, 0x65,
-	0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x66, 0x66, 0x69, 0x2e, 0x66, 0x66, 0x69, 0x2e, 0x56,
-	0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x74, 0x61, 0x69,
-	0x6c, 0x73, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x84, 0x08, 0x0a, 0x0d, 0x43, 0x6f, 0x73,
-	0x6d, 0x6f, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3a, 0x0a, 0x0a, 0x67, 0x65,
-	0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18,
-	0x2e, 0x66, 0x66, 0x69, 0x2e, 0x66, 0x66, 0x69, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x65,
-	0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x0a, 0x67, 0x65, 0x74, 0x41,
-	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x43, 0x0a, 0x0d, 0x69, 0x6e, 0x73, 0x65, 0x72, 0x74,
-	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e,
-	0x66, 0x66, 0x69, 0x2e, 0x66, 0x66, 0x69, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x49, 0x6e, 0x73,
-	0x65, 0x72, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x0d, 0x69, 0x6e,
-	0x73, 0x65, 0x72, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x3d, 0x0a, 0x0b, 0x63,
-	0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x73, 0x4b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x19, 0x2e, 0x66, 0x66, 0x69, 0x2e, 0x66, 0x66, 0x69, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79,
-	0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x73, 0x4b, 0x65, 0x79, 0x48, 0x00, 0x52, 0x0b, 0x63,
-	0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x73, 0x4b, 0x65, 0x79, 0x12, 0x40, 0x0a, 0x0b, 0x61, 0x63,
-	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x1c, 0x2e, 0x66, 0x66, 0x69, 0x2e, 0x66, 0x66, 0x69, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47,
-	0x65, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x48, 0x00, 0x52,
-	0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x47, 0x0a, 0x0b,
-	0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x43, 0x65, 0x6c, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x23, 0x2e, 0x66, 0x66, 0x69, 0x2e, 0x66, 0x66, 0x69, 0x2e, 0x51, 0x75, 0x65, 0x72,
-	0x79, 0x47, 0x65, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x61,
-	0x67, 0x65, 0x43, 0x65, 0x6c, 0x6c, 0x48, 0x00, 0x52, 0x0b, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67,
-	0x65, 0x43, 0x65, 0x6c, 0x6c, 0x12, 0x4f, 0x0a, 0x11, 0x69, 0x6e, 0x73, 0x65, 0x72, 0x74, 0x41,
-	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x1f, 0x2e, 0x66, 0x66, 0x69, 0x2e, 0x66, 0x66, 0x69, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79,
-	0x49, 0x6e, 0x73, 0x65, 0x72, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x43, 0x6f, 0x64,
-	0x65, 0x48, 0x00, 0x52, 0x11, 0x69, 0x6e, 0x73, 0x65, 0x72, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75,
-	0x6e, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x4f, 0x0a, 0x11, 0x69, 0x6e, 0x73, 0x65, 0x72, 0x74,
-	0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x43, 0x65, 0x6c, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x1f, 0x2e, 0x66, 0x66, 0x69, 0x2e, 0x66, 0x66, 0x69, 0x2e, 0x51, 0x75, 0x65, 0x72,
-	0x79, 0x49, 0x6e, 0x73, 0x65, 0x72, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x43, 0x65,
-	0x6c, 0x6c, 0x48, 0x00, 0x52, 0x11, 0x69, 0x6e, 0x73, 0x65, 0x72, 0x74, 0x53, 0x74, 0x6f, 0x72,
-	0x61, 0x67, 0x65, 0x43, 0x65, 0x6c, 0x6c, 0x12, 0x2e, 0x0a, 0x06, 0x72, 0x65, 0x6d, 0x6f, 0x76,
-	0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x66, 0x66, 0x69, 0x2e, 0x66, 0x66,
-	0x69, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x48, 0x00, 0x52,
-	0x06, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x12, 0x4f, 0x0a, 0x11, 0x72, 0x65, 0x6d, 0x6f, 0x76,
-	0x65, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x43, 0x65, 0x6c, 0x6c, 0x18, 0x09, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x66, 0x66, 0x69, 0x2e, 0x66, 0x66, 0x69, 0x2e, 0x51, 0x75, 0x65,
-	0x72, 0x79, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x43,
-	0x65, 0x6c, 0x6c, 0x48, 0x00, 0x52, 0x11, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x53, 0x74, 0x6f,
-	0x72, 0x61, 0x67, 0x65, 0x43, 0x65, 0x6c, 0x6c, 0x12, 0x43, 0x0a, 0x0d, 0x72, 0x65, 0x6d, 0x6f,
-	0x76, 0x65, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x1b, 0x2e, 0x66, 0x66, 0x69, 0x2e, 0x66, 0x66, 0x69, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52,
-	0x65, 0x6d, 0x6f, 0x76, 0x65, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x0d,
-	0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x12, 0x37, 0x0a,
-	0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x61, 0x73, 0x68, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x17, 0x2e, 0x66, 0x66, 0x69, 0x2e, 0x66, 0x66, 0x69, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79,
-	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x61, 0x73, 0x68, 0x48, 0x00, 0x52, 0x09, 0x62, 0x6c, 0x6f,
-	0x63, 0x6b, 0x48, 0x61, 0x73, 0x68, 0x12, 0x5e, 0x0a, 0x16, 0x61, 0x64, 0x64, 0x56, 0x65, 0x72,
-	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73,
-	0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x66, 0x66, 0x69, 0x2e, 0x66, 0x66, 0x69,
-	0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x64, 0x64, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x48, 0x00, 0x52, 0x16,
-	0x61, 0x64, 0x64, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44,
-	0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x12, 0x49, 0x0a, 0x0f, 0x68, 0x61, 0x73, 0x56, 0x65, 0x72,
-	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x1d, 0x2e, 0x66, 0x66, 0x69, 0x2e, 0x66, 0x66, 0x69, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x48,
-	0x61, 0x73, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00,
-	0x52, 0x0f, 0x68, 0x61, 0x73, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x12, 0x55, 0x0a, 0x13, 0x67, 0x65, 0x74, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21,
-	0x2e, 0x66, 0x66, 0x69, 0x2e, 0x66, 0x66, 0x69, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x65,
-	0x74, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74,
-	0x61, 0x48, 0x00, 0x52, 0x13, 0x67, 0x65, 0x74, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x42, 0x05, 0x0a, 0x03, 0x72, 0x65, 0x71, 0x22,
-	0x84, 0x02, 0x0a, 0x0f, 0x53, 0x47, 0x58, 0x56, 0x4d, 0x43, 0x61, 0x6c, 0x6c, 0x50, 0x61, 0x72,
-	0x61, 0x6d, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0c, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0c, 0x52, 0x02, 0x74, 0x6f, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1a, 0x0a, 0x08, 0x67,
-	0x61, 0x73, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x67,
-	0x61, 0x73, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x37, 0x0a,
-	0x0a, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x18, 0x06, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x17, 0x2e, 0x66, 0x66, 0x69, 0x2e, 0x66, 0x66, 0x69, 0x2e, 0x41, 0x63, 0x63, 0x65,
-	0x73, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x0a, 0x61, 0x63, 0x63, 0x65,
-	0x73, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74,
-	0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x12, 0x14,
-	0x0a, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x6e,
-	0x6f, 0x6e, 0x63, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x75, 0x6e, 0x65, 0x6e, 0x63, 0x72, 0x79, 0x70,
-	0x74, 0x65, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x75, 0x6e, 0x65, 0x6e, 0x63,
-	0x72, 0x79, 0x70, 0x74, 0x65, 0x64, 0x22, 0xd4, 0x01, 0x0a, 0x11, 0x53, 0x47, 0x58, 0x56, 0x4d,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x12, 0x0a, 0x04,
-	0x66, 0x72, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d,
-	0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04,
-	0x64, 0x61, 0x74, 0x61, 0x12, 0x1a, 0x0a, 0x08, 0x67, 0x61, 0x73, 0x4c, 0x69, 0x6d, 0x69, 0x74,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x67, 0x61, 0x73, 0x4c, 0x69, 0x6d, 0x69, 0x74,
-	0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52,
-	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x37, 0x0a, 0x0a, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73,
-	0x4c, 0x69, 0x73, 0x74, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x66, 0x66, 0x69,
-	0x2e, 0x66, 0x66, 0x69, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x49,
-	0x74, 0x65, 0x6d, 0x52, 0x0a, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x12,
-	0x16, 0x0a, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65,
-	0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x22, 0x7b, 0x0a,
-	0x10, 0x53, 0x47, 0x58, 0x56, 0x4d, 0x43, 0x61, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x30, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x18, 0x2e, 0x66, 0x66, 0x69, 0x2e, 0x66, 0x66, 0x69, 0x2e, 0x53, 0x47, 0x58, 0x56,
-	0x4d, 0x43, 0x61, 0x6c, 0x6c, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x06, 0x70, 0x61, 0x72,
-	0x61, 0x6d, 0x73, 0x12, 0x35, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x66, 0x66, 0x69, 0x2e, 0x66, 0x66, 0x69, 0x2e, 0x54,
-	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78,
-	0x74, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x22, 0x7f, 0x0a, 0x12, 0x53, 0x47,
-	0x58, 0x56, 0x4d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x32, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x1a, 0x2e, 0x66, 0x66, 0x69, 0x2e, 0x66, 0x66, 0x69, 0x2e, 0x53, 0x47, 0x58, 0x56, 0x4d,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x06, 0x70, 0x61,
-	0x72, 0x61, 0x6d, 0x73, 0x12, 0x35, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x66, 0x66, 0x69, 0x2e, 0x66, 0x66, 0x69, 0x2e,
-	0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x65,
-	0x78, 0x74, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x22, 0x38, 0x0a, 0x14, 0x4e,
-	0x6f, 0x64, 0x65, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x75, 0x6d, 0x62,
-	0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x4e,
-	0x75, 0x6d, 0x62, 0x65, 0x72, 0x22, 0x35, 0x0a, 0x15, 0x4e, 0x6f, 0x64, 0x65, 0x50, 0x75, 0x62,
-	0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c,
-	0x0a, 0x09, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0c, 0x52, 0x09, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x22, 0x79, 0x0a, 0x09,
-	0x45, 0x70, 0x6f, 0x63, 0x68, 0x44, 0x61, 0x74, 0x61, 0x12, 0x20, 0x0a, 0x0b, 0x65, 0x70, 0x6f,
-	0x63, 0x68, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b,
-	0x65, 0x70, 0x6f, 0x63, 0x68, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x24, 0x0a, 0x0d, 0x73,
-	0x74, 0x61, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x0d, 0x73, 0x74, 0x61, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x42, 0x6c, 0x6f, 0x63,
-	0x6b, 0x12, 0x24, 0x0a, 0x0d, 0x6e, 0x6f, 0x64, 0x65, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b,
-	0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0d, 0x6e, 0x6f, 0x64, 0x65, 0x50, 0x75,
-	0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x22, 0x40, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x45,
-	0x70, 0x6f, 0x63, 0x68, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a,
-	0x06, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e,
-	0x66, 0x66, 0x69, 0x2e, 0x66, 0x66, 0x69, 0x2e, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x44, 0x61, 0x74,
-	0x61, 0x52, 0x06, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x73, 0x22, 0xe4, 0x01, 0x0a, 0x0a, 0x46, 0x46,
-	0x49, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3d, 0x0a, 0x0b, 0x63, 0x61, 0x6c, 0x6c,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e,
-	0x66, 0x66, 0x69, 0x2e, 0x66, 0x66, 0x69, 0x2e, 0x53, 0x47, 0x58, 0x56, 0x4d, 0x43, 0x61, 0x6c,
-	0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x0b, 0x63, 0x61, 0x6c, 0x6c,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x43, 0x0a, 0x0d, 0x63, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b,
-	0x2e, 0x66, 0x66, 0x69, 0x2e, 0x66, 0x66, 0x69, 0x2e, 0x53, 0x47, 0x58, 0x56, 0x4d, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x0d, 0x63,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x4b, 0x0a, 0x10,
-	0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x66, 0x66, 0x69, 0x2e, 0x66, 0x66, 0x69,
-	0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x10, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b,
-	0x65, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x42, 0x05, 0x0a, 0x03, 0x72, 0x65, 0x71,
-	0x42, 0x26, 0x5a, 0x24, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x53,
-	0x69, 0x67, 0x6d, 0x61, 0x47, 0x6d, 0x62, 0x48, 0x2f, 0x6c, 0x69, 0x62, 0x72, 0x75, 0x73, 0x74,
-	0x67, 0x6f, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+// Option of weighted vote. Weight is a decimal string, for example "0.5"
+type WeightedVoteOption struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Option int32  `protobuf:"varint,1,opt,name=option,proto3" json:"option,omitempty"`
+	Weight string `protobuf:"bytes,2,opt,name=weight,proto3" json:"weight,omitempty"`
 }
 
-var (
-	file_ffi_proto_rawDescOnce sync.Once
-	file_ffi_proto_rawDescData = file_ffi_proto_rawDesc
-)
+func (x *WeightedVoteOption) Reset() {
+	*x = WeightedVoteOption{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ffi_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
 
-func file_ffi_proto_rawDescGZIP() []byte {
-	file_ffi_proto_rawDescOnce.Do(func() {
-		file_ffi_proto_rawDescData = protoimpl.X.CompressGZIP(file_ffi_proto_rawDescData)
-	})
-	return file_ffi_proto_rawDescData
+func (x *WeightedVoteOption) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-var file_ffi_proto_msgTypes = make([]protoimpl.MessageInfo, 46)
-var file_ffi_proto_goTypes = []interface{}{
-	(*AccessListItem)(nil),                      // 0: ffi.ffi.AccessListItem
-	(*TransactionData)(nil),                     // 1: ffi.ffi.TransactionData
-	(*TransactionContext)(nil),                  // 2: ffi.ffi.TransactionContext
-	(*HandleTransactionRequest)(nil),            // 3: ffi.ffi.HandleTransactionRequest
-	(*HandleTransactionResponse)(nil),           // 4: ffi.ffi.HandleTransactionResponse
-	(*Topic)(nil),                               // 5: ffi.ffi.Topic
-	(*Log)(nil),                                 // 6: ffi.ffi.Log
-	(*QueryGetAccount)(nil),                     // 7: ffi.ffi.QueryGetAccount
-	(*QueryGetAccountResponse)(nil),             // 8: ffi.ffi.QueryGetAccountResponse
-	(*QueryInsertAccount)(nil),                  // 9: ffi.ffi.QueryInsertAccount
-	(*QueryInsertAccountResponse)(nil),          // 10: ffi.ffi.QueryInsertAccountResponse
-	(*QueryContainsKey)(nil),                    // 11: ffi.ffi.QueryContainsKey
-	(*QueryContainsKeyResponse)(nil),            // 12: ffi.ffi.QueryContainsKeyResponse
-	(*QueryGetAccountStorageCell)(nil),          // 13: ffi.ffi.QueryGetAccountStorageCell
-	(*QueryGetAccountStorageCellResponse)(nil),  // 14: ffi.ffi.QueryGetAccountStorageCellResponse
-	(*QueryGetAccountCode)(nil),                 // 15: ffi.ffi.QueryGetAccountCode
-	(*QueryGetAccountCodeResponse)(nil),         // 16: ffi.ffi.QueryGetAccountCodeResponse
-	(*QueryInsertAccountCode)(nil),              // 17: ffi.ffi.QueryInsertAccountCode
-	(*QueryInsertAccountCodeResponse)(nil),      // 18: ffi.ffi.QueryInsertAccountCodeResponse
-	(*QueryInsertStorageCell)(nil),              // 19: ffi.ffi.QueryInsertStorageCell
-	(*QueryInsertStorageCellResponse)(nil),      // 20: ffi.ffi.QueryInsertStorageCellResponse
-	(*QueryRemove)(nil),                         // 21: ffi.ffi.QueryRemove
-	(*QueryRemoveResponse)(nil),                 // 22: ffi.ffi.QueryRemoveResponse
-	(*QueryRemoveStorageCell)(nil),              // 23: ffi.ffi.QueryRemoveStorageCell
-	(*QueryRemoveStorageCellResponse)(nil),      // 24: ffi.ffi.QueryRemoveStorageCellResponse
-	(*QueryRemoveStorage)(nil),                  // 25: ffi.ffi.QueryRemoveStorage
-	(*QueryRemoveStorageResponse)(nil),          // 26: ffi.ffi.QueryRemoveStorageResponse
-	(*QueryBlockHash)(nil),                      // 27: ffi.ffi.QueryBlockHash
-	(*QueryBlockHashResponse)(nil),              // 28: ffi.ffi.QueryBlockHashResponse
-	(*QueryAddVerificationDetails)(nil),         // 29: ffi.ffi.QueryAddVerificationDetails
-	(*QueryAddVerificationDetailsResponse)(nil), // 30: ffi.ffi.QueryAddVerificationDetailsResponse
-	(*QueryHasVerification)(nil),                // 31: ffi.ffi.QueryHasVerification
-	(*QueryHasVerificationResponse)(nil),        // 32: ffi.ffi.QueryHasVerificationResponse
-	(*QueryGetVerificationData)(nil),            // 33: ffi.ffi.QueryGetVerificationData
-	(*VerificationDetails)(nil),                 // 34: ffi.ffi.VerificationDetails
-	(*QueryGetVerificationDataResponse)(nil),    // 35: ffi.ffi.QueryGetVerificationDataResponse
-	(*CosmosRequest)(nil),                       // 36: ffi.ffi.CosmosRequest
-	(*SGXVMCallParams)(nil),                     // 37: ffi.ffi.SGXVMCallParams
-	(*SGXVMCreateParams)(nil),                   // 38: ffi.ffi.SGXVMCreateParams
-	(*SGXVMCallRequest)(nil),                    // 39: ffi.ffi.SGXVMCallRequest
-	(*SGXVMCreateRequest)(nil),                  // 40: ffi.ffi.SGXVMCreateRequest
-	(*NodePublicKeyRequest)(nil),                // 41: ffi.ffi.NodePublicKeyRequest
-	(*NodePublicKeyResponse)(nil),               // 42: ffi.ffi.NodePublicKeyResponse
-	(*EpochData)(nil),                           // 43: ffi.ffi.EpochData
-	(*ListEpochsResponse)(nil),                  // 44: ffi.ffi.ListEpochsResponse
-	(*FFIRequest)(nil),                          // 45: ffi.ffi.FFIRequest
+func (*WeightedVoteOption) ProtoMessage() {}
+
+func (x *WeightedVoteOption) ProtoReflect() protoreflect.Message {
+	mi := &file_ffi_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
-var file_ffi_proto_depIdxs = []int32{
-	0,  // 0: ffi.ffi.TransactionData.accessList:type_name -> ffi.ffi.AccessListItem
-	1,  // 1: ffi.ffi.HandleTransactionRequest.tx_data:type_name -> ffi.ffi.TransactionData
-	2,  // 2: ffi.ffi.HandleTransactionRequest.tx_context:type_name -> ffi.ffi.TransactionContext
-	6,  // 3: ffi.ffi.HandleTransactionResponse.logs:type_name -> ffi.ffi.Log
-	5,  // 4: ffi.ffi.Log.topics:type_name -> ffi.ffi.Topic
-	34, // 5: ffi.ffi.QueryGetVerificationDataResponse.data:type_name -> ffi.ffi.VerificationDetails
-	7,  // 6: ffi.ffi.CosmosRequest.getAccount:type_name -> ffi.ffi.QueryGetAccount
-	9,  // 7: ffi.ffi.CosmosRequest.insertAccount:type_name -> ffi.ffi.QueryInsertAccount
-	11, // 8: ffi.ffi.CosmosRequest.containsKey:type_name -> ffi.ffi.QueryContainsKey
-	15, // 9: ffi.ffi.CosmosRequest.accountCode:type_name -> ffi.ffi.QueryGetAccountCode
-	13, // 10: ffi.ffi.CosmosRequest.storageCell:type_name -> ffi.ffi.QueryGetAccountStorageCell
-	17, // 11: ffi.ffi.CosmosRequest.insertAccountCode:type_name -> ffi.ffi.QueryInsertAccountCode
-	19, // 12: ffi.ffi.CosmosRequest.insertStorageCell:type_name -> ffi.ffi.QueryInsertStorageCell
-	21, // 13: ffi.ffi.CosmosRequest.remove:type_name -> ffi.ffi.QueryRemove
-	23, // 14: ffi.ffi.CosmosRequest.removeStorageCell:type_name -> ffi.ffi.QueryRemoveStorageCell
-	25, // 15: ffi.ffi.CosmosRequest.removeStorage:type_name -> ffi.ffi.QueryRemoveStorage
-	27, // 16: ffi.ffi.CosmosRequest.blockHash:type_name -> ffi.ffi.QueryBlockHash
-	29, // 17: ffi.ffi.CosmosRequest.addVerificationDetails:type_name -> ffi.ffi.QueryAddVerificationDetails
-	31, // 18: ffi.ffi.CosmosRequest.hasVerification:type_name -> ffi.ffi.QueryHasVerification
-	33, // 19: ffi.ffi.CosmosRequest.getVerificationData:type_name -> ffi.ffi.QueryGetVerificationData
-	0,  // 20: ffi.ffi.SGXVMCallParams.accessList:type_name -> ffi.ffi.AccessListItem
-	0,  // 21: ffi.ffi.SGXVMCreateParams.accessList:type_name -> ffi.ffi.AccessListItem
-	37, // 22: ffi.ffi.SGXVMCallRequest.params:type_name -> ffi.ffi.SGXVMCallParams
-	2,  // 23: ffi.ffi.SGXVMCallRequest.context:type_name -> ffi.ffi.TransactionContext
-	38, // 24: ffi.ffi.SGXVMCreateRequest.params:type_name -> ffi.ffi.SGXVMCreateParams
-	2,  // 25: ffi.ffi.SGXVMCreateRequest.context:type_name -> ffi.ffi.TransactionContext
-	43, // 26: ffi.ffi.ListEpochsResponse.epochs:type_name -> ffi.ffi.EpochData
-	39, // 27: ffi.ffi.FFIRequest.callRequest:type_name -> ffi.ffi.SGXVMCallRequest
-	40, // 28: ffi.ffi.FFIRequest.createRequest:type_name -> ffi.ffi.SGXVMCreateRequest
-	41, // 29: ffi.ffi.FFIRequest.publicKeyRequest:type_name -> ffi.ffi.NodePublicKeyRequest
-	30, // [30:30] is the sub-list for method output_type
-	30, // [30:30] is the sub-list for method input_type
-	30, // [30:30] is the sub-list for extension type_name
-	30, // [30:30] is the sub-list for extension extendee
-	0,  // [0:30] is the sub-list for field type_name
+
+// Deprecated: Use WeightedVoteOption.ProtoReflect.Descriptor instead.
+func (*WeightedVoteOption) Descriptor() ([]byte, []int) {
+	return file_ffi_proto_rawDescGZIP(), []int{54}
 }
 
-func init() { file_ffi_proto_init() }
-func file_ffi_proto_init() {
-	if File_ffi_proto != nil {
-		return
+func (x *WeightedVoteOption) GetOption() int32 {
+	if x != nil {
+		return x.Option
 	}
-	if !protoimpl.UnsafeEnabled {
-		file_ffi_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AccessListItem); i {
+	return 0
+}
+
+func (x *WeightedVoteOption) GetWeight() string {
+	if x != nil {
+		return x.Weight
+	}
+	return ""
+}
+
+// Casts weighted vote of the voter on governance proposal
+type QueryGovVoteWeighted struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Voter      []byte                `protobuf:"bytes,1,opt,name=voter,proto3" json:"voter,omitempty"`
+	ProposalId uint64                `protobuf:"varint,2,opt,name=proposalId,proto3" json:"proposalId,omitempty"`
+	Options    []*WeightedVoteOption `protobuf:"bytes,3,rep,name=options,proto3" json:"options,omitempty"`
+	Metadata   string                `protobuf:"bytes,4,opt,name=metadata,proto3" json:"metadata,omitempty"`
+}
+
+func (x *QueryGovVoteWeighted) Reset() {
+	*x = QueryGovVoteWeighted{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ffi_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryGovVoteWeighted) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryGovVoteWeighted) ProtoMessage() {}
+
+func (x *QueryGovVoteWeighted) ProtoReflect() protoreflect.Message {
+	mi := &file_ffi_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryGovVoteWeighted.ProtoReflect.Descriptor instead.
+func (*QueryGovVoteWeighted) Descriptor() ([]byte, []int) {
+	return file_ffi_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *QueryGovVoteWeighted) GetVoter() []byte {
+	if x != nil {
+		return x.Voter
+	}
+	return nil
+}
+
+func (x *QueryGovVoteWeighted) GetProposalId() uint64 {
+	if x != nil {
+		return x.ProposalId
+	}
+	return 0
+}
+
+func (x *QueryGovVoteWeighted) GetOptions() []*WeightedVoteOption {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+func (x *QueryGovVoteWeighted) GetMetadata() string {
+	if x != nil {
+		return x.Metadata
+	}
+	return ""
+}
+
+// Contains logs, converted from Cosmos events, emitted during voting
+type QueryGovVoteWeightedResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Logs []*Log `protobuf:"bytes,1,rep,name=logs,proto3" json:"logs,omitempty"`
+}
+
+func (x *QueryGovVoteWeightedResponse) Reset() {
+	*x = QueryGovVoteWeightedResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ffi_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryGovVoteWeightedResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryGovVoteWeightedResponse) ProtoMessage() {}
+
+func (x *QueryGovVoteWeightedResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ffi_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryGovVoteWeightedResponse.ProtoReflect.Descriptor instead.
+func (*QueryGovVoteWeightedResponse) Descriptor() ([]byte, []int) {
+	return file_ffi_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *QueryGovVoteWeightedResponse) GetLogs() []*Log {
+	if x != nil {
+		return x.Logs
+	}
+	return nil
+}
+
+// Deposits coins of the depositor on governance proposal
+type QueryGovDeposit struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Depositor  []byte `protobuf:"bytes,1,opt,name=depositor,proto3" json:"depositor,omitempty"`
+	ProposalId uint64 `protobuf:"varint,2,opt,name=proposalId,proto3" json:"proposalId,omitempty"`
+	Denom      string `protobuf:"bytes,3,opt,name=denom,proto3" json:"denom,omitempty"`
+	Amount     []byte `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (x *QueryGovDeposit) Reset() {
+	*x = QueryGovDeposit{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ffi_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryGovDeposit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryGovDeposit) ProtoMessage() {}
+
+func (x *QueryGovDeposit) ProtoReflect() protoreflect.Message {
+	mi := &file_ffi_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryGovDeposit.ProtoReflect.Descriptor instead.
+func (*QueryGovDeposit) Descriptor() ([]byte, []int) {
+	return file_ffi_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *QueryGovDeposit) GetDepositor() []byte {
+	if x != nil {
+		return x.Depositor
+	}
+	return nil
+}
+
+func (x *QueryGovDeposit) GetProposalId() uint64 {
+	if x != nil {
+		return x.ProposalId
+	}
+	return 0
+}
+
+func (x *QueryGovDeposit) GetDenom() string {
+	if x != nil {
+		return x.Denom
+	}
+	return ""
+}
+
+func (x *QueryGovDeposit) GetAmount() []byte {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+// Contains logs, converted from Cosmos events, emitted during deposit
+type QueryGovDepositResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Logs []*Log `protobuf:"bytes,1,rep,name=logs,proto3" json:"logs,omitempty"`
+}
+
+func (x *QueryGovDepositResponse) Reset() {
+	*x = QueryGovDepositResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ffi_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryGovDepositResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryGovDepositResponse) ProtoMessage() {}
+
+func (x *QueryGovDepositResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ffi_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryGovDepositResponse.ProtoReflect.Descriptor instead.
+func (*QueryGovDepositResponse) Descriptor() ([]byte, []int) {
+	return file_ffi_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *QueryGovDepositResponse) GetLogs() []*Log {
+	if x != nil {
+		return x.Logs
+	}
+	return nil
+}
+
+// Returns governance proposal
+type QueryGovProposal struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposalId,proto3" json:"proposalId,omitempty"`
+}
+
+func (x *QueryGovProposal) Reset() {
+	*x = QueryGovProposal{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ffi_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryGovProposal) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryGovProposal) ProtoMessage() {}
+
+func (x *QueryGovProposal) ProtoReflect() protoreflect.Message {
+	mi := &file_ffi_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryGovProposal.ProtoReflect.Descriptor instead.
+func (*QueryGovProposal) Descriptor() ([]byte, []int) {
+	return file_ffi_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *QueryGovProposal) GetProposalId() uint64 {
+	if x != nil {
+		return x.ProposalId
+	}
+	return 0
+}
+
+// Contains governance proposal. Timestamps are unix timestamps, 0 if not set
+type QueryGovProposalResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id              uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Status          int32  `protobuf:"varint,2,opt,name=status,proto3" json:"status,omitempty"`
+	Proposer        []byte `protobuf:"bytes,3,opt,name=proposer,proto3" json:"proposer,omitempty"`
+	Title           string `protobuf:"bytes,4,opt,name=title,proto3" json:"title,omitempty"`
+	SubmitTime      int64  `protobuf:"varint,5,opt,name=submitTime,proto3" json:"submitTime,omitempty"`
+	DepositEndTime  int64  `protobuf:"varint,6,opt,name=depositEndTime,proto3" json:"depositEndTime,omitempty"`
+	VotingStartTime int64  `protobuf:"varint,7,opt,name=votingStartTime,proto3" json:"votingStartTime,omitempty"`
+	VotingEndTime   int64  `protobuf:"varint,8,opt,name=votingEndTime,proto3" json:"votingEndTime,omitempty"`
+}
+
+func (x *QueryGovProposalResponse) Reset() {
+	*x = QueryGovProposalResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ffi_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryGovProposalResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryGovProposalResponse) ProtoMessage() {}
+
+func (x *QueryGovProposalResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ffi_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryGovProposalResponse.ProtoReflect.Descriptor instead.
+func (*QueryGovProposalResponse) Descriptor() ([]byte, []int) {
+	return file_ffi_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *QueryGovProposalResponse) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *QueryGovProposalResponse) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *QueryGovProposalResponse) GetProposer() []byte {
+	if x != nil {
+		return x.Proposer
+	}
+	return nil
+}
+
+func (x *QueryGovProposalResponse) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *QueryGovProposalResponse) GetSubmitTime() int64 {
+	if x != nil {
+		return x.SubmitTime
+	}
+	return 0
+}
+
+func (x *QueryGovProposalResponse) GetDepositEndTime() int64 {
+	if x != nil {
+		return x.DepositEndTime
+	}
+	return 0
+}
+
+func (x *QueryGovProposalResponse) GetVotingStartTime() int64 {
+	if x != nil {
+		return x.VotingStartTime
+	}
+	return 0
+}
+
+func (x *QueryGovProposalResponse) GetVotingEndTime() int64 {
+	if x != nil {
+		return x.VotingEndTime
+	}
+	return 0
+}
+
+// Returns tally of governance proposal. Tally is calculated for proposals in voting period
+// and contains final result for finished proposals
+type QueryGovTally struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposalId,proto3" json:"proposalId,omitempty"`
+}
+
+func (x *QueryGovTally) Reset() {
+	*x = QueryGovTally{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ffi_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryGovTally) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryGovTally) ProtoMessage() {}
+
+func (x *QueryGovTally) ProtoReflect() protoreflect.Message {
+	mi := &file_ffi_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryGovTally.ProtoReflect.Descriptor instead.
+func (*QueryGovTally) Descriptor() ([]byte, []int) {
+	return file_ffi_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *QueryGovTally) GetProposalId() uint64 {
+	if x != nil {
+		return x.ProposalId
+	}
+	return 0
+}
+
+type QueryGovTallyResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Yes        []byte `protobuf:"bytes,1,opt,name=yes,proto3" json:"yes,omitempty"`
+	Abstain    []byte `protobuf:"bytes,2,opt,name=abstain,proto3" json:"abstain,omitempty"`
+	No         []byte `protobuf:"bytes,3,opt,name=no,proto3" json:"no,omitempty"`
+	NoWithVeto []byte `protobuf:"bytes,4,opt,name=noWithVeto,proto3" json:"noWithVeto,omitempty"`
+}
+
+func (x *QueryGovTallyResponse) Reset() {
+	*x = QueryGovTallyResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ffi_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryGovTallyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryGovTallyResponse) ProtoMessage() {}
+
+func (x *QueryGovTallyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ffi_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryGovTallyResponse.ProtoReflect.Descriptor instead.
+func (*QueryGovTallyResponse) Descriptor() ([]byte, []int) {
+	return file_ffi_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *QueryGovTallyResponse) GetYes() []byte {
+	if x != nil {
+		return x.Yes
+	}
+	return nil
+}
+
+func (x *QueryGovTallyResponse) GetAbstain() []byte {
+	if x != nil {
+		return x.Abstain
+	}
+	return nil
+}
+
+func (x *QueryGovTallyResponse) GetNo() []byte {
+	if x != nil {
+		return x.No
+	}
+	return nil
+}
+
+func (x *QueryGovTallyResponse) GetNoWithVeto() []byte {
+	if x != nil {
+		return x.NoWithVeto
+	}
+	return nil
+}
+
+// Returns the account, which deployed the contract by a transaction. Deployer is empty if
+// contract was created by another contract
+type QueryContractDeployer struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Contract []byte `protobuf:"bytes,1,opt,name=contract,proto3" json:"contract,omitempty"`
+}
+
+func (x *QueryContractDeployer) Reset() {
+	*x = QueryContractDeployer{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ffi_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryContractDeployer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryContractDeployer) ProtoMessage() {}
+
+func (x *QueryContractDeployer) ProtoReflect() protoreflect.Message {
+	mi := &file_ffi_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryContractDeployer.ProtoReflect.Descriptor instead.
+func (*QueryContractDeployer) Descriptor() ([]byte, []int) {
+	return file_ffi_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *QueryContractDeployer) GetContract() []byte {
+	if x != nil {
+		return x.Contract
+	}
+	return nil
+}
+
+type QueryContractDeployerResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Deployer []byte `protobuf:"bytes,1,opt,name=deployer,proto3" json:"deployer,omitempty"`
+}
+
+func (x *QueryContractDeployerResponse) Reset() {
+	*x = QueryContractDeployerResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ffi_proto_msgTypes[64]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryContractDeployerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryContractDeployerResponse) ProtoMessage() {}
+
+func (x *QueryContractDeployerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ffi_proto_msgTypes[64]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryContractDeployerResponse.ProtoReflect.Descriptor instead.
+func (*QueryContractDeployerResponse) Descriptor() ([]byte, []int) {
+	return file_ffi_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *QueryContractDeployerResponse) GetDeployer() []byte {
+	if x != nil {
+		return x.Deployer
+	}
+	return nil
+}
+
+// Takes snapshot of Cosmos state, changed by precompiles during transaction. Snapshot is
+// taken before the first change of Cosmos state within the call frame
+type QueryCosmosSnapshot struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *QueryCosmosSnapshot) Reset() {
+	*x = QueryCosmosSnapshot{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ffi_proto_msgTypes[65]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryCosmosSnapshot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryCosmosSnapshot) ProtoMessage() {}
+
+func (x *QueryCosmosSnapshot) ProtoReflect() protoreflect.Message {
+	mi := &file_ffi_proto_msgTypes[65]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryCosmosSnapshot.ProtoReflect.Descriptor instead.
+func (*QueryCosmosSnapshot) Descriptor() ([]byte, []int) {
+	return file_ffi_proto_rawDescGZIP(), []int{65}
+}
+
+type QueryCosmosSnapshotResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Snapshot uint32 `protobuf:"varint,1,opt,name=snapshot,proto3" json:"snapshot,omitempty"`
+}
+
+func (x *QueryCosmosSnapshotResponse) Reset() {
+	*x = QueryCosmosSnapshotResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ffi_proto_msgTypes[66]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryCosmosSnapshotResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryCosmosSnapshotResponse) ProtoMessage() {}
+
+func (x *QueryCosmosSnapshotResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ffi_proto_msgTypes[66]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryCosmosSnapshotResponse.ProtoReflect.Descriptor instead.
+func (*QueryCosmosSnapshotResponse) Descriptor() ([]byte, []int) {
+	return file_ffi_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *QueryCosmosSnapshotResponse) GetSnapshot() uint32 {
+	if x != nil {
+		return x.Snapshot
+	}
+	return 0
+}
+
+// Reverts changes of Cosmos state, made by precompiles after the snapshot was taken.
+// It is requested if the call frame, which changed Cosmos state, failed
+type QueryRevertCosmosSnapshot struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Snapshot uint32 `protobuf:"varint,1,opt,name=snapshot,proto3" json:"snapshot,omitempty"`
+}
+
+func (x *QueryRevertCosmosSnapshot) Reset() {
+	*x = QueryRevertCosmosSnapshot{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ffi_proto_msgTypes[67]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryRevertCosmosSnapshot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryRevertCosmosSnapshot) ProtoMessage() {}
+
+func (x *QueryRevertCosmosSnapshot) ProtoReflect() protoreflect.Message {
+	mi := &file_ffi_proto_msgTypes[67]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryRevertCosmosSnapshot.ProtoReflect.Descriptor instead.
+func (*QueryRevertCosmosSnapshot) Descriptor() ([]byte, []int) {
+	return file_ffi_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *QueryRevertCosmosSnapshot) GetSnapshot() uint32 {
+	if x != nil {
+		return x.Snapshot
+	}
+	return 0
+}
+
+type QueryRevertCosmosSnapshotResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *QueryRevertCosmosSnapshotResponse) Reset() {
+	*x = QueryRevertCosmosSnapshotResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ffi_proto_msgTypes[68]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryRevertCosmosSnapshotResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryRevertCosmosSnapshotResponse) ProtoMessage() {}
+
+func (x *QueryRevertCosmosSnapshotResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ffi_proto_msgTypes[68]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryRevertCosmosSnapshotResponse.ProtoReflect.Descriptor instead.
+func (*QueryRevertCosmosSnapshotResponse) Descriptor() ([]byte, []int) {
+	return file_ffi_proto_rawDescGZIP(), []int{68}
+}
+
+// Batch of encoded `CosmosRequest` messages, which are handled in a single call.
+// Requests are handled in order. Nested batches are not allowed
+type QueryBatch struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Requests [][]byte `protobuf:"bytes,1,rep,name=requests,proto3" json:"requests,omitempty"`
+}
+
+func (x *QueryBatch) Reset() {
+	*x = QueryBatch{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ffi_proto_msgTypes[69]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryBatch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryBatch) ProtoMessage() {}
+
+func (x *QueryBatch) ProtoReflect() protoreflect.Message {
+	mi := &file_ffi_proto_msgTypes[69]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryBatch.ProtoReflect.Descriptor instead.
+func (*QueryBatch) Descriptor() ([]byte, []int) {
+	return file_ffi_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *QueryBatch) GetRequests() [][]byte {
+	if x != nil {
+		return x.Requests
+	}
+	return nil
+}
+
+// Contains encoded responses for each request of the batch in the same order
+type QueryBatchResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Responses [][]byte `protobuf:"bytes,1,rep,name=responses,proto3" json:"responses,omitempty"`
+}
+
+func (x *QueryBatchResponse) Reset() {
+	*x = QueryBatchResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ffi_proto_msgTypes[70]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryBatchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryBatchResponse) ProtoMessage() {}
+
+func (x *QueryBatchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ffi_proto_msgTypes[70]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryBatchResponse.ProtoReflect.Descriptor instead.
+func (*QueryBatchResponse) Descriptor() ([]byte, []int) {
+	return file_ffi_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *QueryBatchResponse) GetResponses() [][]byte {
+	if x != nil {
+		return x.Responses
+	}
+	return nil
+}
+
+// Encoded `CosmosRequest` of precompile, which is handled with gas meter, limited by gas,
+// available to precompile. Nested metered requests and batches are not allowed
+type QueryMetered struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Request  []byte `protobuf:"bytes,1,opt,name=request,proto3" json:"request,omitempty"`
+	GasLimit uint64 `protobuf:"varint,2,opt,name=gasLimit,proto3" json:"gasLimit,omitempty"`
+}
+
+func (x *QueryMetered) Reset() {
+	*x = QueryMetered{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ffi_proto_msgTypes[71]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryMetered) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryMetered) ProtoMessage() {}
+
+func (x *QueryMetered) ProtoReflect() protoreflect.Message {
+	mi := &file_ffi_proto_msgTypes[71]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryMetered.ProtoReflect.Descriptor instead.
+func (*QueryMetered) Descriptor() ([]byte, []int) {
+	return file_ffi_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *QueryMetered) GetRequest() []byte {
+	if x != nil {
+		return x.Request
+	}
+	return nil
+}
+
+func (x *QueryMetered) GetGasLimit() uint64 {
+	if x != nil {
+		return x.GasLimit
+	}
+	return 0
+}
+
+// Contains encoded response or error of metered request and gas, consumed by Cosmos side.
+// Consumed gas is charged by precompile even if request failed
+type QueryMeteredResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Response []byte `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
+	Error    string `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	GasUsed  uint64 `protobuf:"varint,3,opt,name=gasUsed,proto3" json:"gasUsed,omitempty"`
+	// Changes of EVM denom balances, made by Cosmos message of the request. Precompile applies
+	// them to EVM state, so balances, observed by SGXVM, include them
+	BalanceChanges []*BalanceChange `protobuf:"bytes,4,rep,name=balanceChanges,proto3" json:"balanceChanges,omitempty"`
+}
+
+func (x *QueryMeteredResponse) Reset() {
+	*x = QueryMeteredResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ffi_proto_msgTypes[72]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryMeteredResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryMeteredResponse) ProtoMessage() {}
+
+func (x *QueryMeteredResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ffi_proto_msgTypes[72]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryMeteredResponse.ProtoReflect.Descriptor instead.
+func (*QueryMeteredResponse) Descriptor() ([]byte, []int) {
+	return file_ffi_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *QueryMeteredResponse) GetResponse() []byte {
+	if x != nil {
+		return x.Response
+	}
+	return nil
+}
+
+func (x *QueryMeteredResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *QueryMeteredResponse) GetGasUsed() uint64 {
+	if x != nil {
+		return x.GasUsed
+	}
+	return 0
+}
+
+func (x *QueryMeteredResponse) GetBalanceChanges() []*BalanceChange {
+	if x != nil {
+		return x.BalanceChanges
+	}
+	return nil
+}
+
+// Net change of EVM denom balance of the account. Amount is an absolute value of the change
+type BalanceChange struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address  []byte `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Amount   []byte `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	Decrease bool   `protobuf:"varint,3,opt,name=decrease,proto3" json:"decrease,omitempty"`
+}
+
+func (x *BalanceChange) Reset() {
+	*x = BalanceChange{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ffi_proto_msgTypes[73]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BalanceChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BalanceChange) ProtoMessage() {}
+
+func (x *BalanceChange) ProtoReflect() protoreflect.Message {
+	mi := &file_ffi_proto_msgTypes[73]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BalanceChange.ProtoReflect.Descriptor instead.
+func (*BalanceChange) Descriptor() ([]byte, []int) {
+	return file_ffi_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *BalanceChange) GetAddress() []byte {
+	if x != nil {
+		return x.Address
+	}
+	return nil
+}
+
+func (x *BalanceChange) GetAmount() []byte {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+func (x *BalanceChange) GetDecrease() bool {
+	if x != nil {
+		return x.Decrease
+	}
+	return false
+}
+
+// Single executed opcode, reported by SGXVM during tracing.
+// Contains state of the machine before opcode execution
+type TraceStep struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pc       uint64 `protobuf:"varint,1,opt,name=pc,proto3" json:"pc,omitempty"`
+	Opcode   uint32 `protobuf:"varint,2,opt,name=opcode,proto3" json:"opcode,omitempty"`
+	Gas      uint64 `protobuf:"varint,3,opt,name=gas,proto3" json:"gas,omitempty"`
+	Cost     uint64 `protobuf:"varint,4,opt,name=cost,proto3" json:"cost,omitempty"`
+	Depth    uint32 `protobuf:"varint,5,opt,name=depth,proto3" json:"depth,omitempty"`
+	Contract []byte `protobuf:"bytes,6,opt,name=contract,proto3" json:"contract,omitempty"`
+	Refund   uint64 `protobuf:"varint,9,opt,name=refund,proto3" json:"refund,omitempty"`
+}
+
+func (x *TraceStep) Reset() {
+	*x = TraceStep{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ffi_proto_msgTypes[74]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TraceStep) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TraceStep) ProtoMessage() {}
+
+func (x *TraceStep) ProtoReflect() protoreflect.Message {
+	mi := &file_ffi_proto_msgTypes[74]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TraceStep.ProtoReflect.Descriptor instead.
+func (*TraceStep) Descriptor() ([]byte, []int) {
+	return file_ffi_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *TraceStep) GetPc() uint64 {
+	if x != nil {
+		return x.Pc
+	}
+	return 0
+}
+
+func (x *TraceStep) GetOpcode() uint32 {
+	if x != nil {
+		return x.Opcode
+	}
+	return 0
+}
+
+func (x *TraceStep) GetGas() uint64 {
+	if x != nil {
+		return x.Gas
+	}
+	return 0
+}
+
+func (x *TraceStep) GetCost() uint64 {
+	if x != nil {
+		return x.Cost
+	}
+	return 0
+}
+
+func (x *TraceStep) GetDepth() uint32 {
+	if x != nil {
+		return x.Depth
+	}
+	return 0
+}
+
+func (x *TraceStep) GetContract() []byte {
+	if x != nil {
+		return x.Contract
+	}
+	return nil
+}
+
+func (x *TraceStep) GetRefund() uint64 {
+	if x != nil {
+		return x.Refund
+	}
+	return 0
+}
+
+// Entering into nested call frame
+type TraceEnter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Opcode uint32 `protobuf:"varint,1,opt,name=opcode,proto3" json:"opcode,omitempty"`
+	From   []byte `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	To     []byte `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
+	Input  []byte `protobuf:"bytes,4,opt,name=input,proto3" json:"input,omitempty"`
+	Gas    uint64 `protobuf:"varint,5,opt,name=gas,proto3" json:"gas,omitempty"`
+	Value  []byte `protobuf:"bytes,6,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *TraceEnter) Reset() {
+	*x = TraceEnter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ffi_proto_msgTypes[75]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TraceEnter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TraceEnter) ProtoMessage() {}
+
+func (x *TraceEnter) ProtoReflect() protoreflect.Message {
+	mi := &file_ffi_proto_msgTypes[75]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TraceEnter.ProtoReflect.Descriptor instead.
+func (*TraceEnter) Descriptor() ([]byte, []int) {
+	return file_ffi_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *TraceEnter) GetOpcode() uint32 {
+	if x != nil {
+		return x.Opcode
+	}
+	return 0
+}
+
+func (x *TraceEnter) GetFrom() []byte {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *TraceEnter) GetTo() []byte {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *TraceEnter) GetInput() []byte {
+	if x != nil {
+		return x.Input
+	}
+	return nil
+}
+
+func (x *TraceEnter) GetGas() uint64 {
+	if x != nil {
+		return x.Gas
+	}
+	return 0
+}
+
+func (x *TraceEnter) GetValue() []byte {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+// Exiting from nested call frame
+type TraceExit struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Output  []byte `protobuf:"bytes,1,opt,name=output,proto3" json:"output,omitempty"`
+	GasUsed uint64 `protobuf:"varint,2,opt,name=gasUsed,proto3" json:"gasUsed,omitempty"`
+	Error   string `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *TraceExit) Reset() {
+	*x = TraceExit{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ffi_proto_msgTypes[76]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TraceExit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TraceExit) ProtoMessage() {}
+
+func (x *TraceExit) ProtoReflect() protoreflect.Message {
+	mi := &file_ffi_proto_msgTypes[76]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TraceExit.ProtoReflect.Descriptor instead.
+func (*TraceExit) Descriptor() ([]byte, []int) {
+	return file_ffi_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *TraceExit) GetOutput() []byte {
+	if x != nil {
+		return x.Output
+	}
+	return nil
+}
+
+func (x *TraceExit) GetGasUsed() uint64 {
+	if x != nil {
+		return x.GasUsed
+	}
+	return 0
+}
+
+func (x *TraceExit) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type TraceEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Event:
+	//	*TraceEvent_Step
+	//	*TraceEvent_Enter
+	//	*TraceEvent_Exit
+	Event isTraceEvent_Event `protobuf_oneof:"event"`
+}
+
+func (x *TraceEvent) Reset() {
+	*x = TraceEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ffi_proto_msgTypes[77]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TraceEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TraceEvent) ProtoMessage() {}
+
+func (x *TraceEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_ffi_proto_msgTypes[77]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TraceEvent.ProtoReflect.Descriptor instead.
+func (*TraceEvent) Descriptor() ([]byte, []int) {
+	return file_ffi_proto_rawDescGZIP(), []int{77}
+}
+
+func (m *TraceEvent) GetEvent() isTraceEvent_Event {
+	if m != nil {
+		return m.Event
+	}
+	return nil
+}
+
+func (x *TraceEvent) GetStep() *TraceStep {
+	if x, ok := x.GetEvent().(*TraceEvent_Step); ok {
+		return x.Step
+	}
+	return nil
+}
+
+func (x *TraceEvent) GetEnter() *TraceEnter {
+	if x, ok := x.GetEvent().(*TraceEvent_Enter); ok {
+		return x.Enter
+	}
+	return nil
+}
+
+func (x *TraceEvent) GetExit() *TraceExit {
+	if x, ok := x.GetEvent().(*TraceEvent_Exit); ok {
+		return x.Exit
+	}
+	return nil
+}
+
+type isTraceEvent_Event interface {
+	isTraceEvent_Event()
+}
+
+type TraceEvent_Step struct {
+	Step *TraceStep `protobuf:"bytes,1,opt,name=step,proto3,oneof"`
+}
+
+type TraceEvent_Enter struct {
+	Enter *TraceEnter `protobuf:"bytes,2,opt,name=enter,proto3,oneof"`
+}
+
+type TraceEvent_Exit struct {
+	Exit *TraceExit `protobuf:"bytes,3,opt,name=exit,proto3,oneof"`
+}
+
+func (*TraceEvent_Step) isTraceEvent_Event() {}
+
+func (*TraceEvent_Enter) isTraceEvent_Event() {}
+
+func (*TraceEvent_Exit) isTraceEvent_Event() {}
+
+// Batch of tracing events, reported by SGXVM in order of execution
+type QueryTraceEvents struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Events []*TraceEvent `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+}
+
+func (x *QueryTraceEvents) Reset() {
+	*x = QueryTraceEvents{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ffi_proto_msgTypes[78]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryTraceEvents) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryTraceEvents) ProtoMessage() {}
+
+func (x *QueryTraceEvents) ProtoReflect() protoreflect.Message {
+	mi := &file_ffi_proto_msgTypes[78]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryTraceEvents.ProtoReflect.Descriptor instead.
+func (*QueryTraceEvents) Descriptor() ([]byte, []int) {
+	return file_ffi_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *QueryTraceEvents) GetEvents() []*TraceEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+type QueryTraceEventsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *QueryTraceEventsResponse) Reset() {
+	*x = QueryTraceEventsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ffi_proto_msgTypes[79]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryTraceEventsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryTraceEventsResponse) ProtoMessage() {}
+
+func (x *QueryTraceEventsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ffi_proto_msgTypes[79]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryTraceEventsResponse.ProtoReflect.Descriptor instead.
+func (*QueryTraceEventsResponse) Descriptor() ([]byte, []int) {
+	return file_ffi_proto_rawDescGZIP(), []int{79}
+}
+
+type CosmosRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Req:
+	//	*CosmosRequest_GetAccount
+	//	*CosmosRequest_InsertAccount
+	//	*CosmosRequest_ContainsKey
+	//	*CosmosRequest_AccountCode
+	//	*CosmosRequest_StorageCell
+	//	*CosmosRequest_InsertAccountCode
+	//	*CosmosRequest_InsertStorageCell
+	//	*CosmosRequest_Remove
+	//	*CosmosRequest_RemoveStorageCell
+	//	*CosmosRequest_RemoveStorage
+	//	*CosmosRequest_BlockHash
+	//	*CosmosRequest_AddVerificationDetails
+	//	*CosmosRequest_HasVerification
+	//	*CosmosRequest_GetVerificationData
+	//	*CosmosRequest_Batch
+	//	*CosmosRequest_TraceEvents
+	//	*CosmosRequest_Delegate
+	//	*CosmosRequest_Undelegate
+	//	*CosmosRequest_Redelegate
+	//	*CosmosRequest_Delegation
+	//	*CosmosRequest_WithdrawDelegatorReward
+	//	*CosmosRequest_BankBalance
+	//	*CosmosRequest_BankSend
+	//	*CosmosRequest_IbcTransfer
+	//	*CosmosRequest_GovVote
+	//	*CosmosRequest_GovVoteWeighted
+	//	*CosmosRequest_GovDeposit
+	//	*CosmosRequest_GovProposal
+	//	*CosmosRequest_GovTally
+	//	*CosmosRequest_ContractDeployer
+	//	*CosmosRequest_CosmosSnapshot
+	//	*CosmosRequest_RevertCosmosSnapshot
+	//	*CosmosRequest_Metered
+	Req isCosmosRequest_Req `protobuf_oneof:"req"`
+}
+
+func (x *CosmosRequest) Reset() {
+	*x = CosmosRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ffi_proto_msgTypes[80]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CosmosRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CosmosRequest) ProtoMessage() {}
+
+func (x *CosmosRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ffi_proto_msgTypes[80]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CosmosRequest.ProtoReflect.Descriptor instead.
+func (*CosmosRequest) Descriptor() ([]byte, []int) {
+	return file_ffi_proto_rawDescGZIP(), []int{80}
+}
+
+func (m *CosmosRequest) GetReq() isCosmosRequest_Req {
+	if m != nil {
+		return m.Req
+	}
+	return nil
+}
+
+func (x *CosmosRequest) GetGetAccount() *QueryGetAccount {
+	if x, ok := x.GetReq().(*CosmosRequest_GetAccount); ok {
+		return x.GetAccount
+	}
+	return nil
+}
+
+func (x *CosmosRequest) GetInsertAccount() *QueryInsertAccount {
+	if x, ok := x.GetReq().(*CosmosRequest_InsertAccount); ok {
+		return x.InsertAccount
+	}
+	return nil
+}
+
+func (x *CosmosRequest) GetContainsKey() *QueryContainsKey {
+	if x, ok := x.GetReq().(*CosmosRequest_ContainsKey); ok {
+		return x.ContainsKey
+	}
+	return nil
+}
+
+func (x *CosmosRequest) GetAccountCode() *QueryGetAccountCode {
+	if x, ok := x.GetReq().(*CosmosRequest_AccountCode); ok {
+		return x.AccountCode
+	}
+	return nil
+}
+
+func (x *CosmosRequest) GetStorageCell() *QueryGetAccountStorageCell {
+	if x, ok := x.GetReq().(*CosmosRequest_StorageCell); ok {
+		return x.StorageCell
+	}
+	return nil
+}
+
+func (x *CosmosRequest) GetInsertAccountCode() *QueryInsertAccountCode {
+	if x, ok := x.GetReq().(*CosmosRequest_InsertAccountCode); ok {
+		return x.InsertAccountCode
+	}
+	return nil
+}
+
+func (x *CosmosRequest) GetInsertStorageCell() *QueryInsertStorageCell {
+	if x, ok := x.GetReq().(*CosmosRequest_InsertStorageCell); ok {
+		return x.InsertStorageCell
+	}
+	return nil
+}
+
+func (x *CosmosRequest) GetRemove() *QueryRemove {
+	if x, ok := x.GetReq().(*CosmosRequest_Remove); ok {
+		return x.Remove
+	}
+	return nil
+}
+
+func (x *CosmosRequest) GetRemoveStorageCell() *QueryRemoveStorageCell {
+	if x, ok := x.GetReq().(*CosmosRequest_RemoveStorageCell); ok {
+		return x.RemoveStorageCell
+	}
+	return nil
+}
+
+func (x *CosmosRequest) GetRemoveStorage() *QueryRemoveStorage {
+	if x, ok := x.GetReq().(*CosmosRequest_RemoveStorage); ok {
+		return x.RemoveStorage
+	}
+	return nil
+}
+
+func (x *CosmosRequest) GetBlockHash() *QueryBlockHash {
+	if x, ok := x.GetReq().(*CosmosRequest_BlockHash); ok {
+		return x.BlockHash
+	}
+	return nil
+}
+
+func (x *CosmosRequest) GetAddVerificationDetails() *QueryAddVerificationDetails {
+	if x, ok := x.GetReq().(*CosmosRequest_AddVerificationDetails); ok {
+		return x.AddVerificationDetails
+	}
+	return nil
+}
+
+func (x *CosmosRequest) GetHasVerification() *QueryHasVerification {
+	if x, ok := x.GetReq().(*CosmosRequest_HasVerification); ok {
+		return x.HasVerification
+	}
+	return nil
+}
+
+func (x *CosmosRequest) GetGetVerificationData() *QueryGetVerificationData {
+	if x, ok := x.GetReq().(*CosmosRequest_GetVerificationData); ok {
+		return x.GetVerificationData
+	}
+	return nil
+}
+
+func (x *CosmosRequest) GetBatch() *QueryBatch {
+	if x, ok := x.GetReq().(*CosmosRequest_Batch); ok {
+		return x.Batch
+	}
+	return nil
+}
+
+func (x *CosmosRequest) GetTraceEvents() *QueryTraceEvents {
+	if x, ok := x.GetReq().(*CosmosRequest_TraceEvents); ok {
+		return x.TraceEvents
+	}
+	return nil
+}
+
+func (x *CosmosRequest) GetDelegate() *QueryDelegate {
+	if x, ok := x.GetReq().(*CosmosRequest_Delegate); ok {
+		return x.Delegate
+	}
+	return nil
+}
+
+func (x *CosmosRequest) GetUndelegate() *QueryUndelegate {
+	if x, ok := x.GetReq().(*CosmosRequest_Undelegate); ok {
+		return x.Undelegate
+	}
+	return nil
+}
+
+func (x *CosmosRequest) GetRedelegate() *QueryRedelegate {
+	if x, ok := x.GetReq().(*CosmosRequest_Redelegate); ok {
+		return x.Redelegate
+	}
+	return nil
+}
+
+func (x *CosmosRequest) GetDelegation() *QueryDelegation {
+	if x, ok := x.GetReq().(*CosmosRequest_Delegation); ok {
+		return x.Delegation
+	}
+	return nil
+}
+
+func (x *CosmosRequest) GetWithdrawDelegatorReward() *QueryWithdrawDelegatorReward {
+	if x, ok := x.GetReq().(*CosmosRequest_WithdrawDelegatorReward); ok {
+		return x.WithdrawDelegatorReward
+	}
+	return nil
+}
+
+func (x *CosmosRequest) GetBankBalance() *QueryBankBalance {
+	if x, ok := x.GetReq().(*CosmosRequest_BankBalance); ok {
+		return x.BankBalance
+	}
+	return nil
+}
+
+func (x *CosmosRequest) GetBankSend() *QueryBankSend {
+	if x, ok := x.GetReq().(*CosmosRequest_BankSend); ok {
+		return x.BankSend
+	}
+	return nil
+}
+
+func (x *CosmosRequest) GetIbcTransfer() *QueryIBCTransfer {
+	if x, ok := x.GetReq().(*CosmosRequest_IbcTransfer); ok {
+		return x.IbcTransfer
+	}
+	return nil
+}
+
+func (x *CosmosRequest) GetGovVote() *QueryGovVote {
+	if x, ok := x.GetReq().(*CosmosRequest_GovVote); ok {
+		return x.GovVote
+	}
+	return nil
+}
+
+func (x *CosmosRequest) GetGovVoteWeighted() *QueryGovVoteWeighted {
+	if x, ok := x.GetReq().(*CosmosRequest_GovVoteWeighted); ok {
+		return x.GovVoteWeighted
+	}
+	return nil
+}
+
+func (x *CosmosRequest) GetGovDeposit() *QueryGovDeposit {
+	if x, ok := x.GetReq().(*CosmosRequest_GovDeposit); ok {
+		return x.GovDeposit
+	}
+	return nil
+}
+
+func (x *CosmosRequest) GetGovProposal() *QueryGovProposal {
+	if x, ok := x.GetReq().(*CosmosRequest_GovProposal); ok {
+		return x.GovProposal
+	}
+	return nil
+}
+
+func (x *CosmosRequest) GetGovTally() *QueryGovTally {
+	if x, ok := x.GetReq().(*CosmosRequest_GovTally); ok {
+		return x.GovTally
+	}
+	return nil
+}
+
+func (x *CosmosRequest) GetContractDeployer() *QueryContractDeployer {
+	if x, ok := x.GetReq().(*CosmosRequest_ContractDeployer); ok {
+		return x.ContractDeployer
+	}
+	return nil
+}
+
+func (x *CosmosRequest) GetCosmosSnapshot() *QueryCosmosSnapshot {
+	if x, ok := x.GetReq().(*CosmosRequest_CosmosSnapshot); ok {
+		return x.CosmosSnapshot
+	}
+	return nil
+}
+
+func (x *CosmosRequest) GetRevertCosmosSnapshot() *QueryRevertCosmosSnapshot {
+	if x, ok := x.GetReq().(*CosmosRequest_RevertCosmosSnapshot); ok {
+		return x.RevertCosmosSnapshot
+	}
+	return nil
+}
+
+func (x *CosmosRequest) GetMetered() *QueryMetered {
+	if x, ok := x.GetReq().(*CosmosRequest_Metered); ok {
+		return x.Metered
+	}
+	return nil
+}
+
+type isCosmosRequest_Req interface {
+	isCosmosRequest_Req()
+}
+
+type CosmosRequest_GetAccount struct {
+	GetAccount *QueryGetAccount `protobuf:"bytes,1,opt,name=getAccount,proto3,oneof"`
+}
+
+type CosmosRequest_InsertAccount struct {
+	InsertAccount *QueryInsertAccount `protobuf:"bytes,2,opt,name=insertAccount,proto3,oneof"`
+}
+
+type CosmosRequest_ContainsKey struct {
+	ContainsKey *QueryContainsKey `protobuf:"bytes,3,opt,name=containsKey,proto3,oneof"`
+}
+
+type CosmosRequest_AccountCode struct {
+	AccountCode *QueryGetAccountCode `protobuf:"bytes,4,opt,name=accountCode,proto3,oneof"`
+}
+
+type CosmosRequest_StorageCell struct {
+	StorageCell *QueryGetAccountStorageCell `protobuf:"bytes,5,opt,name=storageCell,proto3,oneof"`
+}
+
+type CosmosRequest_InsertAccountCode struct {
+	InsertAccountCode *QueryInsertAccountCode `protobuf:"bytes,6,opt,name=insertAccountCode,proto3,oneof"`
+}
+
+type CosmosRequest_InsertStorageCell struct {
+	InsertStorageCell *QueryInsertStorageCell `protobuf:"bytes,7,opt,name=insertStorageCell,proto3,oneof"`
+}
+
+type CosmosRequest_Remove struct {
+	Remove *QueryRemove `protobuf:"bytes,8,opt,name=remove,proto3,oneof"`
+}
+
+type CosmosRequest_RemoveStorageCell struct {
+	RemoveStorageCell *QueryRemoveStorageCell `protobuf:"bytes,9,opt,name=removeStorageCell,proto3,oneof"`
+}
+
+type CosmosRequest_RemoveStorage struct {
+	RemoveStorage *QueryRemoveStorage `protobuf:"bytes,10,opt,name=removeStorage,proto3,oneof"`
+}
+
+type CosmosRequest_BlockHash struct {
+	BlockHash *QueryBlockHash `protobuf:"bytes,11,opt,name=blockHash,proto3,oneof"`
+}
+
+type CosmosRequest_AddVerificationDetails struct {
+	AddVerificationDetails *QueryAddVerificationDetails `protobuf:"bytes,12,opt,name=addVerificationDetails,proto3,oneof"`
+}
+
+type CosmosRequest_HasVerification struct {
+	HasVerification *QueryHasVerification `protobuf:"bytes,13,opt,name=hasVerification,proto3,oneof"`
+}
+
+type CosmosRequest_GetVerificationData struct {
+	GetVerificationData *QueryGetVerificationData `protobuf:"bytes,14,opt,name=getVerificationData,proto3,oneof"`
+}
+
+type CosmosRequest_Batch struct {
+	Batch *QueryBatch `protobuf:"bytes,15,opt,name=batch,proto3,oneof"`
+}
+
+type CosmosRequest_TraceEvents struct {
+	TraceEvents *QueryTraceEvents `protobuf:"bytes,16,opt,name=traceEvents,proto3,oneof"`
+}
+
+type CosmosRequest_Delegate struct {
+	Delegate *QueryDelegate `protobuf:"bytes,17,opt,name=delegate,proto3,oneof"`
+}
+
+type CosmosRequest_Undelegate struct {
+	Undelegate *QueryUndelegate `protobuf:"bytes,18,opt,name=undelegate,proto3,oneof"`
+}
+
+type CosmosRequest_Redelegate struct {
+	Redelegate *QueryRedelegate `protobuf:"bytes,19,opt,name=redelegate,proto3,oneof"`
+}
+
+type CosmosRequest_Delegation struct {
+	Delegation *QueryDelegation `protobuf:"bytes,20,opt,name=delegation,proto3,oneof"`
+}
+
+type CosmosRequest_WithdrawDelegatorReward struct {
+	WithdrawDelegatorReward *QueryWithdrawDelegatorReward `protobuf:"bytes,21,opt,name=withdrawDelegatorReward,proto3,oneof"`
+}
+
+type CosmosRequest_BankBalance struct {
+	BankBalance *QueryBankBalance `protobuf:"bytes,22,opt,name=bankBalance,proto3,oneof"`
+}
+
+type CosmosRequest_BankSend struct {
+	BankSend *QueryBankSend `protobuf:"bytes,23,opt,name=bankSend,proto3,oneof"`
+}
+
+type CosmosRequest_IbcTransfer struct {
+	IbcTransfer *QueryIBCTransfer `protobuf:"bytes,24,opt,name=ibcTransfer,proto3,oneof"`
+}
+
+type CosmosRequest_GovVote struct {
+	GovVote *QueryGovVote `protobuf:"bytes,25,opt,name=govVote,proto3,oneof"`
+}
+
+type CosmosRequest_GovVoteWeighted struct {
+	GovVoteWeighted *QueryGovVoteWeighted `protobuf:"bytes,26,opt,name=govVoteWeighted,proto3,oneof"`
+}
+
+type CosmosRequest_GovDeposit struct {
+	GovDeposit *QueryGovDeposit `protobuf:"bytes,27,opt,name=govDeposit,proto3,oneof"`
+}
+
+type CosmosRequest_GovProposal struct {
+	GovProposal *QueryGovProposal `protobuf:"bytes,28,opt,name=govProposal,proto3,oneof"`
+}
+
+type CosmosRequest_GovTally struct {
+	GovTally *QueryGovTally `protobuf:"bytes,29,opt,name=govTally,proto3,oneof"`
+}
+
+type CosmosRequest_ContractDeployer struct {
+	ContractDeployer *QueryContractDeployer `protobuf:"bytes,30,opt,name=contractDeployer,proto3,oneof"`
+}
+
+type CosmosRequest_CosmosSnapshot struct {
+	CosmosSnapshot *QueryCosmosSnapshot `protobuf:"bytes,31,opt,name=cosmosSnapshot,proto3,oneof"`
+}
+
+type CosmosRequest_RevertCosmosSnapshot struct {
+	RevertCosmosSnapshot *QueryRevertCosmosSnapshot `protobuf:"bytes,32,opt,name=revertCosmosSnapshot,proto3,oneof"`
+}
+
+type CosmosRequest_Metered struct {
+	Metered *QueryMetered `protobuf:"bytes,33,opt,name=metered,proto3,oneof"`
+}
+
+func (*CosmosRequest_GetAccount) isCosmosRequest_Req() {}
+
+func (*CosmosRequest_InsertAccount) isCosmosRequest_Req() {}
+
+func (*CosmosRequest_ContainsKey) isCosmosRequest_Req() {}
+
+func (*CosmosRequest_AccountCode) isCosmosRequest_Req() {}
+
+func (*CosmosRequest_StorageCell) isCosmosRequest_Req() {}
+
+func (*CosmosRequest_InsertAccountCode) isCosmosRequest_Req() {}
+
+func (*CosmosRequest_InsertStorageCell) isCosmosRequest_Req() {}
+
+func (*CosmosRequest_Remove) isCosmosRequest_Req() {}
+
+func (*CosmosRequest_RemoveStorageCell) isCosmosRequest_Req() {}
+
+func (*CosmosRequest_RemoveStorage) isCosmosRequest_Req() {}
+
+func (*CosmosRequest_BlockHash) isCosmosRequest_Req() {}
+
+func (*CosmosRequest_AddVerificationDetails) isCosmosRequest_Req() {}
+
+func (*CosmosRequest_HasVerification) isCosmosRequest_Req() {}
+
+func (*CosmosRequest_GetVerificationData) isCosmosRequest_Req() {}
+
+func (*CosmosRequest_Batch) isCosmosRequest_Req() {}
+
+func (*CosmosRequest_TraceEvents) isCosmosRequest_Req() {}
+
+func (*CosmosRequest_Delegate) isCosmosRequest_Req() {}
+
+func (*CosmosRequest_Undelegate) isCosmosRequest_Req() {}
+
+func (*CosmosRequest_Redelegate) isCosmosRequest_Req() {}
+
+func (*CosmosRequest_Delegation) isCosmosRequest_Req() {}
+
+func (*CosmosRequest_WithdrawDelegatorReward) isCosmosRequest_Req() {}
+
+func (*CosmosRequest_BankBalance) isCosmosRequest_Req() {}
+
+func (*CosmosRequest_BankSend) isCosmosRequest_Req() {}
+
+func (*CosmosRequest_IbcTransfer) isCosmosRequest_Req() {}
+
+func (*CosmosRequest_GovVote) isCosmosRequest_Req() {}
+
+func (*CosmosRequest_GovVoteWeighted) isCosmosRequest_Req() {}
+
+func (*CosmosRequest_GovDeposit) isCosmosRequest_Req() {}
+
+func (*CosmosRequest_GovProposal) isCosmosRequest_Req() {}
+
+func (*CosmosRequest_GovTally) isCosmosRequest_Req() {}
+
+func (*CosmosRequest_ContractDeployer) isCosmosRequest_Req() {}
+
+func (*CosmosRequest_CosmosSnapshot) isCosmosRequest_Req() {}
+
+func (*CosmosRequest_RevertCosmosSnapshot) isCosmosRequest_Req() {}
+
+func (*CosmosRequest_Metered) isCosmosRequest_Req() {}
+
+// Message with data required to execute `call` operation
+type SGXVMCallParams struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	From        []byte            `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	To          []byte            `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	Data        []byte            `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	GasLimit    uint64            `protobuf:"varint,4,opt,name=gasLimit,proto3" json:"gasLimit,omitempty"`
+	Value       []byte            `protobuf:"bytes,5,opt,name=value,proto3" json:"value,omitempty"`
+	AccessList  []*AccessListItem `protobuf:"bytes,6,rep,name=accessList,proto3" json:"accessList,omitempty"`
+	Commit      bool              `protobuf:"varint,7,opt,name=commit,proto3" json:"commit,omitempty"`
+	Nonce       uint64            `protobuf:"varint,8,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	Unencrypted bool              `protobuf:"varint,9,opt,name=unencrypted,proto3" json:"unencrypted,omitempty"`
+	// If set, SGXVM reports executed opcodes and call frames for unencrypted execution
+	Trace bool `protobuf:"varint,10,opt,name=trace,proto3" json:"trace,omitempty"`
+	// If set, SGXVM verifies it and collects call tree of decrypted execution of encrypted
+	// transaction. Call tree never leaves SGXVM in plaintext
+	TraceAuthorization *TraceAuthorization `protobuf:"bytes,11,opt,name=traceAuthorization,proto3" json:"traceAuthorization,omitempty"`
+}
+
+func (x *SGXVMCallParams) Reset() {
+	*x = SGXVMCallParams{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ffi_proto_msgTypes[81]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SGXVMCallParams) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SGXVMCallParams) ProtoMessage() {}
+
+func (x *SGXVMCallParams) ProtoReflect() protoreflect.Message {
+	mi := &file_ffi_proto_msgTypes[81]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SGXVMCallParams.ProtoReflect.Descriptor instead.
+func (*SGXVMCallParams) Descriptor() ([]byte, []int) {
+	return file_ffi_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *SGXVMCallParams) GetFrom() []byte {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *SGXVMCallParams) GetTo() []byte {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *SGXVMCallParams) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *SGXVMCallParams) GetGasLimit() uint64 {
+	if x != nil {
+		return x.GasLimit
+	}
+	return 0
+}
+
+func (x *SGXVMCallParams) GetValue() []byte {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+func (x *SGXVMCallParams) GetAccessList() []*AccessListItem {
+	if x != nil {
+		return x.AccessList
+	}
+	return nil
+}
+
+func (x *SGXVMCallParams) GetCommit() bool {
+	if x != nil {
+		return x.Commit
+	}
+	return false
+}
+
+func (x *SGXVMCallParams) GetNonce() uint64 {
+	if x != nil {
+		return x.Nonce
+	}
+	return 0
+}
+
+func (x *SGXVMCallParams) GetUnencrypted() bool {
+	if x != nil {
+		return x.Unencrypted
+	}
+	return false
+}
+
+func (x *SGXVMCallParams) GetTrace() bool {
+	if x != nil {
+		return x.Trace
+	}
+	return false
+}
+
+func (x *SGXVMCallParams) GetTraceAuthorization() *TraceAuthorization {
+	if x != nil {
+		return x.TraceAuthorization
+	}
+	return nil
+}
+
+// Authorization of the transaction sender to trace decrypted execution of the transaction
+type TraceAuthorization struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Signed ethereum transaction in binary encoding. SGXVM recovers its sender and checks
+	// that executed call matches the transaction
+	Transaction []byte `protobuf:"bytes,1,opt,name=transaction,proto3" json:"transaction,omitempty"`
+	// Signature of the sender over EIP-712 `TraceTransaction` typed data with the transaction hash
+	Signature []byte `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+}
+
+func (x *TraceAuthorization) Reset() {
+	*x = TraceAuthorization{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ffi_proto_msgTypes[82]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TraceAuthorization) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TraceAuthorization) ProtoMessage() {}
+
+func (x *TraceAuthorization) ProtoReflect() protoreflect.Message {
+	mi := &file_ffi_proto_msgTypes[82]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TraceAuthorization.ProtoReflect.Descriptor instead.
+func (*TraceAuthorization) Descriptor() ([]byte, []int) {
+	return file_ffi_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *TraceAuthorization) GetTransaction() []byte {
+	if x != nil {
+		return x.Transaction
+	}
+	return nil
+}
+
+func (x *TraceAuthorization) GetSignature() []byte {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+// Message with data required to execute `create` operation
+type SGXVMCreateParams struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	From       []byte            `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	Data       []byte            `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	GasLimit   uint64            `protobuf:"varint,3,opt,name=gasLimit,proto3" json:"gasLimit,omitempty"`
+	Value      []byte            `protobuf:"bytes,4,opt,name=value,proto3" json:"value,omitempty"`
+	AccessList []*AccessListItem `protobuf:"bytes,5,rep,name=accessList,proto3" json:"accessList,omitempty"`
+	Commit     bool              `protobuf:"varint,6,opt,name=commit,proto3" json:"commit,omitempty"`
+	Nonce      uint64            `protobuf:"varint,7,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	// If set, SGXVM reports executed opcodes and call frames
+	Trace bool `protobuf:"varint,8,opt,name=trace,proto3" json:"trace,omitempty"`
+}
+
+func (x *SGXVMCreateParams) Reset() {
+	*x = SGXVMCreateParams{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ffi_proto_msgTypes[83]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SGXVMCreateParams) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SGXVMCreateParams) ProtoMessage() {}
+
+func (x *SGXVMCreateParams) ProtoReflect() protoreflect.Message {
+	mi := &file_ffi_proto_msgTypes[83]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SGXVMCreateParams.ProtoReflect.Descriptor instead.
+func (*SGXVMCreateParams) Descriptor() ([]byte, []int) {
+	return file_ffi_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *SGXVMCreateParams) GetFrom() []byte {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *SGXVMCreateParams) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *SGXVMCreateParams) GetGasLimit() uint64 {
+	if x != nil {
+		return x.GasLimit
+	}
+	return 0
+}
+
+func (x *SGXVMCreateParams) GetValue() []byte {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+func (x *SGXVMCreateParams) GetAccessList() []*AccessListItem {
+	if x != nil {
+		return x.AccessList
+	}
+	return nil
+}
+
+func (x *SGXVMCreateParams) GetCommit() bool {
+	if x != nil {
+		return x.Commit
+	}
+	return false
+}
+
+func (x *SGXVMCreateParams) GetNonce() uint64 {
+	if x != nil {
+		return x.Nonce
+	}
+	return 0
+}
+
+func (x *SGXVMCreateParams) GetTrace() bool {
+	if x != nil {
+		return x.Trace
+	}
+	return false
+}
+
+// Request to execute `call` operation
+type SGXVMCallRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Params  *SGXVMCallParams    `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"`
+	Context *TransactionContext `protobuf:"bytes,2,opt,name=context,proto3" json:"context,omitempty"`
+}
+
+func (x *SGXVMCallRequest) Reset() {
+	*x = SGXVMCallRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ffi_proto_msgTypes[84]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SGXVMCallRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SGXVMCallRequest) ProtoMessage() {}
+
+func (x *SGXVMCallRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ffi_proto_msgTypes[84]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SGXVMCallRequest.ProtoReflect.Descriptor instead.
+func (*SGXVMCallRequest) Descriptor() ([]byte, []int) {
+	return file_ffi_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *SGXVMCallRequest) GetParams() *SGXVMCallParams {
+	if x != nil {
+		return x.Params
+	}
+	return nil
+}
+
+func (x *SGXVMCallRequest) GetContext() *TransactionContext {
+	if x != nil {
+		return x.Context
+	}
+	return nil
+}
+
+// Request to execute `create` operation
+type SGXVMCreateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Params  *SGXVMCreateParams  `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"`
+	Context *TransactionContext `protobuf:"bytes,2,opt,name=context,proto3" json:"context,omitempty"`
+}
+
+func (x *SGXVMCreateRequest) Reset() {
+	*x = SGXVMCreateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ffi_proto_msgTypes[85]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SGXVMCreateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SGXVMCreateRequest) ProtoMessage() {}
+
+func (x *SGXVMCreateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ffi_proto_msgTypes[85]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SGXVMCreateRequest.ProtoReflect.Descriptor instead.
+func (*SGXVMCreateRequest) Descriptor() ([]byte, []int) {
+	return file_ffi_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *SGXVMCreateRequest) GetParams() *SGXVMCreateParams {
+	if x != nil {
+		return x.Params
+	}
+	return nil
+}
+
+func (x *SGXVMCreateRequest) GetContext() *TransactionContext {
+	if x != nil {
+		return x.Context
+	}
+	return nil
+}
+
+// Request to obtain node public key
+type NodePublicKeyRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BlockNumber uint64 `protobuf:"varint,1,opt,name=blockNumber,proto3" json:"blockNumber,omitempty"`
+}
+
+func (x *NodePublicKeyRequest) Reset() {
+	*x = NodePublicKeyRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ffi_proto_msgTypes[86]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NodePublicKeyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NodePublicKeyRequest) ProtoMessage() {}
+
+func (x *NodePublicKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ffi_proto_msgTypes[86]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NodePublicKeyRequest.ProtoReflect.Descriptor instead.
+func (*NodePublicKeyRequest) Descriptor() ([]byte, []int) {
+	return file_ffi_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *NodePublicKeyRequest) GetBlockNumber() uint64 {
+	if x != nil {
+		return x.BlockNumber
+	}
+	return 0
+}
+
+// Response with node public key
+type NodePublicKeyResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PublicKey []byte `protobuf:"bytes,1,opt,name=publicKey,proto3" json:"publicKey,omitempty"`
+}
+
+func (x *NodePublicKeyResponse) Reset() {
+	*x = NodePublicKeyResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ffi_proto_msgTypes[87]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NodePublicKeyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NodePublicKeyResponse) ProtoMessage() {}
+
+func (x *NodePublicKeyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ffi_proto_msgTypes[87]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NodePublicKeyResponse.ProtoReflect.Descriptor instead.
+func (*NodePublicKeyResponse) Descriptor() ([]byte, []int) {
+	return file_ffi_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *NodePublicKeyResponse) GetPublicKey() []byte {
+	if x != nil {
+		return x.PublicKey
+	}
+	return nil
+}
+
+type EpochData struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EpochNumber   uint32 `protobuf:"varint,1,opt,name=epochNumber,proto3" json:"epochNumber,omitempty"`
+	StartingBlock uint64 `protobuf:"varint,2,opt,name=startingBlock,proto3" json:"startingBlock,omitempty"`
+	NodePublicKey []byte `protobuf:"bytes,3,opt,name=nodePublicKey,proto3" json:"nodePublicKey,omitempty"`
+}
+
+func (x *EpochData) Reset() {
+	*x = EpochData{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ffi_proto_msgTypes[88]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EpochData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EpochData) ProtoMessage() {}
+
+func (x *EpochData) ProtoReflect() protoreflect.Message {
+	mi := &file_ffi_proto_msgTypes[88]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EpochData.ProtoReflect.Descriptor instead.
+func (*EpochData) Descriptor() ([]byte, []int) {
+	return file_ffi_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *EpochData) GetEpochNumber() uint32 {
+	if x != nil {
+		return x.EpochNumber
+	}
+	return 0
+}
+
+func (x *EpochData) GetStartingBlock() uint64 {
+	if x != nil {
+		return x.StartingBlock
+	}
+	return 0
+}
+
+func (x *EpochData) GetNodePublicKey() []byte {
+	if x != nil {
+		return x.NodePublicKey
+	}
+	return nil
+}
+
+type ListEpochsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Epochs []*EpochData `protobuf:"bytes,1,rep,name=epochs,proto3" json:"epochs,omitempty"`
+}
+
+func (x *ListEpochsResponse) Reset() {
+	*x = ListEpochsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ffi_proto_msgTypes[89]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListEpochsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListEpochsResponse) ProtoMessage() {}
+
+func (x *ListEpochsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ffi_proto_msgTypes[89]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListEpochsResponse.ProtoReflect.Descriptor instead.
+func (*ListEpochsResponse) Descriptor() ([]byte, []int) {
+	return file_ffi_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *ListEpochsResponse) GetEpochs() []*EpochData {
+	if x != nil {
+		return x.Epochs
+	}
+	return nil
+}
+
+// Overridden storage cell of the account
+type StorageCellOverride struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Index []byte `protobuf:"bytes,1,opt,name=index,proto3" json:"index,omitempty"`
+	// raw value, zero value removes the cell
+	Value []byte `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *StorageCellOverride) Reset() {
+	*x = StorageCellOverride{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ffi_proto_msgTypes[90]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StorageCellOverride) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StorageCellOverride) ProtoMessage() {}
+
+func (x *StorageCellOverride) ProtoReflect() protoreflect.Message {
+	mi := &file_ffi_proto_msgTypes[90]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StorageCellOverride.ProtoReflect.Descriptor instead.
+func (*StorageCellOverride) Descriptor() ([]byte, []int) {
+	return file_ffi_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *StorageCellOverride) GetIndex() []byte {
+	if x != nil {
+		return x.Index
+	}
+	return nil
+}
+
+func (x *StorageCellOverride) GetValue() []byte {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+// Overridden storage cells of the account
+type StorageOverride struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address []byte                 `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Cells   []*StorageCellOverride `protobuf:"bytes,2,rep,name=cells,proto3" json:"cells,omitempty"`
+}
+
+func (x *StorageOverride) Reset() {
+	*x = StorageOverride{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ffi_proto_msgTypes[91]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StorageOverride) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StorageOverride) ProtoMessage() {}
+
+func (x *StorageOverride) ProtoReflect() protoreflect.Message {
+	mi := &file_ffi_proto_msgTypes[91]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StorageOverride.ProtoReflect.Descriptor instead.
+func (*StorageOverride) Descriptor() ([]byte, []int) {
+	return file_ffi_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *StorageOverride) GetAddress() []byte {
+	if x != nil {
+		return x.Address
+	}
+	return nil
+}
+
+func (x *StorageOverride) GetCells() []*StorageCellOverride {
+	if x != nil {
+		return x.Cells
+	}
+	return nil
+}
+
+// Request to write storage cells, overridden in `eth_call` and similar queries. Values are encrypted
+// inside the enclave and written through the querier, so encrypted values are not returned to the caller
+type ApplyStorageOverrideRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Overrides []*StorageOverride `protobuf:"bytes,1,rep,name=overrides,proto3" json:"overrides,omitempty"`
+	// block number is used to select epoch key
+	BlockNumber uint64 `protobuf:"varint,2,opt,name=blockNumber,proto3" json:"blockNumber,omitempty"`
+	// timestamp is used as encryption salt, in the same way as during EVM execution
+	Timestamp uint64 `protobuf:"varint,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+}
+
+func (x *ApplyStorageOverrideRequest) Reset() {
+	*x = ApplyStorageOverrideRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ffi_proto_msgTypes[92]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ApplyStorageOverrideRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplyStorageOverrideRequest) ProtoMessage() {}
+
+func (x *ApplyStorageOverrideRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ffi_proto_msgTypes[92]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplyStorageOverrideRequest.ProtoReflect.Descriptor instead.
+func (*ApplyStorageOverrideRequest) Descriptor() ([]byte, []int) {
+	return file_ffi_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *ApplyStorageOverrideRequest) GetOverrides() []*StorageOverride {
+	if x != nil {
+		return x.Overrides
+	}
+	return nil
+}
+
+func (x *ApplyStorageOverrideRequest) GetBlockNumber() uint64 {
+	if x != nil {
+		return x.BlockNumber
+	}
+	return 0
+}
+
+func (x *ApplyStorageOverrideRequest) GetTimestamp() uint64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+type ApplyStorageOverrideResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ApplyStorageOverrideResponse) Reset() {
+	*x = ApplyStorageOverrideResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ffi_proto_msgTypes[93]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ApplyStorageOverrideResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplyStorageOverrideResponse) ProtoMessage() {}
+
+func (x *ApplyStorageOverrideResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ffi_proto_msgTypes[93]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplyStorageOverrideResponse.ProtoReflect.Descriptor instead.
+func (*ApplyStorageOverrideResponse) Descriptor() ([]byte, []int) {
+	return file_ffi_proto_rawDescGZIP(), []int{93}
+}
+
+// Request to read decrypted storage cells of the contract. Request should be signed with EIP-712
+// `StorageAccess` typed data by the deployer of the contract or by the account, for which contract
+// `isStorageViewer(address)` function returns true. Signature and access of the signer are verified
+// inside the enclave
+type PrivateStorageRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ContractAddress []byte `protobuf:"bytes,1,opt,name=contractAddress,proto3" json:"contractAddress,omitempty"`
+	// storage slots to read
+	Keys [][]byte `protobuf:"bytes,2,rep,name=keys,proto3" json:"keys,omitempty"`
+	// x25519 public key of the user, used to encrypt decrypted values
+	UserPublicKey []byte `protobuf:"bytes,3,opt,name=userPublicKey,proto3" json:"userPublicKey,omitempty"`
+	// unix timestamp, after which the signature is not valid
+	Expiry uint64 `protobuf:"varint,4,opt,name=expiry,proto3" json:"expiry,omitempty"`
+	// EIP-712 `StorageAccess` signature in [R || S || V] format
+	Signature []byte `protobuf:"bytes,5,opt,name=signature,proto3" json:"signature,omitempty"`
+	// context of the block, used to check expiry and access of the signer
+	Context *TransactionContext `protobuf:"bytes,6,opt,name=context,proto3" json:"context,omitempty"`
+}
+
+func (x *PrivateStorageRequest) Reset() {
+	*x = PrivateStorageRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ffi_proto_msgTypes[94]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PrivateStorageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PrivateStorageRequest) ProtoMessage() {}
+
+func (x *PrivateStorageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ffi_proto_msgTypes[94]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PrivateStorageRequest.ProtoReflect.Descriptor instead.
+func (*PrivateStorageRequest) Descriptor() ([]byte, []int) {
+	return file_ffi_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *PrivateStorageRequest) GetContractAddress() []byte {
+	if x != nil {
+		return x.ContractAddress
+	}
+	return nil
+}
+
+func (x *PrivateStorageRequest) GetKeys() [][]byte {
+	if x != nil {
+		return x.Keys
+	}
+	return nil
+}
+
+func (x *PrivateStorageRequest) GetUserPublicKey() []byte {
+	if x != nil {
+		return x.UserPublicKey
+	}
+	return nil
+}
+
+func (x *PrivateStorageRequest) GetExpiry() uint64 {
+	if x != nil {
+		return x.Expiry
+	}
+	return 0
+}
+
+func (x *PrivateStorageRequest) GetSignature() []byte {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+func (x *PrivateStorageRequest) GetContext() *TransactionContext {
+	if x != nil {
+		return x.Context
+	}
+	return nil
+}
+
+// Response with decrypted storage cell values, encrypted to the user public key. If access was
+// denied, values are empty and error contains the reason
+type PrivateStorageResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EncryptedValues [][]byte `protobuf:"bytes,1,rep,name=encryptedValues,proto3" json:"encryptedValues,omitempty"`
+	Error           string   `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *PrivateStorageResponse) Reset() {
+	*x = PrivateStorageResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ffi_proto_msgTypes[95]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PrivateStorageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PrivateStorageResponse) ProtoMessage() {}
+
+func (x *PrivateStorageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ffi_proto_msgTypes[95]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PrivateStorageResponse.ProtoReflect.Descriptor instead.
+func (*PrivateStorageResponse) Descriptor() ([]byte, []int) {
+	return file_ffi_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *PrivateStorageResponse) GetEncryptedValues() [][]byte {
+	if x != nil {
+		return x.EncryptedValues
+	}
+	return nil
+}
+
+func (x *PrivateStorageResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type FFIRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Req:
+	//	*FFIRequest_CallRequest
+	//	*FFIRequest_CreateRequest
+	//	*FFIRequest_PublicKeyRequest
+	//	*FFIRequest_ApplyStorageOverrideRequest
+	//	*FFIRequest_PrivateStorageRequest
+	Req isFFIRequest_Req `protobuf_oneof:"req"`
+}
+
+func (x *FFIRequest) Reset() {
+	*x = FFIRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ffi_proto_msgTypes[96]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FFIRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FFIRequest) ProtoMessage() {}
+
+func (x *FFIRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ffi_proto_msgTypes[96]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FFIRequest.ProtoReflect.Descriptor instead.
+func (*FFIRequest) Descriptor() ([]byte, []int) {
+	return file_ffi_proto_rawDescGZIP(), []int{96}
+}
+
+func (m *FFIRequest) GetReq() isFFIRequest_Req {
+	if m != nil {
+		return m.Req
+	}
+	return nil
+}
+
+func (x *FFIRequest) GetCallRequest() *SGXVMCallRequest {
+	if x, ok := x.GetReq().(*FFIRequest_CallRequest); ok {
+		return x.CallRequest
+	}
+	return nil
+}
+
+func (x *FFIRequest) GetCreateRequest() *SGXVMCreateRequest {
+	if x, ok := x.GetReq().(*FFIRequest_CreateRequest); ok {
+		return x.CreateRequest
+	}
+	return nil
+}
+
+func (x *FFIRequest) GetPublicKeyRequest() *NodePublicKeyRequest {
+	if x, ok := x.GetReq().(*FFIRequest_PublicKeyRequest); ok {
+		return x.PublicKeyRequest
+	}
+	return nil
+}
+
+func (x *FFIRequest) GetApplyStorageOverrideRequest() *ApplyStorageOverrideRequest {
+	if x, ok := x.GetReq().(*FFIRequest_ApplyStorageOverrideRequest); ok {
+		return x.ApplyStorageOverrideRequest
+	}
+	return nil
+}
+
+func (x *FFIRequest) GetPrivateStorageRequest() *PrivateStorageRequest {
+	if x, ok := x.GetReq().(*FFIRequest_PrivateStorageRequest); ok {
+		return x.PrivateStorageRequest
+	}
+	return nil
+}
+
+type isFFIRequest_Req interface {
+	isFFIRequest_Req()
+}
+
+type FFIRequest_CallRequest struct {
+	CallRequest *SGXVMCallRequest `protobuf:"bytes,1,opt,name=callRequest,proto3,oneof"`
+}
+
+type FFIRequest_CreateRequest struct {
+	CreateRequest *SGXVMCreateRequest `protobuf:"bytes,2,opt,name=createRequest,proto3,oneof"`
+}
+
+type FFIRequest_PublicKeyRequest struct {
+	PublicKeyRequest *NodePublicKeyRequest `protobuf:"bytes,3,opt,name=publicKeyRequest,proto3,oneof"`
+}
+
+type FFIRequest_ApplyStorageOverrideRequest struct {
+	ApplyStorageOverrideRequest *ApplyStorageOverrideRequest `protobuf:"bytes,6,opt,name=applyStorageOverrideRequest,proto3,oneof"`
+}
+
+type FFIRequest_PrivateStorageRequest struct {
+	PrivateStorageRequest *PrivateStorageRequest `protobuf:"bytes,7,opt,name=privateStorageRequest,proto3,oneof"`
+}
+
+func (*FFIRequest_CallRequest) isFFIRequest_Req() {}
+
+func (*FFIRequest_CreateRequest) isFFIRequest_Req() {}
+
+func (*FFIRequest_PublicKeyRequest) isFFIRequest_Req() {}
+
+func (*FFIRequest_ApplyStorageOverrideRequest) isFFIRequest_Req() {}
+
+func (*FFIRequest_PrivateStorageRequest) isFFIRequest_Req() {}
+
+var File_ffi_proto protoreflect.FileDescriptor
+
+var file_ffi_proto_rawDesc = []byte{
+	0x0a, 0x09, 0x66, 0x66, 0x69, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x07, 0x66, 0x66, 0x69,
+	0x2e, 0x66, 0x66, 0x69, 0x22, 0x4c, 0x0a, 0x0e, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x4c, 0x69,
+	0x73, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x20, 0x0a, 0x0b, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67,
+	0x65, 0x53, 0x6c, 0x6f, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x0b, 0x73, 0x74, 0x6f,
+	0x72, 0x61, 0x67, 0x65, 0x53, 0x6c, 0x6f, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x22, 0xb4, 0x01, 0x0a, 0x0f, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x74, 0x6f,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x02, 0x74, 0x6f, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61,
+	0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1a,
+	0x0a, 0x08, 0x67, 0x61, 0x73, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x08, 0x67, 0x61, 0x73, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x12, 0x37, 0x0a, 0x0a, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x18, 0x06,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x66, 0x66, 0x69, 0x2e, 0x66, 0x66, 0x69, 0x2e, 0x41,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x0a, 0x61,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x22, 0xc3, 0x02, 0x0a, 0x12, 0x54, 0x72,
+	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74,
+	0x12, 0x19, 0x0a, 0x08, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x07, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x67,
+	0x61, 0x73, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08,
+	0x67, 0x61, 0x73, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x74, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x26, 0x0a, 0x0f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f,
+	0x67, 0x61, 0x73, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0d, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x47, 0x61, 0x73, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x32,
+	0x0a, 0x16, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x66, 0x65, 0x65,
+	0x5f, 0x70, 0x65, 0x72, 0x5f, 0x67, 0x61, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x12,
+	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x42, 0x61, 0x73, 0x65, 0x46, 0x65, 0x65, 0x50, 0x65, 0x72, 0x47,
+	0x61, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x63, 0x6f, 0x69, 0x6e,
+	0x62, 0x61, 0x73, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0d, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x43, 0x6f, 0x69, 0x6e, 0x62, 0x61, 0x73, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x6c, 0x6f,
+	0x63, 0x6b, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x31, 0x0a, 0x14,
+	0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x73, 0x65,
+	0x6e, 0x64, 0x65, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x13, 0x61, 0x75, 0x74, 0x68,
+	0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x65, 0x64, 0x53, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x22,
+	0x89, 0x01, 0x0a, 0x18, 0x48, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x31, 0x0a, 0x07,
+	0x74, 0x78, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e,
+	0x66, 0x66, 0x69, 0x2e, 0x66, 0x66, 0x69, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x52, 0x06, 0x74, 0x78, 0x44, 0x61, 0x74, 0x61, 0x12,
+	0x3a, 0x0a, 0x0a, 0x74, 0x78, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x66, 0x66, 0x69, 0x2e, 0x66, 0x66, 0x69, 0x2e, 0x54, 0x72,
+	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74,
+	0x52, 0x09, 0x74, 0x78, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x22, 0x87, 0x02, 0x0a, 0x19,
+	0x48, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x20, 0x0a, 0x04, 0x6c, 0x6f, 0x67,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x66, 0x66, 0x69, 0x2e, 0x66, 0x66,
+	0x69, 0x2e, 0x4c, 0x6f, 0x67, 0x52, 0x04, 0x6c, 0x6f, 0x67, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x72,
+	0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x72, 0x65, 0x74, 0x12, 0x19, 0x0a,
+	0x08, 0x76, 0x6d, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x76, 0x6d, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x19, 0x0a, 0x08, 0x67, 0x61, 0x73, 0x5f,
+	0x75, 0x73, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x67, 0x61, 0x73, 0x55,
+	0x73, 0x65, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x67, 0x61, 0x73, 0x5f, 0x72, 0x65, 0x66, 0x75, 0x6e,
+	0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x67, 0x61, 0x73, 0x52, 0x65, 0x66, 0x75,
+	0x6e, 0x64, 0x12, 0x38, 0x0a, 0x0b, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x6c, 0x69, 0x73,
+	0x74, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x66, 0x66, 0x69, 0x2e, 0x66, 0x66,
+	0x69, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x74, 0x65, 0x6d,
+	0x52, 0x0a, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x27, 0x0a, 0x0f,
+	0x65, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x65, 0x64, 0x5f, 0x74, 0x72, 0x61, 0x63, 0x65, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0e, 0x65, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x65, 0x64,
+	0x54, 0x72, 0x61, 0x63, 0x65, 0x22, 0x1d, 0x0a, 0x05, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x12, 0x14,
+	0x0a, 0x05, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x69,
+	0x6e, 0x6e, 0x65, 0x72, 0x22, 0x5b, 0x0a, 0x03, 0x4c, 0x6f, 0x67, 0x12, 0x18, 0x0a, 0x07, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x61, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x26, 0x0a, 0x06, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x66, 0x66, 0x69, 0x2e, 0x66, 0x66, 0x69, 0x2e,
+	0x54, 0x6f, 0x70, 0x69, 0x63, 0x52, 0x06, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x73, 0x12, 0x12, 0x0a,
+	0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74,
+	0x61, 0x22, 0x2b, 0x0a, 0x0f, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x65, 0x74, 0x41, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x49,
+	0x0a, 0x17, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x65, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x61, 0x6c,
+	0x61, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x62, 0x61, 0x6c, 0x61,
+	0x6e, 0x63, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x22, 0x5e, 0x0a, 0x12, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x49, 0x6e, 0x73, 0x65, 0x72, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x61, 0x6c,
+	0x61, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x62, 0x61, 0x6c, 0x61,
+	0x6e, 0x63, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x22, 0x1c, 0x0a, 0x1a, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x49, 0x6e, 0x73, 0x65, 0x72, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x24, 0x0a, 0x10, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x73, 0x4b, 0x65, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b,
+	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x22, 0x36, 0x0a,
+	0x18, 0x51, 0x75, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x73, 0x4b, 0x65,
+	0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x6e,
+	0x74, 0x61, 0x69, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x63, 0x6f, 0x6e,
+	0x74, 0x61, 0x69, 0x6e, 0x73, 0x22, 0x4c, 0x0a, 0x1a, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x65,
+	0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x43,
+	0x65, 0x6c, 0x6c, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x14, 0x0a,
+	0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x69, 0x6e,
+	0x64, 0x65, 0x78, 0x22, 0x3a, 0x0a, 0x22, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x65, 0x74, 0x41,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x43, 0x65, 0x6c,
+	0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22,
+	0x2f, 0x0a, 0x13, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x65, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x22, 0x31, 0x0a, 0x1b, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x65, 0x74, 0x41, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x63,
+	0x6f, 0x64, 0x65, 0x22, 0x46, 0x0a, 0x16, 0x51, 0x75, 0x65, 0x72, 0x79, 0x49, 0x6e, 0x73, 0x65,
+	0x72, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a,
+	0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07,
+	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x22, 0x20, 0x0a, 0x1e, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x49, 0x6e, 0x73, 0x65, 0x72, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x5e, 0x0a,
+	0x16, 0x51, 0x75, 0x65, 0x72, 0x79, 0x49, 0x6e, 0x73, 0x65, 0x72, 0x74, 0x53, 0x74, 0x6f, 0x72,
+	0x61, 0x67, 0x65, 0x43, 0x65, 0x6c, 0x6c, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x20, 0x0a,
+	0x1e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x49, 0x6e, 0x73, 0x65, 0x72, 0x74, 0x53, 0x74, 0x6f, 0x72,
+	0x61, 0x67, 0x65, 0x43, 0x65, 0x6c, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x27, 0x0a, 0x0b, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x12, 0x18,
+	0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x15, 0x0a, 0x13, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x48, 0x0a, 0x16, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x53, 0x74,
+	0x6f, 0x72, 0x61, 0x67, 0x65, 0x43, 0x65, 0x6c, 0x6c, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x22, 0x20, 0x0a, 0x1e, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x43,
+	0x65, 0x6c, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2e, 0x0a, 0x12, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67,
+	0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x1c, 0x0a, 0x1a, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x28, 0x0a, 0x0e, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x61, 0x73, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x6e,
+	0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x6e, 0x75, 0x6d,
+	0x62, 0x65, 0x72, 0x22, 0x2c, 0x0a, 0x16, 0x51, 0x75, 0x65, 0x72, 0x79, 0x42, 0x6c, 0x6f, 0x63,
+	0x6b, 0x48, 0x61, 0x73, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a,
+	0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x68, 0x61, 0x73,
+	0x68, 0x22, 0x97, 0x03, 0x0a, 0x1b, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x64, 0x64, 0x56, 0x65,
+	0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c,
+	0x73, 0x12, 0x20, 0x0a, 0x0b, 0x75, 0x73, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b, 0x75, 0x73, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x69, 0x73, 0x73, 0x75, 0x65, 0x72, 0x41, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0d, 0x69, 0x73, 0x73, 0x75,
+	0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x6f, 0x72, 0x69,
+	0x67, 0x69, 0x6e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x12, 0x2a, 0x0a, 0x10, 0x76,
+	0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x10, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x2c, 0x0a, 0x11, 0x69, 0x73, 0x73, 0x75, 0x61,
+	0x6e, 0x63, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x11, 0x69, 0x73, 0x73, 0x75, 0x61, 0x6e, 0x63, 0x65, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x30, 0x0a, 0x13, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x13, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x6f, 0x66,
+	0x44, 0x61, 0x74, 0x61, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x6f,
+	0x66, 0x44, 0x61, 0x74, 0x61, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x12, 0x32, 0x0a,
+	0x14, 0x69, 0x73, 0x73, 0x75, 0x65, 0x72, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x49, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x14, 0x69, 0x73, 0x73,
+	0x75, 0x65, 0x72, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49,
+	0x64, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x4d, 0x0a, 0x23, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x41, 0x64, 0x64, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x26, 0x0a, 0x0e, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0e, 0x76, 0x65, 0x72, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0xbe, 0x01, 0x0a, 0x14, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x48, 0x61, 0x73, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x75, 0x73, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b, 0x75, 0x73, 0x65, 0x72, 0x41, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x2a, 0x0a, 0x10, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x10, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x30, 0x0a, 0x13, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x13,
+	0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x12, 0x26, 0x0a, 0x0e, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x49, 0x73,
+	0x73, 0x75, 0x65, 0x72, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x0e, 0x61, 0x6c, 0x6c,
+	0x6f, 0x77, 0x65, 0x64, 0x49, 0x73, 0x73, 0x75, 0x65, 0x72, 0x73, 0x22, 0x48, 0x0a, 0x1c, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x48, 0x61, 0x73, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x0f, 0x68,
+	0x61, 0x73, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x68, 0x61, 0x73, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x62, 0x0a, 0x18, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x65,
+	0x74, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74,
+	0x61, 0x12, 0x20, 0x0a, 0x0b, 0x75, 0x73, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b, 0x75, 0x73, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x69, 0x73, 0x73, 0x75, 0x65, 0x72, 0x41, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0d, 0x69, 0x73, 0x73, 0x75,
+	0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x9b, 0x03, 0x0a, 0x13, 0x56, 0x65,
+	0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c,
+	0x73, 0x12, 0x2a, 0x0a, 0x10, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x54, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x10, 0x76, 0x65, 0x72,
+	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x26, 0x0a,
+	0x0e, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0e, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x24, 0x0a, 0x0d, 0x69, 0x73, 0x73, 0x75, 0x65, 0x72, 0x41,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0d, 0x69, 0x73,
+	0x73, 0x75, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x6f,
+	0x72, 0x69, 0x67, 0x69, 0x6e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x12, 0x2c, 0x0a,
+	0x11, 0x69, 0x73, 0x73, 0x75, 0x61, 0x6e, 0x63, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x11, 0x69, 0x73, 0x73, 0x75, 0x61, 0x6e,
+	0x63, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x30, 0x0a, 0x13, 0x65,
+	0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x13, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x22, 0x0a,
+	0x0c, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x44, 0x61, 0x74, 0x61, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x0c, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x44, 0x61, 0x74,
+	0x61, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x12, 0x32, 0x0a, 0x14, 0x69, 0x73, 0x73,
+	0x75, 0x65, 0x72, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49,
+	0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x14, 0x69, 0x73, 0x73, 0x75, 0x65, 0x72, 0x56,
+	0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x18, 0x0a,
+	0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07,
+	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x54, 0x0a, 0x20, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x47, 0x65, 0x74, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44,
+	0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x04, 0x64,
+	0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x66, 0x66, 0x69, 0x2e,
+	0x66, 0x66, 0x69, 0x2e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x71, 0x0a,
+	0x0d, 0x51, 0x75, 0x65, 0x72, 0x79, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x12, 0x1c,
+	0x0a, 0x09, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x09, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x2a, 0x0a, 0x10,
+	0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f,
+	0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x22, 0x39, 0x0a, 0x15, 0x51, 0x75, 0x65, 0x72, 0x79, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x20, 0x0a, 0x04, 0x6c, 0x6f, 0x67,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x66, 0x66, 0x69, 0x2e, 0x66, 0x66,
+	0x69, 0x2e, 0x4c, 0x6f, 0x67, 0x52, 0x04, 0x6c, 0x6f, 0x67, 0x73, 0x22, 0x73, 0x0a, 0x0f, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x55, 0x6e, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x12, 0x1c,
+	0x0a, 0x09, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x09, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x2a, 0x0a, 0x10,
+	0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f,
+	0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x22, 0x63, 0x0a, 0x17, 0x51, 0x75, 0x65, 0x72, 0x79, 0x55, 0x6e, 0x64, 0x65, 0x6c, 0x65, 0x67,
+	0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x0e, 0x63,
+	0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0e, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x54,
+	0x69, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x04, 0x6c, 0x6f, 0x67, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x0c, 0x2e, 0x66, 0x66, 0x69, 0x2e, 0x66, 0x66, 0x69, 0x2e, 0x4c, 0x6f, 0x67, 0x52,
+	0x04, 0x6c, 0x6f, 0x67, 0x73, 0x22, 0xab, 0x01, 0x0a, 0x0f, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52,
+	0x65, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x64, 0x65, 0x6c,
+	0x65, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x64, 0x65,
+	0x6c, 0x65, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x30, 0x0a, 0x13, 0x76, 0x61, 0x6c, 0x69, 0x64,
+	0x61, 0x74, 0x6f, 0x72, 0x53, 0x72, 0x63, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x53,
+	0x72, 0x63, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x30, 0x0a, 0x13, 0x76, 0x61, 0x6c,
+	0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x44, 0x73, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f,
+	0x72, 0x44, 0x73, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x61,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x61, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x22, 0x63, 0x0a, 0x17, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x64, 0x65,
+	0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26,
+	0x0a, 0x0e, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69,
+	0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x04, 0x6c, 0x6f, 0x67, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x66, 0x66, 0x69, 0x2e, 0x66, 0x66, 0x69, 0x2e, 0x4c,
+	0x6f, 0x67, 0x52, 0x04, 0x6c, 0x6f, 0x67, 0x73, 0x22, 0x5b, 0x0a, 0x0f, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x64,
+	0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09,
+	0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x2a, 0x0a, 0x10, 0x76, 0x61, 0x6c,
+	0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x10, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x31, 0x0a, 0x17, 0x51, 0x75, 0x65, 0x72, 0x79, 0x44, 0x65,
+	0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x68, 0x0a, 0x1c, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74,
+	0x6f, 0x72, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x64, 0x65, 0x6c, 0x65,
+	0x67, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x64, 0x65, 0x6c,
+	0x65, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x2a, 0x0a, 0x10, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61,
+	0x74, 0x6f, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x10, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x22, 0x60, 0x0a, 0x24, 0x51, 0x75, 0x65, 0x72, 0x79, 0x57, 0x69, 0x74, 0x68, 0x64,
+	0x72, 0x61, 0x77, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x77, 0x61,
+	0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x20, 0x0a, 0x04, 0x6c, 0x6f, 0x67, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x0c, 0x2e, 0x66, 0x66, 0x69, 0x2e, 0x66, 0x66, 0x69, 0x2e, 0x4c, 0x6f, 0x67, 0x52, 0x04,
+	0x6c, 0x6f, 0x67, 0x73, 0x22, 0x42, 0x0a, 0x10, 0x51, 0x75, 0x65, 0x72, 0x79, 0x42, 0x61, 0x6e,
+	0x6b, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x22, 0x32, 0x0a, 0x18, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x42, 0x61, 0x6e, 0x6b, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x61, 0x0a, 0x0d,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x42, 0x61, 0x6e, 0x6b, 0x53, 0x65, 0x6e, 0x64, 0x12, 0x12, 0x0a,
+	0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x66, 0x72, 0x6f,
+	0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x02, 0x74,
+	0x6f, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22,
+	0x39, 0x0a, 0x15, 0x51, 0x75, 0x65, 0x72, 0x79, 0x42, 0x61, 0x6e, 0x6b, 0x53, 0x65, 0x6e, 0x64,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x20, 0x0a, 0x04, 0x6c, 0x6f, 0x67, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x66, 0x66, 0x69, 0x2e, 0x66, 0x66, 0x69,
+	0x2e, 0x4c, 0x6f, 0x67, 0x52, 0x04, 0x6c, 0x6f, 0x67, 0x73, 0x22, 0xe6, 0x02, 0x0a, 0x10, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x49, 0x42, 0x43, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x12,
+	0x16, 0x0a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x50, 0x6f, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x14, 0x0a,
+	0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x64, 0x65,
+	0x6e, 0x6f, 0x6d, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x72,
+	0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72,
+	0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x12, 0x34, 0x0a, 0x15, 0x74, 0x69, 0x6d, 0x65, 0x6f,
+	0x75, 0x74, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x15, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x52,
+	0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x34, 0x0a,
+	0x15, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e,
+	0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x15, 0x74, 0x69,
+	0x6d, 0x65, 0x6f, 0x75, 0x74, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x48, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x12, 0x2a, 0x0a, 0x10, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x09, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x74,
+	0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12,
+	0x12, 0x0a, 0x04, 0x6d, 0x65, 0x6d, 0x6f, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6d,
+	0x65, 0x6d, 0x6f, 0x22, 0x58, 0x0a, 0x18, 0x51, 0x75, 0x65, 0x72, 0x79, 0x49, 0x42, 0x43, 0x54,
+	0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x1a, 0x0a, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x20, 0x0a, 0x04, 0x6c,
+	0x6f, 0x67, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x66, 0x66, 0x69, 0x2e,
+	0x66, 0x66, 0x69, 0x2e, 0x4c, 0x6f, 0x67, 0x52, 0x04, 0x6c, 0x6f, 0x67, 0x73, 0x22, 0x78, 0x0a,
+	0x0c, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x6f, 0x76, 0x56, 0x6f, 0x74, 0x65, 0x12, 0x14, 0x0a,
+	0x05, 0x76, 0x6f, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x76, 0x6f,
+	0x74, 0x65, 0x72, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x49,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61,
+	0x6c, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x06, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x6d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x22, 0x38, 0x0a, 0x14, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x47, 0x6f, 0x76, 0x56, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x20, 0x0a, 0x04, 0x6c, 0x6f, 0x67, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e,
+	0x66, 0x66, 0x69, 0x2e, 0x66, 0x66, 0x69, 0x2e, 0x4c, 0x6f, 0x67, 0x52, 0x04, 0x6c, 0x6f, 0x67,
+	0x73, 0x22, 0x44, 0x0a, 0x12, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x65, 0x64, 0x56, 0x6f, 0x74,
+	0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x16, 0x0a, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0x9f, 0x01, 0x0a, 0x14, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x47, 0x6f, 0x76, 0x56, 0x6f, 0x74, 0x65, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x65, 0x64,
+	0x12, 0x14, 0x0a, 0x05, 0x76, 0x6f, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x05, 0x76, 0x6f, 0x74, 0x65, 0x72, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73,
+	0x61, 0x6c, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x70,
+	0x6f, 0x73, 0x61, 0x6c, 0x49, 0x64, 0x12, 0x35, 0x0a, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x66, 0x66, 0x69, 0x2e, 0x66, 0x66,
+	0x69, 0x2e, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x65, 0x64, 0x56, 0x6f, 0x74, 0x65, 0x4f, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1a, 0x0a,
+	0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x22, 0x40, 0x0a, 0x1c, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x47, 0x6f, 0x76, 0x56, 0x6f, 0x74, 0x65, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x65,
+	0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x20, 0x0a, 0x04, 0x6c, 0x6f, 0x67,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x66, 0x66, 0x69, 0x2e, 0x66, 0x66,
+	0x69, 0x2e, 0x4c, 0x6f, 0x67, 0x52, 0x04, 0x6c, 0x6f, 0x67, 0x73, 0x22, 0x7d, 0x0a, 0x0f, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x47, 0x6f, 0x76, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x12, 0x1c,
+	0x0a, 0x09, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x09, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x12, 0x1e, 0x0a, 0x0a,
+	0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05,
+	0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x64, 0x65, 0x6e,
+	0x6f, 0x6d, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x3b, 0x0a, 0x17, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x47, 0x6f, 0x76, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x20, 0x0a, 0x04, 0x6c, 0x6f, 0x67, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x66, 0x66, 0x69, 0x2e, 0x66, 0x66, 0x69, 0x2e, 0x4c, 0x6f,
+	0x67, 0x52, 0x04, 0x6c, 0x6f, 0x67, 0x73, 0x22, 0x32, 0x0a, 0x10, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x47, 0x6f, 0x76, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x12, 0x1e, 0x0a, 0x0a, 0x70,
+	0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0a, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x49, 0x64, 0x22, 0x8c, 0x02, 0x0a, 0x18,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x6f, 0x76, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05,
+	0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74,
+	0x6c, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x54, 0x69, 0x6d, 0x65,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x54, 0x69,
+	0x6d, 0x65, 0x12, 0x26, 0x0a, 0x0e, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x45, 0x6e, 0x64,
+	0x54, 0x69, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x64, 0x65, 0x70, 0x6f,
+	0x73, 0x69, 0x74, 0x45, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x28, 0x0a, 0x0f, 0x76, 0x6f,
+	0x74, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0f, 0x76, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x72, 0x74,
+	0x54, 0x69, 0x6d, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x76, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x45, 0x6e,
+	0x64, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x76, 0x6f, 0x74,
+	0x69, 0x6e, 0x67, 0x45, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x2f, 0x0a, 0x0d, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x47, 0x6f, 0x76, 0x54, 0x61, 0x6c, 0x6c, 0x79, 0x12, 0x1e, 0x0a, 0x0a, 0x70,
+	0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0a, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x49, 0x64, 0x22, 0x73, 0x0a, 0x15, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x47, 0x6f, 0x76, 0x54, 0x61, 0x6c, 0x6c, 0x79, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x79, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x03, 0x79, 0x65, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x62, 0x73, 0x74, 0x61, 0x69,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x61, 0x62, 0x73, 0x74, 0x61, 0x69, 0x6e,
+	0x12, 0x0e, 0x0a, 0x02, 0x6e, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x02, 0x6e, 0x6f,
+	0x12, 0x1e, 0x0a, 0x0a, 0x6e, 0x6f, 0x57, 0x69, 0x74, 0x68, 0x56, 0x65, 0x74, 0x6f, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x6e, 0x6f, 0x57, 0x69, 0x74, 0x68, 0x56, 0x65, 0x74, 0x6f,
+	0x22, 0x33, 0x0a, 0x15, 0x51, 0x75, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63,
+	0x74, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x6e,
+	0x74, 0x72, 0x61, 0x63, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x63, 0x6f, 0x6e,
+	0x74, 0x72, 0x61, 0x63, 0x74, 0x22, 0x3b, 0x0a, 0x1d, 0x51, 0x75, 0x65, 0x72, 0x79, 0x43, 0x6f,
+	0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x65, 0x72, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79,
+	0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79,
+	0x65, 0x72, 0x22, 0x15, 0x0a, 0x13, 0x51, 0x75, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x22, 0x39, 0x0a, 0x1b, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x6e, 0x61, 0x70,
+	0x73, 0x68, 0x6f, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x73, 0x6e, 0x61, 0x70,
+	0x73, 0x68, 0x6f, 0x74, 0x22, 0x37, 0x0a, 0x19, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x76,
+	0x65, 0x72, 0x74, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f,
+	0x74, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x08, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x22, 0x23, 0x0a,
+	0x21, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x76, 0x65, 0x72, 0x74, 0x43, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x28, 0x0a, 0x0a, 0x51, 0x75, 0x65, 0x72, 0x79, 0x42, 0x61, 0x74, 0x63, 0x68,
+	0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0c, 0x52, 0x08, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x22, 0x32, 0x0a, 0x12,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x09, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x73,
+	0x22, 0x44, 0x0a, 0x0c, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4d, 0x65, 0x74, 0x65, 0x72, 0x65, 0x64,
+	0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x67, 0x61,
+	0x73, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x67, 0x61,
+	0x73, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0xa2, 0x01, 0x0a, 0x14, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x4d, 0x65, 0x74, 0x65, 0x72, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x1a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x12, 0x18, 0x0a, 0x07, 0x67, 0x61, 0x73, 0x55, 0x73, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x07, 0x67, 0x61, 0x73, 0x55, 0x73, 0x65, 0x64, 0x12, 0x3e, 0x0a, 0x0e, 0x62,
+	0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x18, 0x04, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x66, 0x66, 0x69, 0x2e, 0x66, 0x66, 0x69, 0x2e, 0x42, 0x61,
+	0x6c, 0x61, 0x6e, 0x63, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x0e, 0x62, 0x61, 0x6c,
+	0x61, 0x6e, 0x63, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x22, 0x5d, 0x0a, 0x0d, 0x42,
+	0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07,
+	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1a,
+	0x0a, 0x08, 0x64, 0x65, 0x63, 0x72, 0x65, 0x61, 0x73, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x08, 0x64, 0x65, 0x63, 0x72, 0x65, 0x61, 0x73, 0x65, 0x22, 0xb5, 0x01, 0x0a, 0x09, 0x54,
+	0x72, 0x61, 0x63, 0x65, 0x53, 0x74, 0x65, 0x70, 0x12, 0x0e, 0x0a, 0x02, 0x70, 0x63, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x70, 0x63, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x70, 0x63, 0x6f,
+	0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x6f, 0x70, 0x63, 0x6f, 0x64, 0x65,
+	0x12, 0x10, 0x0a, 0x03, 0x67, 0x61, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x03, 0x67,
+	0x61, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x73, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x04, 0x63, 0x6f, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x65, 0x70, 0x74, 0x68, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x64, 0x65, 0x70, 0x74, 0x68, 0x12, 0x1a, 0x0a, 0x08,
+	0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08,
+	0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x66, 0x75,
+	0x6e, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64,
+	0x4a, 0x04, 0x08, 0x07, 0x10, 0x08, 0x4a, 0x04, 0x08, 0x08, 0x10, 0x09, 0x4a, 0x04, 0x08, 0x0a,
+	0x10, 0x0b, 0x22, 0x86, 0x01, 0x0a, 0x0a, 0x54, 0x72, 0x61, 0x63, 0x65, 0x45, 0x6e, 0x74, 0x65,
+	0x72, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x70, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x06, 0x6f, 0x70, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x72, 0x6f,
+	0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x0e, 0x0a,
+	0x02, 0x74, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x02, 0x74, 0x6f, 0x12, 0x14, 0x0a,
+	0x05, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x69, 0x6e,
+	0x70, 0x75, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x67, 0x61, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x03, 0x67, 0x61, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x53, 0x0a, 0x09, 0x54,
+	0x72, 0x61, 0x63, 0x65, 0x45, 0x78, 0x69, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x75, 0x74, 0x70,
+	0x75, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74,
+	0x12, 0x18, 0x0a, 0x07, 0x67, 0x61, 0x73, 0x55, 0x73, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x07, 0x67, 0x61, 0x73, 0x55, 0x73, 0x65, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x22, 0x96, 0x01, 0x0a, 0x0a, 0x54, 0x72, 0x61, 0x63, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12,
+	0x28, 0x0a, 0x04, 0x73, 0x74, 0x65, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e,
+	0x66, 0x66, 0x69, 0x2e, 0x66, 0x66, 0x69, 0x2e, 0x54, 0x72, 0x61, 0x63, 0x65, 0x53, 0x74, 0x65,
+	0x70, 0x48, 0x00, 0x52, 0x04, 0x73, 0x74, 0x65, 0x70, 0x12, 0x2b, 0x0a, 0x05, 0x65, 0x6e, 0x74,
+	0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x66, 0x66, 0x69, 0x2e, 0x66,
+	0x66, 0x69, 0x2e, 0x54, 0x72, 0x61, 0x63, 0x65, 0x45, 0x6e, 0x74, 0x65, 0x72, 0x48, 0x00, 0x52,
+	0x05, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x12, 0x28, 0x0a, 0x04, 0x65, 0x78, 0x69, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x66, 0x66, 0x69, 0x2e, 0x66, 0x66, 0x69, 0x2e, 0x54,
+	0x72, 0x61, 0x63, 0x65, 0x45, 0x78, 0x69, 0x74, 0x48, 0x00, 0x52, 0x04, 0x65, 0x78, 0x69, 0x74,
+	0x42, 0x07, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x22, 0x3f, 0x0a, 0x10, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x54, 0x72, 0x61, 0x63, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x2b, 0x0a,
+	0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e,
+	0x66, 0x66, 0x69, 0x2e, 0x66, 0x66, 0x69, 0x2e, 0x54, 0x72, 0x61, 0x63, 0x65, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x52, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x1a, 0x0a, 0x18, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x54, 0x72, 0x61, 0x63, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xc3, 0x11, 0x0a, 0x0d, 0x43, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3a, 0x0a, 0x0a, 0x67, 0x65, 0x74, 0x41,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x66,
+	0x66, 0x69, 0x2e, 0x66, 0x66, 0x69, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x65, 0x74, 0x41,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x0a, 0x67, 0x65, 0x74, 0x41, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x43, 0x0a, 0x0d, 0x69, 0x6e, 0x73, 0x65, 0x72, 0x74, 0x41, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x66, 0x66,
+	0x69, 0x2e, 0x66, 0x66, 0x69, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x49, 0x6e, 0x73, 0x65, 0x72,
+	0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x0d, 0x69, 0x6e, 0x73, 0x65,
+	0x72, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x3d, 0x0a, 0x0b, 0x63, 0x6f, 0x6e,
+	0x74, 0x61, 0x69, 0x6e, 0x73, 0x4b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19,
+	0x2e, 0x66, 0x66, 0x69, 0x2e, 0x66, 0x66, 0x69, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x43, 0x6f,
+	0x6e, 0x74, 0x61, 0x69, 0x6e, 0x73, 0x4b, 0x65, 0x79, 0x48, 0x00, 0x52, 0x0b, 0x63, 0x6f, 0x6e,
+	0x74, 0x61, 0x69, 0x6e, 0x73, 0x4b, 0x65, 0x79, 0x12, 0x40, 0x0a, 0x0b, 0x61, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
+	0x66, 0x66, 0x69, 0x2e, 0x66, 0x66, 0x69, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x65, 0x74,
+	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x48, 0x00, 0x52, 0x0b, 0x61,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x47, 0x0a, 0x0b, 0x73, 0x74,
+	0x6f, 0x72, 0x61, 0x67, 0x65, 0x43, 0x65, 0x6c, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x23, 0x2e, 0x66, 0x66, 0x69, 0x2e, 0x66, 0x66, 0x69, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47,
+	0x65, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65,
+	0x43, 0x65, 0x6c, 0x6c, 0x48, 0x00, 0x52, 0x0b, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x43,
+	0x65, 0x6c, 0x6c, 0x12, 0x4f, 0x0a, 0x11, 0x69, 0x6e, 0x73, 0x65, 0x72, 0x74, 0x41, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f,
+	0x2e, 0x66, 0x66, 0x69, 0x2e, 0x66, 0x66, 0x69, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x49, 0x6e,
+	0x73, 0x65, 0x72, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x48,
+	0x00, 0x52, 0x11, 0x69, 0x6e, 0x73, 0x65, 0x72, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x43, 0x6f, 0x64, 0x65, 0x12, 0x4f, 0x0a, 0x11, 0x69, 0x6e, 0x73, 0x65, 0x72, 0x74, 0x53, 0x74,
+	0x6f, 0x72, 0x61, 0x67, 0x65, 0x43, 0x65, 0x6c, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1f, 0x2e, 0x66, 0x66, 0x69, 0x2e, 0x66, 0x66, 0x69, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x49,
+	0x6e, 0x73, 0x65, 0x72, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x43, 0x65, 0x6c, 0x6c,
+	0x48, 0x00, 0x52, 0x11, 0x69, 0x6e, 0x73, 0x65, 0x72, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67,
+	0x65, 0x43, 0x65, 0x6c, 0x6c, 0x12, 0x2e, 0x0a, 0x06, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x66, 0x66, 0x69, 0x2e, 0x66, 0x66, 0x69, 0x2e,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x48, 0x00, 0x52, 0x06, 0x72,
+	0x65, 0x6d, 0x6f, 0x76, 0x65, 0x12, 0x4f, 0x0a, 0x11, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x53,
+	0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x43, 0x65, 0x6c, 0x6c, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1f, 0x2e, 0x66, 0x66, 0x69, 0x2e, 0x66, 0x66, 0x69, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x43, 0x65, 0x6c,
+	0x6c, 0x48, 0x00, 0x52, 0x11, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x53, 0x74, 0x6f, 0x72, 0x61,
+	0x67, 0x65, 0x43, 0x65, 0x6c, 0x6c, 0x12, 0x43, 0x0a, 0x0d, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65,
+	0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e,
+	0x66, 0x66, 0x69, 0x2e, 0x66, 0x66, 0x69, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x6d,
+	0x6f, 0x76, 0x65, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x0d, 0x72, 0x65,
+	0x6d, 0x6f, 0x76, 0x65, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x12, 0x37, 0x0a, 0x09, 0x62,
+	0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x61, 0x73, 0x68, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17,
+	0x2e, 0x66, 0x66, 0x69, 0x2e, 0x66, 0x66, 0x69, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x42, 0x6c,
+	0x6f, 0x63, 0x6b, 0x48, 0x61, 0x73, 0x68, 0x48, 0x00, 0x52, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x48, 0x61, 0x73, 0x68, 0x12, 0x5e, 0x0a, 0x16, 0x61, 0x64, 0x64, 0x56, 0x65, 0x72, 0x69, 0x66,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x18, 0x0c,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x66, 0x66, 0x69, 0x2e, 0x66, 0x66, 0x69, 0x2e, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x41, 0x64, 0x64, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x48, 0x00, 0x52, 0x16, 0x61, 0x64,
+	0x64, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x74,
+	0x61, 0x69, 0x6c, 0x73, 0x12, 0x49, 0x0a, 0x0f, 0x68, 0x61, 0x73, 0x56, 0x65, 0x72, 0x69, 0x66,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e,
+	0x66, 0x66, 0x69, 0x2e, 0x66, 0x66, 0x69, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x48, 0x61, 0x73,
+	0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x0f,
+	0x68, 0x61, 0x73, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x55, 0x0a, 0x13, 0x67, 0x65, 0x74, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x66,
+	0x66, 0x69, 0x2e, 0x66, 0x66, 0x69, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x65, 0x74, 0x56,
+	0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x48,
+	0x00, 0x52, 0x13, 0x67, 0x65, 0x74, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x12, 0x2b, 0x0a, 0x05, 0x62, 0x61, 0x74, 0x63, 0x68, 0x18,
+	0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x66, 0x66, 0x69, 0x2e, 0x66, 0x66, 0x69, 0x2e,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x42, 0x61, 0x74, 0x63, 0x68, 0x48, 0x00, 0x52, 0x05, 0x62, 0x61,
+	0x74, 0x63, 0x68, 0x12, 0x3d, 0x0a, 0x0b, 0x74, 0x72, 0x61, 0x63, 0x65, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x73, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x66, 0x66, 0x69, 0x2e, 0x66,
+	0x66, 0x69, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x72, 0x61, 0x63, 0x65, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x73, 0x48, 0x00, 0x52, 0x0b, 0x74, 0x72, 0x61, 0x63, 0x65, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x73, 0x12, 0x34, 0x0a, 0x08, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x18, 0x11,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x66, 0x66, 0x69, 0x2e, 0x66, 0x66, 0x69, 0x2e, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x48, 0x00, 0x52, 0x08,
+	0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x12, 0x3a, 0x0a, 0x0a, 0x75, 0x6e, 0x64, 0x65,
+	0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x18, 0x12, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x66,
+	0x66, 0x69, 0x2e, 0x66, 0x66, 0x69, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x55, 0x6e, 0x64, 0x65,
+	0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x48, 0x00, 0x52, 0x0a, 0x75, 0x6e, 0x64, 0x65, 0x6c, 0x65,
+	0x67, 0x61, 0x74, 0x65, 0x12, 0x3a, 0x0a, 0x0a, 0x72, 0x65, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61,
+	0x74, 0x65, 0x18, 0x13, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x66, 0x66, 0x69, 0x2e, 0x66,
+	0x66, 0x69, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61,
+	0x74, 0x65, 0x48, 0x00, 0x52, 0x0a, 0x72, 0x65, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65,
+	0x12, 0x3a, 0x0a, 0x0a, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x14,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x66, 0x66, 0x69, 0x2e, 0x66, 0x66, 0x69, 0x2e, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00,
+	0x52, 0x0a, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x61, 0x0a, 0x17,
+	0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x6f,
+	0x72, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x18, 0x15, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e,
+	0x66, 0x66, 0x69, 0x2e, 0x66, 0x66, 0x69, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x57, 0x69, 0x74,
+	0x68, 0x64, 0x72, 0x61, 0x77, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x65,
+	0x77, 0x61, 0x72, 0x64, 0x48, 0x00, 0x52, 0x17, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77,
+	0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x12,
+	0x3d, 0x0a, 0x0b, 0x62, 0x61, 0x6e, 0x6b, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x16,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x66, 0x66, 0x69, 0x2e, 0x66, 0x66, 0x69, 0x2e, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x42, 0x61, 0x6e, 0x6b, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x48,
+	0x00, 0x52, 0x0b, 0x62, 0x61, 0x6e, 0x6b, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x34,
+	0x0a, 0x08, 0x62, 0x61, 0x6e, 0x6b, 0x53, 0x65, 0x6e, 0x64, 0x18, 0x17, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x16, 0x2e, 0x66, 0x66, 0x69, 0x2e, 0x66, 0x66, 0x69, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x42, 0x61, 0x6e, 0x6b, 0x53, 0x65, 0x6e, 0x64, 0x48, 0x00, 0x52, 0x08, 0x62, 0x61, 0x6e, 0x6b,
+	0x53, 0x65, 0x6e, 0x64, 0x12, 0x3d, 0x0a, 0x0b, 0x69, 0x62, 0x63, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x66, 0x65, 0x72, 0x18, 0x18, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x66, 0x66, 0x69, 0x2e,
+	0x66, 0x66, 0x69, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x49, 0x42, 0x43, 0x54, 0x72, 0x61, 0x6e,
+	0x73, 0x66, 0x65, 0x72, 0x48, 0x00, 0x52, 0x0b, 0x69, 0x62, 0x63, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x66, 0x65, 0x72, 0x12, 0x31, 0x0a, 0x07, 0x67, 0x6f, 0x76, 0x56, 0x6f, 0x74, 0x65, 0x18, 0x19,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x66, 0x66, 0x69, 0x2e, 0x66, 0x66, 0x69, 0x2e, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x47, 0x6f, 0x76, 0x56, 0x6f, 0x74, 0x65, 0x48, 0x00, 0x52, 0x07, 0x67,
+	0x6f, 0x76, 0x56, 0x6f, 0x74, 0x65, 0x12, 0x49, 0x0a, 0x0f, 0x67, 0x6f, 0x76, 0x56, 0x6f, 0x74,
+	0x65, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x65, 0x64, 0x18, 0x1a, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1d, 0x2e, 0x66, 0x66, 0x69, 0x2e, 0x66, 0x66, 0x69, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47,
+	0x6f, 0x76, 0x56, 0x6f, 0x74, 0x65, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x65, 0x64, 0x48, 0x00,
+	0x52, 0x0f, 0x67, 0x6f, 0x76, 0x56, 0x6f, 0x74, 0x65, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x65,
+	0x64, 0x12, 0x3a, 0x0a, 0x0a, 0x67, 0x6f, 0x76, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x18,
+	0x1b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x66, 0x66, 0x69, 0x2e, 0x66, 0x66, 0x69, 0x2e,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x6f, 0x76, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x48,
+	0x00, 0x52, 0x0a, 0x67, 0x6f, 0x76, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x12, 0x3d, 0x0a,
+	0x0b, 0x67, 0x6f, 0x76, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x18, 0x1c, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x66, 0x66, 0x69, 0x2e, 0x66, 0x66, 0x69, 0x2e, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x47, 0x6f, 0x76, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x48, 0x00, 0x52,
+	0x0b, 0x67, 0x6f, 0x76, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x12, 0x34, 0x0a, 0x08,
+	0x67, 0x6f, 0x76, 0x54, 0x61, 0x6c, 0x6c, 0x79, 0x18, 0x1d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16,
+	0x2e, 0x66, 0x66, 0x69, 0x2e, 0x66, 0x66, 0x69, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x6f,
+	0x76, 0x54, 0x61, 0x6c, 0x6c, 0x79, 0x48, 0x00, 0x52, 0x08, 0x67, 0x6f, 0x76, 0x54, 0x61, 0x6c,
+	0x6c, 0x79, 0x12, 0x4c, 0x0a, 0x10, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x44, 0x65,
+	0x70, 0x6c, 0x6f, 0x79, 0x65, 0x72, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x66,
+	0x66, 0x69, 0x2e, 0x66, 0x66, 0x69, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x6e, 0x74,
+	0x72, 0x61, 0x63, 0x74, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x65, 0x72, 0x48, 0x00, 0x52, 0x10,
+	0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x65, 0x72,
+	0x12, 0x46, 0x0a, 0x0e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68,
+	0x6f, 0x74, 0x18, 0x1f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x66, 0x66, 0x69, 0x2e, 0x66,
+	0x66, 0x69, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x53, 0x6e,
+	0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x48, 0x00, 0x52, 0x0e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x58, 0x0a, 0x14, 0x72, 0x65, 0x76, 0x65,
+	0x72, 0x74, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74,
+	0x18, 0x20, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x66, 0x66, 0x69, 0x2e, 0x66, 0x66, 0x69,
+	0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x76, 0x65, 0x72, 0x74, 0x43, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x48, 0x00, 0x52, 0x14, 0x72, 0x65,
+	0x76, 0x65, 0x72, 0x74, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68,
+	0x6f, 0x74, 0x12, 0x31, 0x0a, 0x07, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x65, 0x64, 0x18, 0x21, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x66, 0x66, 0x69, 0x2e, 0x66, 0x66, 0x69, 0x2e, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x4d, 0x65, 0x74, 0x65, 0x72, 0x65, 0x64, 0x48, 0x00, 0x52, 0x07, 0x6d, 0x65,
+	0x74, 0x65, 0x72, 0x65, 0x64, 0x42, 0x05, 0x0a, 0x03, 0x72, 0x65, 0x71, 0x22, 0xe7, 0x02, 0x0a,
+	0x0f, 0x53, 0x47, 0x58, 0x56, 0x4d, 0x43, 0x61, 0x6c, 0x6c, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73,
+	0x12, 0x12, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04,
+	0x66, 0x72, 0x6f, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x02, 0x74, 0x6f, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1a, 0x0a, 0x08, 0x67, 0x61, 0x73, 0x4c,
+	0x69, 0x6d, 0x69, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x67, 0x61, 0x73, 0x4c,
+	0x69, 0x6d, 0x69, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x37, 0x0a, 0x0a, 0x61, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17,
+	0x2e, 0x66, 0x66, 0x69, 0x2e, 0x66, 0x66, 0x69, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x4c,
+	0x69, 0x73, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x0a, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x4c,
+	0x69, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6e,
+	0x6f, 0x6e, 0x63, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x6e, 0x6f, 0x6e, 0x63,
+	0x65, 0x12, 0x20, 0x0a, 0x0b, 0x75, 0x6e, 0x65, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x65, 0x64,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x75, 0x6e, 0x65, 0x6e, 0x63, 0x72, 0x79, 0x70,
+	0x74, 0x65, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x72, 0x61, 0x63, 0x65, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x05, 0x74, 0x72, 0x61, 0x63, 0x65, 0x12, 0x4b, 0x0a, 0x12, 0x74, 0x72, 0x61,
+	0x63, 0x65, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x66, 0x66, 0x69, 0x2e, 0x66, 0x66, 0x69, 0x2e,
+	0x54, 0x72, 0x61, 0x63, 0x65, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x12, 0x74, 0x72, 0x61, 0x63, 0x65, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69,
+	0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x54, 0x0a, 0x12, 0x54, 0x72, 0x61, 0x63, 0x65, 0x41,
+	0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x0b,
+	0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x0b, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1c,
+	0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0xea, 0x01, 0x0a,
+	0x11, 0x53, 0x47, 0x58, 0x56, 0x4d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x61,
+	0x6d, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1a, 0x0a, 0x08, 0x67, 0x61,
+	0x73, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x67, 0x61,
+	0x73, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x37, 0x0a, 0x0a,
+	0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x17, 0x2e, 0x66, 0x66, 0x69, 0x2e, 0x66, 0x66, 0x69, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x0a, 0x61, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x12, 0x14, 0x0a,
+	0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x6e, 0x6f,
+	0x6e, 0x63, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x72, 0x61, 0x63, 0x65, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x05, 0x74, 0x72, 0x61, 0x63, 0x65, 0x22, 0x7b, 0x0a, 0x10, 0x53, 0x47, 0x58,
+	0x56, 0x4d, 0x43, 0x61, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x30, 0x0a,
+	0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e,
+	0x66, 0x66, 0x69, 0x2e, 0x66, 0x66, 0x69, 0x2e, 0x53, 0x47, 0x58, 0x56, 0x4d, 0x43, 0x61, 0x6c,
+	0x6c, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12,
+	0x35, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1b, 0x2e, 0x66, 0x66, 0x69, 0x2e, 0x66, 0x66, 0x69, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x52, 0x07, 0x63,
+	0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x22, 0x7f, 0x0a, 0x12, 0x53, 0x47, 0x58, 0x56, 0x4d, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x32, 0x0a, 0x06,
+	0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x66,
+	0x66, 0x69, 0x2e, 0x66, 0x66, 0x69, 0x2e, 0x53, 0x47, 0x58, 0x56, 0x4d, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73,
+	0x12, 0x35, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1b, 0x2e, 0x66, 0x66, 0x69, 0x2e, 0x66, 0x66, 0x69, 0x2e, 0x54, 0x72, 0x61, 0x6e,
+	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x52, 0x07,
+	0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x22, 0x38, 0x0a, 0x14, 0x4e, 0x6f, 0x64, 0x65, 0x50,
+	0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x20, 0x0a, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65,
+	0x72, 0x22, 0x35, 0x0a, 0x15, 0x4e, 0x6f, 0x64, 0x65, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b,
+	0x65, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x75,
+	0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x70,
+	0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x22, 0x79, 0x0a, 0x09, 0x45, 0x70, 0x6f, 0x63,
+	0x68, 0x44, 0x61, 0x74, 0x61, 0x12, 0x20, 0x0a, 0x0b, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x4e, 0x75,
+	0x6d, 0x62, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x65, 0x70, 0x6f, 0x63,
+	0x68, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x24, 0x0a, 0x0d, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x69, 0x6e, 0x67, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d,
+	0x73, 0x74, 0x61, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x24, 0x0a,
+	0x0d, 0x6e, 0x6f, 0x64, 0x65, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x0d, 0x6e, 0x6f, 0x64, 0x65, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63,
+	0x4b, 0x65, 0x79, 0x22, 0x40, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x70, 0x6f, 0x63, 0x68,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a, 0x06, 0x65, 0x70, 0x6f,
+	0x63, 0x68, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x66, 0x66, 0x69, 0x2e,
+	0x66, 0x66, 0x69, 0x2e, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x44, 0x61, 0x74, 0x61, 0x52, 0x06, 0x65,
+	0x70, 0x6f, 0x63, 0x68, 0x73, 0x22, 0x41, 0x0a, 0x13, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65,
+	0x43, 0x65, 0x6c, 0x6c, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x12, 0x14, 0x0a, 0x05,
+	0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x69, 0x6e, 0x64,
+	0x65, 0x78, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x5f, 0x0a, 0x0f, 0x53, 0x74, 0x6f, 0x72,
+	0x61, 0x67, 0x65, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x61, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x32, 0x0a, 0x05, 0x63, 0x65, 0x6c, 0x6c, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x66, 0x66, 0x69, 0x2e, 0x66, 0x66, 0x69, 0x2e, 0x53,
+	0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x43, 0x65, 0x6c, 0x6c, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69,
+	0x64, 0x65, 0x52, 0x05, 0x63, 0x65, 0x6c, 0x6c, 0x73, 0x22, 0x95, 0x01, 0x0a, 0x1b, 0x41, 0x70,
+	0x70, 0x6c, 0x79, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69,
+	0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x36, 0x0a, 0x09, 0x6f, 0x76, 0x65,
+	0x72, 0x72, 0x69, 0x64, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x66,
+	0x66, 0x69, 0x2e, 0x66, 0x66, 0x69, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4f, 0x76,
+	0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x52, 0x09, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65,
+	0x73, 0x12, 0x20, 0x0a, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x75, 0x6d,
+	0x62, 0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x22, 0x1e, 0x0a, 0x1c, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67,
+	0x65, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0xe8, 0x01, 0x0a, 0x15, 0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x53, 0x74, 0x6f,
+	0x72, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x28, 0x0a, 0x0f, 0x63,
+	0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x0f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x41, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x0c, 0x52, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x75, 0x73, 0x65,
+	0x72, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x0d, 0x75, 0x73, 0x65, 0x72, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x12,
+	0x16, 0x0a, 0x06, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x06, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61,
+	0x74, 0x75, 0x72, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e,
+	0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x35, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x66, 0x66, 0x69, 0x2e, 0x66, 0x66, 0x69,
+	0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x74,
+	0x65, 0x78, 0x74, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x22, 0x58, 0x0a, 0x16,
+	0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x0f, 0x65, 0x6e, 0x63, 0x72, 0x79, 0x70,
+	0x74, 0x65, 0x64, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0c, 0x52,
+	0x0f, 0x65, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x65, 0x64, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73,
+	0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0xb2, 0x03, 0x0a, 0x0a, 0x46, 0x46, 0x49, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3d, 0x0a, 0x0b, 0x63, 0x61, 0x6c, 0x6c, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x66, 0x66, 0x69,
+	0x2e, 0x66, 0x66, 0x69, 0x2e, 0x53, 0x47, 0x58, 0x56, 0x4d, 0x43, 0x61, 0x6c, 0x6c, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x0b, 0x63, 0x61, 0x6c, 0x6c, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x43, 0x0a, 0x0d, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x66, 0x66,
+	0x69, 0x2e, 0x66, 0x66, 0x69, 0x2e, 0x53, 0x47, 0x58, 0x56, 0x4d, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x0d, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x4b, 0x0a, 0x10, 0x70, 0x75, 0x62,
+	0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x66, 0x66, 0x69, 0x2e, 0x66, 0x66, 0x69, 0x2e, 0x4e, 0x6f,
+	0x64, 0x65, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x48, 0x00, 0x52, 0x10, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x68, 0x0a, 0x1b, 0x61, 0x70, 0x70, 0x6c, 0x79, 0x53,
+	0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x66, 0x66,
+	0x69, 0x2e, 0x66, 0x66, 0x69, 0x2e, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x53, 0x74, 0x6f, 0x72, 0x61,
+	0x67, 0x65, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x48, 0x00, 0x52, 0x1b, 0x61, 0x70, 0x70, 0x6c, 0x79, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67,
+	0x65, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x56, 0x0a, 0x15, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x53, 0x74, 0x6f, 0x72, 0x61,
+	0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1e, 0x2e, 0x66, 0x66, 0x69, 0x2e, 0x66, 0x66, 0x69, 0x2e, 0x50, 0x72, 0x69, 0x76, 0x61, 0x74,
+	0x65, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48,
+	0x00, 0x52, 0x15, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x42, 0x05, 0x0a, 0x03, 0x72, 0x65, 0x71, 0x4a,
+	0x04, 0x08, 0x04, 0x10, 0x05, 0x4a, 0x04, 0x08, 0x05, 0x10, 0x06, 0x42, 0x26, 0x5a, 0x24, 0x67,
+	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x53, 0x69, 0x67, 0x6d, 0x61, 0x47,
+	0x6d, 0x62, 0x48, 0x2f, 0x6c, 0x69, 0x62, 0x72, 0x75, 0x73, 0x74, 0x67, 0x6f, 0x2f, 0x74, 0x79,
+	0x70, 0x65, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_ffi_proto_rawDescOnce sync.Once
+	file_ffi_proto_rawDescData = file_ffi_proto_rawDesc
+)
+
+func file_ffi_proto_rawDescGZIP() []byte {
+	file_ffi_proto_rawDescOnce.Do(func() {
+		file_ffi_proto_rawDescData = protoimpl.X.CompressGZIP(file_ffi_proto_rawDescData)
+	})
+	return file_ffi_proto_rawDescData
+}
+
+var file_ffi_proto_msgTypes = make([]protoimpl.MessageInfo, 97)
+var file_ffi_proto_goTypes = []interface{}{
+	(*AccessListItem)(nil),                       // 0: ffi.ffi.AccessListItem
+	(*TransactionData)(nil),                      // 1: ffi.ffi.TransactionData
+	(*TransactionContext)(nil),                   // 2: ffi.ffi.TransactionContext
+	(*HandleTransactionRequest)(nil),             // 3: ffi.ffi.HandleTransactionRequest
+	(*HandleTransactionResponse)(nil),            // 4: ffi.ffi.HandleTransactionResponse
+	(*Topic)(nil),                                // 5: ffi.ffi.Topic
+	(*Log)(nil),                                  // 6: ffi.ffi.Log
+	(*QueryGetAccount)(nil),                      // 7: ffi.ffi.QueryGetAccount
+	(*QueryGetAccountResponse)(nil),              // 8: ffi.ffi.QueryGetAccountResponse
+	(*QueryInsertAccount)(nil),                   // 9: ffi.ffi.QueryInsertAccount
+	(*QueryInsertAccountResponse)(nil),           // 10: ffi.ffi.QueryInsertAccountResponse
+	(*QueryContainsKey)(nil),                     // 11: ffi.ffi.QueryContainsKey
+	(*QueryContainsKeyResponse)(nil),             // 12: ffi.ffi.QueryContainsKeyResponse
+	(*QueryGetAccountStorageCell)(nil),           // 13: ffi.ffi.QueryGetAccountStorageCell
+	(*QueryGetAccountStorageCellResponse)(nil),   // 14: ffi.ffi.QueryGetAccountStorageCellResponse
+	(*QueryGetAccountCode)(nil),                  // 15: ffi.ffi.QueryGetAccountCode
+	(*QueryGetAccountCodeResponse)(nil),          // 16: ffi.ffi.QueryGetAccountCodeResponse
+	(*QueryInsertAccountCode)(nil),               // 17: ffi.ffi.QueryInsertAccountCode
+	(*QueryInsertAccountCodeResponse)(nil),       // 18: ffi.ffi.QueryInsertAccountCodeResponse
+	(*QueryInsertStorageCell)(nil),               // 19: ffi.ffi.QueryInsertStorageCell
+	(*QueryInsertStorageCellResponse)(nil),       // 20: ffi.ffi.QueryInsertStorageCellResponse
+	(*QueryRemove)(nil),                          // 21: ffi.ffi.QueryRemove
+	(*QueryRemoveResponse)(nil),                  // 22: ffi.ffi.QueryRemoveResponse
+	(*QueryRemoveStorageCell)(nil),               // 23: ffi.ffi.QueryRemoveStorageCell
+	(*QueryRemoveStorageCellResponse)(nil),       // 24: ffi.ffi.QueryRemoveStorageCellResponse
+	(*QueryRemoveStorage)(nil),                   // 25: ffi.ffi.QueryRemoveStorage
+	(*QueryRemoveStorageResponse)(nil),           // 26: ffi.ffi.QueryRemoveStorageResponse
+	(*QueryBlockHash)(nil),                       // 27: ffi.ffi.QueryBlockHash
+	(*QueryBlockHashResponse)(nil),               // 28: ffi.ffi.QueryBlockHashResponse
+	(*QueryAddVerificationDetails)(nil),          // 29: ffi.ffi.QueryAddVerificationDetails
+	(*QueryAddVerificationDetailsResponse)(nil),  // 30: ffi.ffi.QueryAddVerificationDetailsResponse
+	(*QueryHasVerification)(nil),                 // 31: ffi.ffi.QueryHasVerification
+	(*QueryHasVerificationResponse)(nil),         // 32: ffi.ffi.QueryHasVerificationResponse
+	(*QueryGetVerificationData)(nil),             // 33: ffi.ffi.QueryGetVerificationData
+	(*VerificationDetails)(nil),                  // 34: ffi.ffi.VerificationDetails
+	(*QueryGetVerificationDataResponse)(nil),     // 35: ffi.ffi.QueryGetVerificationDataResponse
+	(*QueryDelegate)(nil),                        // 36: ffi.ffi.QueryDelegate
+	(*QueryDelegateResponse)(nil),                // 37: ffi.ffi.QueryDelegateResponse
+	(*QueryUndelegate)(nil),                      // 38: ffi.ffi.QueryUndelegate
+	(*QueryUndelegateResponse)(nil),              // 39: ffi.ffi.QueryUndelegateResponse
+	(*QueryRedelegate)(nil),                      // 40: ffi.ffi.QueryRedelegate
+	(*QueryRedelegateResponse)(nil),              // 41: ffi.ffi.QueryRedelegateResponse
+	(*QueryDelegation)(nil),                      // 42: ffi.ffi.QueryDelegation
+	(*QueryDelegationResponse)(nil),              // 43: ffi.ffi.QueryDelegationResponse
+	(*QueryWithdrawDelegatorReward)(nil),         // 44: ffi.ffi.QueryWithdrawDelegatorReward
+	(*QueryWithdrawDelegatorRewardResponse)(nil), // 45: ffi.ffi.QueryWithdrawDelegatorRewardResponse
+	(*QueryBankBalance)(nil),                     // 46: ffi.ffi.QueryBankBalance
+	(*QueryBankBalanceResponse)(nil),             // 47: ffi.ffi.QueryBankBalanceResponse
+	(*QueryBankSend)(nil),                        // 48: ffi.ffi.QueryBankSend
+	(*QueryBankSendResponse)(nil),                // 49: ffi.ffi.QueryBankSendResponse
+	(*QueryIBCTransfer)(nil),                     // 50: ffi.ffi.QueryIBCTransfer
+	(*QueryIBCTransferResponse)(nil),             // 51: ffi.ffi.QueryIBCTransferResponse
+	(*QueryGovVote)(nil),                         // 52: ffi.ffi.QueryGovVote
+	(*QueryGovVoteResponse)(nil),                 // 53: ffi.ffi.QueryGovVoteResponse
+	(*WeightedVoteOption)(nil),                   // 54: ffi.ffi.WeightedVoteOption
+	(*QueryGovVoteWeighted)(nil),                 // 55: ffi.ffi.QueryGovVoteWeighted
+	(*QueryGovVoteWeightedResponse)(nil),         // 56: ffi.ffi.QueryGovVoteWeightedResponse
+	(*QueryGovDeposit)(nil),                      // 57: ffi.ffi.QueryGovDeposit
+	(*QueryGovDepositResponse)(nil),              // 58: ffi.ffi.QueryGovDepositResponse
+	(*QueryGovProposal)(nil),                     // 59: ffi.ffi.QueryGovProposal
+	(*QueryGovProposalResponse)(nil),             // 60: ffi.ffi.QueryGovProposalResponse
+	(*QueryGovTally)(nil),                        // 61: ffi.ffi.QueryGovTally
+	(*QueryGovTallyResponse)(nil),                // 62: ffi.ffi.QueryGovTallyResponse
+	(*QueryContractDeployer)(nil),                // 63: ffi.ffi.QueryContractDeployer
+	(*QueryContractDeployerResponse)(nil),        // 64: ffi.ffi.QueryContractDeployerResponse
+	(*QueryCosmosSnapshot)(nil),                  // 65: ffi.ffi.QueryCosmosSnapshot
+	(*QueryCosmosSnapshotResponse)(nil),          // 66: ffi.ffi.QueryCosmosSnapshotResponse
+	(*QueryRevertCosmosSnapshot)(nil),            // 67: ffi.ffi.QueryRevertCosmosSnapshot
+	(*QueryRevertCosmosSnapshotResponse)(nil),    // 68: ffi.ffi.QueryRevertCosmosSnapshotResponse
+	(*QueryBatch)(nil),                           // 69: ffi.ffi.QueryBatch
+	(*QueryBatchResponse)(nil),                   // 70: ffi.ffi.QueryBatchResponse
+	(*QueryMetered)(nil),                         // 71: ffi.ffi.QueryMetered
+	(*QueryMeteredResponse)(nil),                 // 72: ffi.ffi.QueryMeteredResponse
+	(*BalanceChange)(nil),                        // 73: ffi.ffi.BalanceChange
+	(*TraceStep)(nil),                            // 74: ffi.ffi.TraceStep
+	(*TraceEnter)(nil),                           // 75: ffi.ffi.TraceEnter
+	(*TraceExit)(nil),                            // 76: ffi.ffi.TraceExit
+	(*TraceEvent)(nil),                           // 77: ffi.ffi.TraceEvent
+	(*QueryTraceEvents)(nil),                     // 78: ffi.ffi.QueryTraceEvents
+	(*QueryTraceEventsResponse)(nil),             // 79: ffi.ffi.QueryTraceEventsResponse
+	(*CosmosRequest)(nil),                        // 80: ffi.ffi.CosmosRequest
+	(*SGXVMCallParams)(nil),                      // 81: ffi.ffi.SGXVMCallParams
+	(*TraceAuthorization)(nil),                   // 82: ffi.ffi.TraceAuthorization
+	(*SGXVMCreateParams)(nil),                    // 83: ffi.ffi.SGXVMCreateParams
+	(*SGXVMCallRequest)(nil),                     // 84: ffi.ffi.SGXVMCallRequest
+	(*SGXVMCreateRequest)(nil),                   // 85: ffi.ffi.SGXVMCreateRequest
+	(*NodePublicKeyRequest)(nil),                 // 86: ffi.ffi.NodePublicKeyRequest
+	(*NodePublicKeyResponse)(nil),                // 87: ffi.ffi.NodePublicKeyResponse
+	(*EpochData)(nil),                            // 88: ffi.ffi.EpochData
+	(*ListEpochsResponse)(nil),                   // 89: ffi.ffi.ListEpochsResponse
+	(*StorageCellOverride)(nil),                  // 90: ffi.ffi.StorageCellOverride
+	(*StorageOverride)(nil),                      // 91: ffi.ffi.StorageOverride
+	(*ApplyStorageOverrideRequest)(nil),          // 92: ffi.ffi.ApplyStorageOverrideRequest
+	(*ApplyStorageOverrideResponse)(nil),         // 93: ffi.ffi.ApplyStorageOverrideResponse
+	(*PrivateStorageRequest)(nil),                // 94: ffi.ffi.PrivateStorageRequest
+	(*PrivateStorageResponse)(nil),               // 95: ffi.ffi.PrivateStorageResponse
+	(*FFIRequest)(nil),                           // 96: ffi.ffi.FFIRequest
+}
+var file_ffi_proto_depIdxs = []int32{
+	0,  // 0: ffi.ffi.TransactionData.accessList:type_name -> ffi.ffi.AccessListItem
+	1,  // 1: ffi.ffi.HandleTransactionRequest.tx_data:type_name -> ffi.ffi.TransactionData
+	2,  // 2: ffi.ffi.HandleTransactionRequest.tx_context:type_name -> ffi.ffi.TransactionContext
+	6,  // 3: ffi.ffi.HandleTransactionResponse.logs:type_name -> ffi.ffi.Log
+	0,  // 4: ffi.ffi.HandleTransactionResponse.access_list:type_name -> ffi.ffi.AccessListItem
+	5,  // 5: ffi.ffi.Log.topics:type_name -> ffi.ffi.Topic
+	34, // 6: ffi.ffi.QueryGetVerificationDataResponse.data:type_name -> ffi.ffi.VerificationDetails
+	6,  // 7: ffi.ffi.QueryDelegateResponse.logs:type_name -> ffi.ffi.Log
+	6,  // 8: ffi.ffi.QueryUndelegateResponse.logs:type_name -> ffi.ffi.Log
+	6,  // 9: ffi.ffi.QueryRedelegateResponse.logs:type_name -> ffi.ffi.Log
+	6,  // 10: ffi.ffi.QueryWithdrawDelegatorRewardResponse.logs:type_name -> ffi.ffi.Log
+	6,  // 11: ffi.ffi.QueryBankSendResponse.logs:type_name -> ffi.ffi.Log
+	6,  // 12: ffi.ffi.QueryIBCTransferResponse.logs:type_name -> ffi.ffi.Log
+	6,  // 13: ffi.ffi.QueryGovVoteResponse.logs:type_name -> ffi.ffi.Log
+	54, // 14: ffi.ffi.QueryGovVoteWeighted.options:type_name -> ffi.ffi.WeightedVoteOption
+	6,  // 15: ffi.ffi.QueryGovVoteWeightedResponse.logs:type_name -> ffi.ffi.Log
+	6,  // 16: ffi.ffi.QueryGovDepositResponse.logs:type_name -> ffi.ffi.Log
+	73, // 17: ffi.ffi.QueryMeteredResponse.balanceChanges:type_name -> ffi.ffi.BalanceChange
+	74, // 18: ffi.ffi.TraceEvent.step:type_name -> ffi.ffi.TraceStep
+	75, // 19: ffi.ffi.TraceEvent.enter:type_name -> ffi.ffi.TraceEnter
+	76, // 20: ffi.ffi.TraceEvent.exit:type_name -> ffi.ffi.TraceExit
+	77, // 21: ffi.ffi.QueryTraceEvents.events:type_name -> ffi.ffi.TraceEvent
+	7,  // 22: ffi.ffi.CosmosRequest.getAccount:type_name -> ffi.ffi.QueryGetAccount
+	9,  // 23: ffi.ffi.CosmosRequest.insertAccount:type_name -> ffi.ffi.QueryInsertAccount
+	11, // 24: ffi.ffi.CosmosRequest.containsKey:type_name -> ffi.ffi.QueryContainsKey
+	15, // 25: ffi.ffi.CosmosRequest.accountCode:type_name -> ffi.ffi.QueryGetAccountCode
+	13, // 26: ffi.ffi.CosmosRequest.storageCell:type_name -> ffi.ffi.QueryGetAccountStorageCell
+	17, // 27: ffi.ffi.CosmosRequest.insertAccountCode:type_name -> ffi.ffi.QueryInsertAccountCode
+	19, // 28: ffi.ffi.CosmosRequest.insertStorageCell:type_name -> ffi.ffi.QueryInsertStorageCell
+	21, // 29: ffi.ffi.CosmosRequest.remove:type_name -> ffi.ffi.QueryRemove
+	23, // 30: ffi.ffi.CosmosRequest.removeStorageCell:type_name -> ffi.ffi.QueryRemoveStorageCell
+	25, // 31: ffi.ffi.CosmosRequest.removeStorage:type_name -> ffi.ffi.QueryRemoveStorage
+	27, // 32: ffi.ffi.CosmosRequest.blockHash:type_name -> ffi.ffi.QueryBlockHash
+	29, // 33: ffi.ffi.CosmosRequest.addVerificationDetails:type_name -> ffi.ffi.QueryAddVerificationDetails
+	31, // 34: ffi.ffi.CosmosRequest.hasVerification:type_name -> ffi.ffi.QueryHasVerification
+	33, // 35: ffi.ffi.CosmosRequest.getVerificationData:type_name -> ffi.ffi.QueryGetVerificationData
+	69, // 36: ffi.ffi.CosmosRequest.batch:type_name -> ffi.ffi.QueryBatch
+	78, // 37: ffi.ffi.CosmosRequest.traceEvents:type_name -> ffi.ffi.QueryTraceEvents
+	36, // 38: ffi.ffi.CosmosRequest.delegate:type_name -> ffi.ffi.QueryDelegate
+	38, // 39: ffi.ffi.CosmosRequest.undelegate:type_name -> ffi.ffi.QueryUndelegate
+	40, // 40: ffi.ffi.CosmosRequest.redelegate:type_name -> ffi.ffi.QueryRedelegate
+	42, // 41: ffi.ffi.CosmosRequest.delegation:type_name -> ffi.ffi.QueryDelegation
+	44, // 42: ffi.ffi.CosmosRequest.withdrawDelegatorReward:type_name -> ffi.ffi.QueryWithdrawDelegatorReward
+	46, // 43: ffi.ffi.CosmosRequest.bankBalance:type_name -> ffi.ffi.QueryBankBalance
+	48, // 44: ffi.ffi.CosmosRequest.bankSend:type_name -> ffi.ffi.QueryBankSend
+	50, // 45: ffi.ffi.CosmosRequest.ibcTransfer:type_name -> ffi.ffi.QueryIBCTransfer
+	52, // 46: ffi.ffi.CosmosRequest.govVote:type_name -> ffi.ffi.QueryGovVote
+	55, // 47: ffi.ffi.CosmosRequest.govVoteWeighted:type_name -> ffi.ffi.QueryGovVoteWeighted
+	57, // 48: ffi.ffi.CosmosRequest.govDeposit:type_name -> ffi.ffi.QueryGovDeposit
+	59, // 49: ffi.ffi.CosmosRequest.govProposal:type_name -> ffi.ffi.QueryGovProposal
+	61, // 50: ffi.ffi.CosmosRequest.govTally:type_name -> ffi.ffi.QueryGovTally
+	63, // 51: ffi.ffi.CosmosRequest.contractDeployer:type_name -> ffi.ffi.QueryContractDeployer
+	65, // 52: ffi.ffi.CosmosRequest.cosmosSnapshot:type_name -> ffi.ffi.QueryCosmosSnapshot
+	67, // 53: ffi.ffi.CosmosRequest.revertCosmosSnapshot:type_name -> ffi.ffi.QueryRevertCosmosSnapshot
+	71, // 54: ffi.ffi.CosmosRequest.metered:type_name -> ffi.ffi.QueryMetered
+	0,  // 55: ffi.ffi.SGXVMCallParams.accessList:type_name -> ffi.ffi.AccessListItem
+	82, // 56: ffi.ffi.SGXVMCallParams.traceAuthorization:type_name -> ffi.ffi.TraceAuthorization
+	0,  // 57: ffi.ffi.SGXVMCreateParams.accessList:type_name -> ffi.ffi.AccessListItem
+	81, // 58: ffi.ffi.SGXVMCallRequest.params:type_name -> ffi.ffi.SGXVMCallParams
+	2,  // 59: ffi.ffi.SGXVMCallRequest.context:type_name -> ffi.ffi.TransactionContext
+	83, // 60: ffi.ffi.SGXVMCreateRequest.params:type_name -> ffi.ffi.SGXVMCreateParams
+	2,  // 61: ffi.ffi.SGXVMCreateRequest.context:type_name -> ffi.ffi.TransactionContext
+	88, // 62: ffi.ffi.ListEpochsResponse.epochs:type_name -> ffi.ffi.EpochData
+	90, // 63: ffi.ffi.StorageOverride.cells:type_name -> ffi.ffi.StorageCellOverride
+	91, // 64: ffi.ffi.ApplyStorageOverrideRequest.overrides:type_name -> ffi.ffi.StorageOverride
+	2,  // 65: ffi.ffi.PrivateStorageRequest.context:type_name -> ffi.ffi.TransactionContext
+	84, // 66: ffi.ffi.FFIRequest.callRequest:type_name -> ffi.ffi.SGXVMCallRequest
+	85, // 67: ffi.ffi.FFIRequest.createRequest:type_name -> ffi.ffi.SGXVMCreateRequest
+	86, // 68: ffi.ffi.FFIRequest.publicKeyRequest:type_name -> ffi.ffi.NodePublicKeyRequest
+	92, // 69: ffi.ffi.FFIRequest.applyStorageOverrideRequest:type_name -> ffi.ffi.ApplyStorageOverrideRequest
+	94, // 70: ffi.ffi.FFIRequest.privateStorageRequest:type_name -> ffi.ffi.PrivateStorageRequest
+	71, // [71:71] is the sub-list for method output_type
+	71, // [71:71] is the sub-list for method input_type
+	71, // [71:71] is the sub-list for extension type_name
+	71, // [71:71] is the sub-list for extension extendee
+	0,  // [0:71] is the sub-list for field type_name
+}
+
+func init() { file_ffi_proto_init() }
+func file_ffi_proto_init() {
+	if File_ffi_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_ffi_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AccessListItem); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ffi_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TransactionData); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ffi_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TransactionContext); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ffi_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HandleTransactionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ffi_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HandleTransactionResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ffi_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Topic); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ffi_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Log); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ffi_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryGetAccount); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ffi_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryGetAccountResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ffi_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryInsertAccount); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ffi_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryInsertAccountResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ffi_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryContainsKey); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ffi_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryContainsKeyResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ffi_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryGetAccountStorageCell); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ffi_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryGetAccountStorageCellResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ffi_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryGetAccountCode); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ffi_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryGetAccountCodeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ffi_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryInsertAccountCode); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ffi_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryInsertAccountCodeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ffi_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryInsertStorageCell); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ffi_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryInsertStorageCellResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ffi_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryRemove); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ffi_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryRemoveResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ffi_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryRemoveStorageCell); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ffi_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryRemoveStorageCellResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ffi_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryRemoveStorage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ffi_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryRemoveStorageResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ffi_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryBlockHash); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ffi_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryBlockHashResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ffi_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryAddVerificationDetails); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ffi_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryAddVerificationDetailsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ffi_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryHasVerification); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ffi_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryHasVerificationResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ffi_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryGetVerificationData); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ffi_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VerificationDetails); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ffi_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryGetVerificationDataResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3431,8 +7810,8 @@ func file_ffi_proto_init() {
 				return nil
 			}
 		}
-		file_ffi_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TransactionData); i {
+		file_ffi_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryDelegate); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3443,8 +7822,8 @@ func file_ffi_proto_init() {
 				return nil
 			}
 		}
-		file_ffi_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TransactionContext); i {
+		file_ffi_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryDelegateResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3455,8 +7834,8 @@ func file_ffi_proto_init() {
 				return nil
 			}
 		}
-		file_ffi_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*HandleTransactionRequest); i {
+		file_ffi_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryUndelegate); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3467,8 +7846,8 @@ func file_ffi_proto_init() {
 				return nil
 			}
 		}
-		file_ffi_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*HandleTransactionResponse); i {
+		file_ffi_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryUndelegateResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3479,8 +7858,8 @@ func file_ffi_proto_init() {
 				return nil
 			}
 		}
-		file_ffi_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Topic); i {
+		file_ffi_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryRedelegate); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3491,8 +7870,8 @@ func file_ffi_proto_init() {
 				return nil
 			}
 		}
-		file_ffi_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Log); i {
+		file_ffi_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryRedelegateResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3503,8 +7882,8 @@ func file_ffi_proto_init() {
 				return nil
 			}
 		}
-		file_ffi_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryGetAccount); i {
+		file_ffi_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryDelegation); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3515,8 +7894,8 @@ func file_ffi_proto_init() {
 				return nil
 			}
 		}
-		file_ffi_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryGetAccountResponse); i {
+		file_ffi_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryDelegationResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3527,8 +7906,8 @@ func file_ffi_proto_init() {
 				return nil
 			}
 		}
-		file_ffi_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryInsertAccount); i {
+		file_ffi_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryWithdrawDelegatorReward); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3539,8 +7918,8 @@ func file_ffi_proto_init() {
 				return nil
 			}
 		}
-		file_ffi_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryInsertAccountResponse); i {
+		file_ffi_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryWithdrawDelegatorRewardResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3551,8 +7930,8 @@ func file_ffi_proto_init() {
 				return nil
 			}
 		}
-		file_ffi_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryContainsKey); i {
+		file_ffi_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryBankBalance); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3563,8 +7942,8 @@ func file_ffi_proto_init() {
 				return nil
 			}
 		}
-		file_ffi_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryContainsKeyResponse); i {
+		file_ffi_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryBankBalanceResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3575,8 +7954,8 @@ func file_ffi_proto_init() {
 				return nil
 			}
 		}
-		file_ffi_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryGetAccountStorageCell); i {
+		file_ffi_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryBankSend); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3587,8 +7966,8 @@ func file_ffi_proto_init() {
 				return nil
 			}
 		}
-		file_ffi_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryGetAccountStorageCellResponse); i {
+		file_ffi_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryBankSendResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3599,8 +7978,8 @@ func file_ffi_proto_init() {
 				return nil
 			}
 		}
-		file_ffi_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryGetAccountCode); i {
+		file_ffi_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryIBCTransfer); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3611,8 +7990,8 @@ func file_ffi_proto_init() {
 				return nil
 			}
 		}
-		file_ffi_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryGetAccountCodeResponse); i {
+		file_ffi_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryIBCTransferResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3623,8 +8002,8 @@ func file_ffi_proto_init() {
 				return nil
 			}
 		}
-		file_ffi_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryInsertAccountCode); i {
+		file_ffi_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryGovVote); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3635,8 +8014,8 @@ func file_ffi_proto_init() {
 				return nil
 			}
 		}
-		file_ffi_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryInsertAccountCodeResponse); i {
+		file_ffi_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryGovVoteResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3647,8 +8026,8 @@ func file_ffi_proto_init() {
 				return nil
 			}
 		}
-		file_ffi_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryInsertStorageCell); i {
+		file_ffi_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WeightedVoteOption); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3659,8 +8038,8 @@ func file_ffi_proto_init() {
 				return nil
 			}
 		}
-		file_ffi_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryInsertStorageCellResponse); i {
+		file_ffi_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryGovVoteWeighted); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3671,8 +8050,8 @@ func file_ffi_proto_init() {
 				return nil
 			}
 		}
-		file_ffi_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryRemove); i {
+		file_ffi_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryGovVoteWeightedResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3683,8 +8062,8 @@ func file_ffi_proto_init() {
 				return nil
 			}
 		}
-		file_ffi_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryRemoveResponse); i {
+		file_ffi_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryGovDeposit); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3695,8 +8074,8 @@ func file_ffi_proto_init() {
 				return nil
 			}
 		}
-		file_ffi_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryRemoveStorageCell); i {
+		file_ffi_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryGovDepositResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3707,8 +8086,8 @@ func file_ffi_proto_init() {
 				return nil
 			}
 		}
-		file_ffi_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryRemoveStorageCellResponse); i {
+		file_ffi_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryGovProposal); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3719,8 +8098,8 @@ func file_ffi_proto_init() {
 				return nil
 			}
 		}
-		file_ffi_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryRemoveStorage); i {
+		file_ffi_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryGovProposalResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3731,8 +8110,8 @@ func file_ffi_proto_init() {
 				return nil
 			}
 		}
-		file_ffi_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryRemoveStorageResponse); i {
+		file_ffi_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryGovTally); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3743,8 +8122,8 @@ func file_ffi_proto_init() {
 				return nil
 			}
 		}
-		file_ffi_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryBlockHash); i {
+		file_ffi_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryGovTallyResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3755,8 +8134,8 @@ func file_ffi_proto_init() {
 				return nil
 			}
 		}
-		file_ffi_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryBlockHashResponse); i {
+		file_ffi_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryContractDeployer); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3767,8 +8146,8 @@ func file_ffi_proto_init() {
 				return nil
 			}
 		}
-		file_ffi_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryAddVerificationDetails); i {
+		file_ffi_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryContractDeployerResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3779,8 +8158,8 @@ func file_ffi_proto_init() {
 				return nil
 			}
 		}
-		file_ffi_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryAddVerificationDetailsResponse); i {
+		file_ffi_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryCosmosSnapshot); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3791,8 +8170,8 @@ func file_ffi_proto_init() {
 				return nil
 			}
 		}
-		file_ffi_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryHasVerification); i {
+		file_ffi_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryCosmosSnapshotResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3803,8 +8182,8 @@ func file_ffi_proto_init() {
 				return nil
 			}
 		}
-		file_ffi_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryHasVerificationResponse); i {
+		file_ffi_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryRevertCosmosSnapshot); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3815,8 +8194,8 @@ func file_ffi_proto_init() {
 				return nil
 			}
 		}
-		file_ffi_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryGetVerificationData); i {
+		file_ffi_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryRevertCosmosSnapshotResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3827,8 +8206,8 @@ func file_ffi_proto_init() {
 				return nil
 			}
 		}
-		file_ffi_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*VerificationDetails); i {
+		file_ffi_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryBatch); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3839,8 +8218,8 @@ func file_ffi_proto_init() {
 				return nil
 			}
 		}
-		file_ffi_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryGetVerificationDataResponse); i {
+		file_ffi_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryBatchResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3851,7 +8230,115 @@ func file_ffi_proto_init() {
 				return nil
 			}
 		}
-		file_ffi_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+		file_ffi_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryMetered); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ffi_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryMeteredResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ffi_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BalanceChange); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ffi_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TraceStep); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ffi_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TraceEnter); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ffi_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TraceExit); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ffi_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TraceEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ffi_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryTraceEvents); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ffi_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryTraceEventsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ffi_proto_msgTypes[80].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CosmosRequest); i {
 			case 0:
 				return &v.state
@@ -3863,7 +8350,7 @@ func file_ffi_proto_init() {
 				return nil
 			}
 		}
-		file_ffi_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+		file_ffi_proto_msgTypes[81].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SGXVMCallParams); i {
 			case 0:
 				return &v.state
@@ -3875,7 +8362,19 @@ func file_ffi_proto_init() {
 				return nil
 			}
 		}
-		file_ffi_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+		file_ffi_proto_msgTypes[82].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TraceAuthorization); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ffi_proto_msgTypes[83].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SGXVMCreateParams); i {
 			case 0:
 				return &v.state
@@ -3887,7 +8386,7 @@ func file_ffi_proto_init() {
 				return nil
 			}
 		}
-		file_ffi_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+		file_ffi_proto_msgTypes[84].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SGXVMCallRequest); i {
 			case 0:
 				return &v.state
@@ -3899,7 +8398,7 @@ func file_ffi_proto_init() {
 				return nil
 			}
 		}
-		file_ffi_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+		file_ffi_proto_msgTypes[85].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SGXVMCreateRequest); i {
 			case 0:
 				return &v.state
@@ -3911,7 +8410,7 @@ func file_ffi_proto_init() {
 				return nil
 			}
 		}
-		file_ffi_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+		file_ffi_proto_msgTypes[86].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*NodePublicKeyRequest); i {
 			case 0:
 				return &v.state
@@ -3923,7 +8422,7 @@ func file_ffi_proto_init() {
 				return nil
 			}
 		}
-		file_ffi_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+		file_ffi_proto_msgTypes[87].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*NodePublicKeyResponse); i {
 			case 0:
 				return &v.state
@@ -3935,7 +8434,7 @@ func file_ffi_proto_init() {
 				return nil
 			}
 		}
-		file_ffi_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+		file_ffi_proto_msgTypes[88].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*EpochData); i {
 			case 0:
 				return &v.state
@@ -3947,7 +8446,7 @@ func file_ffi_proto_init() {
 				return nil
 			}
 		}
-		file_ffi_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+		file_ffi_proto_msgTypes[89].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ListEpochsResponse); i {
 			case 0:
 				return &v.state
@@ -3959,7 +8458,79 @@ func file_ffi_proto_init() {
 				return nil
 			}
 		}
-		file_ffi_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+		file_ffi_proto_msgTypes[90].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StorageCellOverride); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ffi_proto_msgTypes[91].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StorageOverride); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ffi_proto_msgTypes[92].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ApplyStorageOverrideRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ffi_proto_msgTypes[93].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ApplyStorageOverrideResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ffi_proto_msgTypes[94].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PrivateStorageRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ffi_proto_msgTypes[95].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PrivateStorageResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ffi_proto_msgTypes[96].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*FFIRequest); i {
 			case 0:
 				return &v.state
@@ -3972,7 +8543,12 @@ func file_ffi_proto_init() {
 			}
 		}
 	}
-	file_ffi_proto_msgTypes[36].OneofWrappers = []interface{}{
+	file_ffi_proto_msgTypes[77].OneofWrappers = []interface{}{
+		(*TraceEvent_Step)(nil),
+		(*TraceEvent_Enter)(nil),
+		(*TraceEvent_Exit)(nil),
+	}
+	file_ffi_proto_msgTypes[80].OneofWrappers = []interface{}{
 		(*CosmosRequest_GetAccount)(nil),
 		(*CosmosRequest_InsertAccount)(nil),
 		(*CosmosRequest_ContainsKey)(nil),
@@ -3987,11 +8563,32 @@ func file_ffi_proto_init() {
 		(*CosmosRequest_AddVerificationDetails)(nil),
 		(*CosmosRequest_HasVerification)(nil),
 		(*CosmosRequest_GetVerificationData)(nil),
-	}
-	file_ffi_proto_msgTypes[45].OneofWrappers = []interface{}{
+		(*CosmosRequest_Batch)(nil),
+		(*CosmosRequest_TraceEvents)(nil),
+		(*CosmosRequest_Delegate)(nil),
+		(*CosmosRequest_Undelegate)(nil),
+		(*CosmosRequest_Redelegate)(nil),
+		(*CosmosRequest_Delegation)(nil),
+		(*CosmosRequest_WithdrawDelegatorReward)(nil),
+		(*CosmosRequest_BankBalance)(nil),
+		(*CosmosRequest_BankSend)(nil),
+		(*CosmosRequest_IbcTransfer)(nil),
+		(*CosmosRequest_GovVote)(nil),
+		(*CosmosRequest_GovVoteWeighted)(nil),
+		(*CosmosRequest_GovDeposit)(nil),
+		(*CosmosRequest_GovProposal)(nil),
+		(*CosmosRequest_GovTally)(nil),
+		(*CosmosRequest_ContractDeployer)(nil),
+		(*CosmosRequest_CosmosSnapshot)(nil),
+		(*CosmosRequest_RevertCosmosSnapshot)(nil),
+		(*CosmosRequest_Metered)(nil),
+	}
+	file_ffi_proto_msgTypes[96].OneofWrappers = []interface{}{
 		(*FFIRequest_CallRequest)(nil),
 		(*FFIRequest_CreateRequest)(nil),
 		(*FFIRequest_PublicKeyRequest)(nil),
+		(*FFIRequest_ApplyStorageOverrideRequest)(nil),
+		(*FFIRequest_PrivateStorageRequest)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -3999,7 +8596,7 @@ func file_ffi_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_ffi_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   46,
+			NumMessages:   97,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
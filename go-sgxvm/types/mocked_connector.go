@@ -104,12 +104,13 @@ func (c MockedConnector) Query(request []byte) ([]byte, error) {
 
 func GetDefaultTxContext() *TransactionContext {
 	return &TransactionContext{
-		BlockCoinbase:      common.Address{}.Bytes(),
-		BlockNumber:        0,
-		BlockBaseFeePerGas: make([]byte, 32),
-		Timestamp:          0,
-		BlockGasLimit:      100000000000,
-		ChainId:            1,
-		GasPrice:           make([]byte, 32),
+		BlockCoinbase:       common.Address{}.Bytes(),
+		BlockNumber:         0,
+		BlockBaseFeePerGas:  make([]byte, 32),
+		Timestamp:           0,
+		BlockGasLimit:       100000000000,
+		ChainId:             1,
+		GasPrice:            make([]byte, 32),
+		AuthenticatedSender: true,
 	}
 }
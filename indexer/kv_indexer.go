@@ -17,6 +17,7 @@ package indexer
 
 import (
 	"fmt"
+	"math/big"
 
 	rpctypes "swisstronik/rpc/types"
 
@@ -30,6 +31,8 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authante "github.com/cosmos/cosmos-sdk/x/auth/ante"
 	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rlp"
 
 	ethermint "swisstronik/types"
 	evmtypes "swisstronik/x/evm/types"
@@ -38,6 +41,19 @@ import (
 const (
 	KeyPrefixTxHash  = 1
 	KeyPrefixTxIndex = 2
+	KeyPrefixReceipt = 3
+	// KeyPrefixAddressLog is the prefix of `(log address, block number)` entries
+	KeyPrefixAddressLog = 4
+	// KeyPrefixTopicLog is the prefix of `(log first topic, block number)` entries
+	KeyPrefixTopicLog = 5
+	// KeyPrefixBlockBloom is the prefix of blooms of the blocks, whose bloom bits section is not indexed yet
+	KeyPrefixBlockBloom = 6
+	// KeyPrefixBloomBits is the prefix of `(bloom bit, section) -> bit vector` entries
+	KeyPrefixBloomBits = 7
+	// KeyPrefixLogIndex is the prefix of the range of blocks, covered by the log index
+	KeyPrefixLogIndex = 8
+	// KeyPrefixUnindexedLogs is the prefix of blocks, whose logs are not available to the indexer
+	KeyPrefixUnindexedLogs = 9
 
 	// TxIndexKeyLength is the length of tx-index key
 	TxIndexKeyLength = 1 + 8 + 8
@@ -62,14 +78,24 @@ func NewKVIndexer(db dbm.DB, logger log.Logger, clientCtx client.Context) *KVInd
 // - Parses eth Tx infos from cosmos-sdk events for every TxResult
 // - Iterates over all the messages of the Tx
 // - Builds and stores a indexer.TxResult based on parsed events for every message
+// - Builds and stores a receipt with logs from the tx responses for every message
+// - Indexes the logs of the block by their addresses and first topics, and by bloom bits
 func (kv *KVIndexer) IndexBlock(block *tmtypes.Block, txResults []*abci.ResponseDeliverTx) error {
 	height := block.Header.Height
+	blockHash := common.BytesToHash(block.Hash())
 
 	batch := kv.db.NewBatch()
 	defer batch.Close()
 
 	// record index of valid eth tx during the iteration
 	var ethTxIndex int32
+	// record block-wide index of the next log, logs are indexed in the same way as by the evm keeper.
+	// If logs of some tx are not available, indices of the following logs are unknown, so receipts
+	// of the remaining txs in the block are not stored.
+	var logIndex uint64
+	indexReceipts := true
+	// logs of all the eth txs in the block
+	var blockLogs []*ethtypes.Log
 	for txIndex, tx := range block.Txs {
 		result := txResults[txIndex]
 		if !rpctypes.TxSuccessOrExceedsBlockGasLimit(result) {
@@ -89,9 +115,19 @@ func (kv *KVIndexer) IndexBlock(block *tmtypes.Block, txResults []*abci.Response
 		txs, err := rpctypes.ParseTxResult(result, tx)
 		if err != nil {
 			kv.logger.Error("Fail to parse event", "err", err, "block", height, "txIndex", txIndex)
+			indexReceipts = false
 			continue
 		}
 
+		var responses []*evmtypes.MsgEthereumTxResponse
+		if result.Code == abci.CodeTypeOK && indexReceipts {
+			responses, err = evmtypes.DecodeTxResponses(result.Data)
+			if err != nil || len(responses) != len(tx.GetMsgs()) {
+				kv.logger.Error("Fail to decode tx responses", "err", err, "block", height, "txIndex", txIndex)
+				indexReceipts = false
+			}
+		}
+
 		var cumulativeGasUsed uint64
 		for msgIndex, msg := range tx.GetMsgs() {
 			ethMsg := msg.(*evmtypes.MsgHandleTx)
@@ -112,6 +148,7 @@ func (kv *KVIndexer) IndexBlock(block *tmtypes.Block, txResults []*abci.Response
 				parsedTx := txs.GetTxByMsgIndex(msgIndex)
 				if parsedTx == nil {
 					kv.logger.Error("msg index not found in events", "msgIndex", msgIndex)
+					indexReceipts = false
 					continue
 				}
 				if parsedTx.EthTxIndex >= 0 && parsedTx.EthTxIndex != ethTxIndex {
@@ -119,6 +156,9 @@ func (kv *KVIndexer) IndexBlock(block *tmtypes.Block, txResults []*abci.Response
 				}
 				txResult.GasUsed = parsedTx.GasUsed
 				txResult.Failed = parsedTx.Failed
+				if parsedTx.StateRoot != (common.Hash{}) {
+					txResult.StateRoot = parsedTx.StateRoot.Bytes()
+				}
 			}
 
 			cumulativeGasUsed += txResult.GasUsed
@@ -128,8 +168,36 @@ func (kv *KVIndexer) IndexBlock(block *tmtypes.Block, txResults []*abci.Response
 			if err := saveTxResult(kv.clientCtx.Codec, batch, txHash, &txResult); err != nil {
 				return errorsmod.Wrapf(err, "IndexBlock %d", height)
 			}
+
+			if !indexReceipts {
+				continue
+			}
+			receipt := &ethtypes.Receipt{
+				Status:            ethtypes.ReceiptStatusSuccessful,
+				CumulativeGasUsed: txResult.CumulativeGasUsed,
+			}
+			if txResult.Failed {
+				receipt.Status = ethtypes.ReceiptStatusFailed
+			}
+			if result.Code == abci.CodeTypeOK {
+				receipt.Logs = evmtypes.LogsToEthereum(responses[msgIndex].Logs)
+			}
+			if err := saveReceipt(batch, txHash, &storedReceipt{
+				Receipt:   (*ethtypes.ReceiptForStorage)(receipt),
+				BlockHash: blockHash,
+				LogIndex:  logIndex,
+			}); err != nil {
+				return errorsmod.Wrapf(err, "IndexBlock %d", height)
+			}
+			logIndex += uint64(len(receipt.Logs))
+			blockLogs = append(blockLogs, receipt.Logs...)
 		}
 	}
+
+	// if logs of some txs are not available, the block is always scanned by log queries
+	if err := kv.indexLogs(batch, height, blockLogs, indexReceipts); err != nil {
+		return errorsmod.Wrapf(err, "IndexBlock %d", height)
+	}
 	if err := batch.Write(); err != nil {
 		return errorsmod.Wrapf(err, "IndexBlock %d, write batch", block.Height)
 	}
@@ -174,6 +242,81 @@ func (kv *KVIndexer) GetByBlockAndIndex(blockNumber int64, txIndex int32) (*ethe
 	return kv.GetByTxHash(common.BytesToHash(bz))
 }
 
+// GetReceiptByTxHash finds receipt of eth tx by eth tx hash
+func (kv *KVIndexer) GetReceiptByTxHash(hash common.Hash) (*ethtypes.Receipt, error) {
+	txResult, err := kv.GetByTxHash(hash)
+	if err != nil {
+		return nil, err
+	}
+	return kv.loadReceipt(hash, txResult)
+}
+
+// GetReceiptsByBlock finds receipts of all eth txs in the block, ordered by eth tx index
+func (kv *KVIndexer) GetReceiptsByBlock(blockNumber int64) ([]*ethtypes.Receipt, error) {
+	first, err := kv.FirstIndexedBlock()
+	if err != nil {
+		return nil, err
+	}
+	last, err := kv.LastIndexedBlock()
+	if err != nil {
+		return nil, err
+	}
+	if blockNumber < first || blockNumber > last {
+		return nil, fmt.Errorf("block is not indexed, block: %d", blockNumber)
+	}
+
+	it, err := kv.db.Iterator(TxIndexKey(blockNumber, 0), TxIndexKey(blockNumber+1, 0))
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "GetReceiptsByBlock %d", blockNumber)
+	}
+	defer it.Close()
+
+	receipts := make([]*ethtypes.Receipt, 0)
+	for ; it.Valid(); it.Next() {
+		hash := common.BytesToHash(it.Value())
+		txResult, err := kv.GetByTxHash(hash)
+		if err != nil {
+			return nil, err
+		}
+		receipt, err := kv.loadReceipt(hash, txResult)
+		if err != nil {
+			return nil, err
+		}
+		receipts = append(receipts, receipt)
+	}
+	return receipts, nil
+}
+
+// loadReceipt loads stored receipt of eth tx and fills its fields, derived from the tx result
+func (kv *KVIndexer) loadReceipt(hash common.Hash, txResult *ethermint.TxResult) (*ethtypes.Receipt, error) {
+	bz, err := kv.db.Get(ReceiptKey(hash))
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "GetReceiptByTxHash %s", hash.Hex())
+	}
+	if len(bz) == 0 {
+		return nil, fmt.Errorf("receipt not found, hash: %s", hash.Hex())
+	}
+	var stored storedReceipt
+	if err := rlp.DecodeBytes(bz, &stored); err != nil {
+		return nil, errorsmod.Wrapf(err, "GetReceiptByTxHash %s", hash.Hex())
+	}
+
+	receipt := (*ethtypes.Receipt)(stored.Receipt)
+	receipt.TxHash = hash
+	receipt.GasUsed = txResult.GasUsed
+	receipt.BlockHash = stored.BlockHash
+	receipt.BlockNumber = big.NewInt(txResult.Height)
+	receipt.TransactionIndex = uint(txResult.EthTxIndex)
+	for i, log := range receipt.Logs {
+		log.BlockNumber = uint64(txResult.Height)
+		log.BlockHash = stored.BlockHash
+		log.TxHash = hash
+		log.TxIndex = uint(txResult.EthTxIndex)
+		log.Index = uint(stored.LogIndex) + uint(i)
+	}
+	return receipt, nil
+}
+
 // TxHashKey returns the key for db entry: `tx hash -> tx result struct`
 func TxHashKey(hash common.Hash) []byte {
 	return append([]byte{KeyPrefixTxHash}, hash.Bytes()...)
@@ -186,6 +329,11 @@ func TxIndexKey(blockNumber int64, txIndex int32) []byte {
 	return append(append([]byte{KeyPrefixTxIndex}, bz1...), bz2...)
 }
 
+// ReceiptKey returns the key for db entry: `tx hash -> stored receipt`
+func ReceiptKey(hash common.Hash) []byte {
+	return append([]byte{KeyPrefixReceipt}, hash.Bytes()...)
+}
+
 // LoadLastBlock returns the latest indexed block number, returns -1 if db is empty
 func LoadLastBlock(db dbm.DB) (int64, error) {
 	it, err := db.ReverseIterator([]byte{KeyPrefixTxIndex}, []byte{KeyPrefixTxIndex + 1})
@@ -237,6 +385,27 @@ func saveTxResult(codec codec.Codec, batch dbm.Batch, txHash common.Hash, txResu
 	return nil
 }
 
+// storedReceipt is the compact form of eth tx receipt, kept by the indexer. Receipt contains only status,
+// cumulative gas used and logs, fields which can be derived from the tx result are not stored.
+type storedReceipt struct {
+	Receipt   *ethtypes.ReceiptForStorage
+	BlockHash common.Hash
+	// block-wide index of the first log of the tx
+	LogIndex uint64
+}
+
+// saveReceipt index the receipt into the kv db batch
+func saveReceipt(batch dbm.Batch, txHash common.Hash, receipt *storedReceipt) error {
+	bz, err := rlp.EncodeToBytes(receipt)
+	if err != nil {
+		return errorsmod.Wrap(err, "encode receipt")
+	}
+	if err := batch.Set(ReceiptKey(txHash), bz); err != nil {
+		return errorsmod.Wrap(err, "set receipt key")
+	}
+	return nil
+}
+
 func parseBlockNumberFromKey(key []byte) (int64, error) {
 	if len(key) != TxIndexKeyLength {
 		return 0, fmt.Errorf("wrong tx index key length, expect: %d, got: %d", TxIndexKeyLength, len(key))
@@ -2,6 +2,7 @@ package indexer_test
 
 import (
 	"math/big"
+	"strconv"
 	"testing"
 
 	"swisstronik/app"
@@ -18,8 +19,11 @@ import (
 	tmlog "github.com/cometbft/cometbft/libs/log"
 	tmtypes "github.com/cometbft/cometbft/types"
 	"github.com/cosmos/cosmos-sdk/client"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/common"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/gogo/protobuf/proto"
 	"github.com/stretchr/testify/require"
 )
 
@@ -185,6 +189,106 @@ func TestKVIndexer(t *testing.T) {
 	}
 }
 
+func TestKVIndexerReceipts(t *testing.T) {
+	priv, err := ethsecp256k1.GenerateKey()
+	require.NoError(t, err)
+	from := common.BytesToAddress(priv.PubKey().Address().Bytes())
+	signer := tests.NewTestSigner(priv)
+	ethSigner := ethtypes.LatestSignerForChainID(nil)
+
+	encodingConfig := MakeEncodingConfig()
+	clientCtx := client.Context{}.WithTxConfig(encodingConfig.TxConfig).WithCodec(encodingConfig.Codec)
+
+	contract := common.BigToAddress(big.NewInt(1))
+	topic := common.BytesToHash([]byte("topic"))
+
+	block := &tmtypes.Block{Header: tmtypes.Header{Height: 1}}
+	var (
+		txHashes  []common.Hash
+		txResults []*abci.ResponseDeliverTx
+	)
+	for i := 0; i < 2; i++ {
+		tx := types.NewTx(
+			nil, uint64(i), &contract, big.NewInt(0), 100000, nil, nil, nil, nil, nil, nil, nil,
+		)
+		tx.From = from.Hex()
+		require.NoError(t, tx.Sign(ethSigner, signer))
+		txHash := tx.AsTransaction().Hash()
+		txHashes = append(txHashes, txHash)
+
+		tmTx, err := tx.BuildTx(clientCtx.TxConfig.NewTxBuilder(), utils.BaseDenom)
+		require.NoError(t, err)
+		txBz, err := clientCtx.TxConfig.TxEncoder()(tmTx)
+		require.NoError(t, err)
+		block.Txs = append(block.Txs, txBz)
+
+		// each tx emits two logs, indexed within the tx by old versions of the node
+		res := &types.MsgEthereumTxResponse{
+			Hash: txHash.Hex(),
+			Logs: []*types.Log{
+				{Address: contract.Hex(), Topics: []string{topic.Hex()}, Data: []byte{byte(i), 0}},
+				{Address: contract.Hex(), Data: []byte{byte(i), 1}},
+			},
+			GasUsed: 30000,
+		}
+		data, err := proto.Marshal(&sdk.TxMsgData{MsgResponses: []*codectypes.Any{codectypes.UnsafePackAny(res)}})
+		require.NoError(t, err)
+
+		txResults = append(txResults, &abci.ResponseDeliverTx{
+			Code:    0,
+			Data:    data,
+			GasUsed: 30000,
+			Events: []abci.Event{
+				{Type: types.EventTypeEthereumTx, Attributes: []abci.EventAttribute{
+					{Key: "ethereumTxHash", Value: txHash.Hex()},
+					{Key: "txIndex", Value: strconv.Itoa(i)},
+					{Key: "amount", Value: "0"},
+					{Key: "txGasUsed", Value: "30000"},
+				}},
+			},
+		})
+	}
+
+	db := dbm.NewMemDB()
+	idxer := indexer.NewKVIndexer(db, tmlog.NewNopLogger(), clientCtx)
+	require.NoError(t, idxer.IndexBlock(block, txResults))
+
+	blockHash := common.BytesToHash(block.Hash())
+	receipts, err := idxer.GetReceiptsByBlock(1)
+	require.NoError(t, err)
+	require.Len(t, receipts, 2)
+	for i, receipt := range receipts {
+		require.Equal(t, ethtypes.ReceiptStatusSuccessful, receipt.Status)
+		require.Equal(t, txHashes[i], receipt.TxHash)
+		require.Equal(t, uint64(30000), receipt.GasUsed)
+		require.Equal(t, blockHash, receipt.BlockHash)
+		require.Equal(t, uint(i), receipt.TransactionIndex)
+		require.True(t, receipt.Bloom.Test(contract.Bytes()))
+		require.True(t, receipt.Bloom.Test(topic.Bytes()))
+
+		require.Len(t, receipt.Logs, 2)
+		for j, log := range receipt.Logs {
+			require.Equal(t, contract, log.Address)
+			require.Equal(t, []byte{byte(i), byte(j)}, log.Data)
+			require.Equal(t, uint(2*i+j), log.Index)
+			require.Equal(t, txHashes[i], log.TxHash)
+			require.Equal(t, uint(i), log.TxIndex)
+			require.Equal(t, blockHash, log.BlockHash)
+			require.Equal(t, uint64(1), log.BlockNumber)
+		}
+
+		byHash, err := idxer.GetReceiptByTxHash(txHashes[i])
+		require.NoError(t, err)
+		require.Equal(t, receipt, byHash)
+	}
+
+	// receipts of not indexed blocks are not available
+	_, err = idxer.GetReceiptsByBlock(2)
+	require.Error(t, err)
+	_, err = idxer.GetReceiptByTxHash(common.Hash{})
+	require.Error(t, err)
+}
+
 // MakeEncodingConfig creates the EncodingConfig
 func MakeEncodingConfig() params.EncodingConfig {
 	return evmenc.MakeConfig(app.ModuleBasics)
@@ -0,0 +1,450 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package indexer
+
+import (
+	"fmt"
+	"sort"
+
+	errorsmod "cosmossdk.io/errors"
+	dbm "github.com/cometbft/cometbft-db"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/bitutil"
+	"github.com/ethereum/go-ethereum/core/bloombits"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// BloomBitsBlocks is the number of blocks a single bloom bit section vector contains.
+const BloomBitsBlocks = 4096
+
+// The log index covers a contiguous range of blocks and consists of:
+//   - `(address, block number)` and `(topic0, block number)` entries for every log of the block,
+//     used to find blocks by the log addresses and first topics.
+//   - bloom bits sections, `(bloom bit, section) -> bit vector of the section blocks`, like the
+//     bloombits index of go-ethereum, used to find blocks by the other topics.
+//   - blooms of the blocks of the sections, which are not complete yet.
+//   - blocks, whose logs are not available to the indexer, they are always returned as candidates.
+//
+// Inside of the range, the absence of an entry means that the block doesn't contain matching logs.
+
+// indexLogs adds the logs of the block to the log index. If complete is false, the logs of the block
+// are not known, and the block is marked as unindexed.
+func (kv *KVIndexer) indexLogs(batch dbm.Batch, height int64, logs []*ethtypes.Log, complete bool) error {
+	first, last, err := kv.LogIndexRange()
+	if err != nil {
+		return err
+	}
+
+	var bloom ethtypes.Bloom
+	if complete {
+		bloom = ethtypes.BytesToBloom(ethtypes.LogsBloom(logs))
+		if err := batch.Delete(UnindexedLogsKey(height)); err != nil {
+			return errorsmod.Wrap(err, "delete unindexed logs key")
+		}
+	} else {
+		// matches any filter
+		for i := range bloom {
+			bloom[i] = 0xff
+		}
+		if err := batch.Set(UnindexedLogsKey(height), []byte{}); err != nil {
+			return errorsmod.Wrap(err, "set unindexed logs key")
+		}
+	}
+
+	for _, log := range logs {
+		if err := batch.Set(AddressLogKey(log.Address, height), []byte{}); err != nil {
+			return errorsmod.Wrap(err, "set address log key")
+		}
+		if len(log.Topics) > 0 {
+			if err := batch.Set(TopicLogKey(log.Topics[0], height), []byte{}); err != nil {
+				return errorsmod.Wrap(err, "set topic log key")
+			}
+		}
+	}
+
+	// the index is extended block by block in both directions, if the block is not adjacent to
+	// the indexed range, the range is restarted from the block to avoid covering a gap.
+	newFirst, newLast := first, last
+	switch {
+	case first < 0 || height < first-1 || height > last+1:
+		if first >= 0 {
+			kv.logger.Error("block is not adjacent to the log index, restart the index", "block", height, "first", first, "last", last)
+		}
+		newFirst, newLast = height, height
+	case height == first-1:
+		newFirst = height
+	case height == last+1:
+		newLast = height
+	}
+	if err := batch.Set(LogIndexRangeKey(), append(sdk.Uint64ToBigEndian(uint64(newFirst)), sdk.Uint64ToBigEndian(uint64(newLast))...)); err != nil {
+		return errorsmod.Wrap(err, "set log index range key")
+	}
+
+	section := uint64(height) / BloomBitsBlocks
+	switch {
+	case sectionCovered(section, first, last):
+		// bloom bits of the section are already built
+		return nil
+	case sectionCovered(section, newFirst, newLast):
+		return kv.buildBloomBits(batch, section, height, bloom)
+	case bloom == (ethtypes.Bloom{}):
+		// empty blooms are not stored
+		return batch.Delete(BlockBloomKey(height))
+	default:
+		return batch.Set(BlockBloomKey(height), bloom.Bytes())
+	}
+}
+
+// buildBloomBits builds the bloom bits of the section from the blooms of its blocks, and removes the blooms
+// of the blocks. The bloom of the block being indexed is not stored yet, so it's passed explicitly.
+func (kv *KVIndexer) buildBloomBits(batch dbm.Batch, section uint64, height int64, bloom ethtypes.Bloom) error {
+	gen, err := bloombits.NewGenerator(BloomBitsBlocks)
+	if err != nil {
+		return err
+	}
+	for i := uint64(0); i < BloomBitsBlocks; i++ {
+		number := int64(section*BloomBitsBlocks + i)
+		blockBloom := bloom
+		if number != height {
+			if blockBloom, err = kv.loadBlockBloom(number); err != nil {
+				return err
+			}
+			if err := batch.Delete(BlockBloomKey(number)); err != nil {
+				return errorsmod.Wrap(err, "delete block bloom key")
+			}
+		}
+		if err := gen.AddBloom(uint(i), blockBloom); err != nil {
+			return err
+		}
+	}
+	for bit := uint(0); bit < ethtypes.BloomBitLength; bit++ {
+		bits, err := gen.Bitset(bit)
+		if err != nil {
+			return err
+		}
+		// empty bit vectors are not stored
+		bz := bitutil.CompressBytes(bits)
+		if len(bz) == 0 {
+			continue
+		}
+		if err := batch.Set(BloomBitsKey(bit, section), bz); err != nil {
+			return errorsmod.Wrap(err, "set bloom bits key")
+		}
+	}
+	return nil
+}
+
+// LogIndexRange returns the range of blocks covered by the log index, returns -1 if the log index is empty
+func (kv *KVIndexer) LogIndexRange() (int64, int64, error) {
+	bz, err := kv.db.Get(LogIndexRangeKey())
+	if err != nil {
+		return 0, 0, errorsmod.Wrap(err, "LogIndexRange")
+	}
+	if len(bz) == 0 {
+		return -1, -1, nil
+	}
+	if len(bz) != 16 {
+		return 0, 0, fmt.Errorf("wrong log index range length, expect: 16, got: %d", len(bz))
+	}
+	return int64(sdk.BigEndianToUint64(bz[:8])), int64(sdk.BigEndianToUint64(bz[8:])), nil
+}
+
+// GetLogBlocks returns the ordered numbers of the blocks in `[from, to]`, which may contain logs matching
+// the addresses and topics. The block range must be covered by the log index.
+func (kv *KVIndexer) GetLogBlocks(from, to int64, addresses []common.Address, topics [][]common.Hash) ([]int64, error) {
+	first, last, err := kv.LogIndexRange()
+	if err != nil {
+		return nil, err
+	}
+	if first < 0 || from < first || to > last {
+		return nil, fmt.Errorf("blocks are not covered by the log index, range: [%d, %d], index: [%d, %d]", from, to, first, last)
+	}
+	if from > to {
+		return []int64{}, nil
+	}
+
+	var topic0 []common.Hash
+	if len(topics) > 0 {
+		topic0 = topics[0]
+	}
+
+	var blocks map[int64]struct{}
+	switch {
+	case len(addresses) > 0 || len(topic0) > 0:
+		if len(addresses) > 0 {
+			keys := make([][]byte, len(addresses))
+			for i, address := range addresses {
+				keys[i] = AddressLogKey(address, 0)[:1+common.AddressLength]
+			}
+			if blocks, err = kv.iterateLogKeys(keys, from, to); err != nil {
+				return nil, err
+			}
+		}
+		if len(topic0) > 0 {
+			keys := make([][]byte, len(topic0))
+			for i, topic := range topic0 {
+				keys[i] = TopicLogKey(topic, 0)[:1+common.HashLength]
+			}
+			topicBlocks, err := kv.iterateLogKeys(keys, from, to)
+			if err != nil {
+				return nil, err
+			}
+			if blocks == nil {
+				blocks = topicBlocks
+			} else {
+				for number := range blocks {
+					if _, ok := topicBlocks[number]; !ok {
+						delete(blocks, number)
+					}
+				}
+			}
+		}
+	case len(topics) > 1:
+		if blocks, err = kv.matchBloomBits(from, to, first, last, topics[1:]); err != nil {
+			return nil, err
+		}
+	default:
+		if blocks, err = kv.iterateTxBlocks(from, to); err != nil {
+			return nil, err
+		}
+	}
+
+	// logs of the unindexed blocks are unknown
+	unindexed, err := kv.iterateLogKeys([][]byte{{KeyPrefixUnindexedLogs}}, from, to)
+	if err != nil {
+		return nil, err
+	}
+	for number := range unindexed {
+		blocks[number] = struct{}{}
+	}
+
+	res := make([]int64, 0, len(blocks))
+	for number := range blocks {
+		res = append(res, number)
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i] < res[j] })
+	return res, nil
+}
+
+// iterateLogKeys returns the numbers of the blocks in `[from, to]` of the entries with any of the key prefixes
+func (kv *KVIndexer) iterateLogKeys(prefixes [][]byte, from, to int64) (map[int64]struct{}, error) {
+	blocks := make(map[int64]struct{})
+	for _, prefix := range prefixes {
+		start := append(append([]byte{}, prefix...), sdk.Uint64ToBigEndian(uint64(from))...)
+		end := append(append([]byte{}, prefix...), sdk.Uint64ToBigEndian(uint64(to+1))...)
+		it, err := kv.db.Iterator(start, end)
+		if err != nil {
+			return nil, errorsmod.Wrap(err, "GetLogBlocks")
+		}
+		for ; it.Valid(); it.Next() {
+			key := it.Key()
+			blocks[int64(sdk.BigEndianToUint64(key[len(prefix):]))] = struct{}{}
+		}
+		it.Close()
+	}
+	return blocks, nil
+}
+
+// iterateTxBlocks returns the numbers of the blocks in `[from, to]` containing eth txs
+func (kv *KVIndexer) iterateTxBlocks(from, to int64) (map[int64]struct{}, error) {
+	it, err := kv.db.Iterator(TxIndexKey(from, 0), TxIndexKey(to+1, 0))
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "GetLogBlocks")
+	}
+	defer it.Close()
+
+	blocks := make(map[int64]struct{})
+	for ; it.Valid(); it.Next() {
+		number, err := parseBlockNumberFromKey(it.Key())
+		if err != nil {
+			return nil, err
+		}
+		blocks[number] = struct{}{}
+	}
+	return blocks, nil
+}
+
+// matchBloomBits returns the numbers of the blocks in `[from, to]`, whose blooms match the topics. Bloom bits are
+// used for the sections covered by the log index, blooms of the blocks are used for the rest.
+func (kv *KVIndexer) matchBloomBits(from, to, first, last int64, topics [][]common.Hash) (map[int64]struct{}, error) {
+	var filters [][][3]uint
+	for _, alternatives := range topics {
+		if len(alternatives) == 0 {
+			continue
+		}
+		filter := make([][3]uint, len(alternatives))
+		for i, topic := range alternatives {
+			filter[i] = calcBloomIndexes(topic.Bytes())
+		}
+		filters = append(filters, filter)
+	}
+	if len(filters) == 0 {
+		return kv.iterateTxBlocks(from, to)
+	}
+
+	blocks := make(map[int64]struct{})
+	for section := uint64(from) / BloomBitsBlocks; section <= uint64(to)/BloomBitsBlocks; section++ {
+		start := int64(section * BloomBitsBlocks)
+		if !sectionCovered(section, first, last) {
+			for number := max(start, from); number <= min(start+BloomBitsBlocks-1, to); number++ {
+				bz, err := kv.db.Get(BlockBloomKey(number))
+				if err != nil {
+					return nil, errorsmod.Wrap(err, "GetLogBlocks")
+				}
+				if len(bz) > 0 && bloomMatches(ethtypes.BytesToBloom(bz), topics) {
+					blocks[number] = struct{}{}
+				}
+			}
+			continue
+		}
+
+		bitsets := make(map[uint][]byte)
+		var matches []byte
+		for _, filter := range filters {
+			filterMatches := make([]byte, BloomBitsBlocks/8)
+			for _, idxs := range filter {
+				alternative := make([]byte, BloomBitsBlocks/8)
+				for i := range alternative {
+					alternative[i] = 0xff
+				}
+				for _, bit := range idxs {
+					bits, ok := bitsets[bit]
+					if !ok {
+						var err error
+						if bits, err = kv.loadBloomBits(bit, section); err != nil {
+							return nil, err
+						}
+						bitsets[bit] = bits
+					}
+					bitutil.ANDBytes(alternative, alternative, bits)
+				}
+				bitutil.ORBytes(filterMatches, filterMatches, alternative)
+			}
+			if matches == nil {
+				matches = filterMatches
+			} else {
+				bitutil.ANDBytes(matches, matches, filterMatches)
+			}
+		}
+
+		for i := uint64(0); i < BloomBitsBlocks; i++ {
+			number := start + int64(i)
+			if number < from || number > to {
+				continue
+			}
+			if matches[i/8]&(1<<(7-i%8)) != 0 {
+				blocks[number] = struct{}{}
+			}
+		}
+	}
+	return blocks, nil
+}
+
+// loadBlockBloom loads the stored bloom of the block, the bloom is empty if it's not stored
+func (kv *KVIndexer) loadBlockBloom(number int64) (ethtypes.Bloom, error) {
+	bz, err := kv.db.Get(BlockBloomKey(number))
+	if err != nil {
+		return ethtypes.Bloom{}, errorsmod.Wrapf(err, "load block bloom %d", number)
+	}
+	return ethtypes.BytesToBloom(bz), nil
+}
+
+// loadBloomBits loads the bit vector of the bloom bit in the section, the vector is empty if it's not stored
+func (kv *KVIndexer) loadBloomBits(bit uint, section uint64) ([]byte, error) {
+	bz, err := kv.db.Get(BloomBitsKey(bit, section))
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "load bloom bits %d, section %d", bit, section)
+	}
+	bits, err := bitutil.DecompressBytes(bz, BloomBitsBlocks/8)
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "decompress bloom bits %d, section %d", bit, section)
+	}
+	return bits, nil
+}
+
+// sectionCovered returns if all the blocks of the section are in the range, the genesis block has no logs
+// and is always covered.
+func sectionCovered(section uint64, first, last int64) bool {
+	start := int64(section * BloomBitsBlocks)
+	if start == 0 {
+		start = 1
+	}
+	return first >= 0 && first <= start && last >= int64((section+1)*BloomBitsBlocks-1)
+}
+
+// bloomMatches returns if the bloom contains any of the alternatives of every topic position
+func bloomMatches(bloom ethtypes.Bloom, topics [][]common.Hash) bool {
+	for _, alternatives := range topics {
+		if len(alternatives) == 0 {
+			continue
+		}
+		var included bool
+		for _, topic := range alternatives {
+			if bloom.Test(topic.Bytes()) {
+				included = true
+				break
+			}
+		}
+		if !included {
+			return false
+		}
+	}
+	return true
+}
+
+// calcBloomIndexes returns the bloom filter bit indexes belonging to the given data,
+// revised from https://github.com/ethereum/go-ethereum/blob/v1.10.26/core/bloombits/matcher.go#L44
+func calcBloomIndexes(data []byte) [3]uint {
+	hash := crypto.Keccak256(data)
+
+	var idxs [3]uint
+	for i := range idxs {
+		idxs[i] = (uint(hash[2*i])<<8)&2047 + uint(hash[2*i+1])
+	}
+	return idxs
+}
+
+// AddressLogKey returns the key for db entry: `(log address, block number) -> nil`
+func AddressLogKey(address common.Address, blockNumber int64) []byte {
+	return append(append([]byte{KeyPrefixAddressLog}, address.Bytes()...), sdk.Uint64ToBigEndian(uint64(blockNumber))...)
+}
+
+// TopicLogKey returns the key for db entry: `(log first topic, block number) -> nil`
+func TopicLogKey(topic common.Hash, blockNumber int64) []byte {
+	return append(append([]byte{KeyPrefixTopicLog}, topic.Bytes()...), sdk.Uint64ToBigEndian(uint64(blockNumber))...)
+}
+
+// BlockBloomKey returns the key for db entry: `block number -> block bloom`
+func BlockBloomKey(blockNumber int64) []byte {
+	return append([]byte{KeyPrefixBlockBloom}, sdk.Uint64ToBigEndian(uint64(blockNumber))...)
+}
+
+// BloomBitsKey returns the key for db entry: `(bloom bit, section) -> compressed bit vector`
+func BloomBitsKey(bit uint, section uint64) []byte {
+	return append([]byte{KeyPrefixBloomBits, byte(bit >> 8), byte(bit)}, sdk.Uint64ToBigEndian(section)...)
+}
+
+// LogIndexRangeKey returns the key for db entry: `-> (first block number, last block number)`
+func LogIndexRangeKey() []byte {
+	return []byte{KeyPrefixLogIndex}
+}
+
+// UnindexedLogsKey returns the key for db entry: `block number -> nil`
+func UnindexedLogsKey(blockNumber int64) []byte {
+	return append([]byte{KeyPrefixUnindexedLogs}, sdk.Uint64ToBigEndian(uint64(blockNumber))...)
+}
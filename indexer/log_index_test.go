@@ -0,0 +1,188 @@
+package indexer_test
+
+import (
+	"math/big"
+	"testing"
+
+	"swisstronik/crypto/ethsecp256k1"
+	"swisstronik/indexer"
+	"swisstronik/tests"
+	"swisstronik/utils"
+	"swisstronik/x/evm/types"
+
+	dbm "github.com/cometbft/cometbft-db"
+	abci "github.com/cometbft/cometbft/abci/types"
+	tmlog "github.com/cometbft/cometbft/libs/log"
+	tmtypes "github.com/cometbft/cometbft/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/gogo/protobuf/proto"
+	"github.com/stretchr/testify/require"
+)
+
+// logsBlock builds a block with a single eth tx emitting the logs, if valid is false the tx events are invalid
+// and the logs are not available to the indexer.
+func logsBlock(t *testing.T, clientCtx client.Context, height int64, logs []*types.Log, valid bool) (*tmtypes.Block, []*abci.ResponseDeliverTx) {
+	priv, err := ethsecp256k1.GenerateKey()
+	require.NoError(t, err)
+	signer := tests.NewTestSigner(priv)
+
+	to := common.BigToAddress(big.NewInt(1))
+	tx := types.NewTx(nil, 0, &to, big.NewInt(0), 100000, nil, nil, nil, nil, nil, nil, nil)
+	tx.From = common.BytesToAddress(priv.PubKey().Address().Bytes()).Hex()
+	require.NoError(t, tx.Sign(ethtypes.LatestSignerForChainID(nil), signer))
+	txHash := tx.AsTransaction().Hash()
+
+	tmTx, err := tx.BuildTx(clientCtx.TxConfig.NewTxBuilder(), utils.BaseDenom)
+	require.NoError(t, err)
+	txBz, err := clientCtx.TxConfig.TxEncoder()(tmTx)
+	require.NoError(t, err)
+
+	res := &types.MsgEthereumTxResponse{Hash: txHash.Hex(), Logs: logs, GasUsed: 30000}
+	data, err := proto.Marshal(&sdk.TxMsgData{MsgResponses: []*codectypes.Any{codectypes.UnsafePackAny(res)}})
+	require.NoError(t, err)
+
+	result := &abci.ResponseDeliverTx{Code: 0, Data: data, GasUsed: 30000}
+	if valid {
+		result.Events = []abci.Event{
+			{Type: types.EventTypeEthereumTx, Attributes: []abci.EventAttribute{
+				{Key: "ethereumTxHash", Value: txHash.Hex()},
+				{Key: "txIndex", Value: "0"},
+				{Key: "amount", Value: "0"},
+				{Key: "txGasUsed", Value: "30000"},
+			}},
+		}
+	}
+	block := &tmtypes.Block{Header: tmtypes.Header{Height: height}, Data: tmtypes.Data{Txs: []tmtypes.Tx{txBz}}}
+	return block, []*abci.ResponseDeliverTx{result}
+}
+
+func TestKVIndexerLogBlocks(t *testing.T) {
+	encodingConfig := MakeEncodingConfig()
+	clientCtx := client.Context{}.WithTxConfig(encodingConfig.TxConfig).WithCodec(encodingConfig.Codec)
+
+	addrA := common.BigToAddress(big.NewInt(0xa))
+	addrB := common.BigToAddress(big.NewInt(0xb))
+	topicA := common.BytesToHash([]byte("topicA"))
+	topicB := common.BytesToHash([]byte("topicB"))
+	topicX := common.BytesToHash([]byte("topicX"))
+
+	blockLogs := map[int64][]*types.Log{
+		5:    {{Address: addrA.Hex(), Topics: []string{topicA.Hex(), topicX.Hex()}}},
+		10:   {{Address: addrB.Hex(), Topics: []string{topicB.Hex()}}},
+		20:   {{Address: addrA.Hex(), Topics: []string{topicB.Hex()}}},
+		4100: {{Address: addrA.Hex(), Topics: []string{topicB.Hex(), topicX.Hex()}}},
+	}
+	// logs of the block are not available to the indexer
+	const unindexed = int64(20)
+
+	db := dbm.NewMemDB()
+	idxer := indexer.NewKVIndexer(db, tmlog.NewNopLogger(), clientCtx)
+
+	first, last, err := idxer.LogIndexRange()
+	require.NoError(t, err)
+	require.Equal(t, int64(-1), first)
+	require.Equal(t, int64(-1), last)
+
+	for height := int64(1); height <= 4200; height++ {
+		block := &tmtypes.Block{Header: tmtypes.Header{Height: height}}
+		var results []*abci.ResponseDeliverTx
+		if logs, ok := blockLogs[height]; ok {
+			block, results = logsBlock(t, clientCtx, height, logs, height != unindexed)
+		}
+		require.NoError(t, idxer.IndexBlock(block, results))
+	}
+
+	first, last, err = idxer.LogIndexRange()
+	require.NoError(t, err)
+	require.Equal(t, int64(1), first)
+	require.Equal(t, int64(4200), last)
+
+	// bloom bits of the first section are built, blooms of its blocks are removed
+	bz, err := db.Get(indexer.BlockBloomKey(5))
+	require.NoError(t, err)
+	require.Empty(t, bz)
+	bz, err = db.Get(indexer.BlockBloomKey(4100))
+	require.NoError(t, err)
+	require.NotEmpty(t, bz)
+	bz, err = db.Get(indexer.BloomBitsKey(0, 0))
+	require.NoError(t, err)
+	require.NotEmpty(t, bz)
+
+	testCases := []struct {
+		name      string
+		from, to  int64
+		addresses []common.Address
+		topics    [][]common.Hash
+		exp       []int64
+	}{
+		{"no criteria", 1, 4200, nil, nil, []int64{5, 10, 20, 4100}},
+		{"address", 1, 4200, []common.Address{addrA}, nil, []int64{5, 20, 4100}},
+		{"addresses", 1, 4200, []common.Address{addrA, addrB}, nil, []int64{5, 10, 20, 4100}},
+		{"topic0", 1, 4200, nil, [][]common.Hash{{topicB}}, []int64{10, 20, 4100}},
+		{"address and topic0", 1, 4200, []common.Address{addrA}, [][]common.Hash{{topicB}}, []int64{20, 4100}},
+		{"topic1, bloom bits", 1, 4200, nil, [][]common.Hash{nil, {topicX}}, []int64{5, 20, 4100}},
+		{"topic1, sub range", 6, 4200, nil, [][]common.Hash{nil, {topicX}}, []int64{20, 4100}},
+		{"address, sub range", 6, 4099, []common.Address{addrA}, nil, []int64{20}},
+		{"no matches", 21, 4099, []common.Address{addrB}, nil, []int64{}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			blocks, err := idxer.GetLogBlocks(tc.from, tc.to, tc.addresses, tc.topics)
+			require.NoError(t, err)
+			require.Equal(t, tc.exp, blocks)
+		})
+	}
+
+	// blocks out of the log index range
+	_, err = idxer.GetLogBlocks(0, 10, nil, nil)
+	require.Error(t, err)
+	_, err = idxer.GetLogBlocks(1, 4201, nil, nil)
+	require.Error(t, err)
+}
+
+func TestKVIndexerLogIndexRange(t *testing.T) {
+	encodingConfig := MakeEncodingConfig()
+	clientCtx := client.Context{}.WithTxConfig(encodingConfig.TxConfig).WithCodec(encodingConfig.Codec)
+
+	addr := common.BigToAddress(big.NewInt(0xa))
+	idxer := indexer.NewKVIndexer(dbm.NewMemDB(), tmlog.NewNopLogger(), clientCtx)
+
+	// index backward, the section is built once its first block is indexed
+	for height := int64(2 * indexer.BloomBitsBlocks); height >= indexer.BloomBitsBlocks; height-- {
+		block := &tmtypes.Block{Header: tmtypes.Header{Height: height}}
+		var results []*abci.ResponseDeliverTx
+		if height == indexer.BloomBitsBlocks+1 {
+			block, results = logsBlock(t, clientCtx, height, []*types.Log{{Address: addr.Hex()}}, true)
+		}
+		require.NoError(t, idxer.IndexBlock(block, results))
+	}
+	first, last, err := idxer.LogIndexRange()
+	require.NoError(t, err)
+	require.Equal(t, int64(indexer.BloomBitsBlocks), first)
+	require.Equal(t, int64(2*indexer.BloomBitsBlocks), last)
+
+	blocks, err := idxer.GetLogBlocks(first, last, nil, [][]common.Hash{nil, {common.Hash{}}})
+	require.NoError(t, err)
+	require.Empty(t, blocks)
+	blocks, err = idxer.GetLogBlocks(first, last, []common.Address{addr}, nil)
+	require.NoError(t, err)
+	require.Equal(t, []int64{indexer.BloomBitsBlocks + 1}, blocks)
+
+	// re-indexing of the covered blocks doesn't change the range
+	require.NoError(t, idxer.IndexBlock(&tmtypes.Block{Header: tmtypes.Header{Height: last}}, nil))
+	first, last, err = idxer.LogIndexRange()
+	require.NoError(t, err)
+	require.Equal(t, int64(indexer.BloomBitsBlocks), first)
+	require.Equal(t, int64(2*indexer.BloomBitsBlocks), last)
+
+	// the range is restarted if a gap would be created
+	require.NoError(t, idxer.IndexBlock(&tmtypes.Block{Header: tmtypes.Header{Height: last + 2}}, nil))
+	first, last, err = idxer.LogIndexRange()
+	require.NoError(t, err)
+	require.Equal(t, int64(2*indexer.BloomBitsBlocks+2), first)
+	require.Equal(t, int64(2*indexer.BloomBitsBlocks+2), last)
+}
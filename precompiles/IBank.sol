@@ -0,0 +1,32 @@
+// SPDX-License-Identifier: MIT
+pragma solidity ^0.8;
+
+import "./ICosmosEvents.sol";
+
+/// @dev Address of the bank precompile
+address constant BANK_PRECOMPILE_ADDRESS = 0x000000000000000000000000000000000000040A;
+
+/// @dev Bank precompile instance
+IBank constant BANK_CONTRACT = IBank(BANK_PRECOMPILE_ADDRESS);
+
+/// @title Bank precompile interface
+/// @notice Allows contracts to query and send native coins of any denom through x/bank module
+/// @dev `send` reverts if it is called within static call or through delegate call. Changes are
+/// rolled back together with the transaction. Events of x/bank module are emitted as `CosmosEvent` logs.
+/// Changes of EVM denom balances, made by `send`, become visible to EVM (for example, through
+/// `address(this).balance`) after the transaction is finished, while `balanceOf` returns actual balance.
+interface IBank is ICosmosEvents {
+    /// @notice Returns balance of `account` in provided denom
+    function balanceOf(
+        address account,
+        string memory denom
+    ) external view returns (uint256 amount);
+
+    /// @notice Sends `amount` of coins of provided denom from `msg.sender` to `to`
+    /// @return success True if coins were sent
+    function send(
+        address to,
+        string memory denom,
+        uint256 amount
+    ) external returns (bool success);
+}
@@ -0,0 +1,13 @@
+// SPDX-License-Identifier: MIT
+pragma solidity ^0.8;
+
+/// @title Cosmos events, emitted by precompiles
+/// @notice Precompiles, which execute Cosmos messages (staking, distribution, bank and ICS-20),
+/// emit each Cosmos event of the message as `CosmosEvent` log with the address of the precompile.
+/// Logs are included in transaction receipts and can be queried with `eth_getLogs`.
+interface ICosmosEvents {
+    /// @param eventType Type of Cosmos event, for example `transfer` or `coin_spent`
+    /// @param keys Keys of event attributes
+    /// @param values Values of event attributes in the same order as keys
+    event CosmosEvent(string indexed eventType, string[] keys, string[] values);
+}
@@ -0,0 +1,25 @@
+// SPDX-License-Identifier: MIT
+pragma solidity ^0.8;
+
+import "./ICosmosEvents.sol";
+
+/// @dev Address of the distribution precompile
+address constant DISTRIBUTION_PRECOMPILE_ADDRESS = 0x0000000000000000000000000000000000000409;
+
+/// @dev Distribution precompile instance
+IDistribution constant DISTRIBUTION_CONTRACT = IDistribution(DISTRIBUTION_PRECOMPILE_ADDRESS);
+
+/// @title Distribution precompile interface
+/// @notice Allows contracts to withdraw staking rewards through x/distribution module
+/// @dev `withdrawDelegatorRewards` reverts if `delegator` is not `msg.sender`, if it is called
+/// within static call or through delegate call. Events of x/distribution module are emitted as
+/// `CosmosEvent` logs.
+interface IDistribution is ICosmosEvents {
+    /// @notice Withdraws rewards of `delegator` from the validator. Rewards are sent to the
+    /// withdraw address of `delegator`, which is `delegator` itself by default
+    /// @return amount Withdrawn amount, denominated in staking bond denom
+    function withdrawDelegatorRewards(
+        address delegator,
+        string memory validatorAddress
+    ) external returns (uint256 amount);
+}
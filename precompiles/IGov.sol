@@ -0,0 +1,90 @@
+// SPDX-License-Identifier: MIT
+pragma solidity ^0.8;
+
+import "./ICosmosEvents.sol";
+
+/// @dev Address of the governance precompile
+address constant GOV_PRECOMPILE_ADDRESS = 0x000000000000000000000000000000000000040C;
+
+/// @dev Governance precompile instance
+IGov constant GOV_CONTRACT = IGov(GOV_PRECOMPILE_ADDRESS);
+
+/// @dev Vote options of x/gov module
+uint8 constant VOTE_OPTION_YES = 1;
+uint8 constant VOTE_OPTION_ABSTAIN = 2;
+uint8 constant VOTE_OPTION_NO = 3;
+uint8 constant VOTE_OPTION_NO_WITH_VETO = 4;
+
+/// @dev Proposal statuses of x/gov module
+uint8 constant PROPOSAL_STATUS_DEPOSIT_PERIOD = 1;
+uint8 constant PROPOSAL_STATUS_VOTING_PERIOD = 2;
+uint8 constant PROPOSAL_STATUS_PASSED = 3;
+uint8 constant PROPOSAL_STATUS_REJECTED = 4;
+uint8 constant PROPOSAL_STATUS_FAILED = 5;
+
+/// @dev Option of weighted vote
+struct WeightedVoteOption {
+    uint8 option;
+    /// @dev Decimal weight of the option, for example "0.5". Weights of all options must sum up to 1
+    string weight;
+}
+
+/// @title Governance precompile interface
+/// @notice Allows contracts, for example DAOs, to vote and deposit on x/gov proposals on their own
+/// behalf. Voting power of the contract is defined by its delegations, see `IStaking`.
+/// @dev `msg.sender` is used as voter and depositor. State-changing functions revert if they are
+/// called within static call or through delegate call. Events of x/gov module are emitted as
+/// `CosmosEvent` logs.
+interface IGov is ICosmosEvents {
+    /// @notice Casts vote on the proposal
+    /// @return success True if vote was cast
+    function vote(
+        uint64 proposalId,
+        uint8 option,
+        string memory metadata
+    ) external returns (bool success);
+
+    /// @notice Casts weighted vote on the proposal
+    /// @return success True if vote was cast
+    function voteWeighted(
+        uint64 proposalId,
+        WeightedVoteOption[] memory options,
+        string memory metadata
+    ) external returns (bool success);
+
+    /// @notice Deposits `amount` of coins in `denom` on the proposal
+    /// @return success True if deposit was made
+    function deposit(
+        uint64 proposalId,
+        string memory denom,
+        uint256 amount
+    ) external returns (bool success);
+
+    /// @notice Returns the proposal. Reverts if proposal does not exist.
+    /// Timestamps are unix timestamps, or zero if they are not set yet.
+    /// Proposer is zero address if it cannot be represented as EVM address
+    function getProposal(
+        uint64 proposalId
+    )
+        external
+        view
+        returns (
+            uint64 id,
+            uint8 status,
+            address proposer,
+            string memory title,
+            uint64 submitTime,
+            uint64 depositEndTime,
+            uint64 votingStartTime,
+            uint64 votingEndTime
+        );
+
+    /// @notice Returns tally of the proposal. For proposals in voting period current tally is
+    /// calculated, otherwise final tally result is returned
+    function getTallyResult(
+        uint64 proposalId
+    )
+        external
+        view
+        returns (uint256 yes, uint256 abstain, uint256 no, uint256 noWithVeto);
+}
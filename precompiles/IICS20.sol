@@ -0,0 +1,37 @@
+// SPDX-License-Identifier: MIT
+pragma solidity ^0.8;
+
+import "./ICosmosEvents.sol";
+
+/// @dev Address of the ICS-20 precompile
+address constant ICS20_PRECOMPILE_ADDRESS = 0x000000000000000000000000000000000000040B;
+
+/// @dev ICS-20 precompile instance
+IICS20 constant ICS20_CONTRACT = IICS20(ICS20_PRECOMPILE_ADDRESS);
+
+/// @title ICS-20 precompile interface
+/// @notice Allows contracts to transfer their coins to other chains over IBC
+/// @dev `transfer` reverts if it is called within static call or through delegate call.
+/// Events of ICS-20 and IBC core modules are emitted as `CosmosEvent` logs.
+interface IICS20 is ICosmosEvents {
+    /// @notice Starts ICS-20 transfer of `amount` of coins of provided denom from `msg.sender`.
+    /// At least one of timeout height and timeout timestamp must be set
+    /// @param sourcePort Port of the channel, usually `transfer`
+    /// @param sourceChannel Channel on this chain, for example `channel-0`
+    /// @param receiver Address of the receiver on the destination chain
+    /// @param timeoutRevisionNumber Revision number of timeout height on the destination chain
+    /// @param timeoutRevisionHeight Timeout height on the destination chain, 0 to disable
+    /// @param timeoutTimestamp Timeout timestamp in nanoseconds, 0 to disable
+    /// @return sequence Sequence of sent packet
+    function transfer(
+        string memory sourcePort,
+        string memory sourceChannel,
+        string memory denom,
+        uint256 amount,
+        string memory receiver,
+        uint64 timeoutRevisionNumber,
+        uint64 timeoutRevisionHeight,
+        uint64 timeoutTimestamp,
+        string memory memo
+    ) external returns (uint64 sequence);
+}
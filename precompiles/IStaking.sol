@@ -0,0 +1,52 @@
+// SPDX-License-Identifier: MIT
+pragma solidity ^0.8;
+
+import "./ICosmosEvents.sol";
+
+/// @dev Address of the staking precompile
+address constant STAKING_PRECOMPILE_ADDRESS = 0x0000000000000000000000000000000000000408;
+
+/// @dev Staking precompile instance
+IStaking constant STAKING_CONTRACT = IStaking(STAKING_PRECOMPILE_ADDRESS);
+
+/// @title Staking precompile interface
+/// @notice Allows contracts to manage delegations of their own coins through x/staking module.
+/// Amounts are denominated in staking bond denom. Validators are identified by bech32 operator
+/// address, for example `swtrvaloper1...`.
+/// @dev State-changing functions revert if `delegator` is not `msg.sender`, if they are called
+/// within static call or through delegate call. Balance changes, made by these functions, become
+/// visible to EVM (for example, through `address(this).balance`) after the transaction is finished.
+/// Events of x/staking module are emitted as `CosmosEvent` logs.
+interface IStaking is ICosmosEvents {
+    /// @notice Delegates `amount` of coins of `delegator` to the validator
+    /// @return success True if delegation was created
+    function delegate(
+        address delegator,
+        string memory validatorAddress,
+        uint256 amount
+    ) external returns (bool success);
+
+    /// @notice Starts unbonding of `amount` of coins, delegated to the validator.
+    /// Coins are returned to `delegator` after unbonding period
+    /// @return completionTime Unix timestamp, when unbonding is completed
+    function undelegate(
+        address delegator,
+        string memory validatorAddress,
+        uint256 amount
+    ) external returns (int64 completionTime);
+
+    /// @notice Moves `amount` of delegated coins from source validator to destination validator
+    /// @return completionTime Unix timestamp, when redelegation is completed
+    function redelegate(
+        address delegator,
+        string memory validatorSrcAddress,
+        string memory validatorDstAddress,
+        uint256 amount
+    ) external returns (int64 completionTime);
+
+    /// @notice Returns amount of coins, delegated by `delegator` to the validator
+    function delegation(
+        address delegator,
+        string memory validatorAddress
+    ) external view returns (uint256 amount);
+}
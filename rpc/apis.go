@@ -27,6 +27,7 @@ import (
 	"swisstronik/rpc/namespaces/ethereum/debug"
 	"swisstronik/rpc/namespaces/ethereum/eth"
 	"swisstronik/rpc/namespaces/ethereum/eth/filters"
+	"swisstronik/rpc/namespaces/ethereum/evm"
 	"swisstronik/rpc/namespaces/ethereum/miner"
 	"swisstronik/rpc/namespaces/ethereum/net"
 	"swisstronik/rpc/namespaces/ethereum/personal"
@@ -54,6 +55,7 @@ const (
 	DebugNamespace    = "debug"
 	MinerNamespace    = "miner"
 	UtilsNamespace    = "utils"
+	EvmNamespace      = "evm"
 
 	apiVersion = "1.0"
 )
@@ -177,6 +179,23 @@ func init() {
 				},
 			}
 		},
+		EvmNamespace: func(ctx *server.Context,
+			clientCtx client.Context,
+			_ *rpcclient.WSClient,
+			allowUnprotectedTxs bool,
+			indexer ethermint.EVMTxIndexer,
+			allowUnencryptedTxs bool,
+		) []rpc.API {
+			evmBackend := backend.NewBackend(ctx, ctx.Logger, clientCtx, allowUnprotectedTxs, indexer, allowUnencryptedTxs)
+			return []rpc.API{
+				{
+					Namespace: EvmNamespace,
+					Version:   apiVersion,
+					Service:   evm.NewPublicAPI(ctx.Logger, evmBackend),
+					Public:    true,
+				},
+			}
+		},
 		UtilsNamespace: func(_ *server.Context,
 			_ client.Context,
 			_ *rpcclient.WSClient,
@@ -16,6 +16,7 @@
 package backend
 
 import (
+	"encoding/json"
 	"fmt"
 	"math"
 	"math/big"
@@ -231,3 +232,30 @@ func (b *Backend) GetNodePublicKey(blockNum rpctypes.BlockNumber) (string, error
 
 	return res.NodePublicKey, nil
 }
+
+// GetVerifiedContract returns ABI and source hash of the contract at the given address and block number,
+// if sources, submitted for the contract, match its deployed bytecode
+func (b *Backend) GetVerifiedContract(address common.Address, blockNrOrHash rpctypes.BlockNumberOrHash) (*rpctypes.VerifiedContractResult, error) {
+	blockNum, err := b.BlockNumberFromTendermint(blockNrOrHash)
+	if err != nil {
+		return nil, err
+	}
+
+	req := &evmtypes.QueryVerifiedContractRequest{
+		Address: address.String(),
+	}
+
+	res, err := b.queryClient.VerifiedContract(rpctypes.ContextWithHeight(blockNum.Int64()), req)
+	if err != nil {
+		return nil, err
+	}
+
+	return &rpctypes.VerifiedContractResult{
+		Address:         common.HexToAddress(res.Contract.Address),
+		SourceHash:      common.HexToHash(res.Contract.SourceHash),
+		ContractName:    res.Contract.ContractName,
+		CompilerVersion: res.Contract.CompilerVersion,
+		ABI:             json.RawMessage(res.Contract.Abi),
+		ExactMatch:      res.Contract.ExactMatch,
+	}, nil
+}
@@ -329,6 +329,9 @@ func (b *Backend) HeaderByNumber(blockNum rpctypes.BlockNumber) (*ethtypes.Heade
 	}
 
 	ethHeader := rpctypes.EthHeaderFromTendermint(resBlock.Block.Header, bloom, baseFee)
+	if stateRoot, err := b.BlockStateRoot(blockRes); err == nil {
+		ethHeader.Root = stateRoot
+	}
 	return ethHeader, nil
 }
 
@@ -359,6 +362,9 @@ func (b *Backend) HeaderByHash(blockHash common.Hash) (*ethtypes.Header, error)
 	}
 
 	ethHeader := rpctypes.EthHeaderFromTendermint(resBlock.Block.Header, bloom, baseFee)
+	if stateRoot, err := b.BlockStateRoot(blockRes); err == nil {
+		ethHeader.Root = stateRoot
+	}
 	return ethHeader, nil
 }
 
@@ -378,6 +384,25 @@ func (b *Backend) BlockBloom(blockRes *tmrpctypes.ResultBlockResults) (ethtypes.
 	return ethtypes.Bloom{}, errors.New("block bloom event is not found")
 }
 
+// BlockStateRoot returns intermediate state root after the last EVM transaction of the block from
+// block results. Blocks, produced before state roots were introduced, don't contain it. It is returned
+// as `stateRoot` of the block header, but commits only to EVM store writes of the block transactions,
+// so it cannot be used to prove account balances or other state of Cosmos modules
+func (b *Backend) BlockStateRoot(blockRes *tmrpctypes.ResultBlockResults) (common.Hash, error) {
+	for _, event := range blockRes.EndBlockEvents {
+		if event.Type != evmtypes.EventTypeBlockStateRoot {
+			continue
+		}
+
+		for _, attr := range event.Attributes {
+			if attr.Key == evmtypes.AttributeKeyStateRoot {
+				return common.HexToHash(attr.Value), nil
+			}
+		}
+	}
+	return common.Hash{}, errors.New("block state root event is not found")
+}
+
 // RPCBlockFromTendermintBlock returns a JSON-RPC compatible Ethereum block from a
 // given Tendermint block and its block result.
 func (b *Backend) RPCBlockFromTendermintBlock(
@@ -470,6 +495,9 @@ func (b *Backend) RPCBlockFromTendermintBlock(
 		gasLimit, new(big.Int).SetUint64(gasUsed),
 		ethRPCTxs, bloom, validatorAddr, baseFee,
 	)
+	if stateRoot, err := b.BlockStateRoot(blockRes); err == nil {
+		formattedBlock["stateRoot"] = stateRoot
+	}
 	return formattedBlock, nil
 }
 
@@ -512,6 +540,9 @@ func (b *Backend) EthBlockFromTendermintBlock(
 	}
 
 	ethHeader := rpctypes.EthHeaderFromTendermint(block.Header, bloom, baseFee)
+	if stateRoot, err := b.BlockStateRoot(blockRes); err == nil {
+		ethHeader.Root = stateRoot
+	}
 	msgs := b.EthMsgsFromTendermintBlock(resBlock, blockRes)
 
 	txs := make([]*ethtypes.Transaction, len(msgs))
@@ -119,6 +119,26 @@ func (b *Backend) Resend(args evmtypes.TransactionArgs, gasPrice *hexutil.Big, g
 
 // SendRawTransaction send a raw Ethereum transaction.
 func (b *Backend) SendRawTransaction(data hexutil.Bytes) (common.Hash, error) {
+	return b.sendRawTransaction(data, nil, nil)
+}
+
+// SendSponsoredRawTransaction sends a raw Ethereum transaction, which fees are paid by the fee payer
+// instead of the sender. Fee payer signs EIP-712 typed data of the transaction, see MsgHandleTx.FeePayerTypedData.
+func (b *Backend) SendSponsoredRawTransaction(
+	data hexutil.Bytes,
+	feePayer common.Address,
+	feePayerSignature hexutil.Bytes,
+) (common.Hash, error) {
+	return b.sendRawTransaction(data, &feePayer, feePayerSignature)
+}
+
+// sendRawTransaction wraps the raw Ethereum transaction into a Cosmos transaction and broadcasts it.
+// If fee payer is provided, fees of the transaction are delegated to it
+func (b *Backend) sendRawTransaction(
+	data hexutil.Bytes,
+	feePayer *common.Address,
+	feePayerSignature []byte,
+) (common.Hash, error) {
 	// RLP decode raw transaction bytes
 	tx := &ethtypes.Transaction{}
 	if err := tx.UnmarshalBinary(data); err != nil {
@@ -144,11 +164,23 @@ func (b *Backend) SendRawTransaction(data hexutil.Bytes) (common.Hash, error) {
 		ethereumTx.Unencrypted = true
 	}
 
+	if feePayer != nil {
+		ethereumTx.FeePayer = feePayer.Hex()
+		ethereumTx.FeePayerSignature = feePayerSignature
+	}
+
 	if err := ethereumTx.ValidateBasic(); err != nil {
 		b.logger.Debug("tx failed basic validation", "error", err.Error())
 		return common.Hash{}, err
 	}
 
+	if ethereumTx.IsFeeDelegated() {
+		if err := ethereumTx.VerifyFeePayerSignature(); err != nil {
+			b.logger.Debug("tx failed fee payer signature verification", "error", err.Error())
+			return common.Hash{}, err
+		}
+	}
+
 	// Query params to use the EVM denomination
 	res, err := b.queryClient.QueryClient.Params(b.ctx, &evmtypes.QueryParamsRequest{})
 	if err != nil {
@@ -282,7 +314,7 @@ func (b *Backend) SetTxDefaults(args evmtypes.TransactionArgs) (evmtypes.Transac
 			input = args.Data
 		}
 
-		callArgs := evmtypes.TransactionArgs{
+		callArgs := evmtypes.CallArgs{
 			From:                 args.From,
 			To:                   args.To,
 			Gas:                  args.Gas,
@@ -297,7 +329,7 @@ func (b *Backend) SetTxDefaults(args evmtypes.TransactionArgs) (evmtypes.Transac
 		}
 
 		blockNr := rpctypes.NewBlockNumber(big.NewInt(0))
-		estimated, err := b.EstimateGas(callArgs, &blockNr)
+		estimated, err := b.EstimateGas(callArgs, &blockNr, nil)
 		if err != nil {
 			return args, err
 		}
@@ -313,7 +345,9 @@ func (b *Backend) SetTxDefaults(args evmtypes.TransactionArgs) (evmtypes.Transac
 }
 
 // EstimateGas returns an estimate of gas usage for the given smart contract call.
-func (b *Backend) EstimateGas(args evmtypes.TransactionArgs, blockNrOptional *rpctypes.BlockNumber) (hexutil.Uint64, error) {
+func (b *Backend) EstimateGas(
+	args evmtypes.CallArgs, blockNrOptional *rpctypes.BlockNumber, overrides *rpctypes.StateOverride,
+) (hexutil.Uint64, error) {
 	blockNr := rpctypes.EthPendingBlockNumber
 	if blockNrOptional != nil {
 		blockNr = *blockNrOptional
@@ -324,6 +358,11 @@ func (b *Backend) EstimateGas(args evmtypes.TransactionArgs, blockNrOptional *rp
 		return 0, err
 	}
 
+	overridesBz, err := marshalStateOverride(overrides)
+	if err != nil {
+		return 0, err
+	}
+
 	header, err := b.TendermintBlockByNumber(blockNr)
 	if err != nil {
 		// the error message imitates geth behavior
@@ -336,6 +375,7 @@ func (b *Backend) EstimateGas(args evmtypes.TransactionArgs, blockNrOptional *rp
 		ProposerAddress: sdk.ConsAddress(header.Block.ProposerAddress),
 		ChainId:         b.chainID.Int64(),
 		Unencrypted:     b.allowUnencryptedTxs,
+		Overrides:       overridesBz,
 	}
 
 	// From ContextWithHeight: if the provided height is 0,
@@ -351,12 +391,16 @@ func (b *Backend) EstimateGas(args evmtypes.TransactionArgs, blockNrOptional *rp
 // DoCall performs a simulated call operation through the evmtypes. It returns the
 // estimated gas used on the operation or an error if fails.
 func (b *Backend) DoCall(
-	args evmtypes.CallArgs, blockNr rpctypes.BlockNumber,
+	args evmtypes.CallArgs, blockNr rpctypes.BlockNumber, overrides *rpctypes.StateOverride,
 ) (*evmtypes.MsgEthereumTxResponse, error) {
 	bz, err := json.Marshal(&args)
 	if err != nil {
 		return nil, err
 	}
+	overridesBz, err := marshalStateOverride(overrides)
+	if err != nil {
+		return nil, err
+	}
 	header, err := b.TendermintBlockByNumber(blockNr)
 	if err != nil {
 		// the error message imitates geth behavior
@@ -369,6 +413,7 @@ func (b *Backend) DoCall(
 		ProposerAddress: sdk.ConsAddress(header.Block.ProposerAddress),
 		ChainId:         b.chainID.Int64(),
 		Unencrypted:     b.allowUnencryptedTxs,
+		Overrides:       overridesBz,
 	}
 
 	// From ContextWithHeight: if the provided height is 0,
@@ -405,6 +450,135 @@ func (b *Backend) DoCall(
 	return res, nil
 }
 
+// Simulate executes ordered list of calls on top of the state of provided block. Each call observes
+// state changes of the previous calls. Reverted or failed calls don't interrupt the simulation and
+// are reported in the result of the call
+func (b *Backend) Simulate(
+	calls []evmtypes.CallArgs, blockNr rpctypes.BlockNumber, overrides *rpctypes.StateOverride,
+) ([]*rpctypes.SimulateCallResult, error) {
+	callsBz := make([][]byte, 0, len(calls))
+	for i := range calls {
+		bz, err := json.Marshal(&calls[i])
+		if err != nil {
+			return nil, err
+		}
+		callsBz = append(callsBz, bz)
+	}
+	overridesBz, err := marshalStateOverride(overrides)
+	if err != nil {
+		return nil, err
+	}
+	header, err := b.TendermintBlockByNumber(blockNr)
+	if err != nil {
+		// the error message imitates geth behavior
+		return nil, errors.New("header not found")
+	}
+
+	req := evmtypes.EthSimulateRequest{
+		Calls:           callsBz,
+		GasCap:          b.RPCGasCap(),
+		ProposerAddress: sdk.ConsAddress(header.Block.ProposerAddress),
+		ChainId:         b.chainID.Int64(),
+		Unencrypted:     b.allowUnencryptedTxs,
+		Overrides:       overridesBz,
+	}
+
+	ctx := rpctypes.ContextWithHeight(blockNr.Int64())
+	timeout := b.RPCEVMTimeout()
+
+	var cancel context.CancelFunc
+	if timeout > 0 {
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+	} else {
+		ctx, cancel = context.WithCancel(ctx)
+	}
+	defer cancel()
+
+	res, err := b.queryClient.EthSimulate(ctx, &req)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]*rpctypes.SimulateCallResult, 0, len(res.Results))
+	for _, callRes := range res.Results {
+		result := &rpctypes.SimulateCallResult{
+			ReturnData: callRes.Ret,
+			Logs:       evmtypes.LogsToEthereum(callRes.Logs),
+			GasUsed:    hexutil.Uint64(callRes.GasUsed),
+			Status:     hexutil.Uint64(ethtypes.ReceiptStatusSuccessful),
+		}
+		if callRes.Failed() {
+			result.Status = hexutil.Uint64(ethtypes.ReceiptStatusFailed)
+			result.Error = callRes.VmError
+			if strings.Contains(callRes.VmError, vm.ErrExecutionReverted.Error()) {
+				result.Error = evmtypes.NewExecErrorWithReason(callRes.Ret).Error()
+			}
+		}
+		if result.Logs == nil {
+			result.Logs = []*ethtypes.Log{}
+		}
+		results = append(results, result)
+	}
+	return results, nil
+}
+
+// CreateAccessList generates access list for the given call, which contains addresses and storage keys
+// accessed during execution, and returns it together with the gas used by the call with this list
+func (b *Backend) CreateAccessList(args evmtypes.CallArgs, blockNr rpctypes.BlockNumber) (*rpctypes.AccessListResult, error) {
+	bz, err := json.Marshal(&args)
+	if err != nil {
+		return nil, err
+	}
+	header, err := b.TendermintBlockByNumber(blockNr)
+	if err != nil {
+		// the error message imitates geth behavior
+		return nil, errors.New("header not found")
+	}
+
+	req := evmtypes.EthCallRequest{
+		Args:            bz,
+		GasCap:          b.RPCGasCap(),
+		ProposerAddress: sdk.ConsAddress(header.Block.ProposerAddress),
+		ChainId:         b.chainID.Int64(),
+		Unencrypted:     b.allowUnencryptedTxs,
+	}
+
+	ctx := rpctypes.ContextWithHeight(blockNr.Int64())
+	timeout := b.RPCEVMTimeout()
+
+	var cancel context.CancelFunc
+	if timeout > 0 {
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+	} else {
+		ctx, cancel = context.WithCancel(ctx)
+	}
+	defer cancel()
+
+	res, err := b.queryClient.CreateAccessList(ctx, &req)
+	if err != nil {
+		return nil, err
+	}
+
+	// Empty access list is encoded as an empty array, as in geth
+	accessList := ethtypes.AccessList{}
+	if len(res.AccessList) > 0 {
+		accessList = *res.AccessList.ToEthAccessList()
+	}
+	return &rpctypes.AccessListResult{
+		AccessList: &accessList,
+		GasUsed:    hexutil.Uint64(res.GasUsed),
+		Error:      res.VmError,
+	}, nil
+}
+
+// marshalStateOverride encodes state override for `EthCallRequest`. Nil override is encoded as empty bytes
+func marshalStateOverride(overrides *rpctypes.StateOverride) ([]byte, error) {
+	if overrides == nil || len(*overrides) == 0 {
+		return nil, nil
+	}
+	return json.Marshal(overrides)
+}
+
 // GasPrice returns the current gas price based on Ethermint's gas price oracle.
 func (b *Backend) GasPrice() (*hexutil.Big, error) {
 	var (
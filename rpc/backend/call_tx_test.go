@@ -8,6 +8,8 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
 	"github.com/ethereum/go-ethereum/rlp"
 	"google.golang.org/grpc/metadata"
 
@@ -38,6 +40,14 @@ func (suite *BackendTestSuite) TestResend() {
 		AccessList:           nil,
 		ChainID:              chainID,
 	}
+	estimateArgs := evmtypes.CallArgs{
+		To:                   callArgs.To,
+		MaxFeePerGas:         callArgs.MaxFeePerGas,
+		MaxPriorityFeePerGas: callArgs.MaxPriorityFeePerGas,
+		Value:                callArgs.Value,
+		Nonce:                callArgs.Nonce,
+		ChainID:              callArgs.ChainID,
+	}
 
 	testCases := []struct {
 		name         string
@@ -218,7 +228,7 @@ func (suite *BackendTestSuite) TestResend() {
 				_, err = RegisterBlockResults(client, 1)
 				suite.Require().NoError(err)
 				RegisterBaseFee(queryClient, baseFee)
-				RegisterEstimateGas(queryClient, callArgs)
+				RegisterEstimateGas(queryClient, estimateArgs)
 				RegisterParams(queryClient, &header, 1)
 				RegisterParamsWithoutHeader(queryClient, 1)
 				RegisterUnconfirmedTxsError(client, nil)
@@ -248,7 +258,7 @@ func (suite *BackendTestSuite) TestResend() {
 				_, err = RegisterBlockResults(client, 1)
 				suite.Require().NoError(err)
 				RegisterBaseFee(queryClient, baseFee)
-				RegisterEstimateGas(queryClient, callArgs)
+				RegisterEstimateGas(queryClient, estimateArgs)
 				RegisterParams(queryClient, &header, 1)
 				RegisterParamsWithoutHeader(queryClient, 1)
 				RegisterUnconfirmedTxsEmpty(client, nil)
@@ -397,6 +407,15 @@ func (suite *BackendTestSuite) TestDoCall() {
 	argsBz, err := json.Marshal(callArgs)
 	suite.Require().NoError(err)
 
+	from := tests.RandomEthAddress()
+	permittedCallArgs := evmtypes.CallArgs{
+		From:   &from,
+		To:     &toAddr,
+		Permit: &evmtypes.CallPermit{Expiry: 1000, Signature: make([]byte, 65)},
+	}
+	permittedArgsBz, err := json.Marshal(permittedCallArgs)
+	suite.Require().NoError(err)
+
 	testCases := []struct {
 		name         string
 		registerMock func()
@@ -433,6 +452,20 @@ func (suite *BackendTestSuite) TestDoCall() {
 			&evmtypes.MsgEthereumTxResponse{},
 			true,
 		},
+		{
+			"pass - Permit is forwarded to the query",
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				_, err := RegisterBlock(client, 1, bz)
+				suite.Require().NoError(err)
+				RegisterEthCall(queryClient, &evmtypes.EthCallRequest{Args: permittedArgsBz, ChainId: suite.backend.chainID.Int64()})
+			},
+			rpctypes.BlockNumber(1),
+			permittedCallArgs,
+			&evmtypes.MsgEthereumTxResponse{},
+			true,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -440,7 +473,7 @@ func (suite *BackendTestSuite) TestDoCall() {
 			suite.SetupTest() // reset test and queries
 			tc.registerMock()
 
-			msgEthTx, err := suite.backend.DoCall(tc.callArgs, tc.blockNum)
+			msgEthTx, err := suite.backend.DoCall(tc.callArgs, tc.blockNum, nil)
 
 			if tc.expPass {
 				suite.Require().Equal(tc.expEthTx, msgEthTx)
@@ -451,6 +484,164 @@ func (suite *BackendTestSuite) TestDoCall() {
 	}
 }
 
+func (suite *BackendTestSuite) TestSimulate() {
+	_, bz := suite.buildEthereumTx()
+	toAddr := tests.RandomEthAddress()
+	calls := []evmtypes.CallArgs{{To: &toAddr}, {To: &toAddr}}
+	callsBz := make([][]byte, 0, len(calls))
+	for i := range calls {
+		callBz, err := json.Marshal(&calls[i])
+		suite.Require().NoError(err)
+		callsBz = append(callsBz, callBz)
+	}
+	request := &evmtypes.EthSimulateRequest{Calls: callsBz, ChainId: suite.backend.chainID.Int64()}
+	log := &evmtypes.Log{Address: toAddr.Hex(), Data: []byte{1}, TxIndex: 0, Index: 0}
+
+	testCases := []struct {
+		name         string
+		registerMock func()
+		expResults   []*rpctypes.SimulateCallResult
+		expPass      bool
+	}{
+		{
+			"fail - Invalid request",
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				_, err := RegisterBlock(client, 1, bz)
+				suite.Require().NoError(err)
+				RegisterEthSimulateError(queryClient, request)
+			},
+			nil,
+			false,
+		},
+		{
+			"pass - Returned result for each call",
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				_, err := RegisterBlock(client, 1, bz)
+				suite.Require().NoError(err)
+				RegisterEthSimulate(queryClient, request, &evmtypes.EthSimulateResponse{
+					Results: []*evmtypes.MsgEthereumTxResponse{
+						{Ret: []byte{1}, GasUsed: 22000, Logs: []*evmtypes.Log{log}},
+						{GasUsed: 21500, VmError: vm.ErrExecutionReverted.Error()},
+					},
+				})
+			},
+			[]*rpctypes.SimulateCallResult{
+				{
+					ReturnData: []byte{1},
+					Logs:       evmtypes.LogsToEthereum([]*evmtypes.Log{log}),
+					GasUsed:    22000,
+					Status:     hexutil.Uint64(ethtypes.ReceiptStatusSuccessful),
+				},
+				{
+					Logs:    []*ethtypes.Log{},
+					GasUsed: 21500,
+					Status:  hexutil.Uint64(ethtypes.ReceiptStatusFailed),
+					Error:   vm.ErrExecutionReverted.Error(),
+				},
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("case %s", tc.name), func() {
+			suite.SetupTest() // reset test and queries
+			tc.registerMock()
+
+			results, err := suite.backend.Simulate(calls, rpctypes.BlockNumber(1), nil)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expResults, results)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *BackendTestSuite) TestCreateAccessList() {
+	_, bz := suite.buildEthereumTx()
+	toAddr := tests.RandomEthAddress()
+	callArgs := evmtypes.CallArgs{To: &toAddr}
+	argsBz, err := json.Marshal(callArgs)
+	suite.Require().NoError(err)
+	request := &evmtypes.EthCallRequest{Args: argsBz, ChainId: suite.backend.chainID.Int64()}
+
+	accessList := ethtypes.AccessList{{
+		Address:     tests.RandomEthAddress(),
+		StorageKeys: []common.Hash{common.BigToHash(big.NewInt(1))},
+	}}
+
+	testCases := []struct {
+		name         string
+		registerMock func()
+		expResult    *rpctypes.AccessListResult
+		expPass      bool
+	}{
+		{
+			"fail - Invalid request",
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				_, err := RegisterBlock(client, 1, bz)
+				suite.Require().NoError(err)
+				RegisterCreateAccessListError(queryClient, request)
+			},
+			nil,
+			false,
+		},
+		{
+			"pass - Empty access list",
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				_, err := RegisterBlock(client, 1, bz)
+				suite.Require().NoError(err)
+				RegisterCreateAccessList(queryClient, request, &evmtypes.CreateAccessListResponse{GasUsed: 21000})
+			},
+			&rpctypes.AccessListResult{AccessList: &ethtypes.AccessList{}, GasUsed: 21000},
+			true,
+		},
+		{
+			"pass - Returned access list",
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				_, err := RegisterBlock(client, 1, bz)
+				suite.Require().NoError(err)
+				RegisterCreateAccessList(queryClient, request, &evmtypes.CreateAccessListResponse{
+					AccessList: evmtypes.NewAccessList(&accessList),
+					GasUsed:    25000,
+					VmError:    vm.ErrExecutionReverted.Error(),
+				})
+			},
+			&rpctypes.AccessListResult{AccessList: &accessList, GasUsed: 25000, Error: vm.ErrExecutionReverted.Error()},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("case %s", tc.name), func() {
+			suite.SetupTest() // reset test and queries
+			tc.registerMock()
+
+			result, err := suite.backend.CreateAccessList(callArgs, rpctypes.BlockNumber(1))
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expResult, result)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
 func (suite *BackendTestSuite) TestGasPrice() {
 	defaultGasPrice := (*hexutil.Big)(big.NewInt(1))
 
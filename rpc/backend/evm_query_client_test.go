@@ -50,6 +50,16 @@ func RegisterTraceTransactionError(queryClient *mocks.EVMQueryClient, msgEthTx *
 		Return(nil, errortypes.ErrInvalidRequest)
 }
 
+func RegisterTraceTransactionPrivate(queryClient *mocks.EVMQueryClient, msgEthTx *evmtypes.MsgHandleTx, signature []byte) {
+	queryClient.On("TraceTxPrivate", mock.Anything, &evmtypes.QueryTraceTxRequest{Msg: msgEthTx, BlockNumber: 1, ChainId: 1291, TraceSignature: signature}).
+		Return(&evmtypes.QueryTraceTxResponse{Data: []byte{0x01, 0x02}}, nil)
+}
+
+func RegisterTraceTransactionPrivateError(queryClient *mocks.EVMQueryClient, msgEthTx *evmtypes.MsgHandleTx, signature []byte) {
+	queryClient.On("TraceTxPrivate", mock.Anything, &evmtypes.QueryTraceTxRequest{Msg: msgEthTx, BlockNumber: 1, ChainId: 1291, TraceSignature: signature}).
+		Return(nil, errortypes.ErrUnauthorized)
+}
+
 // TraceBlock
 func RegisterTraceBlock(queryClient *mocks.EVMQueryClient, txs []*evmtypes.MsgHandleTx) {
 	data := []byte{0x7b, 0x22, 0x74, 0x65, 0x73, 0x74, 0x22, 0x3a, 0x20, 0x22, 0x68, 0x65, 0x6c, 0x6c, 0x6f, 0x22, 0x7d}
@@ -63,6 +73,18 @@ func RegisterTraceBlockError(queryClient *mocks.EVMQueryClient) {
 		Return(nil, errortypes.ErrInvalidRequest)
 }
 
+// TraceCall
+func RegisterTraceCall(queryClient *mocks.EVMQueryClient, request *evmtypes.QueryTraceCallRequest) {
+	data := []byte{0x7b, 0x22, 0x74, 0x65, 0x73, 0x74, 0x22, 0x3a, 0x20, 0x22, 0x68, 0x65, 0x6c, 0x6c, 0x6f, 0x22, 0x7d}
+	queryClient.On("TraceCall", mock.Anything, request).
+		Return(&evmtypes.QueryTraceTxResponse{Data: data}, nil)
+}
+
+func RegisterTraceCallError(queryClient *mocks.EVMQueryClient, request *evmtypes.QueryTraceCallRequest) {
+	queryClient.On("TraceCall", mock.Anything, request).
+		Return(nil, errortypes.ErrInvalidRequest)
+}
+
 // Params
 func RegisterParams(queryClient *mocks.EVMQueryClient, header *metadata.MD, height int64) {
 	queryClient.On("Params", rpc.ContextWithHeight(height), &evmtypes.QueryParamsRequest{}, grpc.Header(header)).
@@ -150,8 +172,30 @@ func RegisterEthCallError(queryClient *mocks.EVMQueryClient, request *evmtypes.E
 		Return(nil, errortypes.ErrInvalidRequest)
 }
 
+// EthSimulate
+func RegisterEthSimulate(queryClient *mocks.EVMQueryClient, request *evmtypes.EthSimulateRequest, response *evmtypes.EthSimulateResponse) {
+	queryClient.On("EthSimulate", mock.Anything, request).
+		Return(response, nil)
+}
+
+func RegisterEthSimulateError(queryClient *mocks.EVMQueryClient, request *evmtypes.EthSimulateRequest) {
+	queryClient.On("EthSimulate", mock.Anything, request).
+		Return(nil, errortypes.ErrInvalidRequest)
+}
+
+// CreateAccessList
+func RegisterCreateAccessList(queryClient *mocks.EVMQueryClient, request *evmtypes.EthCallRequest, response *evmtypes.CreateAccessListResponse) {
+	queryClient.On("CreateAccessList", mock.Anything, request).
+		Return(response, nil)
+}
+
+func RegisterCreateAccessListError(queryClient *mocks.EVMQueryClient, request *evmtypes.EthCallRequest) {
+	queryClient.On("CreateAccessList", mock.Anything, request).
+		Return(nil, errortypes.ErrInvalidRequest)
+}
+
 // Estimate Gas
-func RegisterEstimateGas(queryClient *mocks.EVMQueryClient, args evmtypes.TransactionArgs) {
+func RegisterEstimateGas(queryClient *mocks.EVMQueryClient, args evmtypes.CallArgs) {
 	bz, _ := json.Marshal(args)
 	queryClient.On("EstimateGas", rpc.ContextWithHeight(1), &evmtypes.EthCallRequest{Args: bz, ChainId: args.ChainID.ToInt().Int64(), Unencrypted: false}).
 		Return(&evmtypes.EstimateGasResponse{}, nil)
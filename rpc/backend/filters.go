@@ -16,6 +16,7 @@
 package backend
 
 import (
+	tmrpctypes "github.com/cometbft/cometbft/rpc/core/types"
 	"github.com/ethereum/go-ethereum/common"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/pkg/errors"
@@ -35,6 +36,12 @@ func (b *Backend) GetLogs(hash common.Hash) ([][]*ethtypes.Log, error) {
 
 // GetLogsByHeight returns all the logs from all the ethereum transactions in a block.
 func (b *Backend) GetLogsByHeight(height *int64) ([][]*ethtypes.Log, error) {
+	if height != nil {
+		if blockLogs, ok := b.indexedBlockLogs(*height); ok {
+			return blockLogs, nil
+		}
+	}
+
 	// NOTE: we query the state in case the tx result logs are not persisted after an upgrade.
 	blockRes, err := b.TendermintBlockResultByNumber(height)
 	if err != nil {
@@ -44,6 +51,52 @@ func (b *Backend) GetLogsByHeight(height *int64) ([][]*ethtypes.Log, error) {
 	return GetLogsFromBlockResults(blockRes)
 }
 
+// BlockLogs returns all the logs from all the ethereum transactions in a block. Logs are read from the
+// receipts, stored by the indexer, and are parsed from the block result events if the block is not indexed.
+func (b *Backend) BlockLogs(blockRes *tmrpctypes.ResultBlockResults) ([][]*ethtypes.Log, error) {
+	if blockLogs, ok := b.indexedBlockLogs(blockRes.Height); ok {
+		return blockLogs, nil
+	}
+
+	return GetLogsFromBlockResults(blockRes)
+}
+
+// indexedBlockLogs returns the logs from the receipts of the block, stored by the indexer,
+// returns false if the receipts are not indexed.
+func (b *Backend) indexedBlockLogs(height int64) ([][]*ethtypes.Log, bool) {
+	if b.indexer == nil {
+		return nil, false
+	}
+	receipts, err := b.indexer.GetReceiptsByBlock(height)
+	if err != nil {
+		b.logger.Debug("block receipts are not indexed", "height", height, "error", err.Error())
+		return nil, false
+	}
+	blockLogs := make([][]*ethtypes.Log, 0, len(receipts))
+	for _, receipt := range receipts {
+		blockLogs = append(blockLogs, receipt.Logs)
+	}
+	return blockLogs, true
+}
+
+// LogIndexRange returns the range of blocks covered by the log index of the indexer, returns -1 if the log index
+// is empty. Returns error if the indexer is disabled.
+func (b *Backend) LogIndexRange() (int64, int64, error) {
+	if b.indexer == nil {
+		return 0, 0, errors.New("log index is not available, indexer is disabled")
+	}
+	return b.indexer.LogIndexRange()
+}
+
+// LogBlocks returns the ordered numbers of the blocks in `[from, to]`, which may contain logs matching the
+// addresses and topics. The blocks must be covered by the log index of the indexer.
+func (b *Backend) LogBlocks(from, to int64, addresses []common.Address, topics [][]common.Hash) ([]int64, error) {
+	if b.indexer == nil {
+		return nil, errors.New("log index is not available, indexer is disabled")
+	}
+	return b.indexer.GetLogBlocks(from, to, addresses, topics)
+}
+
 // BloomStatus returns the BloomBitsBlocks and the number of processed sections maintained
 // by the chain indexer.
 func (b *Backend) BloomStatus() (uint64, uint64) {
@@ -3,10 +3,18 @@ package backend
 import (
 	"encoding/json"
 
+	dbm "github.com/cometbft/cometbft-db"
+	abci "github.com/cometbft/cometbft/abci/types"
+	tmlog "github.com/cometbft/cometbft/libs/log"
+	tmrpctypes "github.com/cometbft/cometbft/rpc/core/types"
 	tmtypes "github.com/cometbft/cometbft/types"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/common"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/gogo/protobuf/proto"
 
+	"swisstronik/indexer"
 	"swisstronik/rpc/backend/mocks"
 	ethrpc "swisstronik/rpc/types"
 	evmtypes "swisstronik/x/evm/types"
@@ -88,6 +96,147 @@ func (suite *BackendTestSuite) TestGetLogs() {
 	}
 }
 
+func (suite *BackendTestSuite) TestBlockLogs() {
+	msgHandleTx, _ := suite.buildEthereumTx()
+	txBz := suite.signAndEncodeEthTx(msgHandleTx)
+	txHash := msgHandleTx.AsTransaction().Hash()
+	block := &tmtypes.Block{Header: tmtypes.Header{Height: 1, ChainID: "test"}, Data: tmtypes.Data{Txs: []tmtypes.Tx{txBz}}}
+
+	logs := []*evmtypes.Log{
+		{Address: common.Address{}.Hex(), Data: []byte{1}},
+		{Address: common.Address{}.Hex(), Data: []byte{2}},
+	}
+	data, err := proto.Marshal(&sdk.TxMsgData{MsgResponses: []*codectypes.Any{
+		codectypes.UnsafePackAny(&evmtypes.MsgEthereumTxResponse{Hash: txHash.Hex(), Logs: logs}),
+	}})
+	suite.Require().NoError(err)
+	txResults := []*abci.ResponseDeliverTx{
+		{
+			Code: 0,
+			Data: data,
+			Events: []abci.Event{
+				{Type: evmtypes.EventTypeEthereumTx, Attributes: []abci.EventAttribute{
+					{Key: "ethereumTxHash", Value: txHash.Hex()},
+					{Key: "txIndex", Value: "0"},
+					{Key: "amount", Value: "0"},
+					{Key: "txGasUsed", Value: "21000"},
+				}},
+			},
+		},
+	}
+
+	testCases := []struct {
+		name     string
+		indexed  bool
+		blockRes *tmrpctypes.ResultBlockResults
+		expLogs  []uint
+	}{
+		{
+			"pass - block is not indexed, logs are parsed from events",
+			false,
+			&tmrpctypes.ResultBlockResults{
+				Height: 1,
+				TxsResults: []*abci.ResponseDeliverTx{{Code: 0, Events: []abci.Event{{
+					Type: evmtypes.EventTypeTxLog,
+					Attributes: []abci.EventAttribute{
+						{Key: evmtypes.AttributeKeyTxLog, Value: `{"address":"0x0000000000000000000000000000000000000000","data":"AQ==","index":"0"}`},
+					},
+				}}}},
+			},
+			[]uint{0},
+		},
+		{
+			"pass - logs are read from indexed receipts",
+			true,
+			&tmrpctypes.ResultBlockResults{Height: 1, TxsResults: txResults},
+			[]uint{0, 1},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			suite.backend.indexer = indexer.NewKVIndexer(dbm.NewMemDB(), tmlog.NewNopLogger(), suite.backend.clientCtx)
+			if tc.indexed {
+				suite.Require().NoError(suite.backend.indexer.IndexBlock(block, txResults))
+			}
+
+			blockLogs, err := suite.backend.BlockLogs(tc.blockRes)
+			suite.Require().NoError(err)
+			suite.Require().Len(blockLogs, 1)
+			suite.Require().Len(blockLogs[0], len(tc.expLogs))
+			for i, index := range tc.expLogs {
+				suite.Require().Equal(index, blockLogs[0][i].Index)
+				suite.Require().Equal([]byte{byte(i + 1)}, blockLogs[0][i].Data)
+			}
+		})
+	}
+}
+
+func (suite *BackendTestSuite) TestLogBlocks() {
+	msgHandleTx, _ := suite.buildEthereumTx()
+	txBz := suite.signAndEncodeEthTx(msgHandleTx)
+	txHash := msgHandleTx.AsTransaction().Hash()
+	block := &tmtypes.Block{Header: tmtypes.Header{Height: 1, ChainID: "test"}, Data: tmtypes.Data{Txs: []tmtypes.Tx{txBz}}}
+
+	data, err := proto.Marshal(&sdk.TxMsgData{MsgResponses: []*codectypes.Any{
+		codectypes.UnsafePackAny(&evmtypes.MsgEthereumTxResponse{Hash: txHash.Hex(), Logs: []*evmtypes.Log{
+			{Address: common.Address{}.Hex(), Data: []byte{1}},
+		}}),
+	}})
+	suite.Require().NoError(err)
+	txResults := []*abci.ResponseDeliverTx{
+		{
+			Code: 0,
+			Data: data,
+			Events: []abci.Event{
+				{Type: evmtypes.EventTypeEthereumTx, Attributes: []abci.EventAttribute{
+					{Key: "ethereumTxHash", Value: txHash.Hex()},
+					{Key: "txIndex", Value: "0"},
+					{Key: "amount", Value: "0"},
+					{Key: "txGasUsed", Value: "21000"},
+				}},
+			},
+		},
+	}
+
+	suite.Run("fail - indexer is disabled", func() {
+		suite.SetupTest()
+		suite.backend.indexer = nil
+
+		_, _, err := suite.backend.LogIndexRange()
+		suite.Require().Error(err)
+		_, err = suite.backend.LogBlocks(1, 1, nil, nil)
+		suite.Require().Error(err)
+	})
+
+	suite.Run("pass - blocks and their logs are read from the indexer", func() {
+		suite.SetupTest()
+		suite.backend.indexer = indexer.NewKVIndexer(dbm.NewMemDB(), tmlog.NewNopLogger(), suite.backend.clientCtx)
+		suite.Require().NoError(suite.backend.indexer.IndexBlock(block, txResults))
+
+		first, last, err := suite.backend.LogIndexRange()
+		suite.Require().NoError(err)
+		suite.Require().Equal(int64(1), first)
+		suite.Require().Equal(int64(1), last)
+
+		heights, err := suite.backend.LogBlocks(1, 1, []common.Address{{}}, nil)
+		suite.Require().NoError(err)
+		suite.Require().Equal([]int64{1}, heights)
+		heights, err = suite.backend.LogBlocks(1, 1, []common.Address{{1}}, nil)
+		suite.Require().NoError(err)
+		suite.Require().Empty(heights)
+
+		// block results are not queried
+		height := int64(1)
+		blockLogs, err := suite.backend.GetLogsByHeight(&height)
+		suite.Require().NoError(err)
+		suite.Require().Len(blockLogs, 1)
+		suite.Require().Len(blockLogs[0], 1)
+		suite.Require().Equal([]byte{1}, blockLogs[0][0].Data)
+	})
+}
+
 func (suite *BackendTestSuite) TestBloomStatus() {
 	testCases := []struct {
 		name         string
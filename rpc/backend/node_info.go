@@ -340,6 +340,11 @@ func (b *Backend) RPCBlockRangeCap() int32 {
 	return b.cfg.JSONRPC.BlockRangeCap
 }
 
+// RPCIndexedBlockRangeCap defines the max range of blocks, covered by the log index, allowed for `eth_getLogs` query.
+func (b *Backend) RPCIndexedBlockRangeCap() int32 {
+	return b.cfg.JSONRPC.IndexedBlockRangeCap
+}
+
 // RPCMinGasPrice returns the minimum gas price for a transaction obtained from
 // the node config. If set value is 0, it will default to 20.
 func (b *Backend) RPCMinGasPrice() int64 {
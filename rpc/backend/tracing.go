@@ -22,6 +22,7 @@ import (
 	tmrpctypes "github.com/cometbft/cometbft/rpc/core/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/pkg/errors"
 
 	rpctypes "swisstronik/rpc/types"
@@ -31,28 +32,75 @@ import (
 // TraceTransaction returns the structured logs created during the execution of EVM
 // and returns them as a JSON object.
 func (b *Backend) TraceTransaction(hash common.Hash, config *evmtypes.TraceConfig) (interface{}, error) {
+	traceTxRequest, contextHeight, err := b.traceTxRequest(hash)
+	if err != nil {
+		return nil, err
+	}
+
+	if config != nil {
+		traceTxRequest.TraceConfig = config
+	}
+
+	traceResult, err := b.queryClient.TraceTx(rpctypes.ContextWithHeight(contextHeight), traceTxRequest)
+	if err != nil {
+		return nil, err
+	}
+
+	// Response format is unknown due to custom tracer config param
+	// More information can be found here https://geth.ethereum.org/docs/dapp/tracing-filtered
+	var decodedResult interface{}
+	err = json.Unmarshal(traceResult.Data, &decodedResult)
+	if err != nil {
+		return nil, err
+	}
+
+	return decodedResult, nil
+}
+
+// TraceTransactionPrivate returns the call tree and revert reasons of decrypted execution of the encrypted
+// transaction. Signature over EIP-712 typed data, created by `eip712.WrapTraceTransactionToTypedData`, should
+// be produced by the sender of the transaction and is verified by SGXVM. Result is encrypted by SGXVM to the key,
+// which was used by the sender to encrypt transaction data, and can be decrypted with `deoxys.DecryptECDH`
+func (b *Backend) TraceTransactionPrivate(hash common.Hash, signature hexutil.Bytes) (hexutil.Bytes, error) {
+	traceTxRequest, contextHeight, err := b.traceTxRequest(hash)
+	if err != nil {
+		return nil, err
+	}
+	traceTxRequest.TraceSignature = signature
+
+	traceResult, err := b.queryClient.TraceTxPrivate(rpctypes.ContextWithHeight(contextHeight), traceTxRequest)
+	if err != nil {
+		return nil, err
+	}
+
+	return traceResult.Data, nil
+}
+
+// traceTxRequest creates request to trace transaction with provided hash. It returns the request and the height
+// of the context of block beginning
+func (b *Backend) traceTxRequest(hash common.Hash) (*evmtypes.QueryTraceTxRequest, int64, error) {
 	// Get transaction by hash
 	transaction, err := b.GetTxByEthHash(hash)
 	if err != nil {
 		b.logger.Debug("tx not found", "hash", hash)
-		return nil, err
+		return nil, 0, err
 	}
 
 	// check if block number is 0
 	if transaction.Height == 0 {
-		return nil, errors.New("genesis is not traceable")
+		return nil, 0, errors.New("genesis is not traceable")
 	}
 
 	blk, err := b.TendermintBlockByNumber(rpctypes.BlockNumber(transaction.Height))
 	if err != nil {
 		b.logger.Debug("block not found", "height", transaction.Height)
-		return nil, err
+		return nil, 0, err
 	}
 
 	// check tx index is not out of bound
 	if uint32(len(blk.Block.Txs)) < transaction.TxIndex {
 		b.logger.Debug("tx index out of bounds", "index", transaction.TxIndex, "hash", hash.String(), "height", blk.Block.Height)
-		return nil, fmt.Errorf("transaction not included in block %v", blk.Block.Height)
+		return nil, 0, fmt.Errorf("transaction not included in block %v", blk.Block.Height)
 	}
 
 	var predecessors []*evmtypes.MsgHandleTx
@@ -75,7 +123,7 @@ func (b *Backend) TraceTransaction(hash common.Hash, config *evmtypes.TraceConfi
 	tx, err := b.clientCtx.TxConfig.TxDecoder()(blk.Block.Txs[transaction.TxIndex])
 	if err != nil {
 		b.logger.Debug("tx not found", "hash", hash)
-		return nil, err
+		return nil, 0, err
 	}
 
 	// add predecessor messages in current cosmos tx
@@ -90,10 +138,10 @@ func (b *Backend) TraceTransaction(hash common.Hash, config *evmtypes.TraceConfi
 	ethMessage, ok := tx.GetMsgs()[transaction.MsgIndex].(*evmtypes.MsgHandleTx)
 	if !ok {
 		b.logger.Debug("invalid transaction type", "type", fmt.Sprintf("%T", tx))
-		return nil, fmt.Errorf("invalid transaction type %T", tx)
+		return nil, 0, fmt.Errorf("invalid transaction type %T", tx)
 	}
 
-	traceTxRequest := evmtypes.QueryTraceTxRequest{
+	traceTxRequest := &evmtypes.QueryTraceTxRequest{
 		Msg:             ethMessage,
 		Predecessors:    predecessors,
 		BlockNumber:     blk.Block.Height,
@@ -104,23 +152,43 @@ func (b *Backend) TraceTransaction(hash common.Hash, config *evmtypes.TraceConfi
 		Unencrypted:     b.allowUnencryptedTxs,
 	}
 
-	if config != nil {
-		traceTxRequest.TraceConfig = config
-	}
-
 	// minus one to get the context of block beginning
 	contextHeight := transaction.Height - 1
 	if contextHeight < 1 {
 		// 0 is a special value in `ContextWithHeight`
 		contextHeight = 1
 	}
-	traceResult, err := b.queryClient.TraceTx(rpctypes.ContextWithHeight(contextHeight), &traceTxRequest)
+	return traceTxRequest, contextHeight, nil
+}
+
+// TraceCall executes provided call arguments on top of the state of provided block and returns
+// the structured logs created during the execution of EVM. State changes are not persisted
+func (b *Backend) TraceCall(args evmtypes.CallArgs, blockNr rpctypes.BlockNumber, config *evmtypes.TraceConfig) (interface{}, error) {
+	bz, err := json.Marshal(&args)
+	if err != nil {
+		return nil, err
+	}
+	header, err := b.TendermintBlockByNumber(blockNr)
+	if err != nil {
+		// the error message imitates geth behavior
+		return nil, errors.New("header not found")
+	}
+
+	traceCallRequest := evmtypes.QueryTraceCallRequest{
+		Args:            bz,
+		GasCap:          b.RPCGasCap(),
+		TraceConfig:     config,
+		ProposerAddress: sdk.ConsAddress(header.Block.ProposerAddress),
+		ChainId:         b.chainID.Int64(),
+		Unencrypted:     b.allowUnencryptedTxs,
+	}
+
+	traceResult, err := b.queryClient.TraceCall(rpctypes.ContextWithHeight(blockNr.Int64()), &traceCallRequest)
 	if err != nil {
 		return nil, err
 	}
 
 	// Response format is unknown due to custom tracer config param
-	// More information can be found here https://geth.ethereum.org/docs/dapp/tracing-filtered
 	var decodedResult interface{}
 	err = json.Unmarshal(traceResult.Data, &decodedResult)
 	if err != nil {
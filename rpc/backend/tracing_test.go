@@ -1,6 +1,7 @@
 package backend
 
 import (
+	"encoding/json"
 	"fmt"
 
 	dbm "github.com/cometbft/cometbft-db"
@@ -10,12 +11,17 @@ import (
 	"github.com/cometbft/cometbft/types"
 	tmtypes "github.com/cometbft/cometbft/types"
 	"github.com/cosmos/cosmos-sdk/crypto"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
 
 	"swisstronik/crypto/ethsecp256k1"
+	"swisstronik/ethereum/eip712"
 	"swisstronik/indexer"
 	"swisstronik/rpc/backend/mocks"
+	"swisstronik/tests"
 	"swisstronik/utils"
 	evmtypes "swisstronik/x/evm/types"
 )
@@ -200,6 +206,159 @@ func (suite *BackendTestSuite) TestTraceTransaction() {
 	}
 }
 
+func (suite *BackendTestSuite) TestTraceTransactionPrivate() {
+	from, priv := tests.RandomEthAddressWithPrivateKey()
+	_, otherPriv := tests.RandomEthAddressWithPrivateKey()
+
+	queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+	RegisterParamsWithoutHeader(queryClient, 1)
+
+	msgHandleTx, _ := suite.buildEthereumTx()
+	msgHandleTx.From = from.String()
+	err := msgHandleTx.Sign(ethtypes.LatestSigner(suite.backend.ChainConfig()), tests.NewTestSigner(priv))
+	suite.Require().NoError(err)
+	tx, err := msgHandleTx.BuildTx(suite.backend.clientCtx.TxConfig.NewTxBuilder(), utils.BaseDenom)
+	suite.Require().NoError(err)
+	txBz, err := suite.backend.clientCtx.TxConfig.TxEncoder()(tx)
+	suite.Require().NoError(err)
+	txHash := msgHandleTx.AsTransaction().Hash()
+
+	block := &types.Block{Header: types.Header{Height: 1}, Data: types.Data{Txs: []types.Tx{txBz}}}
+	responseBlock := []*abci.ResponseDeliverTx{
+		{
+			Code: 0,
+			Events: []abci.Event{
+				{Type: evmtypes.EventTypeEthereumTx, Attributes: []abci.EventAttribute{
+					{Key: "ethereumTxHash", Value: txHash.Hex()},
+					{Key: "txIndex", Value: "0"},
+					{Key: "amount", Value: "1000"},
+					{Key: "txGasUsed", Value: "21000"},
+					{Key: "txHash", Value: ""},
+					{Key: "recipient", Value: "0x775b87ef5D82ca211811C1a02CE0fE0CA3a455d7"},
+				}},
+			},
+		},
+	}
+
+	typedData := eip712.WrapTraceTransactionToTypedData(suite.backend.chainID.Uint64(), txHash)
+	sigHash, _, err := apitypes.TypedDataAndHash(typedData)
+	suite.Require().NoError(err)
+	sign := func(priv cryptotypes.PrivKey) hexutil.Bytes {
+		signature, err := priv.Sign(sigHash)
+		suite.Require().NoError(err)
+		return signature
+	}
+
+	testCases := []struct {
+		name      string
+		signature hexutil.Bytes
+		expPass   bool
+	}{
+		{
+			"fail - invalid signature",
+			hexutil.Bytes{0x01},
+			false,
+		},
+		{
+			"fail - signed not by the sender",
+			sign(otherPriv),
+			false,
+		},
+		{
+			"pass - signed by the sender",
+			sign(priv),
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("case %s", tc.name), func() {
+			suite.SetupTest() // reset test and queries
+			queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+			client := suite.backend.clientCtx.Client.(*mocks.Client)
+			_, err := RegisterBlock(client, 1, txBz)
+			suite.Require().NoError(err)
+			// signature is verified by SGXVM
+			if tc.expPass {
+				RegisterTraceTransactionPrivate(queryClient, msgHandleTx, tc.signature)
+			} else {
+				RegisterTraceTransactionPrivateError(queryClient, msgHandleTx, tc.signature)
+			}
+
+			db := dbm.NewMemDB()
+			suite.backend.indexer = indexer.NewKVIndexer(db, tmlog.NewNopLogger(), suite.backend.clientCtx)
+			err = suite.backend.indexer.IndexBlock(block, responseBlock)
+			suite.Require().NoError(err)
+
+			result, err := suite.backend.TraceTransactionPrivate(txHash, tc.signature)
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(hexutil.Bytes{0x01, 0x02}, result)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *BackendTestSuite) TestTraceCall() {
+	_, bz := suite.buildEthereumTx()
+	toAddr := tests.RandomEthAddress()
+	callArgs := evmtypes.CallArgs{To: &toAddr}
+	argsBz, err := json.Marshal(callArgs)
+	suite.Require().NoError(err)
+	config := &evmtypes.TraceConfig{Tracer: "callTracer"}
+	request := &evmtypes.QueryTraceCallRequest{Args: argsBz, TraceConfig: config, ChainId: suite.backend.chainID.Int64()}
+
+	testCases := []struct {
+		name         string
+		registerMock func()
+		expResult    interface{}
+		expPass      bool
+	}{
+		{
+			"fail - Invalid request",
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				_, err := RegisterBlock(client, 1, bz)
+				suite.Require().NoError(err)
+				RegisterTraceCallError(queryClient, request)
+			},
+			nil,
+			false,
+		},
+		{
+			"pass - Returned trace",
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				_, err := RegisterBlock(client, 1, bz)
+				suite.Require().NoError(err)
+				RegisterTraceCall(queryClient, request)
+			},
+			map[string]interface{}{"test": "hello"},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("case %s", tc.name), func() {
+			suite.SetupTest() // reset test and queries
+			tc.registerMock()
+
+			result, err := suite.backend.TraceCall(callArgs, 1, config)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expResult, result)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
 func (suite *BackendTestSuite) TestTraceBlock() {
 	msgEthTx, bz := suite.buildEthereumTx()
 	emptyBlock := tmtypes.MakeBlock(1, []tmtypes.Tx{}, nil, nil)
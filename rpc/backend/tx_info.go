@@ -21,6 +21,7 @@ import (
 	"math/big"
 
 	errorsmod "cosmossdk.io/errors"
+	abci "github.com/cometbft/cometbft/abci/types"
 	tmrpcclient "github.com/cometbft/cometbft/rpc/client"
 	tmrpctypes "github.com/cometbft/cometbft/rpc/core/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -177,23 +178,16 @@ func (b *Backend) GetTransactionReceipt(hash common.Hash) (map[string]interface{
 		return nil, err
 	}
 
-	cumulativeGasUsed := uint64(0)
 	blockRes, err := b.TendermintBlockResultByNumber(&res.Height)
 	if err != nil {
 		b.logger.Debug("failed to retrieve block results", "height", res.Height, "error", err.Error())
 		return nil, nil
 	}
+	cumulativeGasUsed := uint64(0)
 	for _, txResult := range blockRes.TxsResults[0:res.TxIndex] {
 		cumulativeGasUsed += uint64(txResult.GasUsed)
 	}
-	cumulativeGasUsed += res.CumulativeGasUsed
 
-	var status hexutil.Uint
-	if res.Failed {
-		status = hexutil.Uint(ethtypes.ReceiptStatusFailed)
-	} else {
-		status = hexutil.Uint(ethtypes.ReceiptStatusSuccessful)
-	}
 	chainID, err := b.ChainID()
 	if err != nil {
 		return nil, err
@@ -204,8 +198,7 @@ func (b *Backend) GetTransactionReceipt(hash common.Hash) (map[string]interface{
 		return nil, err
 	}
 
-	// parse tx logs from events
-	logs, err := TxLogsFromEvents(blockRes.TxsResults[res.TxIndex].Events, int(res.MsgIndex))
+	logs, err := b.GetTransactionLogs(hash, res, blockRes)
 	if err != nil {
 		b.logger.Debug("failed to parse logs", "hash", hexTx, "error", err.Error())
 	}
@@ -225,22 +218,202 @@ func (b *Backend) GetTransactionReceipt(hash common.Hash) (map[string]interface{
 		return nil, errors.New("can't find index of ethereum tx")
 	}
 
+	var baseFee *big.Int
+	if _, ok := txData.(*evmtypes.DynamicFeeTx); ok {
+		baseFee, err = b.BaseFee(blockRes)
+		if err != nil {
+			// tolerate the error for pruned node.
+			b.logger.Error("fetch basefee failed, node is pruned?", "height", res.Height, "error", err)
+		}
+	}
+
+	blockHash := common.BytesToHash(resBlock.Block.Header.Hash())
+	return b.formatTxReceipt(ethMsg, txData, res, blockHash, cumulativeGasUsed, from, logs, baseFee), nil
+}
+
+// GetBlockReceipts returns the receipts of all ethereum transactions in the block identified by number or hash.
+// The block and its results are fetched once and all receipts are built in a single pass over the block.
+func (b *Backend) GetBlockReceipts(blockNrOrHash rpctypes.BlockNumberOrHash) ([]map[string]interface{}, error) {
+	b.logger.Debug("eth_getBlockReceipts", "block number or hash", blockNrOrHash)
+
+	var (
+		resBlock *tmrpctypes.ResultBlock
+		err      error
+	)
+	switch {
+	case blockNrOrHash.BlockHash != nil:
+		resBlock, err = b.TendermintBlockByHash(*blockNrOrHash.BlockHash)
+	case blockNrOrHash.BlockNumber != nil:
+		resBlock, err = b.TendermintBlockByNumber(*blockNrOrHash.BlockNumber)
+	default:
+		return nil, errors.New("types BlockHash and BlockNumber cannot be both nil")
+	}
+	if err != nil {
+		b.logger.Debug("block not found", "block number or hash", blockNrOrHash, "error", err.Error())
+		return nil, nil
+	}
+	if resBlock == nil || resBlock.Block == nil {
+		return nil, nil
+	}
+
+	height := resBlock.Block.Height
+	blockRes, err := b.TendermintBlockResultByNumber(&height)
+	if err != nil {
+		b.logger.Debug("failed to retrieve block results", "height", height, "error", err.Error())
+		return nil, nil
+	}
+
+	chainID, err := b.ChainID()
+	if err != nil {
+		return nil, err
+	}
+
+	// logs of the block are read from the receipts, stored by the indexer, if they are available
+	indexedLogs := make(map[common.Hash][]*ethtypes.Log)
+	if b.indexer != nil {
+		receipts, err := b.indexer.GetReceiptsByBlock(height)
+		if err != nil {
+			b.logger.Debug("block receipts are not indexed", "height", height, "error", err.Error())
+		}
+		for _, receipt := range receipts {
+			indexedLogs[receipt.TxHash] = receipt.Logs
+		}
+	}
+
+	var (
+		baseFee        *big.Int
+		baseFeeFetched bool
+		// gas used by the previous cosmos txs in the block
+		blockGasUsed uint64
+		ethTxIndex   int32
+	)
+	blockHash := common.BytesToHash(resBlock.Block.Header.Hash())
+	receipts := make([]map[string]interface{}, 0)
+	for txIndex, txBz := range resBlock.Block.Txs {
+		result := blockRes.TxsResults[txIndex]
+		prevBlockGasUsed := blockGasUsed
+		blockGasUsed += uint64(result.GasUsed)
+
+		if !rpctypes.TxSuccessOrExceedsBlockGasLimit(result) {
+			continue
+		}
+
+		tx, err := b.clientCtx.TxConfig.TxDecoder()(txBz)
+		if err != nil {
+			b.logger.Debug("failed to decode transaction in block", "height", height, "error", err.Error())
+			continue
+		}
+
+		parsedTxs, err := rpctypes.ParseTxResult(result, tx)
+		if err != nil {
+			b.logger.Debug("failed to parse tx events", "height", height, "txIndex", txIndex, "error", err.Error())
+			continue
+		}
+
+		// gas used by the eth msgs of the cosmos tx
+		var txGasUsed uint64
+		for msgIndex, msg := range tx.GetMsgs() {
+			ethMsg, ok := msg.(*evmtypes.MsgHandleTx)
+			if !ok {
+				continue
+			}
+			res := &ethermint.TxResult{
+				Height:     height,
+				TxIndex:    uint32(txIndex),
+				MsgIndex:   uint32(msgIndex),
+				EthTxIndex: ethTxIndex,
+			}
+			ethTxIndex++
+
+			if result.Code != abci.CodeTypeOK {
+				// exceeds block gas limit scenario, gas limit is charged by ante handler.
+				res.GasUsed = ethMsg.GetGas()
+				res.Failed = true
+			} else {
+				parsedTx := parsedTxs.GetTxByMsgIndex(msgIndex)
+				if parsedTx == nil {
+					b.logger.Debug("msg index not found in events", "height", height, "txIndex", txIndex, "msgIndex", msgIndex)
+					continue
+				}
+				res.GasUsed = parsedTx.GasUsed
+				res.Failed = parsedTx.Failed
+				if parsedTx.StateRoot != (common.Hash{}) {
+					res.StateRoot = parsedTx.StateRoot.Bytes()
+				}
+			}
+			txGasUsed += res.GasUsed
+			res.CumulativeGasUsed = txGasUsed
+
+			txData, err := evmtypes.UnpackTxData(ethMsg.Data)
+			if err != nil {
+				b.logger.Error("failed to unpack tx data", "error", err.Error())
+				return nil, err
+			}
+
+			from, err := ethMsg.GetSender(chainID.ToInt())
+			if err != nil {
+				return nil, err
+			}
+
+			hash := ethMsg.AsTransaction().Hash()
+			logs, found := indexedLogs[hash]
+			if !found && !res.Failed {
+				if logs, err = TxLogsFromEvents(result.Events, msgIndex); err != nil {
+					b.logger.Debug("failed to parse logs", "hash", hash.Hex(), "error", err.Error())
+				}
+			}
+
+			if _, ok := txData.(*evmtypes.DynamicFeeTx); ok && !baseFeeFetched {
+				baseFeeFetched = true
+				if baseFee, err = b.BaseFee(blockRes); err != nil {
+					// tolerate the error for pruned node.
+					b.logger.Error("fetch basefee failed, node is pruned?", "height", height, "error", err)
+				}
+			}
+
+			receipts = append(receipts, b.formatTxReceipt(ethMsg, txData, res, blockHash, prevBlockGasUsed, from, logs, baseFee))
+		}
+	}
+
+	return receipts, nil
+}
+
+// formatTxReceipt returns the receipt of the ethereum tx in the JSON-RPC format. Gas used by the cosmos
+// txs, preceding the tx in the block, is added to its cumulative gas used. Base fee of the block is only
+// used for dynamic fee txs and is nil if it can't be fetched.
+func (b *Backend) formatTxReceipt(
+	ethMsg *evmtypes.MsgHandleTx,
+	txData evmtypes.TxData,
+	res *ethermint.TxResult,
+	blockHash common.Hash,
+	prevGasUsed uint64,
+	from common.Address,
+	logs []*ethtypes.Log,
+	baseFee *big.Int,
+) map[string]interface{} {
+	var status hexutil.Uint
+	if res.Failed {
+		status = hexutil.Uint(ethtypes.ReceiptStatusFailed)
+	} else {
+		status = hexutil.Uint(ethtypes.ReceiptStatusSuccessful)
+	}
+
 	receipt := map[string]interface{}{
 		// Consensus fields: These fields are defined by the Yellow Paper
 		"status":            status,
-		"cumulativeGasUsed": hexutil.Uint64(cumulativeGasUsed),
+		"cumulativeGasUsed": hexutil.Uint64(prevGasUsed + res.CumulativeGasUsed),
 		"logsBloom":         ethtypes.BytesToBloom(ethtypes.LogsBloom(logs)),
 		"logs":              logs,
 
 		// Implementation fields: These fields are added by geth when processing a transaction.
 		// They are stored in the chain database.
-		"transactionHash": hash,
+		"transactionHash": ethMsg.AsTransaction().Hash(),
 		"contractAddress": nil,
 		"gasUsed":         hexutil.Uint64(b.GetGasUsed(res, txData.GetGasPrice(), txData.GetGas())),
 
 		// Inclusion information: These fields provide information about the inclusion of the
 		// transaction corresponding to this receipt.
-		"blockHash":        common.BytesToHash(resBlock.Block.Header.Hash()).Hex(),
+		"blockHash":        blockHash.Hex(),
 		"blockNumber":      hexutil.Uint64(res.Height),
 		"transactionIndex": hexutil.Uint64(res.EthTxIndex),
 
@@ -254,22 +427,41 @@ func (b *Backend) GetTransactionReceipt(hash common.Hash) (map[string]interface{
 		receipt["logs"] = [][]*ethtypes.Log{}
 	}
 
+	// intermediate state root is not available for transactions, executed before it was introduced.
+	// It commits to EVM store writes of the transaction, not to the full state
+	if len(res.StateRoot) > 0 {
+		receipt["root"] = hexutil.Bytes(res.StateRoot)
+	}
+
 	// If the ContractAddress is 20 0x0 bytes, assume it is not a contract creation
 	if txData.GetTo() == nil {
 		receipt["contractAddress"] = crypto.CreateAddress(from, txData.GetNonce())
 	}
 
-	if dynamicTx, ok := txData.(*evmtypes.DynamicFeeTx); ok {
-		baseFee, err := b.BaseFee(blockRes)
-		if err != nil {
-			// tolerate the error for pruned node.
-			b.logger.Error("fetch basefee failed, node is pruned?", "height", res.Height, "error", err)
-		} else {
-			receipt["effectiveGasPrice"] = hexutil.Big(*dynamicTx.EffectiveGasPrice(baseFee))
+	if dynamicTx, ok := txData.(*evmtypes.DynamicFeeTx); ok && baseFee != nil {
+		receipt["effectiveGasPrice"] = hexutil.Big(*dynamicTx.EffectiveGasPrice(baseFee))
+	}
+
+	return receipt
+}
+
+// GetTransactionLogs returns the logs of the transaction identified by hash. Logs are read from the receipt,
+// stored by the indexer, and are parsed from the tx result events if the receipt is not indexed.
+func (b *Backend) GetTransactionLogs(
+	hash common.Hash,
+	res *ethermint.TxResult,
+	blockRes *tmrpctypes.ResultBlockResults,
+) ([]*ethtypes.Log, error) {
+	if b.indexer != nil {
+		receipt, err := b.indexer.GetReceiptByTxHash(hash)
+		if err == nil {
+			return receipt.Logs, nil
 		}
+		b.logger.Debug("receipt is not indexed", "hash", hash.Hex(), "error", err.Error())
 	}
 
-	return receipt, nil
+	// parse tx logs from events
+	return TxLogsFromEvents(blockRes.TxsResults[res.TxIndex].Events, int(res.MsgIndex))
 }
 
 // GetTransactionByBlockHashAndIndex returns the transaction identified by hash and index.
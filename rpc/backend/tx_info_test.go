@@ -9,9 +9,13 @@ import (
 	tmlog "github.com/cometbft/cometbft/libs/log"
 	tmrpctypes "github.com/cometbft/cometbft/rpc/core/types"
 	"github.com/cometbft/cometbft/types"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/gogo/protobuf/proto"
+	"github.com/stretchr/testify/mock"
 	"google.golang.org/grpc/metadata"
 
 	"swisstronik/indexer"
@@ -614,6 +618,139 @@ func (suite *BackendTestSuite) TestGetTransactionReceipt() {
 	}
 }
 
+func (suite *BackendTestSuite) TestGetBlockReceipts() {
+	msgHandleTx, _ := suite.buildEthereumTx()
+	txBz := suite.signAndEncodeEthTx(msgHandleTx)
+	txHash := msgHandleTx.AsTransaction().Hash()
+	blockNum := rpctypes.BlockNumber(1)
+
+	ethTxEvent := abci.Event{Type: evmtypes.EventTypeEthereumTx, Attributes: []abci.EventAttribute{
+		{Key: "ethereumTxHash", Value: txHash.Hex()},
+		{Key: "txIndex", Value: "0"},
+		{Key: "amount", Value: "0"},
+		{Key: "txGasUsed", Value: "30000"},
+		{Key: "txHash", Value: ""},
+	}}
+	txLogEvent := abci.Event{Type: evmtypes.EventTypeTxLog, Attributes: []abci.EventAttribute{
+		{Key: evmtypes.AttributeKeyTxLog, Value: `{"address":"0x0000000000000000000000000000000000000000","data":"AQ==","index":"0"}`},
+	}}
+	data, err := proto.Marshal(&sdk.TxMsgData{MsgResponses: []*codectypes.Any{
+		codectypes.UnsafePackAny(&evmtypes.MsgEthereumTxResponse{
+			Hash: txHash.Hex(),
+			Logs: []*evmtypes.Log{
+				{Address: common.Address{}.Hex(), Data: []byte{1}},
+				{Address: common.Address{}.Hex(), Data: []byte{2}},
+			},
+		}),
+	}})
+	suite.Require().NoError(err)
+
+	registerBlockResults := func(txResults []*abci.ResponseDeliverTx) {
+		client := suite.backend.clientCtx.Client.(*mocks.Client)
+		client.On("BlockResults", rpctypes.ContextWithHeight(1), mock.AnythingOfType("*int64")).
+			Return(&tmrpctypes.ResultBlockResults{Height: 1, TxsResults: txResults}, nil)
+	}
+	registerChainID := func() {
+		var header metadata.MD
+		queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+		RegisterParams(queryClient, &header, 1)
+		RegisterParamsWithoutHeader(queryClient, 1)
+	}
+
+	testCases := []struct {
+		name         string
+		registerMock func()
+		indexed      []*abci.ResponseDeliverTx
+		expReceipts  int
+		expLogs      int
+	}{
+		{
+			"pass - block not found",
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				RegisterBlockError(client, 1)
+			},
+			nil,
+			-1,
+			0,
+		},
+		{
+			"pass - block without transactions",
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				_, err := RegisterBlock(client, 1, nil)
+				suite.Require().NoError(err)
+				registerBlockResults([]*abci.ResponseDeliverTx{})
+				registerChainID()
+			},
+			nil,
+			0,
+			0,
+		},
+		{
+			"pass - logs are parsed from events",
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				_, err := RegisterBlock(client, 1, txBz)
+				suite.Require().NoError(err)
+				registerBlockResults([]*abci.ResponseDeliverTx{
+					{Code: 0, GasUsed: 30000, Events: []abci.Event{ethTxEvent, txLogEvent}},
+				})
+				registerChainID()
+			},
+			nil,
+			1,
+			1,
+		},
+		{
+			"pass - logs are read from indexed receipts",
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				_, err := RegisterBlock(client, 1, txBz)
+				suite.Require().NoError(err)
+				registerBlockResults([]*abci.ResponseDeliverTx{
+					{Code: 0, GasUsed: 30000, Events: []abci.Event{ethTxEvent}},
+				})
+				registerChainID()
+			},
+			[]*abci.ResponseDeliverTx{
+				{Code: 0, GasUsed: 30000, Data: data, Events: []abci.Event{ethTxEvent}},
+			},
+			1,
+			2,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+			tc.registerMock()
+
+			suite.backend.indexer = indexer.NewKVIndexer(dbm.NewMemDB(), tmlog.NewNopLogger(), suite.backend.clientCtx)
+			if tc.indexed != nil {
+				block := &types.Block{Header: types.Header{Height: 1}, Data: types.Data{Txs: []types.Tx{txBz}}}
+				suite.Require().NoError(suite.backend.indexer.IndexBlock(block, tc.indexed))
+			}
+
+			receipts, err := suite.backend.GetBlockReceipts(rpctypes.BlockNumberOrHash{BlockNumber: &blockNum})
+			suite.Require().NoError(err)
+			if tc.expReceipts < 0 {
+				suite.Require().Nil(receipts)
+				return
+			}
+			suite.Require().Len(receipts, tc.expReceipts)
+			for i, receipt := range receipts {
+				suite.Require().Equal(txHash, receipt["transactionHash"])
+				suite.Require().Equal(hexutil.Uint(ethtypes.ReceiptStatusSuccessful), receipt["status"])
+				suite.Require().Equal(hexutil.Uint64(30000), receipt["gasUsed"])
+				suite.Require().Equal(hexutil.Uint64(30000), receipt["cumulativeGasUsed"])
+				suite.Require().Equal(hexutil.Uint64(i), receipt["transactionIndex"])
+				suite.Require().Len(receipt["logs"], tc.expLogs)
+			}
+		})
+	}
+}
+
 func (suite *BackendTestSuite) TestGetGasUsed() {
 	origin := suite.backend.cfg.JSONRPC.FixRevertGasRefundHeight
 	testCases := []struct {
@@ -81,6 +81,27 @@ func (a *API) TraceTransaction(hash common.Hash, config *evmtypes.TraceConfig) (
 	return a.backend.TraceTransaction(hash, config)
 }
 
+// TraceTransactionPrivate returns the call tree and revert reasons of decrypted execution of the encrypted
+// transaction. Signature over EIP-712 typed data with the transaction hash should be produced by the
+// sender of the transaction. Result is encrypted to the key, used by the sender to encrypt transaction data
+func (a *API) TraceTransactionPrivate(hash common.Hash, signature hexutil.Bytes) (hexutil.Bytes, error) {
+	a.logger.Debug("debug_traceTransactionPrivate", "hash", hash)
+	return a.backend.TraceTransactionPrivate(hash, signature)
+}
+
+// TraceCall lets you trace a given eth_call. It collects the structured logs created during
+// the execution of EVM if the given transaction was added on top of the provided block.
+// Structured logs don't contain stack, memory and storage values, since they can be derived
+// from encrypted contract storage
+func (a *API) TraceCall(args evmtypes.CallArgs, blockNrOrHash rpctypes.BlockNumberOrHash, config *evmtypes.TraceConfig) (interface{}, error) {
+	a.logger.Debug("debug_traceCall", "args", args.String(), "block number or hash", blockNrOrHash)
+	blockNum, err := a.backend.BlockNumberFromTendermint(blockNrOrHash)
+	if err != nil {
+		return nil, err
+	}
+	return a.backend.TraceCall(args, blockNum, config)
+}
+
 // TraceBlockByNumber returns the structured logs created during the execution of
 // EVM and returns them as a JSON object.
 func (a *API) TraceBlockByNumber(height rpctypes.BlockNumber, config *evmtypes.TraceConfig) ([]*evmtypes.TxTraceResult, error) {
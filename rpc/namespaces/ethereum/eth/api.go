@@ -60,13 +60,14 @@ type EthereumAPI interface {
 	GetTransactionReceipt(hash common.Hash) (map[string]interface{}, error)
 	GetTransactionByBlockHashAndIndex(hash common.Hash, idx hexutil.Uint) (*rpctypes.RPCTransaction, error)
 	GetTransactionByBlockNumberAndIndex(blockNum rpctypes.BlockNumber, idx hexutil.Uint) (*rpctypes.RPCTransaction, error)
-	// eth_getBlockReceipts
+	GetBlockReceipts(blockNrOrHash rpctypes.BlockNumberOrHash) ([]map[string]interface{}, error)
 
 	// Writing Transactions
 	//
 	// Allows developers to both send ETH from one address to another, write data
 	// on-chain, and interact with smart contracts.
 	SendRawTransaction(data hexutil.Bytes) (common.Hash, error)
+	SendSponsoredRawTransaction(data hexutil.Bytes, feePayer common.Address, feePayerSignature hexutil.Bytes) (common.Hash, error)
 	SendTransaction(args evmtypes.TransactionArgs) (common.Hash, error)
 	// eth_sendPrivateTransaction
 	// eth_cancel	PrivateTransaction
@@ -84,14 +85,20 @@ type EthereumAPI interface {
 	//
 	// Allows developers to read data from the blockchain which includes executing
 	// smart contracts. However, no data is published to the Ethereum network.
-	Call(args evmtypes.CallArgs, blockNrOrHash rpctypes.BlockNumberOrHash, _ *rpctypes.StateOverride) (hexutil.Bytes, error)
+	Call(args evmtypes.CallArgs, blockNrOrHash rpctypes.BlockNumberOrHash, overrides *rpctypes.StateOverride) (hexutil.Bytes, error)
+	SimulateV1(
+		calls []evmtypes.CallArgs,
+		blockNrOrHash rpctypes.BlockNumberOrHash,
+		overrides *rpctypes.StateOverride,
+	) ([]*rpctypes.SimulateCallResult, error)
+	CreateAccessList(args evmtypes.CallArgs, blockNrOrHash *rpctypes.BlockNumberOrHash) (*rpctypes.AccessListResult, error)
 
 	// Chain Information
 	//
 	// Returns information on the Ethereum network and internal settings.
 	ProtocolVersion() hexutil.Uint
 	GasPrice() (*hexutil.Big, error)
-	EstimateGas(args evmtypes.TransactionArgs, blockNrOptional *rpctypes.BlockNumber) (hexutil.Uint64, error)
+	EstimateGas(args evmtypes.CallArgs, blockNrOptional *rpctypes.BlockNumber, overrides *rpctypes.StateOverride) (hexutil.Uint64, error)
 	FeeHistory(blockCount rpc.DecimalOrHex, lastBlock rpc.BlockNumber, rewardPercentiles []float64) (*rpctypes.FeeHistoryResult, error)
 	MaxPriorityFeePerGas() (*hexutil.Big, error)
 	ChainId() (*hexutil.Big, error)
@@ -157,7 +164,8 @@ func (e *PublicAPI) BlockNumber() (hexutil.Uint64, error) {
 	return e.backend.BlockNumber()
 }
 
-// GetBlockByNumber returns the block identified by number.
+// GetBlockByNumber returns the block identified by number. `stateRoot` of the block is a commitment over EVM
+// store writes of its transactions, not a root of the full state.
 func (e *PublicAPI) GetBlockByNumber(ethBlockNum rpctypes.BlockNumber, fullTx bool) (map[string]interface{}, error) {
 	e.logger.Debug("eth_getBlockByNumber", "number", ethBlockNum, "full", fullTx)
 	return e.backend.GetBlockByNumber(ethBlockNum, fullTx)
@@ -189,13 +197,20 @@ func (e *PublicAPI) GetTransactionCount(address common.Address, blockNrOrHash rp
 	return e.backend.GetTransactionCount(address, blockNum)
 }
 
-// GetTransactionReceipt returns the transaction receipt identified by hash.
+// GetTransactionReceipt returns the transaction receipt identified by hash. `root` of the receipt is
+// a commitment over EVM store writes of the transaction, not a root of the full state.
 func (e *PublicAPI) GetTransactionReceipt(hash common.Hash) (map[string]interface{}, error) {
 	hexTx := hash.Hex()
 	e.logger.Debug("eth_getTransactionReceipt", "hash", hexTx)
 	return e.backend.GetTransactionReceipt(hash)
 }
 
+// GetBlockReceipts returns the receipts of all transactions in the block identified by number or hash.
+func (e *PublicAPI) GetBlockReceipts(blockNrOrHash rpctypes.BlockNumberOrHash) ([]map[string]interface{}, error) {
+	e.logger.Debug("eth_getBlockReceipts", "block number or hash", blockNrOrHash)
+	return e.backend.GetBlockReceipts(blockNrOrHash)
+}
+
 // GetBlockTransactionCountByHash returns the number of transactions in the block identified by hash.
 func (e *PublicAPI) GetBlockTransactionCountByHash(hash common.Hash) *hexutil.Uint {
 	e.logger.Debug("eth_getBlockTransactionCountByHash", "hash", hash.Hex())
@@ -230,6 +245,17 @@ func (e *PublicAPI) SendRawTransaction(data hexutil.Bytes) (common.Hash, error)
 	return e.backend.SendRawTransaction(data)
 }
 
+// SendSponsoredRawTransaction sends a raw Ethereum transaction, which fees are paid by the fee payer.
+// Fee payer signs EIP-712 typed data with sender, nonce, gas limit, fee cap and hash of the transaction.
+func (e *PublicAPI) SendSponsoredRawTransaction(
+	data hexutil.Bytes,
+	feePayer common.Address,
+	feePayerSignature hexutil.Bytes,
+) (common.Hash, error) {
+	e.logger.Debug("eth_sendSponsoredRawTransaction", "length", len(data), "fee payer", feePayer)
+	return e.backend.SendSponsoredRawTransaction(data, feePayer, feePayerSignature)
+}
+
 // SendTransaction sends an Ethereum transaction.
 func (e *PublicAPI) SendTransaction(args evmtypes.TransactionArgs) (common.Hash, error) {
 	e.logger.Debug("eth_sendTransaction", "args", args.String())
@@ -277,10 +303,11 @@ func (e *PublicAPI) GetProof(address common.Address,
 ///                           EVM/Smart Contract Execution				          ///
 ///////////////////////////////////////////////////////////////////////////////
 
-// Call performs a raw contract call.
+// Call performs a raw contract call. Sender is exposed to the called contract only if the call
+// is signed or contains EIP-712 permit, signed by `from`
 func (e *PublicAPI) Call(args evmtypes.CallArgs,
 	blockNrOrHash rpctypes.BlockNumberOrHash,
-	_ *rpctypes.StateOverride,
+	overrides *rpctypes.StateOverride,
 ) (hexutil.Bytes, error) {
 	e.logger.Debug("eth_call", "args", args.String(), "block number or hash", blockNrOrHash)
 
@@ -288,7 +315,7 @@ func (e *PublicAPI) Call(args evmtypes.CallArgs,
 	if err != nil {
 		return nil, err
 	}
-	data, err := e.backend.DoCall(args, blockNum)
+	data, err := e.backend.DoCall(args, blockNum, overrides)
 	if err != nil {
 		return []byte{}, err
 	}
@@ -296,6 +323,39 @@ func (e *PublicAPI) Call(args evmtypes.CallArgs,
 	return (hexutil.Bytes)(data.Ret), nil
 }
 
+// SimulateV1 executes ordered list of calls, where each call observes state changes of the previous
+// calls. State changes are not persisted
+func (e *PublicAPI) SimulateV1(calls []evmtypes.CallArgs,
+	blockNrOrHash rpctypes.BlockNumberOrHash,
+	overrides *rpctypes.StateOverride,
+) ([]*rpctypes.SimulateCallResult, error) {
+	e.logger.Debug("eth_simulateV1", "calls", len(calls), "block number or hash", blockNrOrHash)
+
+	blockNum, err := e.backend.BlockNumberFromTendermint(blockNrOrHash)
+	if err != nil {
+		return nil, err
+	}
+	return e.backend.Simulate(calls, blockNum, overrides)
+}
+
+// CreateAccessList creates an EIP-2930 access list for the given call, which can be attached to the
+// transaction to reduce its cost. If block is not specified, pending block is used
+func (e *PublicAPI) CreateAccessList(args evmtypes.CallArgs,
+	blockNrOrHash *rpctypes.BlockNumberOrHash,
+) (*rpctypes.AccessListResult, error) {
+	e.logger.Debug("eth_createAccessList", "args", args.String(), "block number or hash", blockNrOrHash)
+
+	blockNum := rpctypes.EthPendingBlockNumber
+	if blockNrOrHash != nil {
+		var err error
+		blockNum, err = e.backend.BlockNumberFromTendermint(*blockNrOrHash)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return e.backend.CreateAccessList(args, blockNum)
+}
+
 // GetNodePublicKey returns x25519 based public key
 func (e *PublicAPI) GetNodePublicKey(blockNum rpctypes.BlockNumber) (string, error) {
 	e.logger.Debug("eth_getNodePublicKey", "block number", blockNum)
@@ -323,10 +383,15 @@ func (e *PublicAPI) GasPrice() (*hexutil.Big, error) {
 	return e.backend.GasPrice()
 }
 
-// EstimateGas returns an estimate of gas usage for the given smart contract call.
-func (e *PublicAPI) EstimateGas(args evmtypes.TransactionArgs, blockNrOptional *rpctypes.BlockNumber) (hexutil.Uint64, error) {
+// EstimateGas returns an estimate of gas usage for the given smart contract call. As for eth_call,
+// sender is exposed to executed contracts only if the arguments are signed or carry a valid permit
+func (e *PublicAPI) EstimateGas(
+	args evmtypes.CallArgs,
+	blockNrOptional *rpctypes.BlockNumber,
+	overrides *rpctypes.StateOverride,
+) (hexutil.Uint64, error) {
 	e.logger.Debug("eth_estimateGas")
-	return e.backend.EstimateGas(args, blockNrOptional)
+	return e.backend.EstimateGas(args, blockNrOptional, overrides)
 }
 
 func (e *PublicAPI) FeeHistory(blockCount rpc.DecimalOrHex,
@@ -450,8 +515,7 @@ func (e *PublicAPI) GetTransactionLogs(txHash common.Hash) ([]*ethtypes.Log, err
 		return nil, nil
 	}
 
-	// parse tx logs from events
-	return backend.TxLogsFromEvents(resBlockResult.TxsResults[res.TxIndex].Events, int(res.MsgIndex))
+	return e.backend.GetTransactionLogs(txHash, res, resBlockResult)
 }
 
 // SignTypedData signs EIP-712 conformant typed data
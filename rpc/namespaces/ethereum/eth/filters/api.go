@@ -58,6 +58,9 @@ type Backend interface {
 	TendermintBlockResultByNumber(height *int64) (*coretypes.ResultBlockResults, error)
 	GetLogs(blockHash common.Hash) ([][]*ethtypes.Log, error)
 	GetLogsByHeight(*int64) ([][]*ethtypes.Log, error)
+	BlockLogs(blockRes *coretypes.ResultBlockResults) ([][]*ethtypes.Log, error)
+	LogIndexRange() (int64, int64, error)
+	LogBlocks(from, to int64, addresses []common.Address, topics [][]common.Hash) ([]int64, error)
 	BlockBloom(blockRes *coretypes.ResultBlockResults) (ethtypes.Bloom, error)
 
 	BloomStatus() (uint64, uint64)
@@ -65,6 +68,7 @@ type Backend interface {
 	RPCFilterCap() int32
 	RPCLogsCap() int32
 	RPCBlockRangeCap() int32
+	RPCIndexedBlockRangeCap() int32
 }
 
 // consider a filter inactive if it has not been polled for within deadline
@@ -546,7 +550,7 @@ func (api *PublicFilterAPI) GetLogs(ctx context.Context, crit filters.FilterCrit
 	}
 
 	// Run the filter and return all the logs
-	logs, err := filter.Logs(ctx, int(api.backend.RPCLogsCap()), int64(api.backend.RPCBlockRangeCap()))
+	logs, err := filter.Logs(ctx, int(api.backend.RPCLogsCap()), int64(api.backend.RPCBlockRangeCap()), int64(api.backend.RPCIndexedBlockRangeCap()))
 	if err != nil {
 		return nil, err
 	}
@@ -607,7 +611,7 @@ func (api *PublicFilterAPI) GetFilterLogs(ctx context.Context, id rpc.ID) ([]*et
 		filter = NewRangeFilter(api.logger, api.backend, begin, end, f.crit.Addresses, f.crit.Topics)
 	}
 	// Run the filter and return all the logs
-	logs, err := filter.Logs(ctx, int(api.backend.RPCLogsCap()), int64(api.backend.RPCBlockRangeCap()))
+	logs, err := filter.Logs(ctx, int(api.backend.RPCLogsCap()), int64(api.backend.RPCBlockRangeCap()), int64(api.backend.RPCIndexedBlockRangeCap()))
 	if err != nil {
 		return nil, err
 	}
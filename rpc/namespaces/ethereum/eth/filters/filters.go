@@ -21,7 +21,6 @@ import (
 	"fmt"
 	"math/big"
 
-	"swisstronik/rpc/backend"
 	"swisstronik/rpc/types"
 
 	"github.com/cometbft/cometbft/libs/log"
@@ -105,9 +104,23 @@ const (
 	maxToOverhang = 600
 )
 
+// hasAddressOrTopic returns true if the filter matches logs of specific addresses or with specific topics
+func (f *Filter) hasAddressOrTopic() bool {
+	if len(f.criteria.Addresses) > 0 {
+		return true
+	}
+	for _, topics := range f.criteria.Topics {
+		if len(topics) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
 // Logs searches the blockchain for matching log entries, returning all from the
 // first block that contains matches, updating the start of the filter accordingly.
-func (f *Filter) Logs(ctx context.Context, logLimit int, blockLimit int64) ([]*ethtypes.Log, error) {
+// Blocks, covered by the log index, are limited by indexedBlockLimit instead of blockLimit.
+func (f *Filter) Logs(ctx context.Context, logLimit int, blockLimit, indexedBlockLimit int64) ([]*ethtypes.Log, error) {
 	logs := []*ethtypes.Log{}
 	var err error
 
@@ -155,9 +168,29 @@ func (f *Filter) Logs(ctx context.Context, logLimit int, blockLimit int64) ([]*e
 		f.criteria.ToBlock = big.NewInt(1)
 	}
 
-	if f.criteria.ToBlock.Int64()-f.criteria.FromBlock.Int64() > blockLimit {
+	// blocks covered by the log index of the indexer are not scanned, so only the rest of the blocks
+	// count towards the block limit.
+	indexedFrom, indexedTo := int64(0), int64(-1)
+	if first, last, err := f.backend.LogIndexRange(); err == nil && first >= 0 {
+		indexedFrom = max(f.criteria.FromBlock.Int64(), first)
+		indexedTo = min(f.criteria.ToBlock.Int64(), last, head)
+	}
+	scanned := f.criteria.ToBlock.Int64() - f.criteria.FromBlock.Int64()
+	if indexedFrom <= indexedTo {
+		scanned -= indexedTo - indexedFrom + 1
+	}
+	if scanned > blockLimit {
 		return nil, fmt.Errorf("maximum [from, to] blocks distance: %d", blockLimit)
 	}
+	// lookups in the log index are cheap, but not free, so indexed blocks are limited separately. Queries
+	// over range above the block limit should filter by address or topic, otherwise they fetch logs of
+	// every block with logs in the range
+	if indexedFrom <= indexedTo && indexedTo-indexedFrom > indexedBlockLimit {
+		return nil, fmt.Errorf("maximum indexed [from, to] blocks distance: %d", indexedBlockLimit)
+	}
+	if f.criteria.ToBlock.Int64()-f.criteria.FromBlock.Int64() > blockLimit && !f.hasAddressOrTopic() {
+		return nil, fmt.Errorf("address or topic is required for [from, to] blocks distance above %d", blockLimit)
+	}
 
 	// check bounds
 	if f.criteria.FromBlock.Int64() > head {
@@ -169,6 +202,44 @@ func (f *Filter) Logs(ctx context.Context, logLimit int, blockLimit int64) ([]*e
 	from := f.criteria.FromBlock.Int64()
 	to := f.criteria.ToBlock.Int64()
 
+	if indexedFrom > indexedTo {
+		return f.scanLogs(logs, from, to, logLimit)
+	}
+
+	if logs, err = f.scanLogs(logs, from, indexedFrom-1, logLimit); logs == nil || err != nil {
+		return logs, err
+	}
+
+	heights, err := f.backend.LogBlocks(indexedFrom, indexedTo, f.criteria.Addresses, f.criteria.Topics)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to query log index [%d, %d]", indexedFrom, indexedTo)
+	}
+	for _, height := range heights {
+		height := height
+		logsList, err := f.backend.GetLogsByHeight(&height)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to fetch logs block number %d", height)
+		}
+
+		unfiltered := make([]*ethtypes.Log, 0)
+		for _, txLogs := range logsList {
+			unfiltered = append(unfiltered, txLogs...)
+		}
+		filtered := FilterLogs(unfiltered, nil, nil, f.criteria.Addresses, f.criteria.Topics)
+
+		// check logs limit
+		if len(logs)+len(filtered) > logLimit {
+			return nil, fmt.Errorf("query returned more than %d results", logLimit)
+		}
+		logs = append(logs, filtered...)
+	}
+
+	return f.scanLogs(logs, indexedTo+1, to, logLimit)
+}
+
+// scanLogs appends the logs matching the filter criteria within the blocks in `[from, to]`, the blocks
+// are fetched one by one and are checked against their bloom. Returns nil if a block can't be fetched.
+func (f *Filter) scanLogs(logs []*ethtypes.Log, from, to int64, logLimit int) ([]*ethtypes.Log, error) {
 	for height := from; height <= to; height++ {
 		blockRes, err := f.backend.TendermintBlockResultByNumber(&height)
 		if err != nil {
@@ -201,7 +272,7 @@ func (f *Filter) blockLogs(blockRes *tmrpctypes.ResultBlockResults, bloom ethtyp
 		return []*ethtypes.Log{}, nil
 	}
 
-	logsList, err := backend.GetLogsFromBlockResults(blockRes)
+	logsList, err := f.backend.BlockLogs(blockRes)
 	if err != nil {
 		return []*ethtypes.Log{}, errors.Wrapf(err, "failed to fetch logs block number %d", blockRes.Height)
 	}
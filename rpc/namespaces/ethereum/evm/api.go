@@ -0,0 +1,46 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package evm
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/cometbft/cometbft/libs/log"
+
+	"swisstronik/rpc/backend"
+	rpctypes "swisstronik/rpc/types"
+)
+
+// PublicAPI is the evm prefixed set of APIs, which expose swisstronik specific EVM features
+type PublicAPI struct {
+	logger  log.Logger
+	backend backend.EVMBackend
+}
+
+// NewPublicAPI creates an instance of the evm API.
+func NewPublicAPI(logger log.Logger, backend backend.EVMBackend) *PublicAPI {
+	return &PublicAPI{
+		logger:  logger.With("api", "evm"),
+		backend: backend,
+	}
+}
+
+// GetVerifiedContract returns ABI and source hash of the contract, verified by recompiling submitted
+// sources. It is only available on nodes with configured solc binary.
+func (api *PublicAPI) GetVerifiedContract(address common.Address, blockNrOrHash rpctypes.BlockNumberOrHash) (*rpctypes.VerifiedContractResult, error) {
+	api.logger.Debug("evm_getVerifiedContract", "address", address.String(), "block number or hash", blockNrOrHash)
+	return api.backend.GetVerifiedContract(address, blockNrOrHash)
+}
@@ -73,6 +73,8 @@ type ParsedTx struct {
 	EthTxIndex int32
 	GasUsed    uint64
 	Failed     bool
+	// intermediate state root after the tx, empty if it is not emitted
+	StateRoot common.Hash
 }
 
 // NewParsedTx initialize a ParsedTx
@@ -172,6 +174,7 @@ func ParseTxIndexerResult(txResult *tmrpctypes.ResultTx, tx sdk.Tx, getter func(
 		Failed:            parsedTx.Failed,
 		GasUsed:           parsedTx.GasUsed,
 		CumulativeGasUsed: txs.AccumulativeGasUsed(parsedTx.MsgIndex),
+		StateRoot:         stateRootBytes(parsedTx.StateRoot),
 	}, nil
 }
 
@@ -261,10 +264,20 @@ func fillTxAttribute(tx *ParsedTx, key string, value string) error {
 		tx.GasUsed = gasUsed
 	case evmtypes.AttributeKeyEthereumTxFailed:
 		tx.Failed = len(value) > 0
+	case evmtypes.AttributeKeyStateRoot:
+		tx.StateRoot = common.HexToHash(value)
 	}
 	return nil
 }
 
+// stateRootBytes returns bytes of the state root, or nil if it was not emitted
+func stateRootBytes(stateRoot common.Hash) []byte {
+	if stateRoot == (common.Hash{}) {
+		return nil
+	}
+	return stateRoot.Bytes()
+}
+
 func fillTxAttributes(tx *ParsedTx, attrs []abci.EventAttribute) error {
 	for _, attr := range attrs {
 		if err := fillTxAttribute(tx, attr.Key, attr.Value); err != nil {
@@ -15,6 +15,7 @@ func TestParseTxResult(t *testing.T) {
 	address := "0x57f96e6B86CdeFdB3d412547816a82E3E0EbF9D2"
 	txHash := common.BigToHash(big.NewInt(1))
 	txHash2 := common.BigToHash(big.NewInt(2))
+	stateRoot := common.BigToHash(big.NewInt(3))
 
 	testCases := []struct {
 		name     string
@@ -120,6 +121,35 @@ func TestParseTxResult(t *testing.T) {
 				},
 			},
 		},
+		{
+			"format 2 events with state root",
+			abci.ResponseDeliverTx{
+				GasUsed: 21000,
+				Events: []abci.Event{
+					{Type: evmtypes.EventTypeEthereumTx, Attributes: []abci.EventAttribute{
+						{Key: "ethereumTxHash", Value: txHash.Hex()},
+						{Key: "txIndex", Value: "0"},
+					}},
+					{Type: evmtypes.EventTypeEthereumTx, Attributes: []abci.EventAttribute{
+						{Key: "amount", Value: "1000"},
+						{Key: "ethereumTxHash", Value: txHash.Hex()},
+						{Key: "txIndex", Value: "0"},
+						{Key: "txGasUsed", Value: "21000"},
+						{Key: "stateRoot", Value: stateRoot.Hex()},
+					}},
+				},
+			},
+			[]*ParsedTx{
+				{
+					MsgIndex:   0,
+					Hash:       txHash,
+					EthTxIndex: 0,
+					GasUsed:    21000,
+					Failed:     false,
+					StateRoot:  stateRoot,
+				},
+			},
+		},
 		{
 			"format 1 events, failed",
 			abci.ResponseDeliverTx{
@@ -16,11 +16,14 @@
 package types
 
 import (
+	"encoding/json"
 	"math/big"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	evmtypes "swisstronik/x/evm/types"
 )
 
 // Copied the Account and StorageResult types since they are registered under an
@@ -37,6 +40,16 @@ type AccountResult struct {
 	StorageProof []StorageResult `json:"storageProof"`
 }
 
+// VerifiedContractResult defines the format of verified contract, returned by evm_getVerifiedContract
+type VerifiedContractResult struct {
+	Address         common.Address  `json:"address"`
+	SourceHash      common.Hash     `json:"sourceHash"`
+	ContractName    string          `json:"contractName"`
+	CompilerVersion string          `json:"compilerVersion"`
+	ABI             json.RawMessage `json:"abi"`
+	ExactMatch      bool            `json:"exactMatch"`
+}
+
 // StorageResult defines the format for storage proof return
 type StorageResult struct {
 	Key   string       `json:"key"`
@@ -68,20 +81,26 @@ type RPCTransaction struct {
 }
 
 // StateOverride is the collection of overridden accounts.
-type StateOverride map[common.Address]OverrideAccount
+type StateOverride = evmtypes.StateOverride
 
 // OverrideAccount indicates the overriding fields of account during the execution of
 // a message call.
-// Note, state and stateDiff can't be specified at the same time. If state is
-// set, message execution will only use the data in the given state. Otherwise
-// if statDiff is set, all diff will be applied first and then execute the call
-// message.
-type OverrideAccount struct {
-	Nonce     *hexutil.Uint64              `json:"nonce"`
-	Code      *hexutil.Bytes               `json:"code"`
-	Balance   **hexutil.Big                `json:"balance"`
-	State     *map[common.Hash]common.Hash `json:"state"`
-	StateDiff *map[common.Hash]common.Hash `json:"stateDiff"`
+type OverrideAccount = evmtypes.OverrideAccount
+
+// SimulateCallResult is the result of a single call, executed by `eth_simulateV1`
+type SimulateCallResult struct {
+	ReturnData hexutil.Bytes   `json:"returnData"`
+	Logs       []*ethtypes.Log `json:"logs"`
+	GasUsed    hexutil.Uint64  `json:"gasUsed"`
+	Status     hexutil.Uint64  `json:"status"`
+	Error      string          `json:"error,omitempty"`
+}
+
+// AccessListResult is the result of `eth_createAccessList`
+type AccessListResult struct {
+	AccessList *ethtypes.AccessList `json:"accessList"`
+	Error      string               `json:"error,omitempty"`
+	GasUsed    hexutil.Uint64       `json:"gasUsed"`
 }
 
 type FeeHistoryResult struct {
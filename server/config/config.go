@@ -57,6 +57,10 @@ const (
 
 	DefaultMaxTxGasWanted = 0
 
+	// DefaultSolcPath is the default path of solc binary, used for verification of contract sources.
+	// Verification is disabled if path is empty
+	DefaultSolcPath = ""
+
 	DefaultGasCap uint64 = 25000000
 
 	DefaultFilterCap int32 = 200
@@ -67,6 +71,8 @@ const (
 
 	DefaultBlockRangeCap int32 = 10000
 
+	DefaultIndexedBlockRangeCap int32 = 1000000
+
 	DefaultEVMTimeout = 600 * time.Second
 
 	// default 1.0 eth
@@ -105,6 +111,9 @@ type EVMConfig struct {
 	Tracer string `mapstructure:"tracer"`
 	// MaxTxGasWanted defines the gas wanted for each eth tx returned in ante handler in check tx mode.
 	MaxTxGasWanted uint64 `mapstructure:"max-tx-gas-wanted"`
+	// SolcPath defines path of solc binary, used for verification of submitted contract sources.
+	// "{version}" in the path is replaced with requested compiler version. Empty path disables verification
+	SolcPath string `mapstructure:"solc-path"`
 }
 
 // JSONRPCConfig defines configuration for the EVM RPC server.
@@ -133,8 +142,12 @@ type JSONRPCConfig struct {
 	Enable bool `mapstructure:"enable"`
 	// LogsCap defines the max number of results can be returned from single `eth_getLogs` query.
 	LogsCap int32 `mapstructure:"logs-cap"`
-	// BlockRangeCap defines the max block range allowed for `eth_getLogs` query.
+	// BlockRangeCap defines the max block range allowed for `eth_getLogs` query. Blocks covered by the log index
+	// of the custom indexer are not counted, they are limited by IndexedBlockRangeCap.
 	BlockRangeCap int32 `mapstructure:"block-range-cap"`
+	// IndexedBlockRangeCap defines the max range of blocks, covered by the log index of the custom indexer, allowed
+	// for `eth_getLogs` query. Queries with block range above BlockRangeCap should filter by address or topic.
+	IndexedBlockRangeCap int32 `mapstructure:"indexed-block-range-cap"`
 	// HTTPTimeout is the read/write timeout of http json-rpc server.
 	HTTPTimeout time.Duration `mapstructure:"http-timeout"`
 	// HTTPIdleTimeout is the idle timeout of http json-rpc server.
@@ -211,6 +224,7 @@ func DefaultEVMConfig() *EVMConfig {
 	return &EVMConfig{
 		Tracer:         DefaultEVMTracer,
 		MaxTxGasWanted: DefaultMaxTxGasWanted,
+		SolcPath:       DefaultSolcPath,
 	}
 }
 
@@ -230,7 +244,7 @@ func GetDefaultAPINamespaces() []string {
 
 // GetAPINamespaces returns the all the available JSON-RPC API namespaces.
 func GetAPINamespaces() []string {
-	return []string{"web3", "eth", "personal", "net", "txpool", "debug", "miner", "utils"}
+	return []string{"web3", "eth", "personal", "net", "txpool", "debug", "miner", "utils", "evm"}
 }
 
 // DefaultJSONRPCConfig returns an EVM config with the JSON-RPC API enabled by default
@@ -248,6 +262,7 @@ func DefaultJSONRPCConfig() *JSONRPCConfig {
 		FilterCap:                DefaultFilterCap,
 		FeeHistoryCap:            DefaultFeeHistoryCap,
 		BlockRangeCap:            DefaultBlockRangeCap,
+		IndexedBlockRangeCap:     DefaultIndexedBlockRangeCap,
 		LogsCap:                  DefaultLogsCap,
 		HTTPTimeout:              DefaultHTTPTimeout,
 		HTTPIdleTimeout:          DefaultHTTPIdleTimeout,
@@ -289,6 +304,10 @@ func (c JSONRPCConfig) Validate() error {
 		return errors.New("JSON-RPC block range cap cannot be negative")
 	}
 
+	if c.IndexedBlockRangeCap < 0 {
+		return errors.New("JSON-RPC indexed block range cap cannot be negative")
+	}
+
 	if c.HTTPTimeout < 0 {
 		return errors.New("JSON-RPC HTTP timeout duration cannot be negative")
 	}
@@ -347,6 +366,7 @@ func GetConfig(v *viper.Viper) (Config, error) {
 		EVM: EVMConfig{
 			Tracer:         v.GetString("evm.tracer"),
 			MaxTxGasWanted: v.GetUint64("evm.max-tx-gas-wanted"),
+			SolcPath:       v.GetString("evm.solc-path"),
 		},
 		JSONRPC: JSONRPCConfig{
 			Enable:                   v.GetBool("json-rpc.enable"),
@@ -362,6 +382,7 @@ func GetConfig(v *viper.Viper) (Config, error) {
 			EVMTimeout:               v.GetDuration("json-rpc.evm-timeout"),
 			LogsCap:                  v.GetInt32("json-rpc.logs-cap"),
 			BlockRangeCap:            v.GetInt32("json-rpc.block-range-cap"),
+			IndexedBlockRangeCap:     v.GetInt32("json-rpc.indexed-block-range-cap"),
 			HTTPTimeout:              v.GetDuration("json-rpc.http-timeout"),
 			HTTPIdleTimeout:          v.GetDuration("json-rpc.http-idle-timeout"),
 			MaxOpenConnections:       v.GetInt("json-rpc.max-open-connections"),
@@ -31,6 +31,11 @@ tracer = "{{ .EVM.Tracer }}"
 # MaxTxGasWanted defines the gas wanted for each eth tx returned in ante handler in check tx mode.
 max-tx-gas-wanted = {{ .EVM.MaxTxGasWanted }}
 
+# SolcPath defines the path of solc binary, used to verify submitted contract sources against
+# deployed bytecode. "{version}" in the path is replaced with the requested compiler version,
+# for example "/opt/solc/solc-{version}". Verification is disabled if the path is empty.
+solc-path = "{{ .EVM.SolcPath }}"
+
 ###############################################################################
 ###                           JSON RPC Configuration                        ###
 ###############################################################################
@@ -75,8 +80,14 @@ feehistory-cap = {{ .JSONRPC.FeeHistoryCap }}
 logs-cap = {{ .JSONRPC.LogsCap }}
 
 # BlockRangeCap defines the max block range allowed for 'eth_getLogs' query.
+# Blocks covered by the log index of the custom indexer (enable-indexer) are not counted,
+# they are limited by indexed-block-range-cap.
 block-range-cap = {{ .JSONRPC.BlockRangeCap }}
 
+# IndexedBlockRangeCap defines the max range of blocks, covered by the log index of the custom indexer,
+# allowed for 'eth_getLogs' query. Queries with block range above block-range-cap should filter by address or topic.
+indexed-block-range-cap = {{ .JSONRPC.IndexedBlockRangeCap }}
+
 # HTTPTimeout is the read/write timeout of http json-rpc server.
 http-timeout = "{{ .JSONRPC.HTTPTimeout }}"
 
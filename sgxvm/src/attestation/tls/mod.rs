@@ -5,7 +5,7 @@ use std::vec::Vec;
 use std::string::String;
 
 #[cfg(feature = "attestation_server")]
-use crate::key_manager::{keys::RegistrationKey, UNSEALED_KEY_MANAGER};
+use crate::key_manager::{keys::RegistrationKey, with_unsealed_key_manager};
 #[cfg(not(feature = "attestation_server"))]
 use crate::key_manager::keys::RegistrationKey;
 
@@ -92,10 +92,11 @@ pub fn perform_epoch_keys_provisioning(
     let registration_key = RegistrationKey::random()?;
 
     // Unseal key manager to get access to master key
-    let encrypted_epoch_data = match &*UNSEALED_KEY_MANAGER {
-        Some(key_manager) => {
-            key_manager
-                .encrypt_epoch_data(&registration_key, client_public_key.to_vec())
+    let encrypted_epoch_data = match with_unsealed_key_manager(|key_manager| {
+        key_manager.encrypt_epoch_data(&registration_key, client_public_key.to_vec())
+    }) {
+        Some(encrypted_epoch_data) => {
+            encrypted_epoch_data
                 .map_err(|err| {
                     println!(
                         "[Enclave] Cannot encrypt epoch data to share it. Reason: {:?}",
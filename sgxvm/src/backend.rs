@@ -6,6 +6,7 @@ use std::vec::Vec;
 use crate::{
     coder,
     error::Error,
+    precompiles::CosmosSupplyChange,
     protobuf_generated::ffi,
     querier,
     storage::FFIStorage,
@@ -55,15 +56,36 @@ impl<'state> ExtendedBackend for FFIBackend<'state> {
         self.logs.clone()
     }
 
-    fn apply<A, I, L>(&mut self, values: A, logs: L, _delete_empty: bool) -> Result<(), Error>
+    fn apply<A, I, L>(
+        &mut self,
+        values: A,
+        logs: L,
+        supply_change: CosmosSupplyChange,
+        _delete_empty: bool,
+    ) -> Result<(), Error>
     where
         A: IntoIterator<Item = Apply<I>>,
         I: IntoIterator<Item = (H256, H256)>,
         L: IntoIterator<Item = Log>,
     {
+        let values: Vec<Apply<I>> = values.into_iter().collect();
+
+        // Previous account data is requested in a single batch to check total supply invariant
+        let modified_addresses: Vec<H160> = values
+            .iter()
+            .filter_map(|apply| match apply {
+                Apply::Modify { address, .. } => Some(*address),
+                Apply::Delete { .. } => None,
+            })
+            .collect();
+        let mut previous_accounts = self.state.get_accounts(&modified_addresses).into_iter();
+
         let mut total_supply_add = U256::zero();
         let mut total_supply_sub = U256::zero();
 
+        // Code and storage updates are sent in a single batch after all accounts are updated
+        let mut batched_writes = Vec::new();
+
         for apply in values {
             match apply {
                 Apply::Modify {
@@ -74,7 +96,7 @@ impl<'state> ExtendedBackend for FFIBackend<'state> {
                     ..
                 } => {
                     // Update account balance and nonce
-                    let previous_account_data = self.state.get_account(&address);
+                    let previous_account_data = previous_accounts.next().unwrap_or_default();
 
                     if basic.balance > previous_account_data.balance {
                         total_supply_add = total_supply_add
@@ -89,32 +111,33 @@ impl<'state> ExtendedBackend for FFIBackend<'state> {
 
                     // Handle contract updates
                     if let Some(code) = code {
-                        self.state.insert_account_code(address, code)?;
+                        batched_writes.push(coder::encode_insert_account_code(address, code));
                     }
 
                     // Handle storage updates
                     for (index, value) in storage {
-                        if value == H256::default() {
-                            self.state.remove_storage_cell(&address, &index)?;
-                        } else {
-                            self.state.insert_storage_cell(address, index, value)?;
-                        }
+                        batched_writes.push(self.state.encode_storage_cell_update(address, index, value)?);
                     }
                 }
                 // Used by `SELFDESTRUCT` opcode
                 Apply::Delete { address } => {
-                    self.state.remove(&address)?;
+                    batched_writes.push(coder::encode_remove(&address));
                 }
             }
         }
 
-        // Used to avoid corrupting state via invariant violation
+        // Used to avoid corrupting state via invariant violation. Balance changes, made by Cosmos messages,
+        // are excluded, since Cosmos messages can move coins to or from accounts, which are not accessible from EVM
+        let total_supply_add = total_supply_add.checked_add(supply_change.removed).unwrap();
+        let total_supply_sub = total_supply_sub.checked_add(supply_change.added).unwrap();
         assert_eq!(
             total_supply_add, total_supply_sub,
             "evm execution would lead to invariant violation ({} != {})",
             total_supply_add, total_supply_sub
         );
 
+        self.state.write_batch(batched_writes)?;
+
         for log in logs {
             self.logs.push(log);
         }
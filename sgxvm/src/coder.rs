@@ -1,7 +1,7 @@
 use ethabi::Address;
 use evm::backend::Basic;
 use primitive_types::{H160, U256, H256};
-use protobuf::Message;
+use protobuf::{Message, RepeatedField};
 use crate::protobuf_generated::ffi;
 use std::{
     vec::Vec,
@@ -162,3 +162,218 @@ pub fn encode_get_verification_data(user_address: Address, issuer_address: H160)
     cosmos_request.set_getVerificationData(request);
     cosmos_request.write_to_bytes().unwrap()
 }
+
+pub fn encode_batch(requests: Vec<Vec<u8>>) -> Vec<u8> {
+    let mut cosmos_request = ffi::CosmosRequest::new();
+    let mut request = ffi::QueryBatch::new();
+    request.set_requests(RepeatedField::from_vec(requests));
+    cosmos_request.set_batch(request);
+    cosmos_request.write_to_bytes().unwrap()
+}
+
+pub fn encode_metered_request(request: Vec<u8>, gas_limit: u64) -> Vec<u8> {
+    let mut cosmos_request = ffi::CosmosRequest::new();
+    let mut metered = ffi::QueryMetered::new();
+    metered.set_request(request);
+    metered.set_gasLimit(gas_limit);
+    cosmos_request.set_metered(metered);
+    cosmos_request.write_to_bytes().unwrap()
+}
+
+pub fn encode_trace_events(events: Vec<ffi::TraceEvent>) -> Vec<u8> {
+    let mut cosmos_request = ffi::CosmosRequest::new();
+    let mut request = ffi::QueryTraceEvents::new();
+    request.set_events(RepeatedField::from_vec(events));
+    cosmos_request.set_traceEvents(request);
+    cosmos_request.write_to_bytes().unwrap()
+}
+
+pub fn encode_delegate_request(delegator: H160, validator_address: String, amount: U256) -> Vec<u8> {
+    let mut cosmos_request = ffi::CosmosRequest::new();
+    let mut request = ffi::QueryDelegate::new();
+    request.set_delegator(delegator.as_bytes().to_vec());
+    request.set_validatorAddress(validator_address);
+    request.set_amount(u256_to_vec(amount));
+    cosmos_request.set_delegate(request);
+    cosmos_request.write_to_bytes().unwrap()
+}
+
+pub fn encode_undelegate_request(delegator: H160, validator_address: String, amount: U256) -> Vec<u8> {
+    let mut cosmos_request = ffi::CosmosRequest::new();
+    let mut request = ffi::QueryUndelegate::new();
+    request.set_delegator(delegator.as_bytes().to_vec());
+    request.set_validatorAddress(validator_address);
+    request.set_amount(u256_to_vec(amount));
+    cosmos_request.set_undelegate(request);
+    cosmos_request.write_to_bytes().unwrap()
+}
+
+pub fn encode_redelegate_request(
+    delegator: H160,
+    validator_src_address: String,
+    validator_dst_address: String,
+    amount: U256,
+) -> Vec<u8> {
+    let mut cosmos_request = ffi::CosmosRequest::new();
+    let mut request = ffi::QueryRedelegate::new();
+    request.set_delegator(delegator.as_bytes().to_vec());
+    request.set_validatorSrcAddress(validator_src_address);
+    request.set_validatorDstAddress(validator_dst_address);
+    request.set_amount(u256_to_vec(amount));
+    cosmos_request.set_redelegate(request);
+    cosmos_request.write_to_bytes().unwrap()
+}
+
+pub fn encode_delegation_request(delegator: H160, validator_address: String) -> Vec<u8> {
+    let mut cosmos_request = ffi::CosmosRequest::new();
+    let mut request = ffi::QueryDelegation::new();
+    request.set_delegator(delegator.as_bytes().to_vec());
+    request.set_validatorAddress(validator_address);
+    cosmos_request.set_delegation(request);
+    cosmos_request.write_to_bytes().unwrap()
+}
+
+pub fn encode_withdraw_delegator_reward_request(delegator: H160, validator_address: String) -> Vec<u8> {
+    let mut cosmos_request = ffi::CosmosRequest::new();
+    let mut request = ffi::QueryWithdrawDelegatorReward::new();
+    request.set_delegator(delegator.as_bytes().to_vec());
+    request.set_validatorAddress(validator_address);
+    cosmos_request.set_withdrawDelegatorReward(request);
+    cosmos_request.write_to_bytes().unwrap()
+}
+
+pub fn encode_bank_balance_request(address: H160, denom: String) -> Vec<u8> {
+    let mut cosmos_request = ffi::CosmosRequest::new();
+    let mut request = ffi::QueryBankBalance::new();
+    request.set_address(address.as_bytes().to_vec());
+    request.set_denom(denom);
+    cosmos_request.set_bankBalance(request);
+    cosmos_request.write_to_bytes().unwrap()
+}
+
+pub fn encode_bank_send_request(from: H160, to: H160, denom: String, amount: U256) -> Vec<u8> {
+    let mut cosmos_request = ffi::CosmosRequest::new();
+    let mut request = ffi::QueryBankSend::new();
+    request.set_from(from.as_bytes().to_vec());
+    request.set_to(to.as_bytes().to_vec());
+    request.set_denom(denom);
+    request.set_amount(u256_to_vec(amount));
+    cosmos_request.set_bankSend(request);
+    cosmos_request.write_to_bytes().unwrap()
+}
+
+/// Parameters of ICS-20 transfer, requested by precompile
+pub struct IBCTransferParams {
+    pub sender: H160,
+    pub source_port: String,
+    pub source_channel: String,
+    pub denom: String,
+    pub amount: U256,
+    pub receiver: String,
+    pub timeout_revision_number: u64,
+    pub timeout_revision_height: u64,
+    pub timeout_timestamp: u64,
+    pub memo: String,
+}
+
+pub fn encode_ibc_transfer_request(params: IBCTransferParams) -> Vec<u8> {
+    let mut cosmos_request = ffi::CosmosRequest::new();
+    let mut request = ffi::QueryIBCTransfer::new();
+    request.set_sender(params.sender.as_bytes().to_vec());
+    request.set_sourcePort(params.source_port);
+    request.set_sourceChannel(params.source_channel);
+    request.set_denom(params.denom);
+    request.set_amount(u256_to_vec(params.amount));
+    request.set_receiver(params.receiver);
+    request.set_timeoutRevisionNumber(params.timeout_revision_number);
+    request.set_timeoutRevisionHeight(params.timeout_revision_height);
+    request.set_timeoutTimestamp(params.timeout_timestamp);
+    request.set_memo(params.memo);
+    cosmos_request.set_ibcTransfer(request);
+    cosmos_request.write_to_bytes().unwrap()
+}
+
+pub fn encode_gov_vote_request(voter: H160, proposal_id: u64, option: i32, metadata: String) -> Vec<u8> {
+    let mut cosmos_request = ffi::CosmosRequest::new();
+    let mut request = ffi::QueryGovVote::new();
+    request.set_voter(voter.as_bytes().to_vec());
+    request.set_proposalId(proposal_id);
+    request.set_option(option);
+    request.set_metadata(metadata);
+    cosmos_request.set_govVote(request);
+    cosmos_request.write_to_bytes().unwrap()
+}
+
+pub fn encode_gov_vote_weighted_request(
+    voter: H160,
+    proposal_id: u64,
+    options: Vec<(i32, String)>,
+    metadata: String,
+) -> Vec<u8> {
+    let mut cosmos_request = ffi::CosmosRequest::new();
+    let mut request = ffi::QueryGovVoteWeighted::new();
+    request.set_voter(voter.as_bytes().to_vec());
+    request.set_proposalId(proposal_id);
+    let options: Vec<ffi::WeightedVoteOption> = options
+        .into_iter()
+        .map(|(option, weight)| {
+            let mut weighted_option = ffi::WeightedVoteOption::new();
+            weighted_option.set_option(option);
+            weighted_option.set_weight(weight);
+            weighted_option
+        })
+        .collect();
+    request.set_options(RepeatedField::from_vec(options));
+    request.set_metadata(metadata);
+    cosmos_request.set_govVoteWeighted(request);
+    cosmos_request.write_to_bytes().unwrap()
+}
+
+pub fn encode_gov_deposit_request(depositor: H160, proposal_id: u64, denom: String, amount: U256) -> Vec<u8> {
+    let mut cosmos_request = ffi::CosmosRequest::new();
+    let mut request = ffi::QueryGovDeposit::new();
+    request.set_depositor(depositor.as_bytes().to_vec());
+    request.set_proposalId(proposal_id);
+    request.set_denom(denom);
+    request.set_amount(u256_to_vec(amount));
+    cosmos_request.set_govDeposit(request);
+    cosmos_request.write_to_bytes().unwrap()
+}
+
+pub fn encode_gov_proposal_request(proposal_id: u64) -> Vec<u8> {
+    let mut cosmos_request = ffi::CosmosRequest::new();
+    let mut request = ffi::QueryGovProposal::new();
+    request.set_proposalId(proposal_id);
+    cosmos_request.set_govProposal(request);
+    cosmos_request.write_to_bytes().unwrap()
+}
+
+pub fn encode_gov_tally_request(proposal_id: u64) -> Vec<u8> {
+    let mut cosmos_request = ffi::CosmosRequest::new();
+    let mut request = ffi::QueryGovTally::new();
+    request.set_proposalId(proposal_id);
+    cosmos_request.set_govTally(request);
+    cosmos_request.write_to_bytes().unwrap()
+}
+
+pub fn encode_contract_deployer_request(contract: &H160) -> Vec<u8> {
+    let mut cosmos_request = ffi::CosmosRequest::new();
+    let mut request = ffi::QueryContractDeployer::new();
+    request.set_contract(contract.as_bytes().to_vec());
+    cosmos_request.set_contractDeployer(request);
+    cosmos_request.write_to_bytes().unwrap()
+}
+
+pub fn encode_cosmos_snapshot_request() -> Vec<u8> {
+    let mut cosmos_request = ffi::CosmosRequest::new();
+    cosmos_request.set_cosmosSnapshot(ffi::QueryCosmosSnapshot::new());
+    cosmos_request.write_to_bytes().unwrap()
+}
+
+pub fn encode_revert_cosmos_snapshot_request(snapshot: u32) -> Vec<u8> {
+    let mut cosmos_request = ffi::CosmosRequest::new();
+    let mut request = ffi::QueryRevertCosmosSnapshot::new();
+    request.set_snapshot(snapshot);
+    cosmos_request.set_revertCosmosSnapshot(request);
+    cosmos_request.write_to_bytes().unwrap()
+}
@@ -0,0 +1,105 @@
+use k256::{
+    ecdsa::recoverable,
+    elliptic_curve::{sec1::ToEncodedPoint, IsHigh},
+};
+use primitive_types::{H160, H256, U256};
+use sha3::{Digest, Keccak256};
+use std::vec::Vec;
+
+use crate::error::Error;
+
+/// Type of the domain of typed data, which authorize access to decrypted data.
+/// Domain and typed data match `ethereum/eip712` package on Cosmos side
+const DOMAIN_TYPE: &[u8] = b"EIP712Domain(string name,string version,uint256 chainId)";
+const DOMAIN_NAME: &[u8] = b"Swisstronik";
+const DOMAIN_VERSION: &[u8] = b"1";
+
+/// Typed data, signed by transaction sender to authorize tracing of decrypted execution of the transaction
+const TRACE_TRANSACTION_TYPE: &[u8] = b"TraceTransaction(bytes32 txHash)";
+
+/// Typed data, signed by the account, which is allowed to read decrypted storage of the contract
+const STORAGE_ACCESS_TYPE: &[u8] = b"StorageAccess(address contract,bytes32 publicKey,uint64 expiry)";
+
+/// Length of signature in [R || S || V] format
+const SIGNATURE_LENGTH: usize = 65;
+
+/// Returns hash of `TraceTransaction` typed data, which should be signed by the sender of the transaction
+pub fn trace_transaction_hash(chain_id: u64, tx_hash: H256) -> H256 {
+    let mut encoded = Vec::with_capacity(64);
+    encoded.extend_from_slice(keccak256(TRACE_TRANSACTION_TYPE).as_bytes());
+    encoded.extend_from_slice(tx_hash.as_bytes());
+    typed_data_hash(chain_id, keccak256(&encoded))
+}
+
+/// Returns hash of `StorageAccess` typed data, which authorizes reading of decrypted storage of the contract
+/// until expiry. Public key is x25519 key, which is used to encrypt decrypted values
+pub fn storage_access_hash(chain_id: u64, contract: H160, public_key: H256, expiry: u64) -> H256 {
+    let mut encoded = Vec::with_capacity(128);
+    encoded.extend_from_slice(keccak256(STORAGE_ACCESS_TYPE).as_bytes());
+    encoded.extend_from_slice(H256::from(contract).as_bytes());
+    encoded.extend_from_slice(public_key.as_bytes());
+    encoded.extend_from_slice(&uint_word(U256::from(expiry)));
+    typed_data_hash(chain_id, keccak256(&encoded))
+}
+
+/// Recovers address of the account, which signed provided hash.
+/// Signature is expected in [R || S || V] format, where V is either 0/1 or 27/28
+pub fn recover_signer(hash: H256, signature: &[u8]) -> Result<H160, Error> {
+    if signature.len() != SIGNATURE_LENGTH {
+        return Err(Error::enclave_err(format!(
+            "Invalid signature length. Expected 65, Got: {:?}",
+            signature.len()
+        )));
+    }
+
+    let mut sig = [0u8; SIGNATURE_LENGTH];
+    sig.copy_from_slice(signature);
+    // Remove the recovery offset if needed (ie. Metamask eip712 signature)
+    if sig[64] == 27 || sig[64] == 28 {
+        sig[64] -= 27;
+    }
+
+    let signature = recoverable::Signature::try_from(&sig[..])
+        .map_err(|_| Error::enclave_err("Invalid signature"))?;
+    if signature.s().is_high().into() {
+        return Err(Error::enclave_err("Invalid signature"));
+    }
+
+    let msg = hash.to_fixed_bytes();
+    let recovered_key = signature
+        .recover_verifying_key_from_digest_bytes(&msg.into())
+        .map_err(|_| Error::enclave_err("Cannot recover signer"))?;
+
+    // Convert Ethereum style address
+    let point = recovered_key.to_encoded_point(false);
+    let address = keccak256(&point.as_bytes()[1..]);
+    Ok(H160::from_slice(&address.as_bytes()[12..]))
+}
+
+/// Returns hash of typed data with provided struct hash, which is signed according to EIP-712
+fn typed_data_hash(chain_id: u64, struct_hash: H256) -> H256 {
+    let mut encoded = Vec::with_capacity(66);
+    encoded.extend_from_slice(&[0x19, 0x01]);
+    encoded.extend_from_slice(domain_separator(chain_id).as_bytes());
+    encoded.extend_from_slice(struct_hash.as_bytes());
+    keccak256(&encoded)
+}
+
+fn domain_separator(chain_id: u64) -> H256 {
+    let mut encoded = Vec::with_capacity(128);
+    encoded.extend_from_slice(keccak256(DOMAIN_TYPE).as_bytes());
+    encoded.extend_from_slice(keccak256(DOMAIN_NAME).as_bytes());
+    encoded.extend_from_slice(keccak256(DOMAIN_VERSION).as_bytes());
+    encoded.extend_from_slice(&uint_word(U256::from(chain_id)));
+    keccak256(&encoded)
+}
+
+fn uint_word(value: U256) -> [u8; 32] {
+    let mut word = [0u8; 32];
+    value.to_big_endian(&mut word);
+    word
+}
+
+fn keccak256(data: &[u8]) -> H256 {
+    H256::from_slice(Keccak256::digest(data).as_slice())
+}
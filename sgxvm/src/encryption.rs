@@ -6,7 +6,7 @@ use k256::sha2::{Digest, Sha256 as kSha256};
 use rand_chacha::rand_core::{RngCore, SeedableRng};
 use std::vec::Vec;
 
-use crate::key_manager::{PRIVATE_KEY_SIZE, UNSEALED_KEY_MANAGER};
+use crate::key_manager::{with_unsealed_key_manager, PRIVATE_KEY_SIZE};
 
 pub const FUNCTION_SELECTOR_LEN: usize = 4;
 pub const ZERO_FUNCTION_SELECTOR: [u8; 4] = [0u8; 4];
@@ -25,9 +25,9 @@ pub fn encrypt_storage_cell(
     encryption_salt: Vec<u8>,
     value: Vec<u8>,
 ) -> Result<Vec<u8>, Error> {
-    match &*UNSEALED_KEY_MANAGER {
-        Some(key_manager) => {
-            match key_manager.get_state_key_by_block(block_number) {
+    match with_unsealed_key_manager(|key_manager| key_manager.get_state_key_by_block(block_number)) {
+        Some(state_key) => {
+            match state_key {
                 Some((epoch_number, state_key)) => {
                     let encrypted_value = state_key.encrypt(contract_address, encryption_salt, value)?;
                     let mut output = epoch_number.to_be_bytes().to_vec();
@@ -70,8 +70,8 @@ pub fn decrypt_storage_cell(
     };
 
     // Find appropriate key in EpochManager
-    let state_key = match &*UNSEALED_KEY_MANAGER {
-        Some(key_manager) => key_manager.get_state_key_by_epoch(epoch_number),
+    let state_key = match with_unsealed_key_manager(|key_manager| key_manager.get_state_key_by_epoch(epoch_number)) {
+        Some(state_key) => state_key,
         None => {
             return Err(Error::encryption_err("Cannot get access to key manager"));
         }
@@ -119,9 +119,9 @@ pub fn decrypt_transaction_data(
     public_key: Vec<u8>,
     block_number: u64,
 ) -> Result<Vec<u8>, Error> {
-    match &*UNSEALED_KEY_MANAGER {
-        Some(key_manager) => {
-            match key_manager.get_tx_key_by_block(block_number) {
+    match with_unsealed_key_manager(|key_manager| key_manager.get_tx_key_by_block(block_number)) {
+        Some(tx_key) => {
+            match tx_key {
                 Some((_, tx_key)) => tx_key.decrypt(public_key, encrypted_data),
                 None => Err(Error::encryption_err("There no keys stored in Epoch Manager")),
             }
@@ -147,9 +147,9 @@ pub fn encrypt_transaction_data(
         return Err(Error::ecdh_err("Wrong nonce size"));
     }
 
-    match &*UNSEALED_KEY_MANAGER {
-        Some(key_manager) => {
-            match key_manager.get_tx_key_by_block(block_number) {
+    match with_unsealed_key_manager(|key_manager| key_manager.get_tx_key_by_block(block_number)) {
+        Some(tx_key) => {
+            match tx_key {
                 Some((_, tx_key)) => tx_key.encrypt(user_public_key, data, nonce),
                 None => Err(Error::encryption_err("There no keys stored in Epoch Manager")),
             }
@@ -2,17 +2,30 @@ use std::vec::Vec;
 use std::slice;
 use sgx_types::sgx_status_t;
 use protobuf::Message;
+use primitive_types::{H160, H256};
 
 use crate::protobuf_generated::ffi::{FFIRequest, FFIRequest_oneof_req};
 use crate::{AllocationWithResult, Allocation};
 use crate::ocall;
 use crate::key_manager::KeyManager;
 use crate::protobuf_generated::ffi::{
+    ApplyStorageOverrideRequest,
+    ApplyStorageOverrideResponse,
     NodePublicKeyResponse,
+    PrivateStorageRequest,
+    PrivateStorageResponse,
+    QueryGetAccountStorageCellResponse,
     SGXVMCallRequest, 
     SGXVMCreateRequest,
 };
+use crate::coder;
+use crate::eip712;
+use crate::encryption;
+use crate::error::Error;
+use crate::key_manager::utils::random_nonce;
+use crate::querier;
 use crate::GoQuerier;
+use crate::storage::FFIStorage;
 
 pub mod tx;
 
@@ -43,6 +56,12 @@ pub fn handle_protobuf_request_inner(
                 },
                 FFIRequest_oneof_req::publicKeyRequest(data) => {
                     handle_public_key_request(data.blockNumber)
+                },
+                FFIRequest_oneof_req::applyStorageOverrideRequest(data) => {
+                    handle_apply_storage_override_request(querier, data)
+                },
+                FFIRequest_oneof_req::privateStorageRequest(data) => {
+                    handle_private_storage_request(querier, data)
                 }
             }
         }
@@ -116,6 +135,134 @@ pub fn handle_public_key_request(block_number: u64) -> AllocationWithResult {
     allocate_inner(encoded_response)
 }
 
+/// Handles incoming request to write overridden storage cells. Values are encrypted in the same way
+/// as during EVM execution and are written through the querier, so they are not returned to the caller
+pub fn handle_apply_storage_override_request(querier: *mut GoQuerier, data: ApplyStorageOverrideRequest) -> AllocationWithResult {
+    let mut storage = FFIStorage::new(querier, data.timestamp, data.blockNumber);
+
+    let mut writes = Vec::new();
+    for storage_override in data.overrides.into_iter() {
+        if storage_override.address.len() != 20 {
+            println!("Invalid address length: {}", storage_override.address.len());
+            return AllocationWithResult::default()
+        }
+        let address = H160::from_slice(&storage_override.address);
+
+        for cell in storage_override.cells.into_iter() {
+            if cell.index.len() != 32 || cell.value.len() != 32 {
+                println!("Invalid storage cell override");
+                return AllocationWithResult::default()
+            }
+
+            match storage.encode_storage_cell_update(address, H256::from_slice(&cell.index), H256::from_slice(&cell.value)) {
+                Ok(write) => writes.push(write),
+                Err(err) => {
+                    println!("Cannot encrypt storage cell. Reason: {:?}", err);
+                    return AllocationWithResult::default()
+                }
+            }
+        }
+    }
+
+    if let Err(err) = storage.write_batch(writes) {
+        println!("Cannot write storage override. Reason: {:?}", err);
+        return AllocationWithResult::default()
+    }
+
+    let encoded_response = match ApplyStorageOverrideResponse::new().write_to_bytes() {
+        Ok(res) => res,
+        Err(err) => {
+            println!("Cannot encode protobuf result. Reason: {:?}", err);
+            return AllocationWithResult::default();
+        }
+    };
+
+    allocate_inner(encoded_response)
+}
+
+/// Handles incoming request for reading of decrypted contract storage cells. Request should be signed
+/// by the account, which is allowed to read storage of the contract. Each decrypted value is encrypted
+/// to the user public key, so it can be read only by the user, which requested it
+pub fn handle_private_storage_request(querier: *mut GoQuerier, data: PrivateStorageRequest) -> AllocationWithResult {
+    let mut response = PrivateStorageResponse::new();
+    match read_private_storage(querier, data) {
+        Ok(encrypted_values) => response.set_encryptedValues(encrypted_values.into()),
+        Err(err) => response.set_error(format!("{:?}", err)),
+    }
+
+    let encoded_response = match response.write_to_bytes() {
+        Ok(res) => res,
+        Err(err) => {
+            println!("Cannot encode protobuf result. Reason: {:?}", err);
+            return AllocationWithResult::default();
+        }
+    };
+
+    allocate_inner(encoded_response)
+}
+
+/// Verifies that request was signed by the account, which is allowed to read storage of the contract,
+/// and returns requested storage cells, encrypted to the user public key
+fn read_private_storage(querier: *mut GoQuerier, data: PrivateStorageRequest) -> Result<Vec<Vec<u8>>, Error> {
+    let context = data
+        .context
+        .into_option()
+        .ok_or_else(|| Error::enclave_err("Empty transaction context"))?;
+    if data.contractAddress.len() != 20 {
+        return Err(Error::enclave_err("Invalid contract address"));
+    }
+    if data.userPublicKey.len() != 32 {
+        return Err(Error::enclave_err("Invalid public key"));
+    }
+    if data.expiry <= context.timestamp {
+        return Err(Error::enclave_err("Signature expired"));
+    }
+
+    let contract = H160::from_slice(&data.contractAddress);
+    let hash = eip712::storage_access_hash(
+        context.chain_id,
+        contract,
+        H256::from_slice(&data.userPublicKey),
+        data.expiry,
+    );
+    let signer = eip712::recover_signer(hash, &data.signature)?;
+
+    let block_number = context.block_number;
+    if !tx::is_storage_viewer(querier, context, contract, signer)? {
+        return Err(Error::enclave_err("Signer is not allowed to read storage of the contract"));
+    }
+
+    let mut encrypted_values = Vec::with_capacity(data.keys.len());
+    for key in data.keys.into_iter() {
+        if key.len() != 32 {
+            return Err(Error::enclave_err("Invalid storage key"));
+        }
+        let encoded_request = coder::encode_get_storage_cell(&contract, &H256::from_slice(&key));
+        let result = querier::make_request(querier, encoded_request)
+            .ok_or_else(|| Error::enclave_err("Get account storage cell failed. Empty response"))?;
+        let cell = protobuf::parse_from_bytes::<QueryGetAccountStorageCellResponse>(&result)
+            .map_err(|err| Error::enclave_err(format!("Cannot decode protobuf response: {:?}", err)))?;
+
+        // Uninitialized slot is returned as zero value
+        let value = match cell.value.is_empty() {
+            true => H256::zero().as_bytes().to_vec(),
+            false => encryption::decrypt_storage_cell(data.contractAddress.clone(), cell.value)?,
+        };
+
+        let nonce = random_nonce()
+            .map_err(|err| Error::enclave_err(format!("Cannot generate nonce: {:?}", err)))?;
+        let encrypted_value = encryption::encrypt_transaction_data(
+            value,
+            data.userPublicKey.clone(),
+            nonce.to_vec(),
+            block_number,
+        )?;
+        encrypted_values.push(encrypted_value);
+    }
+
+    Ok(encrypted_values)
+}
+
 /// Handles incoming request for calling contract or transferring value
 /// * querier - GoQuerier which is used to interact with Go (Cosmos) from SGX Enclave
 /// * data - EVM call data (destination, value, etc.)
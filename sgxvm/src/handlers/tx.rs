@@ -1,32 +1,53 @@
-use evm::executor::stack::{MemoryStackState, StackExecutor, StackSubstateMetadata};
+use ethereum::{
+    EIP1559TransactionMessage, EIP2930TransactionMessage, EnvelopedDecodable,
+    LegacyTransactionMessage, TransactionAction, TransactionV2,
+};
+use evm::executor::stack::{StackExecutor, StackState, StackSubstateMetadata};
 use evm::ExitReason;
 use primitive_types::{H160, H256, U256};
 use protobuf::Message;
 use protobuf::RepeatedField;
-use std::{string::String, vec::Vec};
+use std::{cell::RefCell, collections::BTreeMap, rc::Rc, string::String, vec::Vec};
 
 use crate::backend::{FFIBackend, TxContext};
+use crate::coder;
+use crate::eip712;
+use crate::error::Error;
 use crate::encryption::{
     decrypt_transaction_data, encrypt_transaction_data, extract_public_key_and_data,
 };
 use crate::key_manager::utils::random_nonce;
-use crate::precompiles::EVMPrecompiles;
+use crate::precompiles::{CosmosAwareState, CosmosJournal, EVMPrecompiles};
+use crate::storage::FFIStorage;
 use crate::protobuf_generated::ffi::{
-    AccessListItem, HandleTransactionResponse, Log, SGXVMCallRequest, SGXVMCreateRequest, Topic,
+    AccessListItem, HandleTransactionResponse, Log, QueryContractDeployerResponse, SGXVMCallParams,
+    SGXVMCallRequest, SGXVMCreateRequest, Topic, TraceAuthorization, TransactionContext,
 };
+use crate::querier;
 use crate::std::string::ToString;
-use crate::types::{ExecutionResult, ExtendedBackend, Vicinity, GASOMETER_CONFIG};
+use crate::tracing::{run_with_listeners, CallFrame, TraceMode};
+use crate::types::{ExecutionResult, ExtendedBackend, Storage, Vicinity, GASOMETER_CONFIG};
 use crate::AllocationWithResult;
 use crate::GoQuerier;
 
+/// Gas limit of `isStorageViewer(address)` call, which is used by the contract to check if account
+/// is allowed to read its storage
+const STORAGE_VIEWER_GAS_LIMIT: u64 = 100_000;
+
+/// Selector of `isStorageViewer(address) returns (bool)` function, which can be implemented by the contract
+/// to allow accounts other than deployer to read its decrypted storage
+const IS_STORAGE_VIEWER_SELECTOR: [u8; 4] = [0x80, 0x06, 0xe1, 0x77];
+
 /// Converts raw execution result into protobuf and returns it outside of enclave
 pub fn convert_and_allocate_transaction_result(
     execution_result: ExecutionResult,
 ) -> AllocationWithResult {
     let mut response = HandleTransactionResponse::new();
     response.set_gas_used(execution_result.gas_used);
+    response.set_gas_refund(execution_result.gas_refund);
     response.set_vm_error(execution_result.vm_error);
     response.set_ret(execution_result.data);
+    response.set_encrypted_trace(execution_result.encrypted_trace);
 
     // Convert logs into proper format
     let converted_logs = execution_result
@@ -46,6 +67,7 @@ pub fn convert_and_allocate_transaction_result(
         .collect();
 
     response.set_logs(converted_logs);
+    response.set_access_list(convert_access_list_to_proto(execution_result.access_list));
 
     let encoded_response = match response.write_to_bytes() {
         Ok(res) => res,
@@ -66,12 +88,27 @@ pub fn handle_call_request_inner(
     let params = data.params.unwrap();
     let context = data.context.unwrap();
     let block_number = context.block_number;
+    let sender = message_sender(&params.from, &context);
+
+    // Decrypted execution is traced only if the sender of the transaction authorized it
+    let trace_authorized = match params.traceAuthorization.as_ref() {
+        Some(authorization) => {
+            if let Err(err) = verify_trace_authorization(authorization, &params, &context) {
+                return ExecutionResult::from_error(format!("{:?}", err), Vec::default(), None);
+            }
+            true
+        }
+        None => false,
+    };
 
     let vicinity = Vicinity {
-        origin: H160::from_slice(&params.from),
+        origin: sender,
         nonce: U256::from(params.nonce),
     };
-    let mut storage = crate::storage::FFIStorage::new(querier, context.timestamp, block_number);
+    let to = H160::from_slice(&params.to);
+    let access_list = parse_access_list(params.accessList);
+    let mut storage = FFIStorage::new(querier, context.timestamp, block_number);
+    prefetch_state(&mut storage, Some(to), &access_list);
     let mut backend = FFIBackend::new(querier, &mut storage, vicinity, TxContext::from(context));
 
     // We do not decrypt transaction if no tx.data provided, or provided explicit flag, that transaction is unencrypted
@@ -81,13 +118,15 @@ pub fn handle_call_request_inner(
             querier,
             &mut backend,
             params.gasLimit,
-            H160::from_slice(&params.from),
-            H160::from_slice(&params.to),
+            sender,
+            to,
             U256::from_big_endian(&params.value),
             params.data,
-            parse_access_list(params.accessList),
+            access_list,
             params.commit,
-        ),
+            TraceMode::stream(params.trace),
+        )
+        .0,
         true => {
             // Extract user public key and nonce from transaction data
             let (user_public_key, data, nonce) = match extract_public_key_and_data(params.data) {
@@ -113,16 +152,24 @@ pub fn handle_call_request_inner(
                 Vec::default()
             };
 
-            let mut exec_result = execute_call(
+            // Execution of encrypted transactions is never streamed outside of the enclave.
+            // Authorized call tree is collected inside the enclave and returned only in encrypted form
+            let gas_limit = params.gasLimit;
+            let trace_mode = match trace_authorized {
+                true => TraceMode::CallTree,
+                false => TraceMode::Disabled,
+            };
+            let (mut exec_result, call_tree) = execute_call(
                 querier,
                 &mut backend,
-                params.gasLimit,
-                H160::from_slice(&params.from),
-                H160::from_slice(&params.to),
+                gas_limit,
+                sender,
+                to,
                 U256::from_big_endian(&params.value),
                 decrypted_data,
-                parse_access_list(params.accessList),
+                access_list,
                 params.commit,
+                trace_mode,
             );
 
             // If there is transaction with no incoming transaction data, use random nonce to encrypt output
@@ -141,6 +188,29 @@ pub fn handle_call_request_inner(
                 nonce
             };
 
+            // Encrypt collected call tree, including reverted one, since it contains decrypted data
+            if let Some(mut call_tree) = call_tree {
+                call_tree.set_transaction_gas(gas_limit, exec_result.gas_used);
+                let encrypted_trace = serde_json::to_vec(&call_tree)
+                    .map_err(|err| Error::enclave_err(format!("Cannot encode trace: {:?}", err)))
+                    .and_then(|trace| {
+                        let nonce = random_nonce().map_err(|err| {
+                            Error::enclave_err(format!("Cannot generate nonce: {:?}", err))
+                        })?;
+                        encrypt_transaction_data(trace, user_public_key.clone(), nonce.to_vec(), block_number)
+                    });
+                match encrypted_trace {
+                    Ok(encrypted_trace) => exec_result.encrypted_trace = encrypted_trace,
+                    Err(err) => {
+                        return ExecutionResult::from_error(
+                            format!("{:?}", err),
+                            Vec::default(),
+                            None,
+                        );
+                    }
+                }
+            }
+
             // Return unencrypted transaction response in case of revert
             if !exec_result.vm_error.is_empty() {
                 return exec_result;
@@ -165,6 +235,58 @@ pub fn handle_call_request_inner(
     }
 }
 
+/// Checks if account is allowed to read decrypted storage of the contract. Access is granted to the deployer
+/// of the contract and to accounts, for which contract `isStorageViewer(address)` function returns true
+pub fn is_storage_viewer(
+    querier: *mut GoQuerier,
+    context: TransactionContext,
+    contract: H160,
+    account: H160,
+) -> Result<bool, Error> {
+    let mut storage = FFIStorage::new(querier, context.timestamp, context.block_number);
+    if storage.get_account_code(&contract).map_or(true, |code| code.is_empty()) {
+        return Ok(false);
+    }
+
+    if contract_deployer(querier, &contract)? == Some(account) {
+        return Ok(true);
+    }
+
+    let vicinity = Vicinity {
+        origin: H160::zero(),
+        nonce: U256::zero(),
+    };
+    let mut backend = FFIBackend::new(querier, &mut storage, vicinity, TxContext::from(context));
+    let mut data = IS_STORAGE_VIEWER_SELECTOR.to_vec();
+    data.extend_from_slice(H256::from(account).as_bytes());
+
+    let (result, _) = execute_call(
+        querier,
+        &mut backend,
+        STORAGE_VIEWER_GAS_LIMIT,
+        H160::zero(),
+        contract,
+        U256::zero(),
+        data,
+        Vec::default(),
+        false,
+        TraceMode::Disabled,
+    );
+    Ok(result.vm_error.is_empty() && result.data.len() == 32 && U256::from_big_endian(&result.data) == U256::one())
+}
+
+/// Returns the account, which deployed the contract by a transaction
+fn contract_deployer(querier: *mut GoQuerier, contract: &H160) -> Result<Option<H160>, Error> {
+    let result = querier::make_request(querier, coder::encode_contract_deployer_request(contract))
+        .ok_or_else(|| Error::enclave_err("Get contract deployer failed. Empty response"))?;
+    let response = protobuf::parse_from_bytes::<QueryContractDeployerResponse>(&result)
+        .map_err(|err| Error::enclave_err(format!("Cannot decode protobuf response: {:?}", err)))?;
+    match response.deployer.len() {
+        20 => Ok(Some(H160::from_slice(&response.deployer))),
+        _ => Ok(None),
+    }
+}
+
 /// Inner handler for EVM create request
 pub fn handle_create_request_inner(
     querier: *mut GoQuerier,
@@ -172,24 +294,98 @@ pub fn handle_create_request_inner(
 ) -> ExecutionResult {
     let params = data.params.unwrap();
     let context = data.context.unwrap();
+    let sender = message_sender(&params.from, &context);
 
     let vicinity = Vicinity {
-        origin: H160::from_slice(&params.from),
+        origin: sender,
         nonce: U256::from(params.nonce),
     };
-    let mut storage = crate::storage::FFIStorage::new(querier, context.timestamp, context.block_number);
+    let access_list = parse_access_list(params.accessList);
+    let mut storage = FFIStorage::new(querier, context.timestamp, context.block_number);
+    prefetch_state(&mut storage, None, &access_list);
     let mut backend = FFIBackend::new(querier, &mut storage, vicinity, TxContext::from(context));
 
     execute_create(
         querier,
         &mut backend,
         params.gasLimit,
-        H160::from_slice(&params.from),
+        sender,
         U256::from_big_endian(&params.value),
         params.data,
-        parse_access_list(params.accessList),
+        access_list,
         params.commit,
+        TraceMode::stream(params.trace),
     )
+    .0
+}
+
+/// Returns sender of the message. Sender is exposed to executed contracts only if it was
+/// authenticated on Cosmos side, otherwise zero address is used
+fn message_sender(from: &[u8], context: &TransactionContext) -> H160 {
+    match context.authenticated_sender {
+        true => H160::from_slice(from),
+        false => H160::zero(),
+    }
+}
+
+/// Verifies that tracing of decrypted execution was authorized by the sender of the transaction.
+/// Authorization contains signed transaction, which should match executed message, and EIP-712
+/// signature of its hash by the same account
+fn verify_trace_authorization(
+    authorization: &TraceAuthorization,
+    params: &SGXVMCallParams,
+    context: &TransactionContext,
+) -> Result<(), Error> {
+    let transaction = <TransactionV2 as EnvelopedDecodable>::decode(&authorization.transaction)
+        .map_err(|err| Error::enclave_err(format!("Cannot decode transaction: {:?}", err)))?;
+
+    let (signing_hash, signature, action, value, input) = match &transaction {
+        TransactionV2::Legacy(tx) => {
+            let mut signature = Vec::with_capacity(65);
+            signature.extend_from_slice(tx.signature.r().as_bytes());
+            signature.extend_from_slice(tx.signature.s().as_bytes());
+            signature.push(tx.signature.standard_v());
+            let hash = LegacyTransactionMessage::from(tx.clone()).hash();
+            (hash, signature, &tx.action, tx.value, &tx.input)
+        }
+        TransactionV2::EIP2930(tx) => {
+            let mut signature = Vec::with_capacity(65);
+            signature.extend_from_slice(tx.r.as_bytes());
+            signature.extend_from_slice(tx.s.as_bytes());
+            signature.push(tx.odd_y_parity as u8);
+            let hash = EIP2930TransactionMessage::from(tx.clone()).hash();
+            (hash, signature, &tx.action, tx.value, &tx.input)
+        }
+        TransactionV2::EIP1559(tx) => {
+            let mut signature = Vec::with_capacity(65);
+            signature.extend_from_slice(tx.r.as_bytes());
+            signature.extend_from_slice(tx.s.as_bytes());
+            signature.push(tx.odd_y_parity as u8);
+            let hash = EIP1559TransactionMessage::from(tx.clone()).hash();
+            (hash, signature, &tx.action, tx.value, &tx.input)
+        }
+    };
+
+    // Transaction should match executed message
+    let sender = eip712::recover_signer(signing_hash, &signature)?;
+    if params.from.len() != 20 || sender != H160::from_slice(&params.from) {
+        return Err(Error::enclave_err("Transaction was not sent by the message sender"));
+    }
+    if params.to.len() != 20 || *action != TransactionAction::Call(H160::from_slice(&params.to)) {
+        return Err(Error::enclave_err("Transaction recipient doesn't match the message"));
+    }
+    if value != U256::from_big_endian(&params.value) || input.as_slice() != params.data.as_slice() {
+        return Err(Error::enclave_err("Transaction doesn't match the message"));
+    }
+
+    // Tracing should be authorized by the sender of the transaction
+    let typed_data_hash = eip712::trace_transaction_hash(context.chain_id, transaction.hash());
+    let signer = eip712::recover_signer(typed_data_hash, &authorization.signature)?;
+    if signer != sender {
+        return Err(Error::enclave_err("Tracing was not authorized by the sender of the transaction"));
+    }
+
+    Ok(())
 }
 
 /// Converts access list from RepeatedField to Vec
@@ -210,6 +406,72 @@ fn parse_access_list(data: RepeatedField<AccessListItem>) -> Vec<(H160, Vec<H256
     access_list
 }
 
+/// Loads code of transaction recipient and state of accounts from access list in a single request
+/// before execution, since they are likely to be accessed during execution
+fn prefetch_state(storage: &mut FFIStorage, to: Option<H160>, access_list: &[(H160, Vec<H256>)]) {
+    let mut prefetched: BTreeMap<H160, Vec<H256>> = BTreeMap::new();
+    if let Some(to) = to {
+        prefetched.entry(to).or_default();
+    }
+    for (address, slots) in access_list {
+        let entry = prefetched.entry(*address).or_default();
+        for slot in slots {
+            if !entry.contains(slot) {
+                entry.push(*slot);
+            }
+        }
+    }
+
+    storage.prefetch(&prefetched);
+}
+
+/// Converts access list from Vec to RepeatedField
+fn convert_access_list_to_proto(access_list: Vec<(H160, Vec<H256>)>) -> RepeatedField<AccessListItem> {
+    access_list
+        .into_iter()
+        .map(|(address, slots)| {
+            let mut item = AccessListItem::new();
+            item.set_address(address.as_fixed_bytes().to_vec());
+            item.set_storageSlot(
+                slots
+                    .into_iter()
+                    .map(|slot| slot.as_fixed_bytes().to_vec())
+                    .collect(),
+            );
+            item
+        })
+        .collect()
+}
+
+/// Returns refund counter, accumulated during execution. Refund amounts are defined by EIP-3529 rules of
+/// the gasometer config, while the cap of refund is applied outside of the enclave, since it depends on
+/// gas, used by the whole transaction
+fn collect_gas_refund<'config, S: StackState<'config>>(state: &S) -> u64 {
+    state.metadata().gasometer().refunded_gas().max(0) as u64
+}
+
+/// Collects addresses and storage slots, accessed during execution, from EIP-2929 accessed set.
+/// Precompiles are always warm, so they are excluded
+fn collect_access_list<'config, S: StackState<'config>>(state: &S) -> Vec<(H160, Vec<H256>)> {
+    let accessed = match state.metadata().accessed() {
+        Some(accessed) => accessed,
+        None => return Vec::default(),
+    };
+    let precompiles = EVMPrecompiles::used_addresses();
+
+    let mut access_list: BTreeMap<H160, Vec<H256>> = BTreeMap::new();
+    for address in accessed.accessed_addresses.iter() {
+        if !precompiles.contains(address) {
+            access_list.entry(*address).or_default();
+        }
+    }
+    for (address, slot) in accessed.accessed_storage.iter() {
+        access_list.entry(*address).or_default().push(*slot);
+    }
+
+    access_list.into_iter().collect()
+}
+
 /// Converts EVM topic into protobuf-generated `Topic
 fn convert_topic_to_proto(topic: H256) -> Topic {
     let mut protobuf_topic = Topic::new();
@@ -227,8 +489,9 @@ fn convert_topic_to_proto(topic: H256) -> Topic {
 /// * data - encoded params for smart contract call or arbitrary data
 /// * access_list - EIP-2930 access list
 /// * commit - should apply changes. Provide `false` if you want to simulate transaction, without state changes
+/// * trace_mode - should report executed opcodes and nested call frames to Cosmos side or collect call tree
 ///
-/// Returns EVM execution result  
+/// Returns EVM execution result and call tree, if it was collected
 fn execute_call(
     querier: *mut GoQuerier,
     backend: &mut impl ExtendedBackend,
@@ -239,35 +502,60 @@ fn execute_call(
     data: Vec<u8>,
     access_list: Vec<(H160, Vec<H256>)>,
     commit: bool,
-) -> ExecutionResult {
+    trace_mode: TraceMode,
+) -> (ExecutionResult, Option<CallFrame>) {
     let metadata = StackSubstateMetadata::new(gas_limit, &GASOMETER_CONFIG);
-    let state = MemoryStackState::new(metadata, backend);
-    let precompiles = EVMPrecompiles::new(querier);
+    let journal = Rc::new(RefCell::new(CosmosJournal::new(querier)));
+    let state = CosmosAwareState::new(metadata, backend, journal.clone());
+    let precompiles = EVMPrecompiles::new(querier, journal.clone());
 
     let mut executor = StackExecutor::new_with_precompiles(state, &GASOMETER_CONFIG, &precompiles);
-    let (exit_reason, ret) = executor.transact_call(from, to, value, data, gas_limit, access_list);
+    let ((exit_reason, ret), call_tree) = run_with_listeners(querier, trace_mode, journal.clone(), || {
+        executor.transact_call(from, to, value, data, gas_limit, access_list)
+    });
+
+    // Cosmos state, changed by precompiles within failed frames, should be reverted before
+    // transaction is finished
+    if journal.borrow().failed() {
+        let err = "cannot revert Cosmos state of failed call frame".to_string();
+        return (ExecutionResult::from_error(err, Vec::default(), None), call_tree);
+    }
+    if let Some(address) = executor.state().insufficient_balance() {
+        let err = format!("insufficient balance of {:?} to apply changes made by cosmos messages", address);
+        return (ExecutionResult::from_error(err, Vec::default(), None), call_tree);
+    }
 
-    let gas_used = executor.used_gas();
+    // Refund is applied outside of the enclave, so gas usage is reported without it
+    let gas_used = executor.state().metadata().gasometer().total_used_gas();
+    let gas_refund = collect_gas_refund(executor.state());
+    let access_list = collect_access_list(executor.state());
     let exit_value = match handle_evm_result(exit_reason, ret) {
         Ok(data) => data,
         Err((err, data)) => {
-            return ExecutionResult::from_error(err, data, Some(gas_used));
+            let mut result = ExecutionResult::from_error(err, data, Some(gas_used));
+            result.access_list = access_list;
+            return (result, call_tree);
         }
     };
 
     if commit {
-        let (vals, logs) = executor.into_state().deconstruct();
-        if let Err(err) = backend.apply(vals, logs, false) {
-            return ExecutionResult::from_error(err.to_string(), Vec::default(), None)
+        let supply_change = executor.state().cosmos_supply_change();
+        let (vals, logs) = executor.into_state().into_inner().deconstruct();
+        if let Err(err) = backend.apply(vals, logs, supply_change, false) {
+            return (ExecutionResult::from_error(err.to_string(), Vec::default(), None), call_tree)
         }
     }
 
-    ExecutionResult {
+    let result = ExecutionResult {
         logs: backend.get_logs(),
         data: exit_value,
         gas_used,
+        gas_refund,
         vm_error: "".to_string(),
-    }
+        access_list,
+        encrypted_trace: Vec::default(),
+    };
+    (result, call_tree)
 }
 
 /// Creates new smart contract
@@ -278,8 +566,9 @@ fn execute_call(
 /// * data - encoded bytecode and creation params
 /// * access_list - EIP-2930 access list
 /// * commit - should apply changes. Provide `false` if you want to simulate contract creation, without state changes
+/// * trace_mode - should report executed opcodes and nested call frames to Cosmos side or collect call tree
 ///
-/// Returns EVM execution result  
+/// Returns EVM execution result and call tree, if it was collected
 fn execute_create(
     querier: *mut GoQuerier,
     backend: &mut impl ExtendedBackend,
@@ -289,33 +578,60 @@ fn execute_create(
     data: Vec<u8>,
     access_list: Vec<(H160, Vec<H256>)>,
     commit: bool,
-) -> ExecutionResult {
+    trace_mode: TraceMode,
+) -> (ExecutionResult, Option<CallFrame>) {
     let metadata = StackSubstateMetadata::new(gas_limit, &GASOMETER_CONFIG);
-    let state = MemoryStackState::new(metadata, backend);
-    let precompiles = EVMPrecompiles::new(querier);
+    let journal = Rc::new(RefCell::new(CosmosJournal::new(querier)));
+    let state = CosmosAwareState::new(metadata, backend, journal.clone());
+    let precompiles = EVMPrecompiles::new(querier, journal.clone());
 
     let mut executor = StackExecutor::new_with_precompiles(state, &GASOMETER_CONFIG, &precompiles);
-    let (exit_reason, ret) = executor.transact_create(from, value, data, gas_limit, access_list);
+    let ((exit_reason, ret), call_tree) = run_with_listeners(querier, trace_mode, journal.clone(), || {
+        executor.transact_create(from, value, data, gas_limit, access_list)
+    });
+
+    // Cosmos state, changed by precompiles within failed frames, should be reverted before
+    // transaction is finished
+    if journal.borrow().failed() {
+        let err = "cannot revert Cosmos state of failed call frame".to_string();
+        return (ExecutionResult::from_error(err, Vec::default(), None), call_tree);
+    }
+    if let Some(address) = executor.state().insufficient_balance() {
+        let err = format!("insufficient balance of {:?} to apply changes made by cosmos messages", address);
+        return (ExecutionResult::from_error(err, Vec::default(), None), call_tree);
+    }
 
-    let gas_used = executor.used_gas();
+    // Refund is applied outside of the enclave, so gas usage is reported without it
+    let gas_used = executor.state().metadata().gasometer().total_used_gas();
+    let gas_refund = collect_gas_refund(executor.state());
+    let access_list = collect_access_list(executor.state());
     let exit_value = match handle_evm_result(exit_reason, ret) {
         Ok(data) => data,
-        Err((err, data)) => return ExecutionResult::from_error(err, data, Some(gas_used)),
+        Err((err, data)) => {
+            let mut result = ExecutionResult::from_error(err, data, Some(gas_used));
+            result.access_list = access_list;
+            return (result, call_tree);
+        }
     };
     
     if commit {
-        let (vals, logs) = executor.into_state().deconstruct();
-        if let Err(err) = backend.apply(vals, logs, false) {
-            return ExecutionResult::from_error(err.to_string(), Vec::default(), None)
+        let supply_change = executor.state().cosmos_supply_change();
+        let (vals, logs) = executor.into_state().into_inner().deconstruct();
+        if let Err(err) = backend.apply(vals, logs, supply_change, false) {
+            return (ExecutionResult::from_error(err.to_string(), Vec::default(), None), call_tree)
         }
     }
 
-    ExecutionResult {
+    let result = ExecutionResult {
         logs: backend.get_logs(),
         data: exit_value,
         gas_used,
+        gas_refund,
         vm_error: "".to_string(),
-    }
+        access_list,
+        encrypted_trace: Vec::default(),
+    };
+    (result, call_tree)
 }
 
 /// Handles an EVM result to return either a successful result or a (readable) error reason.
@@ -327,3 +643,99 @@ fn handle_evm_result(exit_reason: ExitReason, data: Vec<u8>) -> Result<Vec<u8>,
         ExitReason::Fatal(err) => Err((format!("fatal evm error: {:?}", err), data)),
     }
 }
+
+#[cfg(test)]
+mod tests {
+    use evm::backend::{MemoryAccount, MemoryBackend, MemoryVicinity};
+    use evm::executor::stack::MemoryStackState;
+
+    use super::*;
+
+    const GAS_LIMIT: u64 = 1_000_000;
+    // EIP-3529 refund for clearing of storage slot
+    const SSTORE_CLEARS_SCHEDULE: u64 = 4_800;
+
+    /// Calls contract with provided code and storage. Returns refund counter, reported by the enclave,
+    /// and gas used by the call
+    fn call_with_refund(code: Vec<u8>, storage: BTreeMap<H256, H256>) -> (u64, u64) {
+        let caller = H160::repeat_byte(1);
+        let contract = H160::repeat_byte(2);
+
+        let vicinity = MemoryVicinity {
+            gas_price: U256::zero(),
+            origin: caller,
+            chain_id: U256::one(),
+            block_hashes: Vec::new(),
+            block_number: U256::zero(),
+            block_coinbase: H160::zero(),
+            block_timestamp: U256::zero(),
+            block_difficulty: U256::zero(),
+            block_randomness: None,
+            block_gas_limit: U256::from(GAS_LIMIT),
+            block_base_fee_per_gas: U256::zero(),
+        };
+        let mut accounts = BTreeMap::new();
+        accounts.insert(caller, MemoryAccount {
+            nonce: U256::zero(),
+            balance: U256::zero(),
+            storage: BTreeMap::new(),
+            code: Vec::new(),
+        });
+        accounts.insert(contract, MemoryAccount {
+            nonce: U256::one(),
+            balance: U256::zero(),
+            storage,
+            code,
+        });
+        let backend = MemoryBackend::new(&vicinity, accounts);
+
+        let metadata = StackSubstateMetadata::new(GAS_LIMIT, &GASOMETER_CONFIG);
+        let state = MemoryStackState::new(metadata, &backend);
+        let precompiles = ();
+        let mut executor = StackExecutor::new_with_precompiles(state, &GASOMETER_CONFIG, &precompiles);
+        let (exit_reason, _) = executor.transact_call(caller, contract, U256::zero(), Vec::new(), GAS_LIMIT, Vec::new());
+        assert!(exit_reason.is_succeed());
+
+        let gas_used = executor.state().metadata().gasometer().total_used_gas();
+        (collect_gas_refund(executor.state()), gas_used)
+    }
+
+    /// Returns code, which clears storage slots from 0 to `count`
+    fn clear_slots_code(count: u8) -> Vec<u8> {
+        let mut code = Vec::new();
+        for slot in 0..count {
+            // PUSH1 0x00 PUSH1 slot SSTORE
+            code.extend_from_slice(&[0x60, 0x00, 0x60, slot, 0x55]);
+        }
+        // STOP
+        code.push(0x00);
+        code
+    }
+
+    fn filled_storage(count: u8) -> BTreeMap<H256, H256> {
+        (0..count)
+            .map(|slot| (H256::from_low_u64_be(slot as u64), H256::from_low_u64_be(1)))
+            .collect()
+    }
+
+    #[test]
+    fn test_storage_clearing_refund() {
+        let (refund, _) = call_with_refund(clear_slots_code(1), filled_storage(1));
+        assert_eq!(refund, SSTORE_CLEARS_SCHEDULE);
+    }
+
+    #[test]
+    fn test_no_selfdestruct_refund() {
+        // PUSH1 0x00 SELFDESTRUCT
+        let (refund, _) = call_with_refund(vec![0x60, 0x00, 0xff], BTreeMap::new());
+        assert_eq!(refund, 0);
+    }
+
+    #[test]
+    fn test_refund_is_reported_without_cap() {
+        // cap of one fifth of used gas is applied by the keeper, so the whole counter is reported
+        let (refund, gas_used) = call_with_refund(clear_slots_code(10), filled_storage(10));
+        assert_eq!(refund, 10 * SSTORE_CLEARS_SCHEDULE);
+        assert!(refund > gas_used / 5);
+    }
+}
@@ -33,14 +33,19 @@ pub struct EpochManager {
 impl EpochManager {
 
     #[cfg(feature = "attestation_server")]
-    /// Generates new epoch with random epoch key, which starts from provided
-    /// `starting_block` param. Returns new instance of EpochManager with added epoch.
+    /// Generates new epoch with random epoch key, which starts from provided `starting_block` param.
+    /// Epoch key cannot be obtained from keys of previous epochs, nodes receive it from key manager
+    /// during epoch keys provisioning. If there is already epoch with the same starting block, epoch
+    /// manager is returned unchanged. Returns new instance of EpochManager with added epoch.
     pub fn add_new_epoch(&self, starting_block: u64) -> SgxResult<EpochManager> {
         let mut updated_epoch_manager = self.clone();
 
         // Check starting block value. It should be greater than existing epochs starting blocks
         for epoch in &updated_epoch_manager.epochs {
-            if epoch.starting_block >= starting_block {
+            if epoch.starting_block == starting_block {
+                return Ok(updated_epoch_manager);
+            }
+            if epoch.starting_block > starting_block {
                 println!("[EpochManager] There is already existing epoch with greater starting block. Existing: {:?}, Provided: {:?}", epoch.starting_block, starting_block);
                 return Err(sgx_status_t::SGX_ERROR_UNEXPECTED);
             }
@@ -71,6 +76,11 @@ impl EpochManager {
         Ok(updated_epoch_manager)
     }
 
+    /// Returns true if there is epoch, which starts from provided block
+    pub fn has_epoch(&self, starting_block: u64) -> bool {
+        self.epochs.iter().any(|epoch| epoch.starting_block == starting_block)
+    }
+
 
     #[cfg(feature = "attestation_server")]
     /// Removes latest epoch. 
@@ -4,6 +4,7 @@ use sgx_tstd::{env, sgxfs::SgxFile};
 use sgx_types::{sgx_status_t, SgxResult};
 use std::io::{Read, Write};
 use std::string::String;
+use std::sync::SgxRwLock;
 use std::vec::Vec;
 
 use crate::key_manager::epoch_manager::EpochManager;
@@ -20,7 +21,9 @@ pub const PUBLIC_KEY_SIZE: usize = 32;
 pub const PRIVATE_KEY_SIZE: usize = 32;
 
 lazy_static! {
-    pub static ref UNSEALED_KEY_MANAGER: Option<KeyManager> = KeyManager::unseal().ok();
+    /// Key manager, unsealed on enclave start. It's reloaded after epochs are changed,
+    /// so new epochs are used without restart of the node
+    static ref UNSEALED_KEY_MANAGER: SgxRwLock<Option<KeyManager>> = SgxRwLock::new(KeyManager::unseal().ok());
     pub static ref KEYMANAGER_HOME: OsString =
         env::var_os("KEYMANAGER_HOME").unwrap_or_else(get_default_keymanager_home);
 }
@@ -55,6 +58,46 @@ pub fn init_enclave_inner(reset_flag: i32) -> sgx_status_t {
     sgx_status_t::SGX_SUCCESS
 }
 
+/// Calls provided function with unsealed key manager. Returns None if key manager cannot be unsealed
+pub fn with_unsealed_key_manager<T>(f: impl FnOnce(&KeyManager) -> T) -> Option<T> {
+    let guard = match UNSEALED_KEY_MANAGER.read() {
+        Ok(guard) => guard,
+        Err(err) => {
+            println!("[KeyManager] Cannot lock unsealed key manager. Reason: {:?}", err);
+            return None;
+        }
+    };
+    guard.as_ref().map(f)
+}
+
+/// Unseals key manager again and replaces the one used for encryption
+fn reload_unsealed_key_manager() -> SgxResult<()> {
+    let key_manager = KeyManager::unseal()?;
+    let mut guard = UNSEALED_KEY_MANAGER.write().map_err(|err| {
+        println!("[KeyManager] Cannot lock unsealed key manager. Reason: {:?}", err);
+        sgx_status_t::SGX_ERROR_UNEXPECTED
+    })?;
+    *guard = Some(key_manager);
+    Ok(())
+}
+
+/// Checks that epoch, which starts from provided block, was provisioned by the key manager. Sealed key
+/// manager is reloaded first, since epoch keys can be requested again while node is running. Nodes
+/// cannot create epochs themselves, since epoch keys are generated by the key manager from fresh randomness
+pub fn ensure_epoch_provisioned(starting_block: u64) -> SgxResult<()> {
+    reload_unsealed_key_manager()?;
+    match with_unsealed_key_manager(|key_manager| key_manager.epoch_manager.has_epoch(starting_block)) {
+        Some(true) => Ok(()),
+        _ => {
+            println!(
+                "[KeyManager] Epoch with starting block {} was not provisioned by key manager",
+                starting_block
+            );
+            Err(sgx_status_t::SGX_ERROR_UNEXPECTED)
+        }
+    }
+}
+
 /// KeyManager handles keys sealing/unsealing and derivation.
 pub struct KeyManager {
     epoch_manager: EpochManager,
@@ -202,8 +245,9 @@ impl KeyManager {
             );
             return Err(sgx_status_t::SGX_ERROR_UNEXPECTED);
         }
+        drop(sealed_file);
 
-        Ok(())
+        reload_unsealed_key_manager()
     }
 
     #[cfg(feature = "attestation_server")]
@@ -220,8 +264,9 @@ impl KeyManager {
             );
             return Err(sgx_status_t::SGX_ERROR_UNEXPECTED);
         }
+        drop(sealed_file);
 
-        Ok(())
+        reload_unsealed_key_manager()
     }
 
     #[cfg(feature = "attestation_server")]
@@ -30,6 +30,7 @@ use protobuf::Message;
 mod attestation;
 mod backend;
 mod coder;
+mod eip712;
 mod encryption;
 mod error;
 mod handlers;
@@ -40,6 +41,7 @@ mod precompiles;
 mod protobuf_generated;
 mod querier;
 mod storage;
+mod tracing;
 mod types;
 
 #[no_mangle]
@@ -255,6 +257,9 @@ pub unsafe extern "C" fn ecall_verify_dcap_quote(
 }
 
 #[no_mangle]
+/// Adds epoch, which starts from provided block. Key manager creates epoch with random key, which is
+/// shared with nodes during epoch keys provisioning. Nodes only check that scheduled epoch was provisioned,
+/// since they cannot create epoch keys themselves
 pub unsafe extern "C" fn ecall_add_epoch(starting_block: u64) -> sgx_status_t {
     #[cfg(feature = "attestation_server")]
     {
@@ -274,8 +279,10 @@ pub unsafe extern "C" fn ecall_add_epoch(starting_block: u64) -> sgx_status_t {
 
     #[cfg(not(feature = "attestation_server"))]
     {
-        println!("[Enclave] Not enabled");
-        sgx_status_t::SGX_ERROR_UNEXPECTED
+        match key_manager::ensure_epoch_provisioned(starting_block) {
+            Ok(_) => sgx_status_t::SGX_SUCCESS,
+            Err(err) => err
+        }
     }
 }
 
@@ -0,0 +1,120 @@
+extern crate sgx_tstd as std;
+
+use ethabi::{encode, ParamType, Token as AbiToken};
+use evm::executor::stack::{PrecompileHandle, PrecompileOutput};
+use primitive_types::U256;
+use std::cell::RefCell;
+use std::prelude::v1::*;
+use std::vec::Vec;
+
+use crate::precompiles::cosmos::{
+    decode_input, decode_string, decode_uint, emit_logs, ensure_state_change_allowed,
+    make_metered_request, revert,
+};
+use crate::precompiles::{
+    CosmosJournal, ExitSucceed, LinearCostCosmosPrecompile, PrecompileFailure, PrecompileResult,
+};
+use crate::protobuf_generated::ffi;
+use crate::{coder, GoQuerier};
+
+// Selector of balanceOf function
+const BALANCE_OF_FN_SELECTOR: &str = "b9b092c8";
+// Selector of send function
+const SEND_FN_SELECTOR: &str = "a8990b8c";
+
+/// Precompile for interactions with x/bank module. Coins are sent from the account of the caller
+pub struct Bank;
+
+impl LinearCostCosmosPrecompile for Bank {
+    const BASE: u64 = 60;
+    const WORD: u64 = 150;
+
+    fn execute(
+        querier: *mut GoQuerier,
+        journal: &RefCell<CosmosJournal>,
+        handle: &mut impl PrecompileHandle,
+    ) -> PrecompileResult {
+        let target_gas = handle.gas_limit();
+        let cost = crate::precompiles::ensure_linear_cost(
+            target_gas,
+            handle.input().len() as u64,
+            Self::BASE,
+            Self::WORD,
+        )?;
+
+        handle.record_cost(cost)?;
+
+        let (exit_status, output) = route(querier, journal, handle)?;
+        Ok(PrecompileOutput {
+            exit_status,
+            output,
+        })
+    }
+}
+
+fn route(
+    querier: *mut GoQuerier,
+    journal: &RefCell<CosmosJournal>,
+    handle: &mut impl PrecompileHandle,
+) -> Result<(ExitSucceed, Vec<u8>), PrecompileFailure> {
+    let data = handle.input().to_vec();
+    if data.len() <= 4 {
+        return Err(revert("cannot decode input"));
+    }
+
+    let input_signature = hex::encode(data[..4].to_vec());
+    match input_signature.as_str() {
+        BALANCE_OF_FN_SELECTOR => {
+            let params = decode_input(vec![ParamType::Address, ParamType::String], &data[4..])?;
+            let account = match params[0].clone().into_address() {
+                Some(address) => address,
+                None => return Err(revert("invalid account address")),
+            };
+            let denom = decode_string(&params[1], "invalid denom")?;
+
+            let encoded_request = coder::encode_bank_balance_request(account, denom);
+            match make_metered_request(querier, journal, handle, encoded_request)? {
+                Some(result) => {
+                    let response = protobuf::parse_from_bytes::<ffi::QueryBankBalanceResponse>(
+                        result.as_slice(),
+                    )
+                    .map_err(|_| revert("cannot decode protobuf response"))?;
+
+                    let amount = U256::from_big_endian(response.amount.as_slice());
+                    Ok((ExitSucceed::Returned, encode(&[AbiToken::Uint(amount)])))
+                }
+                None => Err(revert("call to balanceOf function to x/bank failed")),
+            }
+        }
+        SEND_FN_SELECTOR => {
+            ensure_state_change_allowed(&*handle)?;
+
+            let params = decode_input(
+                vec![ParamType::Address, ParamType::String, ParamType::Uint(256)],
+                &data[4..],
+            )?;
+            let to = match params[0].clone().into_address() {
+                Some(address) => address,
+                None => return Err(revert("invalid recipient address")),
+            };
+            let denom = decode_string(&params[1], "invalid denom")?;
+            let amount = decode_uint(&params[2], "invalid amount")?;
+
+            let from = handle.context().caller;
+            let encoded_request = coder::encode_bank_send_request(from, to, denom, amount);
+            match make_metered_request(querier, journal, handle, encoded_request)? {
+                Some(result) => {
+                    let response = protobuf::parse_from_bytes::<ffi::QueryBankSendResponse>(
+                        result.as_slice(),
+                    )
+                    .map_err(|_| revert("cannot decode protobuf response"))?;
+                    emit_logs(handle, response.logs.into_vec())?;
+
+                    Ok((ExitSucceed::Returned, encode(&[AbiToken::Bool(true)])))
+                }
+                None => Err(revert("call to send function to x/bank failed")),
+            }
+        }
+        _ => Err(revert("incorrect request")),
+    }
+}
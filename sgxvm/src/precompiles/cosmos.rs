@@ -0,0 +1,117 @@
+extern crate sgx_tstd as std;
+
+use ethabi::{encode, ParamType, Token as AbiToken, Token};
+use evm::executor::stack::PrecompileHandle;
+use evm::ExitRevert;
+use primitive_types::{H256, U256};
+use std::cell::RefCell;
+use std::prelude::v1::*;
+use std::string::String;
+use std::vec::Vec;
+
+use crate::precompiles::{CosmosJournal, PrecompileFailure};
+use crate::protobuf_generated::ffi;
+use crate::{coder, querier, GoQuerier};
+
+// Gas costs of emitted logs, same as for LOG opcodes
+const LOG_GAS: u64 = 375;
+const LOG_TOPIC_GAS: u64 = 375;
+const LOG_DATA_GAS: u64 = 8;
+
+/// Returns revert with ABI-encoded reason
+pub fn revert(reason: &str) -> PrecompileFailure {
+    PrecompileFailure::Revert {
+        exit_status: ExitRevert::Reverted,
+        output: encode(&[AbiToken::String(reason.into())]),
+    }
+}
+
+/// Checks that precompile, which changes Cosmos state, is called directly and not within static call.
+/// Delegate call is not allowed, since it would allow the contract to act on behalf of its caller
+pub fn ensure_state_change_allowed(handle: &impl PrecompileHandle) -> Result<(), PrecompileFailure> {
+    if handle.is_static() {
+        return Err(revert("cannot be called within static call"));
+    }
+    if handle.context().address != handle.code_address() {
+        return Err(revert("cannot be called within delegate call"));
+    }
+    Ok(())
+}
+
+/// Sends request, which is handled by Cosmos side with gas meter, limited by gas, remaining in the current
+/// frame. Consumed gas is charged even if request failed, so cost of the request grows with amount of
+/// Cosmos state, accessed by it. Balance changes, made by the request, are recorded to the journal to be
+/// applied to EVM state. Returns `None` if request failed
+pub fn make_metered_request(
+    querier: *mut GoQuerier,
+    journal: &RefCell<CosmosJournal>,
+    handle: &mut impl PrecompileHandle,
+    request: Vec<u8>,
+) -> Result<Option<Vec<u8>>, PrecompileFailure> {
+    let encoded_request = coder::encode_metered_request(request, handle.remaining_gas());
+    let response = match querier::make_request(querier, encoded_request) {
+        Some(result) => protobuf::parse_from_bytes::<ffi::QueryMeteredResponse>(result.as_slice())
+            .map_err(|_| revert("cannot decode protobuf response"))?,
+        None => return Ok(None),
+    };
+
+    handle.record_cost(response.gasUsed)?;
+    if !response.error.is_empty() {
+        return Ok(None);
+    }
+    journal
+        .borrow_mut()
+        .record_balance_changes(response.balanceChanges.into_vec());
+    Ok(Some(response.response))
+}
+
+/// Emits logs, converted from Cosmos events, on behalf of the precompile. Gas is charged
+/// in the same way as for LOG opcodes
+pub fn emit_logs(handle: &mut impl PrecompileHandle, logs: Vec<ffi::Log>) -> Result<(), PrecompileFailure> {
+    let address = handle.code_address();
+    for log in logs.into_iter() {
+        let topics: Vec<H256> = log
+            .topics
+            .into_iter()
+            .map(|topic| H256::from_slice(&topic.inner))
+            .collect();
+        let data = log.data;
+
+        let cost = LOG_GAS
+            .saturating_add(LOG_TOPIC_GAS.saturating_mul(topics.len() as u64))
+            .saturating_add(LOG_DATA_GAS.saturating_mul(data.len() as u64));
+        handle.record_cost(cost)?;
+        handle.log(address, topics, data)?;
+    }
+    Ok(())
+}
+
+pub fn decode_string(token: &Token, error: &str) -> Result<String, PrecompileFailure> {
+    token.clone().into_string().ok_or_else(|| revert(error))
+}
+
+pub fn decode_uint(token: &Token, error: &str) -> Result<U256, PrecompileFailure> {
+    token.clone().into_uint().ok_or_else(|| revert(error))
+}
+
+pub fn decode_u64(token: &Token, error: &str) -> Result<u64, PrecompileFailure> {
+    let value = decode_uint(token, error)?;
+    if value > U256::from(u64::MAX) {
+        return Err(revert(error));
+    }
+    Ok(value.as_u64())
+}
+
+pub fn decode_input(
+    param_types: Vec<ParamType>,
+    input: &[u8],
+) -> Result<Vec<Token>, PrecompileFailure> {
+    let decoded_params = ethabi::decode(&param_types, input)
+        .map_err(|err| revert(&format!("cannot decode params: {:?}", err)))?;
+
+    if decoded_params.len() != param_types.len() {
+        return Err(revert("incorrect decoded params len"));
+    }
+
+    Ok(decoded_params)
+}
@@ -0,0 +1,91 @@
+extern crate sgx_tstd as std;
+
+use ethabi::{encode, ParamType, Token as AbiToken};
+use evm::executor::stack::{PrecompileHandle, PrecompileOutput};
+use primitive_types::U256;
+use std::cell::RefCell;
+use std::prelude::v1::*;
+use std::vec::Vec;
+
+use crate::precompiles::cosmos::{
+    decode_input, decode_string, emit_logs, ensure_state_change_allowed, make_metered_request,
+    revert,
+};
+use crate::precompiles::staking::ensure_delegator;
+use crate::precompiles::{
+    CosmosJournal, ExitSucceed, LinearCostCosmosPrecompile, PrecompileFailure, PrecompileResult,
+};
+use crate::protobuf_generated::ffi;
+use crate::{coder, GoQuerier};
+
+// Selector of withdrawDelegatorRewards function
+const WITHDRAW_DELEGATOR_REWARDS_FN_SELECTOR: &str = "b46a8d61";
+
+/// Precompile for interactions with x/distribution module. Delegator must be the caller of precompile
+pub struct Distribution;
+
+impl LinearCostCosmosPrecompile for Distribution {
+    const BASE: u64 = 60;
+    const WORD: u64 = 150;
+
+    fn execute(
+        querier: *mut GoQuerier,
+        journal: &RefCell<CosmosJournal>,
+        handle: &mut impl PrecompileHandle,
+    ) -> PrecompileResult {
+        let target_gas = handle.gas_limit();
+        let cost = crate::precompiles::ensure_linear_cost(
+            target_gas,
+            handle.input().len() as u64,
+            Self::BASE,
+            Self::WORD,
+        )?;
+
+        handle.record_cost(cost)?;
+
+        let (exit_status, output) = route(querier, journal, handle)?;
+        Ok(PrecompileOutput {
+            exit_status,
+            output,
+        })
+    }
+}
+
+fn route(
+    querier: *mut GoQuerier,
+    journal: &RefCell<CosmosJournal>,
+    handle: &mut impl PrecompileHandle,
+) -> Result<(ExitSucceed, Vec<u8>), PrecompileFailure> {
+    let data = handle.input().to_vec();
+    if data.len() <= 4 {
+        return Err(revert("cannot decode input"));
+    }
+
+    let input_signature = hex::encode(data[..4].to_vec());
+    match input_signature.as_str() {
+        WITHDRAW_DELEGATOR_REWARDS_FN_SELECTOR => {
+            ensure_state_change_allowed(&*handle)?;
+
+            let params = decode_input(vec![ParamType::Address, ParamType::String], &data[4..])?;
+            let delegator = ensure_delegator(&*handle, &params[0])?;
+            let validator_address = decode_string(&params[1], "invalid validator address")?;
+
+            let encoded_request =
+                coder::encode_withdraw_delegator_reward_request(delegator, validator_address);
+            match make_metered_request(querier, journal, handle, encoded_request)? {
+                Some(result) => {
+                    let response = protobuf::parse_from_bytes::<
+                        ffi::QueryWithdrawDelegatorRewardResponse,
+                    >(result.as_slice())
+                    .map_err(|_| revert("cannot decode protobuf response"))?;
+
+                    let amount = U256::from_big_endian(response.amount.as_slice());
+                    emit_logs(handle, response.logs.into_vec())?;
+                    Ok((ExitSucceed::Returned, encode(&[AbiToken::Uint(amount)])))
+                }
+                None => Err(revert("call to withdrawDelegatorRewards function to x/distribution failed")),
+            }
+        }
+        _ => Err(revert("incorrect request")),
+    }
+}
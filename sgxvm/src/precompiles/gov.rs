@@ -0,0 +1,256 @@
+extern crate sgx_tstd as std;
+
+use ethabi::{encode, ParamType, Token as AbiToken, Token};
+use evm::executor::stack::{PrecompileHandle, PrecompileOutput};
+use primitive_types::{H160, U256};
+use std::cell::RefCell;
+use std::prelude::v1::*;
+use std::string::String;
+use std::vec::Vec;
+
+use crate::precompiles::cosmos::{
+    decode_input, decode_string, decode_u64, decode_uint, emit_logs, ensure_state_change_allowed,
+    make_metered_request, revert,
+};
+use crate::precompiles::{
+    CosmosJournal, ExitSucceed, LinearCostCosmosPrecompile, PrecompileFailure, PrecompileResult,
+};
+use crate::protobuf_generated::ffi;
+use crate::{coder, GoQuerier};
+
+// Selector of vote function
+const VOTE_FN_SELECTOR: &str = "528783d5";
+// Selector of voteWeighted function
+const VOTE_WEIGHTED_FN_SELECTOR: &str = "c1cffef3";
+// Selector of deposit function
+const DEPOSIT_FN_SELECTOR: &str = "01c4a8b7";
+// Selector of getProposal function
+const GET_PROPOSAL_FN_SELECTOR: &str = "f1610a28";
+// Selector of getTallyResult function
+const GET_TALLY_RESULT_FN_SELECTOR: &str = "ba66a648";
+
+/// Precompile for interactions with x/gov module. Voter and depositor is the caller of precompile,
+/// so contracts can vote and deposit only on their own behalf
+pub struct Gov;
+
+impl LinearCostCosmosPrecompile for Gov {
+    const BASE: u64 = 60;
+    const WORD: u64 = 150;
+
+    fn execute(
+        querier: *mut GoQuerier,
+        journal: &RefCell<CosmosJournal>,
+        handle: &mut impl PrecompileHandle,
+    ) -> PrecompileResult {
+        let target_gas = handle.gas_limit();
+        let cost = crate::precompiles::ensure_linear_cost(
+            target_gas,
+            handle.input().len() as u64,
+            Self::BASE,
+            Self::WORD,
+        )?;
+
+        handle.record_cost(cost)?;
+
+        let (exit_status, output) = route(querier, journal, handle)?;
+        Ok(PrecompileOutput {
+            exit_status,
+            output,
+        })
+    }
+}
+
+fn route(
+    querier: *mut GoQuerier,
+    journal: &RefCell<CosmosJournal>,
+    handle: &mut impl PrecompileHandle,
+) -> Result<(ExitSucceed, Vec<u8>), PrecompileFailure> {
+    let data = handle.input().to_vec();
+    if data.len() <= 4 {
+        return Err(revert("cannot decode input"));
+    }
+
+    let input_signature = hex::encode(data[..4].to_vec());
+    match input_signature.as_str() {
+        VOTE_FN_SELECTOR => {
+            ensure_state_change_allowed(&*handle)?;
+
+            let params = decode_input(
+                vec![ParamType::Uint(64), ParamType::Uint(8), ParamType::String],
+                &data[4..],
+            )?;
+            let proposal_id = decode_u64(&params[0], "invalid proposal id")?;
+            let option = decode_vote_option(&params[1])?;
+            let metadata = decode_string(&params[2], "invalid metadata")?;
+
+            let encoded_request =
+                coder::encode_gov_vote_request(handle.context().caller, proposal_id, option, metadata);
+            match make_metered_request(querier, journal, handle, encoded_request)? {
+                Some(result) => {
+                    let response = protobuf::parse_from_bytes::<ffi::QueryGovVoteResponse>(
+                        result.as_slice(),
+                    )
+                    .map_err(|_| revert("cannot decode protobuf response"))?;
+                    emit_logs(handle, response.logs.into_vec())?;
+
+                    Ok((ExitSucceed::Returned, encode(&[AbiToken::Bool(true)])))
+                }
+                None => Err(revert("call to vote function to x/gov failed")),
+            }
+        }
+        VOTE_WEIGHTED_FN_SELECTOR => {
+            ensure_state_change_allowed(&*handle)?;
+
+            let params = decode_input(
+                vec![
+                    ParamType::Uint(64),
+                    ParamType::Array(Box::new(ParamType::Tuple(vec![
+                        ParamType::Uint(8),
+                        ParamType::String,
+                    ]))),
+                    ParamType::String,
+                ],
+                &data[4..],
+            )?;
+            let proposal_id = decode_u64(&params[0], "invalid proposal id")?;
+            let options = decode_weighted_vote_options(&params[1])?;
+            let metadata = decode_string(&params[2], "invalid metadata")?;
+
+            let encoded_request = coder::encode_gov_vote_weighted_request(
+                handle.context().caller,
+                proposal_id,
+                options,
+                metadata,
+            );
+            match make_metered_request(querier, journal, handle, encoded_request)? {
+                Some(result) => {
+                    let response = protobuf::parse_from_bytes::<ffi::QueryGovVoteWeightedResponse>(
+                        result.as_slice(),
+                    )
+                    .map_err(|_| revert("cannot decode protobuf response"))?;
+                    emit_logs(handle, response.logs.into_vec())?;
+
+                    Ok((ExitSucceed::Returned, encode(&[AbiToken::Bool(true)])))
+                }
+                None => Err(revert("call to voteWeighted function to x/gov failed")),
+            }
+        }
+        DEPOSIT_FN_SELECTOR => {
+            ensure_state_change_allowed(&*handle)?;
+
+            let params = decode_input(
+                vec![ParamType::Uint(64), ParamType::String, ParamType::Uint(256)],
+                &data[4..],
+            )?;
+            let proposal_id = decode_u64(&params[0], "invalid proposal id")?;
+            let denom = decode_string(&params[1], "invalid denom")?;
+            let amount = decode_uint(&params[2], "invalid amount")?;
+
+            let encoded_request =
+                coder::encode_gov_deposit_request(handle.context().caller, proposal_id, denom, amount);
+            match make_metered_request(querier, journal, handle, encoded_request)? {
+                Some(result) => {
+                    let response = protobuf::parse_from_bytes::<ffi::QueryGovDepositResponse>(
+                        result.as_slice(),
+                    )
+                    .map_err(|_| revert("cannot decode protobuf response"))?;
+                    emit_logs(handle, response.logs.into_vec())?;
+
+                    Ok((ExitSucceed::Returned, encode(&[AbiToken::Bool(true)])))
+                }
+                None => Err(revert("call to deposit function to x/gov failed")),
+            }
+        }
+        GET_PROPOSAL_FN_SELECTOR => {
+            let params = decode_input(vec![ParamType::Uint(64)], &data[4..])?;
+            let proposal_id = decode_u64(&params[0], "invalid proposal id")?;
+
+            let encoded_request = coder::encode_gov_proposal_request(proposal_id);
+            match make_metered_request(querier, journal, handle, encoded_request)? {
+                Some(result) => {
+                    let response = protobuf::parse_from_bytes::<ffi::QueryGovProposalResponse>(
+                        result.as_slice(),
+                    )
+                    .map_err(|_| revert("cannot decode protobuf response"))?;
+
+                    // Proposer is zero address if it is not set or cannot be represented as EVM address
+                    let proposer = match response.proposer.len() {
+                        20 => H160::from_slice(response.proposer.as_slice()),
+                        _ => H160::zero(),
+                    };
+                    let timestamp = |value: i64| AbiToken::Uint(U256::from(value.max(0) as u64));
+
+                    Ok((
+                        ExitSucceed::Returned,
+                        encode(&[
+                            AbiToken::Uint(U256::from(response.id)),
+                            AbiToken::Uint(U256::from(response.status.max(0) as u64)),
+                            AbiToken::Address(proposer),
+                            AbiToken::String(response.title),
+                            timestamp(response.submitTime),
+                            timestamp(response.depositEndTime),
+                            timestamp(response.votingStartTime),
+                            timestamp(response.votingEndTime),
+                        ]),
+                    ))
+                }
+                None => Err(revert("call to getProposal function to x/gov failed")),
+            }
+        }
+        GET_TALLY_RESULT_FN_SELECTOR => {
+            let params = decode_input(vec![ParamType::Uint(64)], &data[4..])?;
+            let proposal_id = decode_u64(&params[0], "invalid proposal id")?;
+
+            let encoded_request = coder::encode_gov_tally_request(proposal_id);
+            match make_metered_request(querier, journal, handle, encoded_request)? {
+                Some(result) => {
+                    let response = protobuf::parse_from_bytes::<ffi::QueryGovTallyResponse>(
+                        result.as_slice(),
+                    )
+                    .map_err(|_| revert("cannot decode protobuf response"))?;
+
+                    Ok((
+                        ExitSucceed::Returned,
+                        encode(&[
+                            AbiToken::Uint(U256::from_big_endian(response.yes.as_slice())),
+                            AbiToken::Uint(U256::from_big_endian(response.abstain.as_slice())),
+                            AbiToken::Uint(U256::from_big_endian(response.no.as_slice())),
+                            AbiToken::Uint(U256::from_big_endian(response.noWithVeto.as_slice())),
+                        ]),
+                    ))
+                }
+                None => Err(revert("call to getTallyResult function to x/gov failed")),
+            }
+        }
+        _ => Err(revert("incorrect request")),
+    }
+}
+
+/// Decodes vote option. Validity of the option is checked by x/gov module
+fn decode_vote_option(token: &Token) -> Result<i32, PrecompileFailure> {
+    let option = decode_uint(token, "invalid vote option")?;
+    if option > U256::from(u8::MAX) {
+        return Err(revert("invalid vote option"));
+    }
+    Ok(option.as_u32() as i32)
+}
+
+/// Decodes array of (option, weight) tuples. Weight is a decimal string, for example "0.5"
+fn decode_weighted_vote_options(token: &Token) -> Result<Vec<(i32, String)>, PrecompileFailure> {
+    let options = match token.clone().into_array() {
+        Some(options) => options,
+        None => return Err(revert("invalid weighted vote options")),
+    };
+
+    let mut result = Vec::with_capacity(options.len());
+    for option in options.iter() {
+        let fields = match option.clone().into_tuple() {
+            Some(fields) if fields.len() == 2 => fields,
+            _ => return Err(revert("invalid weighted vote option")),
+        };
+        let vote_option = decode_vote_option(&fields[0])?;
+        let weight = decode_string(&fields[1], "invalid vote weight")?;
+        result.push((vote_option, weight));
+    }
+    Ok(result)
+}
@@ -0,0 +1,114 @@
+extern crate sgx_tstd as std;
+
+use ethabi::{encode, ParamType, Token as AbiToken};
+use evm::executor::stack::{PrecompileHandle, PrecompileOutput};
+use primitive_types::U256;
+use std::cell::RefCell;
+use std::prelude::v1::*;
+use std::vec::Vec;
+
+use crate::coder::IBCTransferParams;
+use crate::precompiles::cosmos::{
+    decode_input, decode_string, decode_u64, decode_uint, emit_logs, ensure_state_change_allowed,
+    make_metered_request, revert,
+};
+use crate::precompiles::{
+    CosmosJournal, ExitSucceed, LinearCostCosmosPrecompile, PrecompileFailure, PrecompileResult,
+};
+use crate::protobuf_generated::ffi;
+use crate::{coder, GoQuerier};
+
+// Selector of transfer function
+const TRANSFER_FN_SELECTOR: &str = "b5a05158";
+
+/// Precompile for ICS-20 transfers. Coins are transferred from the account of the caller
+pub struct ICS20;
+
+impl LinearCostCosmosPrecompile for ICS20 {
+    const BASE: u64 = 60;
+    const WORD: u64 = 150;
+
+    fn execute(
+        querier: *mut GoQuerier,
+        journal: &RefCell<CosmosJournal>,
+        handle: &mut impl PrecompileHandle,
+    ) -> PrecompileResult {
+        let target_gas = handle.gas_limit();
+        let cost = crate::precompiles::ensure_linear_cost(
+            target_gas,
+            handle.input().len() as u64,
+            Self::BASE,
+            Self::WORD,
+        )?;
+
+        handle.record_cost(cost)?;
+
+        let (exit_status, output) = route(querier, journal, handle)?;
+        Ok(PrecompileOutput {
+            exit_status,
+            output,
+        })
+    }
+}
+
+fn route(
+    querier: *mut GoQuerier,
+    journal: &RefCell<CosmosJournal>,
+    handle: &mut impl PrecompileHandle,
+) -> Result<(ExitSucceed, Vec<u8>), PrecompileFailure> {
+    let data = handle.input().to_vec();
+    if data.len() <= 4 {
+        return Err(revert("cannot decode input"));
+    }
+
+    let input_signature = hex::encode(data[..4].to_vec());
+    match input_signature.as_str() {
+        TRANSFER_FN_SELECTOR => {
+            ensure_state_change_allowed(&*handle)?;
+
+            let params = decode_input(
+                vec![
+                    ParamType::String,
+                    ParamType::String,
+                    ParamType::String,
+                    ParamType::Uint(256),
+                    ParamType::String,
+                    ParamType::Uint(64),
+                    ParamType::Uint(64),
+                    ParamType::Uint(64),
+                    ParamType::String,
+                ],
+                &data[4..],
+            )?;
+
+            let transfer = IBCTransferParams {
+                sender: handle.context().caller,
+                source_port: decode_string(&params[0], "invalid source port")?,
+                source_channel: decode_string(&params[1], "invalid source channel")?,
+                denom: decode_string(&params[2], "invalid denom")?,
+                amount: decode_uint(&params[3], "invalid amount")?,
+                receiver: decode_string(&params[4], "invalid receiver")?,
+                timeout_revision_number: decode_u64(&params[5], "invalid timeout revision number")?,
+                timeout_revision_height: decode_u64(&params[6], "invalid timeout revision height")?,
+                timeout_timestamp: decode_u64(&params[7], "invalid timeout timestamp")?,
+                memo: decode_string(&params[8], "invalid memo")?,
+            };
+
+            let encoded_request = coder::encode_ibc_transfer_request(transfer);
+            match make_metered_request(querier, journal, handle, encoded_request)? {
+                Some(result) => {
+                    let response = protobuf::parse_from_bytes::<ffi::QueryIBCTransferResponse>(
+                        result.as_slice(),
+                    )
+                    .map_err(|_| revert("cannot decode protobuf response"))?;
+
+                    let sequence = U256::from(response.sequence);
+                    emit_logs(handle, response.logs.into_vec())?;
+                    Ok((ExitSucceed::Returned, encode(&[AbiToken::Uint(sequence)])))
+                }
+                None => Err(revert("call to transfer function to ICS-20 module failed")),
+            }
+        }
+        _ => Err(revert("incorrect request")),
+    }
+}
@@ -0,0 +1,97 @@
+extern crate sgx_tstd as std;
+
+use std::vec::Vec;
+
+use crate::protobuf_generated::ffi;
+use crate::{coder, querier, GoQuerier};
+
+/// Journal of call frames, which changed Cosmos state through precompiles. Changes of EVM state are kept
+/// inside the enclave until transaction is finished and are reverted together with the failed frame, while
+/// Cosmos messages, executed by precompiles, are applied on Cosmos side at the moment of the call. Therefore,
+/// snapshot of Cosmos state is taken before the first change within each open frame, and Cosmos state
+/// is reverted to it if the frame fails. Changes of EVM denom balances, made by Cosmos messages, are kept
+/// in the journal until they are applied to EVM state by `CosmosAwareState`
+pub struct CosmosJournal {
+    querier: *mut GoQuerier,
+    // Snapshot of each open frame, if Cosmos state was changed within it
+    frames: Vec<Option<u32>>,
+    // Set if Cosmos state cannot be reverted, in which case transaction should fail
+    failed: bool,
+    // Balance changes, made by Cosmos messages of the currently executed precompile
+    balance_changes: Vec<ffi::BalanceChange>,
+}
+
+impl CosmosJournal {
+    pub fn new(querier: *mut GoQuerier) -> Self {
+        Self {
+            querier,
+            frames: Vec::default(),
+            failed: false,
+            balance_changes: Vec::default(),
+        }
+    }
+
+    /// Returns true if snapshot could not be taken or reverted
+    pub fn failed(&self) -> bool {
+        self.failed
+    }
+
+    /// Records balance changes, returned by Cosmos side in response to request of precompile
+    pub fn record_balance_changes(&mut self, changes: Vec<ffi::BalanceChange>) {
+        self.balance_changes.extend(changes);
+    }
+
+    /// Returns recorded balance changes, which were not applied to EVM state yet, and clears them
+    pub fn take_balance_changes(&mut self) -> Vec<ffi::BalanceChange> {
+        std::mem::take(&mut self.balance_changes)
+    }
+
+    pub fn enter_frame(&mut self) {
+        self.frames.push(None);
+    }
+
+    /// Reverts Cosmos state to the snapshot of exited frame, if frame failed after changing Cosmos state
+    pub fn exit_frame(&mut self, succeed: bool) {
+        let snapshot = match self.frames.pop() {
+            Some(Some(snapshot)) => snapshot,
+            _ => return,
+        };
+        if succeed {
+            return;
+        }
+
+        let encoded_request = coder::encode_revert_cosmos_snapshot_request(snapshot);
+        if querier::make_request(self.querier, encoded_request).is_none() {
+            println!("Cannot revert Cosmos state to snapshot {}", snapshot);
+            self.failed = true;
+        }
+    }
+
+    /// Takes snapshot of Cosmos state for open frames, which didn't change it yet. It should be called
+    /// before Cosmos message is executed by precompile
+    pub fn snapshot(&mut self) -> Result<(), &'static str> {
+        if self.frames.iter().all(Option::is_some) {
+            return Ok(());
+        }
+
+        let snapshot = querier::make_request(self.querier, coder::encode_cosmos_snapshot_request())
+            .and_then(|result| {
+                protobuf::parse_from_bytes::<ffi::QueryCosmosSnapshotResponse>(result.as_slice()).ok()
+            })
+            .map(|response| response.snapshot);
+        let snapshot = match snapshot {
+            Some(snapshot) => snapshot,
+            None => {
+                self.failed = true;
+                return Err("cannot take snapshot of Cosmos state");
+            }
+        };
+
+        // State is not changed since the outermost frame without snapshot was entered, so all
+        // such frames share the same snapshot
+        for frame in self.frames.iter_mut().filter(|frame| frame.is_none()) {
+            *frame = Some(snapshot);
+        }
+        Ok(())
+    }
+}
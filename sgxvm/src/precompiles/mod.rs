@@ -5,7 +5,7 @@ use evm::{
     ExitError, 
     ExitSucceed,
 };
-use std::vec::Vec;
+use std::{cell::RefCell, rc::Rc, vec::Vec};
 use primitive_types::H160 ;
 use crate::GoQuerier;
 
@@ -20,6 +20,17 @@ mod ripemd160;
 mod datacopy;
 mod compliance_bridge;
 mod secp256r1;
+mod cosmos;
+mod staking;
+mod distribution;
+mod bank;
+mod ics20;
+mod gov;
+mod journal;
+mod state;
+
+pub use journal::CosmosJournal;
+pub use state::{CosmosAwareState, CosmosSupplyChange};
 
 pub type PrecompileResult = Result<PrecompileOutput, PrecompileFailure>;
 
@@ -48,6 +59,19 @@ pub trait LinearCostPrecompileWithQuerier {
     fn execute(querier: *mut GoQuerier, handle: &mut impl PrecompileHandle) -> PrecompileResult;
 }
 
+/// Precompile, which interacts with Cosmos modules using metered requests. Balance changes, made by
+/// Cosmos messages of the precompile, are recorded to the journal
+pub trait LinearCostCosmosPrecompile {
+    const BASE: u64;
+    const WORD: u64;
+
+    fn execute(
+        querier: *mut GoQuerier,
+        journal: &RefCell<CosmosJournal>,
+        handle: &mut impl PrecompileHandle,
+    ) -> PrecompileResult;
+}
+
 impl<T: LinearCostPrecompile> Precompile for T {
     fn execute(handle: &mut impl PrecompileHandle) -> PrecompileResult {
         let target_gas = handle.gas_limit();
@@ -92,13 +116,19 @@ pub fn ensure_linear_cost(
 
 pub struct EVMPrecompiles {
     querier: *mut GoQuerier,
+    journal: Rc<RefCell<CosmosJournal>>,
 }
 
 impl EVMPrecompiles {
-    pub fn new(querier: *mut GoQuerier) -> Self {
-        Self{ querier }
+    pub fn new(querier: *mut GoQuerier, journal: Rc<RefCell<CosmosJournal>>) -> Self {
+        Self{ querier, journal }
     }
-    pub fn used_addresses() -> [H160; 17] {
+
+    /// Returns true if precompile with provided address can change Cosmos state
+    fn changes_cosmos_state(address: H160) -> bool {
+        address == hash(1028) || (address >= hash(1032) && address <= hash(1036))
+    }
+    pub fn used_addresses() -> [H160; 22] {
         [
             hash(1),
             hash(2),
@@ -117,13 +147,28 @@ impl EVMPrecompiles {
             hash(1029),
             hash(1030),
             hash(1031),
+            hash(1032),
+            hash(1033),
+            hash(1034),
+            hash(1035),
+            hash(1036),
         ]
     }
 }
 
 impl PrecompileSet for EVMPrecompiles {
     fn execute(&self, handle: &mut impl PrecompileHandle) -> Option<PrecompileResult> {
-        match handle.code_address() {
+        // Cosmos state is changed immediately, so its snapshot is taken to revert it, if any of open frames fails
+        let address = handle.code_address();
+        if Self::changes_cosmos_state(address) && !handle.is_static() {
+            if let Err(err) = self.journal.borrow_mut().snapshot() {
+                return Some(Err(PrecompileFailure::Error {
+                    exit_status: ExitError::Other(err.into()),
+                }));
+            }
+        }
+
+        match address {
             // Ethereum precompiles:
             a if a == hash(1) => Some(ec_recover::ECRecover::execute(handle)),
             a if a == hash(2) => Some(sha256::Sha256::execute(handle)),
@@ -143,6 +188,11 @@ impl PrecompileSet for EVMPrecompiles {
             a if a == hash(1029) => Some(curve25519::Curve25519Add::execute(handle)),
             a if a == hash(1030) => Some(curve25519::Curve25519ScalarMul::execute(handle)),
             a if a == hash(1031) => Some(curve25519::Ed25519Verify::execute(handle)),
+            a if a == hash(1032) => Some(staking::Staking::execute(self.querier, &self.journal, handle)),
+            a if a == hash(1033) => Some(distribution::Distribution::execute(self.querier, &self.journal, handle)),
+            a if a == hash(1034) => Some(bank::Bank::execute(self.querier, &self.journal, handle)),
+            a if a == hash(1035) => Some(ics20::ICS20::execute(self.querier, &self.journal, handle)),
+            a if a == hash(1036) => Some(gov::Gov::execute(self.querier, &self.journal, handle)),
             _ => None,
         }
     }
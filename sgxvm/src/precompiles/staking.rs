@@ -0,0 +1,196 @@
+extern crate sgx_tstd as std;
+
+use ethabi::{encode, ParamType, Token as AbiToken, Token};
+use evm::executor::stack::{PrecompileHandle, PrecompileOutput};
+use primitive_types::{H160, U256};
+use std::cell::RefCell;
+use std::prelude::v1::*;
+use std::vec::Vec;
+
+use crate::precompiles::cosmos::{
+    decode_input, decode_string, decode_uint, emit_logs, ensure_state_change_allowed,
+    make_metered_request, revert,
+};
+use crate::precompiles::{
+    CosmosJournal, ExitSucceed, LinearCostCosmosPrecompile, PrecompileFailure, PrecompileResult,
+};
+use crate::protobuf_generated::ffi;
+use crate::{coder, GoQuerier};
+
+// Selector of delegate function
+const DELEGATE_FN_SELECTOR: &str = "53266bbb";
+// Selector of undelegate function
+const UNDELEGATE_FN_SELECTOR: &str = "3edab33c";
+// Selector of redelegate function
+const REDELEGATE_FN_SELECTOR: &str = "54b826f5";
+// Selector of delegation function
+const DELEGATION_FN_SELECTOR: &str = "241774e6";
+
+/// Precompile for interactions with x/staking module. Delegator must be the caller of precompile,
+/// so contracts can manage only their own delegations
+pub struct Staking;
+
+impl LinearCostCosmosPrecompile for Staking {
+    const BASE: u64 = 60;
+    const WORD: u64 = 150;
+
+    fn execute(
+        querier: *mut GoQuerier,
+        journal: &RefCell<CosmosJournal>,
+        handle: &mut impl PrecompileHandle,
+    ) -> PrecompileResult {
+        let target_gas = handle.gas_limit();
+        let cost = crate::precompiles::ensure_linear_cost(
+            target_gas,
+            handle.input().len() as u64,
+            Self::BASE,
+            Self::WORD,
+        )?;
+
+        handle.record_cost(cost)?;
+
+        let (exit_status, output) = route(querier, journal, handle)?;
+        Ok(PrecompileOutput {
+            exit_status,
+            output,
+        })
+    }
+}
+
+fn route(
+    querier: *mut GoQuerier,
+    journal: &RefCell<CosmosJournal>,
+    handle: &mut impl PrecompileHandle,
+) -> Result<(ExitSucceed, Vec<u8>), PrecompileFailure> {
+    let data = handle.input().to_vec();
+    if data.len() <= 4 {
+        return Err(revert("cannot decode input"));
+    }
+
+    let input_signature = hex::encode(data[..4].to_vec());
+    match input_signature.as_str() {
+        DELEGATE_FN_SELECTOR => {
+            ensure_state_change_allowed(&*handle)?;
+
+            let params = decode_input(
+                vec![ParamType::Address, ParamType::String, ParamType::Uint(256)],
+                &data[4..],
+            )?;
+            let delegator = ensure_delegator(&*handle, &params[0])?;
+            let validator_address = decode_string(&params[1], "invalid validator address")?;
+            let amount = decode_uint(&params[2], "invalid amount")?;
+
+            let encoded_request = coder::encode_delegate_request(delegator, validator_address, amount);
+            match make_metered_request(querier, journal, handle, encoded_request)? {
+                Some(result) => {
+                    let response = protobuf::parse_from_bytes::<ffi::QueryDelegateResponse>(
+                        result.as_slice(),
+                    )
+                    .map_err(|_| revert("cannot decode protobuf response"))?;
+                    emit_logs(handle, response.logs.into_vec())?;
+
+                    Ok((ExitSucceed::Returned, encode(&[AbiToken::Bool(true)])))
+                }
+                None => Err(revert("call to delegate function to x/staking failed")),
+            }
+        }
+        UNDELEGATE_FN_SELECTOR => {
+            ensure_state_change_allowed(&*handle)?;
+
+            let params = decode_input(
+                vec![ParamType::Address, ParamType::String, ParamType::Uint(256)],
+                &data[4..],
+            )?;
+            let delegator = ensure_delegator(&*handle, &params[0])?;
+            let validator_address = decode_string(&params[1], "invalid validator address")?;
+            let amount = decode_uint(&params[2], "invalid amount")?;
+
+            let encoded_request = coder::encode_undelegate_request(delegator, validator_address, amount);
+            match make_metered_request(querier, journal, handle, encoded_request)? {
+                Some(result) => {
+                    let response = protobuf::parse_from_bytes::<ffi::QueryUndelegateResponse>(
+                        result.as_slice(),
+                    )
+                    .map_err(|_| revert("cannot decode protobuf response"))?;
+
+                    let completion_time = U256::from(response.completionTime as u64);
+                    emit_logs(handle, response.logs.into_vec())?;
+                    Ok((ExitSucceed::Returned, encode(&[AbiToken::Int(completion_time)])))
+                }
+                None => Err(revert("call to undelegate function to x/staking failed")),
+            }
+        }
+        REDELEGATE_FN_SELECTOR => {
+            ensure_state_change_allowed(&*handle)?;
+
+            let params = decode_input(
+                vec![
+                    ParamType::Address,
+                    ParamType::String,
+                    ParamType::String,
+                    ParamType::Uint(256),
+                ],
+                &data[4..],
+            )?;
+            let delegator = ensure_delegator(&*handle, &params[0])?;
+            let validator_src_address = decode_string(&params[1], "invalid source validator address")?;
+            let validator_dst_address = decode_string(&params[2], "invalid destination validator address")?;
+            let amount = decode_uint(&params[3], "invalid amount")?;
+
+            let encoded_request = coder::encode_redelegate_request(
+                delegator,
+                validator_src_address,
+                validator_dst_address,
+                amount,
+            );
+            match make_metered_request(querier, journal, handle, encoded_request)? {
+                Some(result) => {
+                    let response = protobuf::parse_from_bytes::<ffi::QueryRedelegateResponse>(
+                        result.as_slice(),
+                    )
+                    .map_err(|_| revert("cannot decode protobuf response"))?;
+
+                    let completion_time = U256::from(response.completionTime as u64);
+                    emit_logs(handle, response.logs.into_vec())?;
+                    Ok((ExitSucceed::Returned, encode(&[AbiToken::Int(completion_time)])))
+                }
+                None => Err(revert("call to redelegate function to x/staking failed")),
+            }
+        }
+        DELEGATION_FN_SELECTOR => {
+            let params = decode_input(vec![ParamType::Address, ParamType::String], &data[4..])?;
+            let delegator = match params[0].clone().into_address() {
+                Some(address) => address,
+                None => return Err(revert("invalid delegator address")),
+            };
+            let validator_address = decode_string(&params[1], "invalid validator address")?;
+
+            let encoded_request = coder::encode_delegation_request(delegator, validator_address);
+            match make_metered_request(querier, journal, handle, encoded_request)? {
+                Some(result) => {
+                    let response = protobuf::parse_from_bytes::<ffi::QueryDelegationResponse>(
+                        result.as_slice(),
+                    )
+                    .map_err(|_| revert("cannot decode protobuf response"))?;
+
+                    let amount = U256::from_big_endian(response.amount.as_slice());
+                    Ok((ExitSucceed::Returned, encode(&[AbiToken::Uint(amount)])))
+                }
+                None => Err(revert("call to delegation function to x/staking failed")),
+            }
+        }
+        _ => Err(revert("incorrect request")),
+    }
+}
+
+/// Decodes delegator address and checks that it matches the caller of precompile
+pub(super) fn ensure_delegator(handle: &impl PrecompileHandle, token: &Token) -> Result<H160, PrecompileFailure> {
+    let delegator = match token.clone().into_address() {
+        Some(address) => address,
+        None => return Err(revert("invalid delegator address")),
+    };
+    if delegator != handle.context().caller {
+        return Err(revert("delegator must be the caller"));
+    }
+    Ok(delegator)
+}
@@ -0,0 +1,243 @@
+extern crate sgx_tstd as std;
+
+use evm::backend::{Backend, Basic};
+use evm::executor::stack::{MemoryStackState, StackState, StackSubstateMetadata};
+use evm::{ExitError, Transfer};
+use primitive_types::{H160, H256, U256};
+use std::{cell::RefCell, rc::Rc, vec::Vec};
+
+use crate::precompiles::CosmosJournal;
+
+/// Total amounts, added to and removed from EVM denom balances by Cosmos messages
+#[derive(Clone, Copy, Default)]
+pub struct CosmosSupplyChange {
+    pub added: U256,
+    pub removed: U256,
+}
+
+impl CosmosSupplyChange {
+    fn merge(&mut self, other: CosmosSupplyChange) {
+        self.added = self.added.saturating_add(other.added);
+        self.removed = self.removed.saturating_add(other.removed);
+    }
+}
+
+/// EVM state, which applies balance changes, made by Cosmos messages of precompiles. Cosmos messages are
+/// executed by Cosmos side at the moment of the call, so balance changes are returned to precompile and
+/// recorded to the journal. They are applied to the substate of precompile call, when it is committed,
+/// so subsequent execution observes actual balances, and are dropped together with the substate, if it
+/// is reverted. Balances, returned by Cosmos side, exclude changes, made by Cosmos messages, so each change
+/// is applied only once
+pub struct CosmosAwareState<'backend, 'config, B> {
+    state: MemoryStackState<'backend, 'config, B>,
+    journal: Rc<RefCell<CosmosJournal>>,
+    // Supply change, made by Cosmos messages within each open substate
+    supply_changes: Vec<CosmosSupplyChange>,
+    // Account, which balance couldn't cover balance change, made by Cosmos message
+    insufficient_balance: Option<H160>,
+}
+
+impl<'backend, 'config, B: Backend> CosmosAwareState<'backend, 'config, B> {
+    pub fn new(
+        metadata: StackSubstateMetadata<'config>,
+        backend: &'backend B,
+        journal: Rc<RefCell<CosmosJournal>>,
+    ) -> Self {
+        Self {
+            state: MemoryStackState::new(metadata, backend),
+            journal,
+            supply_changes: vec![CosmosSupplyChange::default()],
+            insufficient_balance: None,
+        }
+    }
+
+    /// Returns account, which balance in EVM state was not enough to apply balance change, made by
+    /// Cosmos message. In such case Cosmos message spent coins, which were already spent in EVM,
+    /// and transaction should fail
+    pub fn insufficient_balance(&self) -> Option<H160> {
+        self.insufficient_balance
+    }
+
+    /// Returns supply change, made by Cosmos messages within committed substates. It is used to check
+    /// that EVM execution itself doesn't change total supply
+    pub fn cosmos_supply_change(&self) -> CosmosSupplyChange {
+        self.supply_changes.first().copied().unwrap_or_default()
+    }
+
+    pub fn into_inner(self) -> MemoryStackState<'backend, 'config, B> {
+        self.state
+    }
+
+    /// Applies balance changes, recorded by precompile, to the current substate
+    fn apply_balance_changes(&mut self) {
+        let changes = self.journal.borrow_mut().take_balance_changes();
+        let mut supply_change = CosmosSupplyChange::default();
+        for change in changes {
+            let address = H160::from_slice(&change.address);
+            let amount = U256::from_big_endian(&change.amount);
+            if change.decrease {
+                if self.state.withdraw(address, amount).is_err() {
+                    self.insufficient_balance.get_or_insert(address);
+                    continue;
+                }
+                supply_change.removed = supply_change.removed.saturating_add(amount);
+            } else {
+                self.state.deposit(address, amount);
+                supply_change.added = supply_change.added.saturating_add(amount);
+            }
+        }
+        if let Some(current) = self.supply_changes.last_mut() {
+            current.merge(supply_change);
+        }
+    }
+}
+
+impl<'backend, 'config, B: Backend> Backend for CosmosAwareState<'backend, 'config, B> {
+    fn gas_price(&self) -> U256 {
+        self.state.gas_price()
+    }
+    fn origin(&self) -> H160 {
+        self.state.origin()
+    }
+    fn block_hash(&self, number: U256) -> H256 {
+        self.state.block_hash(number)
+    }
+    fn block_number(&self) -> U256 {
+        self.state.block_number()
+    }
+    fn block_coinbase(&self) -> H160 {
+        self.state.block_coinbase()
+    }
+    fn block_timestamp(&self) -> U256 {
+        self.state.block_timestamp()
+    }
+    fn block_difficulty(&self) -> U256 {
+        self.state.block_difficulty()
+    }
+    fn block_randomness(&self) -> Option<H256> {
+        self.state.block_randomness()
+    }
+    fn block_gas_limit(&self) -> U256 {
+        self.state.block_gas_limit()
+    }
+    fn block_base_fee_per_gas(&self) -> U256 {
+        self.state.block_base_fee_per_gas()
+    }
+    fn chain_id(&self) -> U256 {
+        self.state.chain_id()
+    }
+    fn exists(&self, address: H160) -> bool {
+        self.state.exists(address)
+    }
+    fn basic(&self, address: H160) -> Basic {
+        self.state.basic(address)
+    }
+    fn code(&self, address: H160) -> Vec<u8> {
+        self.state.code(address)
+    }
+    fn storage(&self, address: H160, index: H256) -> H256 {
+        self.state.storage(address, index)
+    }
+    fn original_storage(&self, address: H160, index: H256) -> Option<H256> {
+        self.state.original_storage(address, index)
+    }
+}
+
+impl<'backend, 'config, B: Backend> StackState<'config> for CosmosAwareState<'backend, 'config, B> {
+    fn metadata(&self) -> &StackSubstateMetadata<'config> {
+        self.state.metadata()
+    }
+
+    fn metadata_mut(&mut self) -> &mut StackSubstateMetadata<'config> {
+        self.state.metadata_mut()
+    }
+
+    fn enter(&mut self, gas_limit: u64, is_static: bool) {
+        self.supply_changes.push(CosmosSupplyChange::default());
+        self.state.enter(gas_limit, is_static)
+    }
+
+    fn exit_commit(&mut self) -> Result<(), ExitError> {
+        // balance changes are recorded only by precompiles, which don't enter nested substates,
+        // so they belong to the exited substate
+        self.apply_balance_changes();
+        if let Some(supply_change) = self.supply_changes.pop() {
+            if let Some(parent) = self.supply_changes.last_mut() {
+                parent.merge(supply_change);
+            }
+        }
+        self.state.exit_commit()
+    }
+
+    fn exit_revert(&mut self) -> Result<(), ExitError> {
+        self.journal.borrow_mut().take_balance_changes();
+        self.supply_changes.pop();
+        self.state.exit_revert()
+    }
+
+    fn exit_discard(&mut self) -> Result<(), ExitError> {
+        self.journal.borrow_mut().take_balance_changes();
+        self.supply_changes.pop();
+        self.state.exit_discard()
+    }
+
+    fn is_empty(&self, address: H160) -> bool {
+        self.state.is_empty(address)
+    }
+
+    fn deleted(&self, address: H160) -> bool {
+        self.state.deleted(address)
+    }
+
+    fn is_cold(&self, address: H160) -> bool {
+        self.state.is_cold(address)
+    }
+
+    fn is_storage_cold(&self, address: H160, key: H256) -> bool {
+        self.state.is_storage_cold(address, key)
+    }
+
+    fn inc_nonce(&mut self, address: H160) -> Result<(), ExitError> {
+        self.state.inc_nonce(address)
+    }
+
+    fn set_storage(&mut self, address: H160, key: H256, value: H256) {
+        self.state.set_storage(address, key, value)
+    }
+
+    fn reset_storage(&mut self, address: H160) {
+        self.state.reset_storage(address)
+    }
+
+    fn log(&mut self, address: H160, topics: Vec<H256>, data: Vec<u8>) {
+        self.state.log(address, topics, data)
+    }
+
+    fn set_deleted(&mut self, address: H160) {
+        self.state.set_deleted(address)
+    }
+
+    fn set_code(&mut self, address: H160, code: Vec<u8>) {
+        self.state.set_code(address, code)
+    }
+
+    fn transfer(&mut self, transfer: Transfer) -> Result<(), ExitError> {
+        self.state.transfer(transfer)
+    }
+
+    fn reset_balance(&mut self, address: H160) {
+        self.state.reset_balance(address)
+    }
+
+    fn touch(&mut self, address: H160) {
+        self.state.touch(address)
+    }
+
+    fn code_size(&self, address: H160) -> U256 {
+        self.state.code_size(address)
+    }
+
+    fn code_hash(&self, address: H160) -> H256 {
+        self.state.code_hash(address)
+    }
+}
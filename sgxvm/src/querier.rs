@@ -62,3 +62,16 @@ pub fn make_request(querier: *mut GoQuerier, request: Vec<u8>) -> Option<Vec<u8>
 
     Some(result_vec)
 }
+
+/// Sends several encoded requests in a single call. Returns encoded responses in the same order
+pub fn make_batch_request(querier: *mut GoQuerier, requests: Vec<Vec<u8>>) -> Option<Vec<Vec<u8>>> {
+    let encoded_request = crate::coder::encode_batch(requests);
+    let result = make_request(querier, encoded_request)?;
+    match protobuf::parse_from_bytes::<crate::protobuf_generated::ffi::QueryBatchResponse>(result.as_slice()) {
+        Ok(response) => Some(response.responses.into_vec()),
+        Err(err) => {
+            println!("Cannot decode batch response: {:?}", err);
+            None
+        }
+    }
+}
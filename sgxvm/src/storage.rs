@@ -1,6 +1,6 @@
 use evm::backend::Basic;
 use primitive_types::{H160, H256, U256};
-use std::vec::Vec;
+use std::{collections::BTreeMap, vec::Vec};
 
 use crate::{
     coder, encryption, error::Error, protobuf_generated::ffi, querier, types::Storage
@@ -12,6 +12,9 @@ pub struct FFIStorage {
     pub querier: *mut querier::GoQuerier,
     pub context_timestamp: u64,
     pub context_block_number: u64,
+    // Contract code and storage cells, loaded by `prefetch` before execution
+    prefetched_code: BTreeMap<H160, Vec<u8>>,
+    prefetched_cells: BTreeMap<(H160, H256), Option<H256>>,
 }
 
 impl Storage for FFIStorage {
@@ -34,31 +37,19 @@ impl Storage for FFIStorage {
     }
 
     fn get_account_storage_cell(&self, key: &H160, index: &H256) -> Option<H256> {
+        if let Some(value) = self.prefetched_cells.get(&(*key, *index)) {
+            return *value;
+        }
+
         let encoded_request = coder::encode_get_storage_cell(key, index);
         if let Some(result) = querier::make_request(self.querier, encoded_request) {
-            // Decode protobuf
-            let decoded_result = match protobuf::parse_from_bytes::<ffi::QueryGetAccountStorageCellResponse>(result.as_slice()) {
-                Ok(res) => res,
+            match decode_storage_cell(key, result.as_slice()) {
+                Ok(value) => value,
                 Err(err) => {
-                    println!("Cannot decode protobuf response: {:?}", err);
-                    return None
+                    println!("Cannot decode storage cell. Reason: {:?}", err);
+                    None
                 }
-            };
-
-            // Decrypt result
-            if decoded_result.value.is_empty() {
-                return None;
             }
-
-            let decrypted_result = match encryption::decrypt_storage_cell(key.as_bytes().to_vec(), decoded_result.value) {
-                Ok(decrypted_result) => decrypted_result,
-                Err(err) => {
-                    println!("Cannot decrypt result. Reason: {:?}", err);
-                    return None;
-                }
-            };
-
-            Some(H256::from_slice(&decrypted_result))
         } else {
             println!("Get account storage cell failed. Empty response");
             None
@@ -66,18 +57,13 @@ impl Storage for FFIStorage {
     }
 
     fn get_account_code(&self, key: &H160) -> Option<Vec<u8>> {
+        if let Some(code) = self.prefetched_code.get(key) {
+            return Some(code.clone());
+        }
+
         let encoded_request = coder::encode_get_account_code(key);
         if let Some(result) = querier::make_request(self.querier, encoded_request) {
-            // Decode protobuf
-            let decoded_result = match protobuf::parse_from_bytes::<ffi::QueryGetAccountCodeResponse>(result.as_slice()) {
-                Ok(res) => res,
-                Err(err) => {
-                    println!("Cannot decode protobuf response: {:?}", err);
-                    return None
-                }
-            };
-
-            Some(decoded_result.code)
+            decode_account_code(result.as_slice())
         } else {
             println!("Get account code failed. Empty response");
             None
@@ -87,19 +73,7 @@ impl Storage for FFIStorage {
     fn get_account(&self, key: &H160) -> Basic {
         let encoded_request = coder::encode_get_account(key);
         if let Some(result) = querier::make_request(self.querier, encoded_request) {
-            // Decode protobuf
-            let decoded_result = match protobuf::parse_from_bytes::<ffi::QueryGetAccountResponse>(result.as_slice()) {
-                Ok(res) => res,
-                Err(err) => {
-                    println!("Cannot decode protobuf response: {:?}", err);
-                    return Basic::default();
-                }
-            };
-            
-            Basic {
-                balance: U256::from_big_endian(decoded_result.balance.as_slice()),
-                nonce: U256::from(decoded_result.nonce),
-            }
+            decode_account(result.as_slice())
         } else {
             println!("Get account failed. Empty response");
             Basic::default()
@@ -134,14 +108,7 @@ impl Storage for FFIStorage {
     }
 
     fn insert_storage_cell(&mut self, key: H160, index: H256, value: H256) -> Result<(), Error>  {
-        // Encrypt value
-        let encrypted_value = encryption::encrypt_storage_cell(
-            key.as_bytes().to_vec(), 
-            self.context_block_number,
-            self.context_timestamp.to_be_bytes().to_vec(),
-            value.as_bytes().to_vec()
-        )?;
-
+        let encrypted_value = self.encrypt_storage_cell(&key, value)?;
         let encoded_request = coder::encode_insert_storage_cell(key, index, encrypted_value);
         if let Some(result) = querier::make_request(self.querier, encoded_request) {
             match protobuf::parse_from_bytes::<ffi::QueryInsertStorageCellResponse>(result.as_slice()) {
@@ -186,6 +153,139 @@ impl Storage for FFIStorage {
 
 impl FFIStorage {
     pub fn new(querier: *mut querier::GoQuerier, context_timestamp: u64, context_block_number: u64) -> Self {
-        Self { querier, context_timestamp, context_block_number }
+        Self {
+            querier,
+            context_timestamp,
+            context_block_number,
+            prefetched_code: BTreeMap::new(),
+            prefetched_cells: BTreeMap::new(),
+        }
+    }
+
+    /// Loads code of provided accounts and their storage cells in a single request, so they are not
+    /// requested one by one during execution. Balances and nonces are not prefetched, since they can be
+    /// changed by Cosmos messages, executed by precompiles, while code and storage cells are changed
+    /// only when transaction is committed. Prefetching is best-effort: values, which cannot be loaded,
+    /// are requested during execution
+    pub fn prefetch(&mut self, access_list: &BTreeMap<H160, Vec<H256>>) {
+        let mut requests = Vec::new();
+        for (address, slots) in access_list {
+            requests.push(coder::encode_get_account_code(address));
+            for slot in slots {
+                requests.push(coder::encode_get_storage_cell(address, slot));
+            }
+        }
+        if requests.is_empty() {
+            return;
+        }
+
+        let expected_responses = requests.len();
+        let responses = match querier::make_batch_request(self.querier, requests) {
+            Some(responses) if responses.len() == expected_responses => responses,
+            _ => {
+                println!("Prefetch failed. Empty response");
+                return;
+            }
+        };
+
+        let mut responses = responses.into_iter();
+        for (address, slots) in access_list {
+            if let Some(code) = responses.next().and_then(|result| decode_account_code(result.as_slice())) {
+                self.prefetched_code.insert(*address, code);
+            }
+            for slot in slots {
+                // Cells, which cannot be decoded, are requested again during execution
+                if let Some(Ok(value)) = responses.next().map(|result| decode_storage_cell(address, result.as_slice())) {
+                    self.prefetched_cells.insert((*address, *slot), value);
+                }
+            }
+        }
+    }
+
+    /// Returns basic data of provided accounts, requested in a single batch
+    pub fn get_accounts(&self, keys: &[H160]) -> Vec<Basic> {
+        if keys.is_empty() {
+            return Vec::new();
+        }
+
+        let requests = keys.iter().map(coder::encode_get_account).collect();
+        match querier::make_batch_request(self.querier, requests) {
+            Some(responses) if responses.len() == keys.len() => responses
+                .iter()
+                .map(|result| decode_account(result.as_slice()))
+                .collect(),
+            _ => {
+                println!("Get accounts failed. Empty response");
+                keys.iter().map(|key| self.get_account(key)).collect()
+            }
+        }
+    }
+
+    /// Encodes request to update storage cell. Cells with zero value are removed, other values are encrypted
+    pub fn encode_storage_cell_update(&self, key: H160, index: H256, value: H256) -> Result<Vec<u8>, Error> {
+        if value == H256::default() {
+            return Ok(coder::encode_remove_storage_cell(&key, &index));
+        }
+        let encrypted_value = self.encrypt_storage_cell(&key, value)?;
+        Ok(coder::encode_insert_storage_cell(key, index, encrypted_value))
+    }
+
+    /// Sends state-changing requests in a single batch. Prefetched values are dropped, since they
+    /// can be changed by these requests
+    pub fn write_batch(&mut self, requests: Vec<Vec<u8>>) -> Result<(), Error> {
+        self.prefetched_code.clear();
+        self.prefetched_cells.clear();
+        if requests.is_empty() {
+            return Ok(());
+        }
+
+        let expected_responses = requests.len();
+        match querier::make_batch_request(self.querier, requests) {
+            Some(responses) if responses.len() == expected_responses => Ok(()),
+            _ => Err(Error::enclave_err("Batch write failed. Empty response")),
+        }
+    }
+
+    fn encrypt_storage_cell(&self, key: &H160, value: H256) -> Result<Vec<u8>, Error> {
+        encryption::encrypt_storage_cell(
+            key.as_bytes().to_vec(),
+            self.context_block_number,
+            self.context_timestamp.to_be_bytes().to_vec(),
+            value.as_bytes().to_vec()
+        )
+    }
+}
+
+/// Decodes and decrypts storage cell. Returns `None` if cell is empty
+fn decode_storage_cell(key: &H160, result: &[u8]) -> Result<Option<H256>, Error> {
+    let decoded_result = protobuf::parse_from_bytes::<ffi::QueryGetAccountStorageCellResponse>(result)?;
+    if decoded_result.value.is_empty() {
+        return Ok(None);
+    }
+
+    let decrypted_result = encryption::decrypt_storage_cell(key.as_bytes().to_vec(), decoded_result.value)?;
+    Ok(Some(H256::from_slice(&decrypted_result)))
+}
+
+fn decode_account_code(result: &[u8]) -> Option<Vec<u8>> {
+    match protobuf::parse_from_bytes::<ffi::QueryGetAccountCodeResponse>(result) {
+        Ok(res) => Some(res.code),
+        Err(err) => {
+            println!("Cannot decode protobuf response: {:?}", err);
+            None
+        }
+    }
+}
+
+fn decode_account(result: &[u8]) -> Basic {
+    match protobuf::parse_from_bytes::<ffi::QueryGetAccountResponse>(result) {
+        Ok(res) => Basic {
+            balance: U256::from_big_endian(res.balance.as_slice()),
+            nonce: U256::from(res.nonce),
+        },
+        Err(err) => {
+            println!("Cannot decode protobuf response: {:?}", err);
+            Basic::default()
+        }
     }
 }
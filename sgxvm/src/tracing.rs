@@ -0,0 +1,462 @@
+use ethabi::{ParamType, Token};
+use evm::gasometer::tracing as gasometer_tracing;
+use evm::runtime::tracing as runtime_tracing;
+use evm::tracing as evm_tracing;
+use evm::ExitReason;
+use primitive_types::{H160, U256};
+use serde::Serialize;
+use std::{cell::RefCell, rc::Rc, string::String, vec::Vec};
+
+use crate::coder;
+use crate::precompiles::CosmosJournal;
+use crate::protobuf_generated::ffi::{TraceEnter, TraceEvent, TraceExit, TraceStep};
+use crate::querier;
+use crate::GoQuerier;
+
+/// Amount of collected events, after which they are sent to Cosmos side
+const FLUSH_THRESHOLD: usize = 256;
+
+/// Opcode, used to enter into nested frame, if it cannot be obtained from the latest step
+const CALL_OPCODE: u8 = 0xf1;
+
+/// Selector of `Error(string)`, which is used to encode revert reason
+const REVERT_SELECTOR: [u8; 4] = [0x08, 0xc3, 0x79, 0xa0];
+
+/// Tracing of executed transaction
+#[derive(Clone, Copy, PartialEq)]
+pub enum TraceMode {
+    /// Execution is not traced
+    Disabled,
+    /// Executed opcodes and nested call frames are streamed to Cosmos side, where they are passed
+    /// to go-ethereum tracer. Opcodes are reported without stack, memory and storage values, since
+    /// they can be derived from encrypted storage. Top-level frame is not reported, since Cosmos
+    /// side already knows its parameters and result
+    Stream,
+    /// Call tree of executed frames, including the top-level one, is collected inside the enclave.
+    /// Since call tree contains decrypted data, it should leave the enclave only in encrypted form
+    CallTree,
+}
+
+impl TraceMode {
+    /// Returns mode, in which execution is streamed to Cosmos side if `enabled` is set
+    pub fn stream(enabled: bool) -> Self {
+        match enabled {
+            true => TraceMode::Stream,
+            false => TraceMode::Disabled,
+        }
+    }
+}
+
+/// Executes provided function with listener of EVM events. Entered and exited call frames are always
+/// reported to Cosmos journal, so changes of Cosmos state, made within failed frames, are reverted.
+/// Execution is traced according to provided mode. Call tree is returned only in `CallTree` mode
+pub fn run_with_listeners<R, F: FnOnce() -> R>(
+    querier: *mut GoQuerier,
+    mode: TraceMode,
+    journal: Rc<RefCell<CosmosJournal>>,
+    f: F,
+) -> (R, Option<CallFrame>) {
+    let sink = match mode {
+        TraceMode::Disabled => {
+            let mut evm_listener = EvmListener {
+                collector: None,
+                journal,
+            };
+            return (evm_tracing::using(&mut evm_listener, f), None);
+        }
+        TraceMode::Stream => TraceSink::Stream(querier),
+        TraceMode::CallTree => TraceSink::CallTree {
+            frames: Vec::default(),
+            root: None,
+        },
+    };
+
+    let (result, collector) = run_traced(TraceCollector::new(sink), journal, f);
+    match collector.sink {
+        TraceSink::CallTree { root, .. } => (result, root),
+        TraceSink::Stream(_) => (result, None),
+    }
+}
+
+fn run_traced<R, F: FnOnce() -> R>(
+    collector: TraceCollector,
+    journal: Rc<RefCell<CosmosJournal>>,
+    f: F,
+) -> (R, TraceCollector) {
+    let collector = Rc::new(RefCell::new(collector));
+    let mut evm_listener = EvmListener {
+        collector: Some(collector.clone()),
+        journal,
+    };
+    let mut runtime_listener = RuntimeListener(collector.clone());
+    let mut gasometer_listener = GasometerListener(collector.clone());
+
+    let result = evm_tracing::using(&mut evm_listener, || {
+        runtime_tracing::using(&mut runtime_listener, || {
+            gasometer_tracing::using(&mut gasometer_listener, f)
+        })
+    });
+
+    collector.borrow_mut().finish();
+    drop((evm_listener, runtime_listener, gasometer_listener));
+    let collector = match Rc::try_unwrap(collector) {
+        Ok(collector) => collector.into_inner(),
+        Err(_) => unreachable!("listeners are dropped"),
+    };
+    (result, collector)
+}
+
+/// Single call frame in the format of go-ethereum `callTracer`, extended with decoded revert reason
+#[derive(Default, Serialize)]
+#[serde(rename_all = "camelCase")]
+pub struct CallFrame {
+    #[serde(rename = "type")]
+    call_type: String,
+    from: String,
+    #[serde(skip_serializing_if = "String::is_empty")]
+    to: String,
+    #[serde(skip_serializing_if = "String::is_empty")]
+    value: String,
+    gas: String,
+    gas_used: String,
+    input: String,
+    #[serde(skip_serializing_if = "String::is_empty")]
+    output: String,
+    #[serde(skip_serializing_if = "String::is_empty")]
+    error: String,
+    #[serde(skip_serializing_if = "String::is_empty")]
+    revert_reason: String,
+    #[serde(skip_serializing_if = "Vec::is_empty")]
+    calls: Vec<CallFrame>,
+}
+
+impl CallFrame {
+    /// Sets gas limit and gas usage of the transaction to the top-level frame, since gas
+    /// available for the top-level frame doesn't include intrinsic gas
+    pub fn set_transaction_gas(&mut self, gas_limit: u64, gas_used: u64) {
+        self.gas = format!("{:#x}", gas_limit);
+        self.gas_used = format!("{:#x}", gas_used);
+    }
+}
+
+/// Destination of collected tracing events
+enum TraceSink {
+    /// Events are sent to Cosmos side in batches
+    Stream(*mut GoQuerier),
+    /// Call frames are collected into call tree, which never leaves the enclave in plaintext
+    CallTree {
+        frames: Vec<CallFrame>,
+        root: Option<CallFrame>,
+    },
+}
+
+/// Collects tracing events and sends them in batches or builds call tree from them
+struct TraceCollector {
+    sink: TraceSink,
+    events: Vec<TraceEvent>,
+    // Step is reported after its cost becomes known
+    pending_step: Option<TraceStep>,
+    depth: u32,
+    // Remaining gas and refund counter of the current frame
+    gas: u64,
+    refund: u64,
+    // Gas, available for each entered frame
+    frame_gas: Vec<u64>,
+    last_opcode: Option<u8>,
+}
+
+impl TraceCollector {
+    fn new(sink: TraceSink) -> Self {
+        Self {
+            sink,
+            events: Vec::default(),
+            pending_step: None,
+            depth: 0,
+            gas: 0,
+            refund: 0,
+            frame_gas: Vec::default(),
+            last_opcode: None,
+        }
+    }
+
+    /// Returns true if executed opcodes should be reported
+    fn reports_steps(&self) -> bool {
+        matches!(self.sink, TraceSink::Stream(_))
+    }
+
+    fn push_event(&mut self, event: TraceEvent) {
+        self.events.push(event);
+        if self.events.len() >= FLUSH_THRESHOLD {
+            self.flush();
+        }
+    }
+
+    fn complete_step(&mut self) {
+        if let Some(step) = self.pending_step.take() {
+            let mut event = TraceEvent::new();
+            event.set_step(step);
+            self.push_event(event);
+        }
+    }
+
+    fn flush(&mut self) {
+        if self.events.is_empty() {
+            return;
+        }
+        let events = std::mem::take(&mut self.events);
+        if let TraceSink::Stream(querier) = self.sink {
+            if querier::make_request(querier, coder::encode_trace_events(events)).is_none() {
+                println!("Cannot send trace events");
+            }
+        }
+    }
+
+    fn finish(&mut self) {
+        self.complete_step();
+        self.flush();
+    }
+
+    fn enter_frame(&mut self, from: H160, to: H160, input: &[u8], gas: Option<u64>, value: U256) {
+        self.complete_step();
+
+        let gas = gas.unwrap_or(self.gas);
+        let opcode = self.last_opcode.unwrap_or(CALL_OPCODE);
+        if let TraceSink::CallTree { frames, .. } = &mut self.sink {
+            frames.push(CallFrame {
+                call_type: opcode_to_call_type(opcode).into(),
+                from: hex_bytes(from.as_bytes()),
+                to: hex_bytes(to.as_bytes()),
+                // static call cannot transfer value
+                value: match opcode {
+                    0xfa => String::default(),
+                    _ => format!("{:#x}", value),
+                },
+                gas: format!("{:#x}", gas),
+                input: hex_bytes(input),
+                ..Default::default()
+            });
+        } else if self.depth > 0 {
+            let mut enter = TraceEnter::new();
+            enter.set_opcode(opcode as u32);
+            enter.set_from(from.as_bytes().to_vec());
+            enter.set_to(to.as_bytes().to_vec());
+            enter.set_input(input.to_vec());
+            enter.set_gas(gas);
+            enter.set_value(u256_to_vec(value));
+
+            let mut event = TraceEvent::new();
+            event.set_enter(enter);
+            self.push_event(event);
+        }
+
+        self.depth += 1;
+        self.gas = gas;
+        self.frame_gas.push(gas);
+    }
+
+    fn exit_frame(&mut self, reason: &ExitReason, return_value: &[u8]) {
+        self.complete_step();
+
+        let frame_gas = self.frame_gas.pop().unwrap_or_default();
+        self.depth = self.depth.saturating_sub(1);
+        let gas_used = frame_gas.saturating_sub(self.gas);
+        if let TraceSink::CallTree { frames, root } = &mut self.sink {
+            if let Some(mut frame) = frames.pop() {
+                frame.gas_used = format!("{:#x}", gas_used);
+                frame.error = exit_reason_to_error(reason);
+                if !return_value.is_empty() {
+                    frame.output = hex_bytes(return_value);
+                }
+                if let ExitReason::Revert(_) = reason {
+                    frame.revert_reason = decode_revert_reason(return_value).unwrap_or_default();
+                }
+                match frames.last_mut() {
+                    Some(parent) => parent.calls.push(frame),
+                    None => *root = Some(frame),
+                }
+            }
+            return;
+        }
+        if self.depth == 0 {
+            return;
+        }
+
+        let mut exit = TraceExit::new();
+        exit.set_output(return_value.to_vec());
+        exit.set_gas_used(gas_used);
+        exit.set_error(exit_reason_to_error(reason));
+
+        let mut event = TraceEvent::new();
+        event.set_exit(exit);
+        self.push_event(event);
+    }
+
+    fn update_gas(&mut self, snapshot: Option<gasometer_tracing::Snapshot>, is_cost: bool) {
+        let snapshot = match snapshot {
+            Some(snapshot) => snapshot,
+            None => return,
+        };
+        self.gas = snapshot
+            .gas_limit
+            .saturating_sub(snapshot.used_gas)
+            .saturating_sub(snapshot.memory_gas);
+        self.refund = snapshot.refunded_gas.max(0) as u64;
+
+        if is_cost {
+            let gas = self.gas;
+            if let Some(step) = self.pending_step.as_mut() {
+                step.set_cost(step.gas.saturating_sub(gas));
+            }
+        }
+    }
+}
+
+/// Reports call frames to Cosmos journal and, if execution is traced, to trace collector
+struct EvmListener {
+    collector: Option<Rc<RefCell<TraceCollector>>>,
+    journal: Rc<RefCell<CosmosJournal>>,
+}
+
+impl evm_tracing::EventListener for EvmListener {
+    fn event(&mut self, event: evm_tracing::Event) {
+        match &event {
+            evm_tracing::Event::Call { .. } | evm_tracing::Event::Create { .. } => {
+                self.journal.borrow_mut().enter_frame()
+            }
+            evm_tracing::Event::Exit { reason, .. } => {
+                self.journal.borrow_mut().exit_frame(reason.is_succeed())
+            }
+            _ => {}
+        }
+
+        let mut collector = match &self.collector {
+            Some(collector) => collector.borrow_mut(),
+            None => return,
+        };
+        match event {
+            evm_tracing::Event::Call {
+                code_address,
+                input,
+                target_gas,
+                context,
+                ..
+            } => collector.enter_frame(
+                context.caller,
+                code_address,
+                input,
+                target_gas,
+                context.apparent_value,
+            ),
+            evm_tracing::Event::Create {
+                caller,
+                address,
+                value,
+                init_code,
+                target_gas,
+                ..
+            } => collector.enter_frame(caller, address, init_code, target_gas, value),
+            evm_tracing::Event::Exit {
+                reason,
+                return_value,
+            } => collector.exit_frame(reason, return_value),
+            _ => {}
+        }
+    }
+}
+
+/// Reports executed opcodes. Since storage of all contracts is encrypted, stack, memory and loaded
+/// storage values may contain private data or values derived from it, so they are not reported.
+/// Decrypted execution can be observed only through call tree, collected in `CallTree` mode
+struct RuntimeListener(Rc<RefCell<TraceCollector>>);
+
+impl runtime_tracing::EventListener for RuntimeListener {
+    fn event(&mut self, event: runtime_tracing::Event) {
+        let mut collector = self.0.borrow_mut();
+        if let runtime_tracing::Event::Step {
+            context,
+            opcode,
+            position,
+            ..
+        } = event
+        {
+            collector.complete_step();
+            collector.last_opcode = Some(opcode.as_u8());
+            if !collector.reports_steps() {
+                return;
+            }
+
+            let mut step = TraceStep::new();
+            step.set_pc(position.as_ref().map(|pc| *pc as u64).unwrap_or_default());
+            step.set_opcode(opcode.as_u8() as u32);
+            step.set_gas(collector.gas);
+            step.set_depth(collector.depth);
+            step.set_contract(context.address.as_bytes().to_vec());
+            step.set_refund(collector.refund);
+
+            collector.pending_step = Some(step);
+        }
+    }
+}
+
+struct GasometerListener(Rc<RefCell<TraceCollector>>);
+
+impl gasometer_tracing::EventListener for GasometerListener {
+    fn event(&mut self, event: gasometer_tracing::Event) {
+        let mut collector = self.0.borrow_mut();
+        match event {
+            gasometer_tracing::Event::RecordCost { snapshot, .. }
+            | gasometer_tracing::Event::RecordDynamicCost { snapshot, .. } => {
+                collector.update_gas(snapshot, true)
+            }
+            gasometer_tracing::Event::RecordRefund { snapshot, .. }
+            | gasometer_tracing::Event::RecordStipend { snapshot, .. } => {
+                collector.update_gas(snapshot, false)
+            }
+            _ => {}
+        }
+    }
+}
+
+fn exit_reason_to_error(reason: &ExitReason) -> String {
+    match reason {
+        ExitReason::Succeed(_) => String::default(),
+        ExitReason::Revert(_) => "execution reverted".into(),
+        ExitReason::Error(err) => format!("evm error: {:?}", err),
+        ExitReason::Fatal(err) => format!("fatal evm error: {:?}", err),
+    }
+}
+
+fn u256_to_vec(value: U256) -> Vec<u8> {
+    let mut buffer = [0u8; 32];
+    value.to_big_endian(&mut buffer);
+    buffer.to_vec()
+}
+
+fn opcode_to_call_type(opcode: u8) -> &'static str {
+    match opcode {
+        0xf0 => "CREATE",
+        0xf2 => "CALLCODE",
+        0xf4 => "DELEGATECALL",
+        0xf5 => "CREATE2",
+        0xfa => "STATICCALL",
+        _ => "CALL",
+    }
+}
+
+/// Decodes revert reason, encoded as `Error(string)`
+fn decode_revert_reason(data: &[u8]) -> Option<String> {
+    if data.len() < REVERT_SELECTOR.len() || data[..4] != REVERT_SELECTOR {
+        return None;
+    }
+    match ethabi::decode(&[ParamType::String], &data[4..]) {
+        Ok(tokens) => match tokens.into_iter().next() {
+            Some(Token::String(reason)) => Some(reason),
+            _ => None,
+        },
+        Err(_) => None,
+    }
+}
+
+fn hex_bytes(data: &[u8]) -> String {
+    format!("0x{}", hex::encode(data))
+}
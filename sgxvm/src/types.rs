@@ -13,6 +13,7 @@ use std::{
 use std::boxed::Box;
 use sgx_types::*;
 use crate::error::Error;
+use crate::precompiles::CosmosSupplyChange;
 
 pub static GASOMETER_CONFIG: Config = Config::london();
 
@@ -27,8 +28,15 @@ pub struct Vicinity {
 pub trait ExtendedBackend: EvmBackend {
     fn get_logs(&self) -> Vec<Log>;
 
-    /// Apply given values and logs at backend.
-	fn apply<A, I, L>(&mut self, values: A, logs: L, delete_empty: bool) -> Result<(), Error>
+    /// Apply given values and logs at backend. Supply change, made by Cosmos messages of precompiles,
+    /// is excluded from the check of total supply invariant
+	fn apply<A, I, L>(
+		&mut self,
+		values: A,
+		logs: L,
+		supply_change: CosmosSupplyChange,
+		delete_empty: bool,
+	) -> Result<(), Error>
 	where
 		A: IntoIterator<Item = Apply<I>>,
 		I: IntoIterator<Item = (H256, H256)>,
@@ -87,7 +95,12 @@ pub struct ExecutionResult {
     pub logs: Vec<Log>,
     pub data: Vec<u8>,
     pub gas_used: u64,
-    pub vm_error: String
+    pub gas_refund: u64,
+    pub vm_error: String,
+    /// Addresses and storage slots, accessed during execution
+    pub access_list: Vec<(H160, Vec<H256>)>,
+    /// Call tree of authorized tracing, encrypted with the key of the transaction sender
+    pub encrypted_trace: Vec<u8>,
 }
 
 impl ExecutionResult {
@@ -96,8 +109,11 @@ impl ExecutionResult {
         Self {
             logs: Vec::default(),
             gas_used: gas_used.unwrap_or(21000), // This is minimum gas fee to apply the transaction
+            gas_refund: 0, // Refunds of reverted transaction are discarded
             vm_error: reason,
             data,
+            access_list: Vec::default(),
+            encrypted_trace: Vec::default(),
         }
     }
 }
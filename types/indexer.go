@@ -19,6 +19,7 @@ import (
 	abci "github.com/cometbft/cometbft/abci/types"
 	tmtypes "github.com/cometbft/cometbft/types"
 	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
 )
 
 // EVMTxIndexer defines the interface of custom eth tx indexer.
@@ -31,4 +32,16 @@ type EVMTxIndexer interface {
 	GetByTxHash(common.Hash) (*TxResult, error)
 	// GetByBlockAndIndex returns nil if tx not found.
 	GetByBlockAndIndex(int64, int32) (*TxResult, error)
+
+	// GetReceiptByTxHash returns receipt of eth tx with its logs, returns error if receipt is not indexed.
+	GetReceiptByTxHash(common.Hash) (*ethtypes.Receipt, error)
+	// GetReceiptsByBlock returns receipts of all eth txs in the block ordered by eth tx index,
+	// returns error if receipts of the block are not indexed.
+	GetReceiptsByBlock(int64) ([]*ethtypes.Receipt, error)
+
+	// LogIndexRange returns the range of blocks covered by the log index, returns -1 if the log index is empty.
+	LogIndexRange() (int64, int64, error)
+	// GetLogBlocks returns the ordered numbers of the blocks, which may contain logs matching the addresses and
+	// topics, returns error if the blocks are not covered by the log index.
+	GetLogBlocks(from, to int64, addresses []common.Address, topics [][]common.Hash) ([]int64, error)
 }
@@ -0,0 +1,22 @@
+package erc20
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"swisstronik/x/erc20/keeper"
+	"swisstronik/x/erc20/types"
+)
+
+// InitGenesis initializes the module's state from a provided genesis state.
+func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState) {
+	for _, pair := range genState.TokenPairs {
+		k.SetTokenPair(ctx, pair)
+	}
+}
+
+// ExportGenesis returns the module's exported genesis
+func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
+	return &types.GenesisState{
+		TokenPairs: k.GetTokenPairs(ctx),
+	}
+}
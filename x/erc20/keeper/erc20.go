@@ -0,0 +1,41 @@
+package keeper
+
+import (
+	"math/big"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"swisstronik/x/erc20/types"
+)
+
+// erc20CallGasLimit limits gas, which can be used by the ERC-20 contract to transfer escrowed tokens
+const erc20CallGasLimit = 200_000
+
+var (
+	// transferSelector is selector of `transfer(address,uint256) returns (bool)` ERC-20 function
+	transferSelector = crypto.Keccak256([]byte("transfer(address,uint256)"))[:4]
+	// transferEventTopic is topic of `Transfer(address indexed from, address indexed to, uint256 value)` ERC-20 event
+	transferEventTopic = crypto.Keccak256Hash([]byte("Transfer(address,address,uint256)"))
+)
+
+// transferERC20 transfers ERC-20 tokens, escrowed by the module, to the receiver
+func (k Keeper) transferERC20(ctx sdk.Context, contract, receiver common.Address, amount *big.Int) error {
+	data := append(common.CopyBytes(transferSelector), common.LeftPadBytes(receiver.Bytes(), 32)...)
+	data = append(data, common.LeftPadBytes(amount.Bytes(), 32)...)
+
+	res, err := k.evmKeeper.CallEVMWithData(ctx, types.ModuleAddress, contract, data, erc20CallGasLimit, true)
+	if err != nil {
+		return errorsmod.Wrap(types.ErrERC20Call, err.Error())
+	}
+	if res.Failed() {
+		return errorsmod.Wrap(types.ErrERC20Call, res.VmError)
+	}
+	// tokens, which do not follow the standard, may not return anything
+	if len(res.Ret) != 0 && new(big.Int).SetBytes(res.Ret).Cmp(big.NewInt(1)) != 0 {
+		return errorsmod.Wrap(types.ErrERC20Call, "transfer returned false")
+	}
+	return nil
+}
@@ -0,0 +1,68 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/ethereum/go-ethereum/common"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"swisstronik/x/erc20/types"
+)
+
+var _ types.QueryServer = Keeper{}
+
+// TokenPairs returns all registered token pairs
+func (k Keeper) TokenPairs(goCtx context.Context, req *types.QueryTokenPairsRequest) (*types.QueryTokenPairsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixTokenPair)
+
+	var pairs []types.TokenPair
+	pageRes, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		var pair types.TokenPair
+		if err := k.cdc.Unmarshal(value, &pair); err != nil {
+			return err
+		}
+		pairs = append(pairs, pair)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryTokenPairsResponse{
+		TokenPairs: pairs,
+		Pagination: pageRes,
+	}, nil
+}
+
+// TokenPair returns the token pair for provided ERC-20 contract address or coin denomination
+func (k Keeper) TokenPair(goCtx context.Context, req *types.QueryTokenPairRequest) (*types.QueryTokenPairResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	var (
+		pair  types.TokenPair
+		found bool
+	)
+	if common.IsHexAddress(req.Token) {
+		pair, found = k.GetTokenPair(ctx, common.HexToAddress(req.Token))
+	} else {
+		pair, found = k.GetTokenPairByDenom(ctx, req.Token)
+	}
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "token pair for %s not found", req.Token)
+	}
+
+	return &types.QueryTokenPairResponse{TokenPair: pair}, nil
+}
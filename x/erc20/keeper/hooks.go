@@ -0,0 +1,71 @@
+package keeper
+
+import (
+	"math/big"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	"swisstronik/x/erc20/types"
+	evmtypes "swisstronik/x/evm/types"
+)
+
+var _ evmtypes.EvmHooks = Hooks{}
+
+// Hooks wraps the erc20 keeper to implement EVM hooks
+type Hooks struct {
+	k Keeper
+}
+
+// Hooks returns the EVM hooks of the erc20 module
+func (k Keeper) Hooks() Hooks {
+	return Hooks{k}
+}
+
+// PostTxProcessing converts ERC-20 tokens of registered token pairs, transferred to the module address,
+// into native coins, which are minted to the sender of the tokens. Transferred tokens stay escrowed
+// by the module until they are converted back with MsgConvertCoin
+func (h Hooks) PostTxProcessing(ctx sdk.Context, _ core.Message, receipt *ethtypes.Receipt) error {
+	for _, log := range receipt.Logs {
+		if len(log.Topics) != 3 || log.Topics[0] != transferEventTopic || len(log.Data) != 32 {
+			continue
+		}
+		if common.BytesToAddress(log.Topics[2].Bytes()) != types.ModuleAddress {
+			continue
+		}
+
+		pair, found := h.k.GetTokenPair(ctx, log.Address)
+		if !found {
+			continue
+		}
+
+		amount := new(big.Int).SetBytes(log.Data)
+		if amount.Sign() == 0 {
+			continue
+		}
+
+		sender := common.BytesToAddress(log.Topics[1].Bytes())
+		coins := sdk.NewCoins(sdk.NewCoin(pair.Denom, sdkmath.NewIntFromBigInt(amount)))
+		if err := h.k.bankKeeper.MintCoins(ctx, types.ModuleName, coins); err != nil {
+			return err
+		}
+		if err := h.k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, sender.Bytes(), coins); err != nil {
+			return err
+		}
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeConvertERC20,
+				sdk.NewAttribute(types.AttributeKeySender, sender.Hex()),
+				sdk.NewAttribute(types.AttributeKeyReceiver, sdk.AccAddress(sender.Bytes()).String()),
+				sdk.NewAttribute(types.AttributeKeyAmount, amount.String()),
+				sdk.NewAttribute(types.AttributeKeyDenom, pair.Denom),
+				sdk.NewAttribute(types.AttributeKeyERC20Address, pair.Erc20Address),
+			),
+		)
+	}
+	return nil
+}
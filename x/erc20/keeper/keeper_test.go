@@ -0,0 +1,147 @@
+package keeper_test
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"cosmossdk.io/simapp"
+	"github.com/SigmaGmbH/librustgo"
+	"github.com/cometbft/cometbft/crypto/tmhash"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/suite"
+
+	"swisstronik/app"
+	"swisstronik/crypto/ethsecp256k1"
+	"swisstronik/tests"
+	evmcommontypes "swisstronik/types"
+	"swisstronik/utils"
+	"swisstronik/x/erc20/types"
+	evmtypes "swisstronik/x/evm/types"
+	feemarkettypes "swisstronik/x/feemarket/types"
+)
+
+const testDenom = "erc20token"
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	ctx           sdk.Context
+	app           *app.App
+	address       common.Address
+	privateKey    []byte
+	nodePublicKey []byte
+	signer        keyring.Signer
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+func (suite *KeeperTestSuite) SetupTest() {
+	chainID := utils.TestnetChainID + "-1"
+	suite.Require().NoError(librustgo.InitializeEnclave(false))
+	res, err := librustgo.GetNodePublicKey(0)
+	suite.Require().NoError(err)
+	suite.nodePublicKey = res.PublicKey
+
+	priv, err := ethsecp256k1.GenerateKey()
+	suite.Require().NoError(err)
+	suite.privateKey = priv.Bytes()
+	suite.address = common.BytesToAddress(priv.PubKey().Address().Bytes())
+	suite.signer = tests.NewTestSigner(priv)
+
+	consPriv, err := ethsecp256k1.GenerateKey()
+	suite.Require().NoError(err)
+	consAddress := sdk.ConsAddress(consPriv.PubKey().Address())
+
+	suite.app = app.Setup(false, func(app *app.App, genesis simapp.GenesisState) simapp.GenesisState {
+		feemarketGenesis := feemarkettypes.DefaultGenesisState()
+		feemarketGenesis.Params.NoBaseFee = true
+		genesis[feemarkettypes.ModuleName] = app.AppCodec().MustMarshalJSON(feemarketGenesis)
+		return genesis
+	})
+
+	suite.ctx = suite.app.NewContext(false, tmproto.Header{
+		ChainID:         chainID,
+		Height:          1,
+		Time:            time.Now().UTC(),
+		ProposerAddress: consAddress.Bytes(),
+		AppHash:         tmhash.Sum([]byte("app")),
+	})
+
+	suite.app.AccountKeeper.SetAccount(suite.ctx, &evmcommontypes.EthAccount{
+		BaseAccount: authtypes.NewBaseAccount(sdk.AccAddress(suite.address.Bytes()), nil, 0, 0),
+		CodeHash:    common.BytesToHash(crypto.Keccak256(nil)).String(),
+	})
+
+	validator, err := stakingtypes.NewValidator(sdk.ValAddress(suite.address.Bytes()), consPriv.PubKey(), stakingtypes.Description{})
+	suite.Require().NoError(err)
+	suite.Require().NoError(suite.app.StakingKeeper.SetValidatorByConsAddr(suite.ctx, validator))
+	suite.app.StakingKeeper.SetValidator(suite.ctx, validator)
+}
+
+// deployERC20 deploys the test ERC-20 contract, which mints supply to the suite account
+func (suite *KeeperTestSuite) deployERC20(supply *big.Int) common.Address {
+	chainID := suite.app.EvmKeeper.ChainID()
+	nonce := suite.app.EvmKeeper.GetNonce(suite.ctx, suite.address)
+
+	ctorArgs, err := evmtypes.ERC20Contract.ABI.Pack("", suite.address, supply)
+	suite.Require().NoError(err)
+	data := append(common.CopyBytes(evmtypes.ERC20Contract.Bin), ctorArgs...)
+
+	tx := evmtypes.NewTxContract(chainID, nonce, nil, 2_000_000, nil, nil, nil, data, nil)
+	tx.From = suite.address.Hex()
+	suite.Require().NoError(tx.Sign(ethtypes.LatestSignerForChainID(chainID), suite.signer))
+
+	rsp, err := suite.app.EvmKeeper.HandleTx(sdk.WrapSDKContext(suite.ctx), tx)
+	suite.Require().NoError(err)
+	suite.Require().Empty(rsp.VmError)
+	return crypto.CreateAddress(suite.address, nonce)
+}
+
+// transferERC20 sends encrypted ERC-20 transfer transaction from the suite account
+func (suite *KeeperTestSuite) transferERC20(contract, to common.Address, amount *big.Int) *evmtypes.MsgEthereumTxResponse {
+	chainID := suite.app.EvmKeeper.ChainID()
+	nonce := suite.app.EvmKeeper.GetNonce(suite.ctx, suite.address)
+
+	data, err := evmtypes.ERC20Contract.ABI.Pack("transfer", to, amount)
+	suite.Require().NoError(err)
+
+	tx := evmtypes.NewTx(chainID, nonce, &contract, nil, 200_000, nil, nil, nil, data, nil, suite.privateKey, suite.nodePublicKey)
+	tx.From = suite.address.Hex()
+	suite.Require().NoError(tx.Sign(ethtypes.LatestSignerForChainID(chainID), suite.signer))
+
+	rsp, err := suite.app.EvmKeeper.HandleTx(sdk.WrapSDKContext(suite.ctx), tx)
+	suite.Require().NoError(err)
+	return rsp
+}
+
+// balanceOf returns ERC-20 balance of the account
+func (suite *KeeperTestSuite) balanceOf(contract, account common.Address) *big.Int {
+	data, err := evmtypes.ERC20Contract.ABI.Pack("balanceOf", account)
+	suite.Require().NoError(err)
+
+	res, err := suite.app.EvmKeeper.CallEVMWithData(suite.ctx, common.Address{}, contract, data, 100_000, false)
+	suite.Require().NoError(err)
+	suite.Require().Empty(res.VmError)
+	return new(big.Int).SetBytes(res.Ret)
+}
+
+// registerTokenPair registers token pair on behalf of the governance account
+func (suite *KeeperTestSuite) registerTokenPair(contract common.Address, denom string) error {
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	_, err := suite.app.Erc20Keeper.RegisterTokenPair(
+		sdk.WrapSDKContext(suite.ctx),
+		types.NewMsgRegisterTokenPair(authority, contract, denom),
+	)
+	return err
+}
@@ -0,0 +1,98 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"swisstronik/x/erc20/types"
+)
+
+var _ types.MsgServer = Keeper{}
+
+// RegisterTokenPair implements the gRPC MsgServer interface. When a RegisterTokenPair proposal passes,
+// it registers a new token pair between the existing ERC-20 contract and the coin denomination.
+// The denomination must not have any supply, since its coins are minted only for escrowed tokens
+func (k Keeper) RegisterTokenPair(goCtx context.Context, req *types.MsgRegisterTokenPair) (*types.MsgRegisterTokenPairResponse, error) {
+	if k.authority.String() != req.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority.String(), req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	pair := types.TokenPair{Erc20Address: req.Erc20Address, Denom: req.Denom}
+	if err := pair.Validate(); err != nil {
+		return nil, err
+	}
+
+	contract := pair.GetERC20Contract()
+	pair.Erc20Address = contract.Hex()
+	if acct := k.evmKeeper.GetAccountWithoutBalance(ctx, contract); acct == nil || !acct.IsContract() {
+		return nil, errorsmod.Wrapf(types.ErrInvalidTokenPair, "%s is not a contract", pair.Erc20Address)
+	}
+	if _, found := k.GetTokenPair(ctx, contract); found {
+		return nil, errorsmod.Wrapf(types.ErrTokenPairAlreadyExists, "ERC-20 contract %s is already registered", pair.Erc20Address)
+	}
+	if _, found := k.GetTokenPairByDenom(ctx, pair.Denom); found {
+		return nil, errorsmod.Wrapf(types.ErrTokenPairAlreadyExists, "denomination %s is already registered", pair.Denom)
+	}
+	if k.bankKeeper.HasSupply(ctx, pair.Denom) {
+		return nil, errorsmod.Wrapf(types.ErrInvalidTokenPair, "denomination %s already has supply", pair.Denom)
+	}
+
+	k.SetTokenPair(ctx, pair)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRegisterTokenPair,
+			sdk.NewAttribute(types.AttributeKeyDenom, pair.Denom),
+			sdk.NewAttribute(types.AttributeKeyERC20Address, pair.Erc20Address),
+		),
+	)
+
+	return &types.MsgRegisterTokenPairResponse{}, nil
+}
+
+// ConvertCoin implements the gRPC MsgServer interface. It burns native coins of the sender
+// and transfers the same amount of escrowed ERC-20 tokens to the receiver
+func (k Keeper) ConvertCoin(goCtx context.Context, req *types.MsgConvertCoin) (*types.MsgConvertCoinResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(req.Sender)
+	if err != nil {
+		return nil, err
+	}
+	receiver := common.HexToAddress(req.Receiver)
+
+	pair, found := k.GetTokenPairByDenom(ctx, req.Coin.Denom)
+	if !found {
+		return nil, errorsmod.Wrapf(types.ErrTokenPairNotFound, "denomination %s is not registered", req.Coin.Denom)
+	}
+
+	coins := sdk.NewCoins(req.Coin)
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, sender, types.ModuleName, coins); err != nil {
+		return nil, err
+	}
+	if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, coins); err != nil {
+		return nil, err
+	}
+
+	if err := k.transferERC20(ctx, pair.GetERC20Contract(), receiver, req.Coin.Amount.BigInt()); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeConvertCoin,
+			sdk.NewAttribute(types.AttributeKeySender, req.Sender),
+			sdk.NewAttribute(types.AttributeKeyReceiver, receiver.Hex()),
+			sdk.NewAttribute(types.AttributeKeyAmount, req.Coin.Amount.String()),
+			sdk.NewAttribute(types.AttributeKeyDenom, req.Coin.Denom),
+			sdk.NewAttribute(types.AttributeKeyERC20Address, pair.Erc20Address),
+		),
+	)
+
+	return &types.MsgConvertCoinResponse{}, nil
+}
@@ -0,0 +1,130 @@
+package keeper_test
+
+import (
+	"math/big"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"swisstronik/x/erc20/types"
+	evmtypes "swisstronik/x/evm/types"
+)
+
+func (suite *KeeperTestSuite) TestRegisterTokenPair() {
+	var (
+		contract common.Address
+		msg      *types.MsgRegisterTokenPair
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {},
+			true,
+		},
+		{
+			"fail - invalid authority",
+			func() {
+				msg.Authority = sdk.AccAddress(suite.address.Bytes()).String()
+			},
+			false,
+		},
+		{
+			"fail - not a contract",
+			func() {
+				msg.Erc20Address = suite.address.Hex()
+			},
+			false,
+		},
+		{
+			"fail - denomination has supply",
+			func() {
+				msg.Denom = evmtypes.DefaultEVMDenom
+			},
+			false,
+		},
+		{
+			"fail - contract already registered",
+			func() {
+				suite.Require().NoError(suite.registerTokenPair(contract, "othertoken"))
+			},
+			false,
+		},
+		{
+			"fail - denomination already registered",
+			func() {
+				other := suite.deployERC20(big.NewInt(1))
+				suite.Require().NoError(suite.registerTokenPair(other, testDenom))
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			contract = suite.deployERC20(big.NewInt(1000))
+			msg = types.NewMsgRegisterTokenPair(suite.app.Erc20Keeper.GetAuthority().String(), contract, testDenom)
+			tc.malleate()
+
+			_, err := suite.app.Erc20Keeper.RegisterTokenPair(sdk.WrapSDKContext(suite.ctx), msg)
+			if !tc.expPass {
+				suite.Require().Error(err)
+				return
+			}
+			suite.Require().NoError(err)
+
+			pair, found := suite.app.Erc20Keeper.GetTokenPairByDenom(suite.ctx, testDenom)
+			suite.Require().True(found)
+			suite.Require().Equal(types.NewTokenPair(contract, testDenom), pair)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestConvertERC20AndCoin() {
+	suite.SetupTest()
+	contract := suite.deployERC20(big.NewInt(1000))
+	unregistered := suite.deployERC20(big.NewInt(1000))
+	suite.Require().NoError(suite.registerTokenPair(contract, testDenom))
+
+	sender := sdk.AccAddress(suite.address.Bytes())
+	receiver := common.HexToAddress("0x378c50D9264C63F3F92B806d4ee56E9D86FfB3Ec")
+
+	// tokens of the registered contract, transferred to the module, are converted into coins
+	rsp := suite.transferERC20(contract, types.ModuleAddress, big.NewInt(100))
+	suite.Require().Empty(rsp.VmError)
+	suite.Require().Equal(sdkmath.NewInt(100), suite.app.BankKeeper.GetBalance(suite.ctx, sender, testDenom).Amount)
+	suite.Require().Equal(big.NewInt(100), suite.balanceOf(contract, types.ModuleAddress))
+	suite.Require().Equal(big.NewInt(900), suite.balanceOf(contract, suite.address))
+
+	// tokens of other contracts and other receivers are ignored
+	rsp = suite.transferERC20(unregistered, types.ModuleAddress, big.NewInt(100))
+	suite.Require().Empty(rsp.VmError)
+	rsp = suite.transferERC20(contract, receiver, big.NewInt(100))
+	suite.Require().Empty(rsp.VmError)
+	suite.Require().Equal(sdkmath.NewInt(100), suite.app.BankKeeper.GetBalance(suite.ctx, sender, testDenom).Amount)
+
+	// coins are converted back into escrowed tokens
+	msg := types.NewMsgConvertCoin(sdk.NewCoin(testDenom, sdkmath.NewInt(40)), receiver, sender)
+	_, err := suite.app.Erc20Keeper.ConvertCoin(sdk.WrapSDKContext(suite.ctx), msg)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdkmath.NewInt(60), suite.app.BankKeeper.GetBalance(suite.ctx, sender, testDenom).Amount)
+	suite.Require().Equal(sdkmath.NewInt(60), suite.app.BankKeeper.GetSupply(suite.ctx, testDenom).Amount)
+	suite.Require().Equal(big.NewInt(60), suite.balanceOf(contract, types.ModuleAddress))
+	suite.Require().Equal(big.NewInt(140), suite.balanceOf(contract, receiver))
+
+	// sender can't convert more coins than it has
+	msg = types.NewMsgConvertCoin(sdk.NewCoin(testDenom, sdkmath.NewInt(61)), receiver, sender)
+	_, err = suite.app.Erc20Keeper.ConvertCoin(sdk.WrapSDKContext(suite.ctx), msg)
+	suite.Require().Error(err)
+
+	// unregistered denomination can't be converted
+	msg = types.NewMsgConvertCoin(sdk.NewCoin("unknown", sdkmath.NewInt(1)), receiver, sender)
+	_, err = suite.app.Erc20Keeper.ConvertCoin(sdk.WrapSDKContext(suite.ctx), msg)
+	suite.Require().ErrorIs(err, types.ErrTokenPairNotFound)
+}
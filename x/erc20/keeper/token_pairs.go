@@ -0,0 +1,63 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"swisstronik/x/erc20/types"
+)
+
+// SetTokenPair stores the token pair and indexes it by coin denomination
+func (k Keeper) SetTokenPair(ctx sdk.Context, pair types.TokenPair) {
+	store := ctx.KVStore(k.storeKey)
+	contract := pair.GetERC20Contract()
+	store.Set(types.TokenPairKey(contract), k.cdc.MustMarshal(&pair))
+	store.Set(types.TokenPairByDenomKey(pair.Denom), contract.Bytes())
+}
+
+// GetTokenPair returns the token pair for provided ERC-20 contract address
+func (k Keeper) GetTokenPair(ctx sdk.Context, contract common.Address) (types.TokenPair, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.TokenPairKey(contract))
+	if len(bz) == 0 {
+		return types.TokenPair{}, false
+	}
+
+	var pair types.TokenPair
+	k.cdc.MustUnmarshal(bz, &pair)
+	return pair, true
+}
+
+// GetTokenPairByDenom returns the token pair for provided coin denomination
+func (k Keeper) GetTokenPairByDenom(ctx sdk.Context, denom string) (types.TokenPair, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.TokenPairByDenomKey(denom))
+	if len(bz) != common.AddressLength {
+		return types.TokenPair{}, false
+	}
+	return k.GetTokenPair(ctx, common.BytesToAddress(bz))
+}
+
+// IterateTokenPairs iterates over all registered token pairs until cb returns true
+func (k Keeper) IterateTokenPairs(ctx sdk.Context, cb func(pair types.TokenPair) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixTokenPair)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var pair types.TokenPair
+		k.cdc.MustUnmarshal(iterator.Value(), &pair)
+		if cb(pair) {
+			break
+		}
+	}
+}
+
+// GetTokenPairs returns all registered token pairs
+func (k Keeper) GetTokenPairs(ctx sdk.Context) []types.TokenPair {
+	var pairs []types.TokenPair
+	k.IterateTokenPairs(ctx, func(pair types.TokenPair) bool {
+		pairs = append(pairs, pair)
+		return false
+	})
+	return pairs
+}
@@ -0,0 +1,19 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+)
+
+const (
+	codeErrTokenPairNotFound = uint32(iota) + 2 // NOTE: code 1 is reserved for internal errors
+	codeErrTokenPairAlreadyExists
+	codeErrInvalidTokenPair
+	codeErrERC20Call
+)
+
+var (
+	ErrTokenPairNotFound      = errorsmod.Register(ModuleName, codeErrTokenPairNotFound, "token pair not found")
+	ErrTokenPairAlreadyExists = errorsmod.Register(ModuleName, codeErrTokenPairAlreadyExists, "token pair already exists")
+	ErrInvalidTokenPair       = errorsmod.Register(ModuleName, codeErrInvalidTokenPair, "invalid token pair")
+	ErrERC20Call              = errorsmod.Register(ModuleName, codeErrERC20Call, "ERC-20 contract call failed")
+)
@@ -0,0 +1,13 @@
+package types
+
+const (
+	EventTypeRegisterTokenPair = "register_token_pair"
+	EventTypeConvertCoin       = "convert_coin"
+	EventTypeConvertERC20      = "convert_erc20"
+
+	AttributeKeySender       = "sender"
+	AttributeKeyReceiver     = "receiver"
+	AttributeKeyAmount       = "amount"
+	AttributeKeyDenom        = "denom"
+	AttributeKeyERC20Address = "erc20_address"
+)
@@ -0,0 +1,33 @@
+package types
+
+import (
+	"fmt"
+)
+
+// DefaultGenesis returns the default genesis state
+func DefaultGenesis() *GenesisState {
+	return &GenesisState{}
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	contracts := make(map[string]bool, len(gs.TokenPairs))
+	denoms := make(map[string]bool, len(gs.TokenPairs))
+	for _, pair := range gs.TokenPairs {
+		if err := pair.Validate(); err != nil {
+			return err
+		}
+
+		contract := pair.GetERC20Contract().Hex()
+		if contracts[contract] {
+			return fmt.Errorf("duplicated token pair for ERC-20 contract %s", contract)
+		}
+		if denoms[pair.Denom] {
+			return fmt.Errorf("duplicated token pair for denomination %s", pair.Denom)
+		}
+		contracts[contract] = true
+		denoms[pair.Denom] = true
+	}
+	return nil
+}
@@ -0,0 +1,57 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenesisStateValidate(t *testing.T) {
+	contract := common.HexToAddress("0x378c50D9264C63F3F92B806d4ee56E9D86FfB3Ec")
+	other := common.HexToAddress("0x1000000000000000000000000000000000000001")
+
+	testCases := []struct {
+		name     string
+		genState GenesisState
+		expPass  bool
+	}{
+		{"default", *DefaultGenesis(), true},
+		{
+			"valid token pairs",
+			GenesisState{TokenPairs: []TokenPair{NewTokenPair(contract, "token"), NewTokenPair(other, "other")}},
+			true,
+		},
+		{
+			"invalid ERC-20 address",
+			GenesisState{TokenPairs: []TokenPair{{Erc20Address: "0x1234", Denom: "token"}}},
+			false,
+		},
+		{
+			"invalid denomination",
+			GenesisState{TokenPairs: []TokenPair{NewTokenPair(contract, "1token")}},
+			false,
+		},
+		{
+			"duplicated ERC-20 address",
+			GenesisState{TokenPairs: []TokenPair{NewTokenPair(contract, "token"), NewTokenPair(contract, "other")}},
+			false,
+		},
+		{
+			"duplicated denomination",
+			GenesisState{TokenPairs: []TokenPair{NewTokenPair(contract, "token"), NewTokenPair(other, "token")}},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.genState.Validate()
+			if tc.expPass {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,44 @@
+package types
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+const (
+	// ModuleName defines the module name
+	ModuleName = "erc20"
+
+	// StoreKey defines the primary module store key
+	StoreKey = ModuleName
+
+	// RouterKey defines the module's message routing key
+	RouterKey = ModuleName
+)
+
+// ModuleAddress is the EVM address of the module account. ERC-20 tokens transferred to this
+// address are escrowed by the module and converted into native coins
+var ModuleAddress = common.BytesToAddress(authtypes.NewModuleAddress(ModuleName).Bytes())
+
+const (
+	prefixTokenPair = iota + 1
+	prefixTokenPairByDenom
+)
+
+var (
+	// KeyPrefixTokenPair is a prefix for token pairs, indexed by ERC-20 contract address
+	KeyPrefixTokenPair = []byte{prefixTokenPair}
+	// KeyPrefixTokenPairByDenom is a prefix for ERC-20 contract addresses, indexed by coin denomination
+	KeyPrefixTokenPairByDenom = []byte{prefixTokenPairByDenom}
+)
+
+// TokenPairKey returns the key of the token pair with provided ERC-20 contract address
+func TokenPairKey(erc20 common.Address) []byte {
+	return append(KeyPrefixTokenPair, erc20.Bytes()...)
+}
+
+// TokenPairByDenomKey returns the key of the ERC-20 contract address, paired with provided denomination
+func TokenPairByDenomKey(denom string) []byte {
+	return append(KeyPrefixTokenPairByDenom, []byte(denom)...)
+}
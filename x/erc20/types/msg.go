@@ -0,0 +1,76 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+var (
+	_ sdk.Msg = &MsgRegisterTokenPair{}
+	_ sdk.Msg = &MsgConvertCoin{}
+)
+
+// NewMsgRegisterTokenPair creates a new instance of MsgRegisterTokenPair
+func NewMsgRegisterTokenPair(authority string, erc20 common.Address, denom string) *MsgRegisterTokenPair {
+	return &MsgRegisterTokenPair{
+		Authority:    authority,
+		Erc20Address: erc20.Hex(),
+		Denom:        denom,
+	}
+}
+
+// GetSigners returns the expected signers for a MsgRegisterTokenPair message.
+func (m *MsgRegisterTokenPair) GetSigners() []sdk.AccAddress {
+	addr := sdk.MustAccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgRegisterTokenPair) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	return TokenPair{Erc20Address: m.Erc20Address, Denom: m.Denom}.Validate()
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgRegisterTokenPair) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
+}
+
+// NewMsgConvertCoin creates a new instance of MsgConvertCoin
+func NewMsgConvertCoin(coin sdk.Coin, receiver common.Address, sender sdk.AccAddress) *MsgConvertCoin {
+	return &MsgConvertCoin{
+		Coin:     coin,
+		Receiver: receiver.Hex(),
+		Sender:   sender.String(),
+	}
+}
+
+// GetSigners returns the expected signers for a MsgConvertCoin message.
+func (m *MsgConvertCoin) GetSigners() []sdk.AccAddress {
+	addr := sdk.MustAccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgConvertCoin) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return errorsmod.Wrap(err, "invalid sender address")
+	}
+	if !common.IsHexAddress(m.Receiver) {
+		return errorsmod.Wrapf(errortypes.ErrInvalidAddress, "invalid receiver address %s", m.Receiver)
+	}
+	if !m.Coin.IsValid() || !m.Coin.IsPositive() {
+		return errorsmod.Wrapf(errortypes.ErrInvalidCoins, "invalid coin %s", m.Coin)
+	}
+	return nil
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgConvertCoin) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
+}
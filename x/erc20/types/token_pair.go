@@ -0,0 +1,31 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// NewTokenPair returns a token pair between provided ERC-20 contract and coin denomination
+func NewTokenPair(erc20 common.Address, denom string) TokenPair {
+	return TokenPair{
+		Erc20Address: erc20.Hex(),
+		Denom:        denom,
+	}
+}
+
+// GetERC20Contract returns the address of the ERC-20 contract
+func (tp TokenPair) GetERC20Contract() common.Address {
+	return common.HexToAddress(tp.Erc20Address)
+}
+
+// Validate performs a stateless validation of the token pair
+func (tp TokenPair) Validate() error {
+	if !common.IsHexAddress(tp.Erc20Address) {
+		return errorsmod.Wrapf(ErrInvalidTokenPair, "invalid ERC-20 address %s", tp.Erc20Address)
+	}
+	if err := sdk.ValidateDenom(tp.Denom); err != nil {
+		return errorsmod.Wrap(ErrInvalidTokenPair, err.Error())
+	}
+	return nil
+}
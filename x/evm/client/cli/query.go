@@ -16,14 +16,28 @@
 package cli
 
 import (
+	"crypto/rand"
+	"encoding/json"
+	"fmt"
+	"time"
+
 	"github.com/spf13/cobra"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
 
+	"swisstronik/crypto/deoxys"
+	"swisstronik/ethereum/eip712"
+	evmcommontypes "swisstronik/types"
 	"swisstronik/x/evm/types"
 )
 
+// FlagExpiry defines the flag for the duration, during which signed request is valid
+const FlagExpiry = "expiry"
+
 // GetQueryCmd returns the parent command for all x/bank CLi query commands.
 func GetQueryCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -36,8 +50,13 @@ func GetQueryCmd() *cobra.Command {
 
 	cmd.AddCommand(
 		GetStorageCmd(),
+		GetPrivateStorageCmd(),
 		GetCodeCmd(),
 		GetParamsCmd(),
+		GetEpochsCmd(),
+		GetEpochScheduleCmd(),
+		GetContractSourcesCmd(),
+		GetVerifiedContractCmd(),
 	)
 	return cmd
 }
@@ -82,6 +101,111 @@ func GetStorageCmd() *cobra.Command {
 	return cmd
 }
 
+// GetPrivateStorageCmd queries decrypted storage of the contract, signing the request with the key of
+// the contract deployer or of an account, authorized by the contract
+func GetPrivateStorageCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "private-storage ADDRESS KEY [KEY...]",
+		Short: "Gets decrypted storage of the contract with given keys and height",
+		Long: `Gets decrypted storage of the contract with given keys and height. Request is signed by the key, provided
+with --from flag, which should belong to the contract deployer or to an account, authorized by the contract.
+Values are encrypted by the node to the one-time key, generated for this request, and decrypted locally.
+If the height is not provided, it will use the latest height from context.`,
+		Args: cobra.MinimumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			address, err := accountToHex(args[0])
+			if err != nil {
+				return err
+			}
+
+			keys := make([]string, 0, len(args)-1)
+			for _, key := range args[1:] {
+				keys = append(keys, formatKeyToHash(key))
+			}
+
+			chainID, err := evmcommontypes.ParseChainID(clientCtx.ChainID)
+			if err != nil {
+				return err
+			}
+
+			expiryDuration, err := cmd.Flags().GetDuration(FlagExpiry)
+			if err != nil {
+				return err
+			}
+			expiry := uint64(time.Now().Add(expiryDuration).Unix())
+
+			// Generate one-time key, to which returned values are encrypted
+			var privateKey [32]byte
+			if _, err := rand.Read(privateKey[:]); err != nil {
+				return err
+			}
+			publicKey := deoxys.GetCurve25519PublicKey(privateKey)
+
+			typedData := eip712.WrapStorageAccessToTypedData(chainID.Uint64(), common.HexToAddress(address), publicKey[:], expiry)
+			sigHash, _, err := apitypes.TypedDataAndHash(typedData)
+			if err != nil {
+				return err
+			}
+			signature, _, err := clientCtx.Keyring.SignByAddress(clientCtx.GetFromAddress(), sigHash)
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryPrivateStorageRequest{
+				Address:   address,
+				Keys:      keys,
+				PublicKey: publicKey[:],
+				Expiry:    expiry,
+				Signature: signature,
+			}
+
+			res, err := queryClient.PrivateStorage(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			nodePublicKey, err := hexutil.Decode(res.NodePublicKey)
+			if err != nil {
+				return err
+			}
+			if len(res.Values) != len(keys) {
+				return fmt.Errorf("expected %d values, got %d", len(keys), len(res.Values))
+			}
+
+			storage := make(types.Storage, 0, len(keys))
+			for i, encryptedValue := range res.Values {
+				value, err := deoxys.DecryptECDH(privateKey[:], nodePublicKey, encryptedValue)
+				if err != nil {
+					return fmt.Errorf("cannot decrypt value of %s: %w", keys[i], err)
+				}
+				storage = append(storage, types.NewState(common.HexToHash(keys[i]), value))
+			}
+
+			bz, err := json.Marshal(storage)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintRaw(bz)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	cmd.Flags().String(flags.FlagFrom, "", "Name or address of private key, with which to sign the request")
+	cmd.Flags().String(flags.FlagChainID, "", "The network chain ID")
+	cmd.Flags().String(flags.FlagKeyringBackend, flags.DefaultKeyringBackend, "Select keyring's backend (os|file|kwallet|pass|test|memory)")
+	cmd.Flags().String(flags.FlagKeyringDir, "", "The client Keyring directory; if omitted, the default 'home' directory will be used")
+	cmd.Flags().Duration(FlagExpiry, time.Minute, "Duration, during which signed request is valid")
+	_ = cmd.MarkFlagRequired(flags.FlagFrom)
+	return cmd
+}
+
 // GetCodeCmd queries the code field of a given address
 func GetCodeCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -119,6 +243,71 @@ func GetCodeCmd() *cobra.Command {
 	return cmd
 }
 
+// GetContractSourcesCmd queries sources, submitted for verification of the contract
+func GetContractSourcesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "contract-sources ADDRESS",
+		Short: "Gets sources, submitted for verification of the contract",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			address, err := accountToHex(args[0])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.ContractSources(cmd.Context(), &types.QueryContractSourcesRequest{Address: address})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetVerifiedContractCmd queries ABI and source hash of the verified contract
+func GetVerifiedContractCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "verified-contract ADDRESS",
+		Short: "Gets ABI and source hash of the verified contract",
+		Long:  "Gets ABI and source hash of the contract, if submitted sources match its bytecode. Queried node must have configured solc binary.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			address, err := accountToHex(args[0])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.VerifiedContract(cmd.Context(), &types.QueryVerifiedContractRequest{Address: address})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
 // GetParamsCmd queries the fee market params
 func GetParamsCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -146,3 +335,59 @@ func GetParamsCmd() *cobra.Command {
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+// GetEpochsCmd queries epochs, known by the node enclave
+func GetEpochsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "epochs",
+		Short: "Get the list of epochs",
+		Long:  "Get epoch numbers, starting blocks and node public keys of all epochs, known by the node enclave.",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Epochs(cmd.Context(), &types.QueryEpochsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetEpochScheduleCmd queries epochs, scheduled by governance
+func GetEpochScheduleCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "epoch-schedule",
+		Short: "Get the list of scheduled epochs",
+		Long:  "Get starting blocks of epochs, scheduled by governance, which are not yet added to the enclave.",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.EpochSchedule(cmd.Context(), &types.QueryEpochScheduleRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
@@ -19,10 +19,13 @@ import (
 	"bufio"
 	"fmt"
 	"os"
+	"path/filepath"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/input"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/version"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
@@ -31,6 +34,13 @@ import (
 	"swisstronik/x/evm/types"
 )
 
+// Flags of submit-contract-source command
+const (
+	FlagCompilerVersion = "compiler-version"
+	FlagSettings        = "settings"
+	FlagMetadata        = "metadata"
+)
+
 // GetTxCmd returns the transaction commands for this module
 func GetTxCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -40,7 +50,10 @@ func GetTxCmd() *cobra.Command {
 		SuggestionsMinimumDistance: 2,
 		RunE:                       client.ValidateCmd,
 	}
-	cmd.AddCommand(NewRawTxCmd())
+	cmd.AddCommand(
+		NewRawTxCmd(),
+		NewSubmitContractSourceCmd(),
+	)
 	return cmd
 }
 
@@ -124,3 +137,73 @@ func NewRawTxCmd() *cobra.Command {
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
+
+// NewSubmitContractSourceCmd command submits sources of the deployed contract for verification
+func NewSubmitContractSourceCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "submit-contract-source ADDRESS CONTRACT_NAME SOURCE_FILE...",
+		Short: "Submit Solidity sources of the deployed contract for verification",
+		Long: `Submit Solidity sources of the deployed contract for verification. Contract name must be fully
+qualified, such as "contracts/Token.sol:Token", and source files are submitted with paths as provided.`,
+		Example: fmt.Sprintf(
+			"%s tx %s submit-contract-source 0x... contracts/Token.sol:Token contracts/Token.sol --compiler-version 0.8.20 --settings settings.json",
+			version.AppName, types.ModuleName,
+		),
+		Args: cobra.MinimumNArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			sources := make([]types.ContractSourceFile, 0, len(args)-2)
+			for _, path := range args[2:] {
+				content, err := os.ReadFile(filepath.Clean(path))
+				if err != nil {
+					return errors.Wrapf(err, "failed to read source file %s", path)
+				}
+				sources = append(sources, types.ContractSourceFile{Path: path, Content: string(content)})
+			}
+
+			compilerVersion, err := cmd.Flags().GetString(FlagCompilerVersion)
+			if err != nil {
+				return err
+			}
+
+			var settings []byte
+			settingsPath, err := cmd.Flags().GetString(FlagSettings)
+			if err != nil {
+				return err
+			}
+			if settingsPath != "" {
+				if settings, err = os.ReadFile(filepath.Clean(settingsPath)); err != nil {
+					return errors.Wrap(err, "failed to read compiler settings")
+				}
+			}
+
+			metadata, err := cmd.Flags().GetString(FlagMetadata)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgSubmitContractSource{
+				Submitter:       clientCtx.GetFromAddress().String(),
+				Address:         args[0],
+				CompilerVersion: compilerVersion,
+				ContractName:    args[1],
+				Sources:         sources,
+				Settings:        string(settings),
+				Metadata:        metadata,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagCompilerVersion, "", "solc version, used to compile the contract, such as 0.8.20")
+	cmd.Flags().String(FlagSettings, "", "path to JSON file with solc settings, such as optimizer and evmVersion")
+	cmd.Flags().String(FlagMetadata, "", "arbitrary metadata of the contract, such as license or project URL")
+	_ = cmd.MarkFlagRequired(FlagCompilerVersion)
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
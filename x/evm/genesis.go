@@ -83,6 +83,10 @@ func InitGenesis(
 		}
 	}
 
+	for _, startingBlock := range data.ScheduledEpochs {
+		k.SetScheduledEpoch(ctx, startingBlock)
+	}
+
 	return []abci.ValidatorUpdate{}
 }
 
@@ -111,7 +115,8 @@ func ExportGenesis(ctx sdk.Context, k *keeper.Keeper, ak types.AccountKeeper) *t
 	})
 
 	return &types.GenesisState{
-		Accounts: ethGenAccounts,
-		Params:   k.GetParams(ctx),
+		Accounts:        ethGenAccounts,
+		Params:          k.GetParams(ctx),
+		ScheduledEpochs: k.GetScheduledEpochs(ctx),
 	}
 }
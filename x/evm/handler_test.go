@@ -536,7 +536,7 @@ func (suite *EvmTestSuite) deployERC20Contract() common.Address {
 	erc20DeployTx.From = suite.from.Hex()
 	err = erc20DeployTx.Sign(ethtypes.LatestSignerForChainID(chainID), suite.signer)
 	suite.Require().NoError(err)
-	rsp, err := suite.app.EvmKeeper.ApplySGXVMTransaction(suite.ctx, erc20DeployTx.AsTransaction(), true)
+	rsp, err := suite.app.EvmKeeper.ApplySGXVMTransaction(suite.ctx, erc20DeployTx.AsTransaction(), common.Address{}, true)
 	suite.Require().NoError(err)
 	suite.Require().False(rsp.Failed())
 	return crypto.CreateAddress(suite.from, nonce)
@@ -583,7 +583,7 @@ func (suite *EvmTestSuite) TestERC20TransferReverted() {
 			k.SetBalance(suite.ctx, suite.from, big.NewInt(1000000000000000))
 			contract := suite.deployERC20Contract()
 
-			k.SetHooks(tc.hooks)
+			k.CleanHooks().SetHooks(tc.hooks)
 
 			data, err := types.ERC20Contract.ABI.Pack("transfer", suite.from, big.NewInt(10))
 			suite.Require().NoError(err)
@@ -679,7 +679,7 @@ func (suite *EvmTestSuite) TestContractDeploymentRevert() {
 			k := suite.app.EvmKeeper
 
 			// test with different hooks scenarios
-			k.SetHooks(tc.hooks)
+			k.CleanHooks().SetHooks(tc.hooks)
 
 			nonce := k.GetNonce(suite.ctx, suite.from)
 			ctorArgs, err := types.ERC20Contract.ABI.Pack("", suite.from, big.NewInt(0))
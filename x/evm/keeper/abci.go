@@ -21,20 +21,27 @@ import (
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 )
 
-// BeginBlock sets the sdk Context and EIP155 chain id to the Keeper.
+// BeginBlock sets the sdk Context and EIP155 chain id to the Keeper, stores current block hash,
+// adds epoch scheduled for current block to the enclave and reloads epochs from the enclave.
 func (k *Keeper) BeginBlock(ctx sdk.Context, req abci.RequestBeginBlock) {
 	k.WithChainID(ctx)
+	k.storeBlockHash(ctx)
+	k.applyScheduledEpoch(ctx)
+	k.refreshEpochs(ctx)
 }
 
-// EndBlock also retrieves the bloom filter value from the transient store and commits it to the
-// KVStore. The EVM end block logic doesn't update the validator set, thus it returns
-// an empty slice.
+// EndBlock also retrieves the bloom filter value and the intermediate state root after the last
+// EVM transaction from the transient store and emits them as events. Contract sources, submitted in
+// the block, are passed to the node-local verifier. The EVM end block logic doesn't update the
+// validator set, thus it returns an empty slice.
 func (k *Keeper) EndBlock(ctx sdk.Context, req abci.RequestEndBlock) []abci.ValidatorUpdate {
 	// Gas costs are handled within msg handler so costs should be ignored
 	infCtx := ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
 
 	bloom := ethtypes.BytesToBloom(k.GetBlockBloomTransient(infCtx).Bytes())
 	k.EmitBlockBloomEvent(infCtx, bloom)
+	k.EmitBlockStateRootEvent(infCtx, k.GetStateRootTransient(infCtx))
+	k.EnqueueSubmittedContractSources(infCtx)
 
 	return []abci.ValidatorUpdate{}
 }
@@ -1,10 +1,49 @@
 package keeper_test
 
 import (
+	"github.com/SigmaGmbH/librustgo"
 	"github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/crypto/tmhash"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
 	evmtypes "swisstronik/x/evm/types"
 )
 
+func (suite *KeeperTestSuite) TestBeginBlockReloadsEpochs() {
+	const newEpochStartingBlock = 1_000_000
+
+	epochsBefore := suite.app.EvmKeeper.GetEpochs()
+	keyBefore, err := suite.app.EvmKeeper.GetNodePublicKey(newEpochStartingBlock)
+	suite.Require().NoError(err)
+
+	suite.Require().NoError(librustgo.AddEpoch(newEpochStartingBlock))
+	defer func() {
+		suite.Require().NoError(librustgo.RemoveLatestEpoch())
+		suite.Require().NoError(suite.app.EvmKeeper.RefreshEpochs())
+	}()
+
+	// Keeper serves cached epochs until next block
+	suite.Require().Len(suite.app.EvmKeeper.GetEpochs(), len(epochsBefore))
+
+	suite.app.EvmKeeper.BeginBlock(suite.ctx, types.RequestBeginBlock{})
+
+	epochs := suite.app.EvmKeeper.GetEpochs()
+	suite.Require().Len(epochs, len(epochsBefore)+1)
+	latestEpoch := epochs[len(epochs)-1]
+	suite.Require().Equal(uint64(newEpochStartingBlock), latestEpoch.StartingBlock)
+
+	keyAfter, err := suite.app.EvmKeeper.GetNodePublicKey(newEpochStartingBlock)
+	suite.Require().NoError(err)
+	suite.Require().NotEqual(keyBefore, keyAfter)
+	suite.Require().Equal(common.BytesToHash(latestEpoch.NodePublicKey), keyAfter)
+
+	// Node public key for previous blocks is not changed
+	key, err := suite.app.EvmKeeper.GetNodePublicKey(newEpochStartingBlock - 1)
+	suite.Require().NoError(err)
+	suite.Require().Equal(keyBefore, key)
+}
+
 func (suite *KeeperTestSuite) TestEndBlock() {
 	em := suite.ctx.EventManager()
 	suite.Require().Equal(0, len(em.Events()))
@@ -12,7 +51,77 @@ func (suite *KeeperTestSuite) TestEndBlock() {
 	res := suite.app.EvmKeeper.EndBlock(suite.ctx, types.RequestEndBlock{})
 	suite.Require().Equal([]types.ValidatorUpdate{}, res)
 
-	// should emit 1 EventTypeBlockBloom event on EndBlock
-	suite.Require().Equal(1, len(em.Events()))
+	// should emit EventTypeBlockBloom and EventTypeBlockStateRoot events on EndBlock
+	suite.Require().Equal(2, len(em.Events()))
 	suite.Require().Equal(evmtypes.EventTypeBlockBloom, em.Events()[0].Type)
+	suite.Require().Equal(evmtypes.EventTypeBlockStateRoot, em.Events()[1].Type)
+}
+
+func (suite *KeeperTestSuite) TestBeginBlockAddsScheduledEpoch() {
+	startingBlock := uint64(suite.ctx.BlockHeight()) + 1
+	epochsBefore := suite.app.EvmKeeper.GetEpochs()
+
+	suite.app.EvmKeeper.SetScheduledEpoch(suite.ctx, startingBlock)
+	defer func() {
+		suite.Require().NoError(librustgo.RemoveLatestEpoch())
+		suite.Require().NoError(suite.app.EvmKeeper.RefreshEpochs())
+	}()
+
+	// Epoch is not added before starting block
+	suite.app.EvmKeeper.BeginBlock(suite.ctx, types.RequestBeginBlock{})
+	suite.Require().Len(suite.app.EvmKeeper.GetEpochs(), len(epochsBefore))
+
+	ctx := suite.ctx.WithBlockHeight(int64(startingBlock))
+	suite.app.EvmKeeper.BeginBlock(ctx, types.RequestBeginBlock{})
+
+	epochs := suite.app.EvmKeeper.GetEpochs()
+	suite.Require().Len(epochs, len(epochsBefore)+1)
+	suite.Require().Equal(startingBlock, epochs[len(epochs)-1].StartingBlock)
+	suite.Require().Empty(suite.app.EvmKeeper.GetScheduledEpochs(ctx))
+
+	// Replayed block doesn't add epoch twice
+	suite.app.EvmKeeper.SetScheduledEpoch(ctx, startingBlock)
+	suite.app.EvmKeeper.BeginBlock(ctx, types.RequestBeginBlock{})
+	suite.Require().Len(suite.app.EvmKeeper.GetEpochs(), len(epochsBefore)+1)
+	suite.Require().Empty(suite.app.EvmKeeper.GetScheduledEpochs(ctx))
+}
+
+func (suite *KeeperTestSuite) TestBeginBlockDropsEpoch() {
+	startingBlock := uint64(suite.ctx.BlockHeight()) + 1
+	suite.Require().NoError(librustgo.AddEpoch(startingBlock + 1))
+	defer func() {
+		suite.Require().NoError(librustgo.RemoveLatestEpoch())
+		suite.Require().NoError(suite.app.EvmKeeper.RefreshEpochs())
+	}()
+	epochsBefore, err := librustgo.ListEpochs()
+	suite.Require().NoError(err)
+
+	// epoch cannot be added before existing one, so scheduled epoch is dropped without halting the chain
+	suite.app.EvmKeeper.SetScheduledEpoch(suite.ctx, startingBlock)
+	ctx := suite.ctx.WithBlockHeight(int64(startingBlock)).WithEventManager(sdk.NewEventManager())
+	suite.Require().NotPanics(func() {
+		suite.app.EvmKeeper.BeginBlock(ctx, types.RequestBeginBlock{})
+	})
+	suite.Require().Empty(suite.app.EvmKeeper.GetScheduledEpochs(ctx))
+	suite.Require().Len(suite.app.EvmKeeper.GetEpochs(), len(epochsBefore))
+
+	events := ctx.EventManager().Events()
+	suite.Require().Len(events, 1)
+	suite.Require().Equal(evmtypes.EventTypeDropEpoch, events[0].Type)
+}
+
+func (suite *KeeperTestSuite) TestBeginBlockStoresBlockHash() {
+	hash := tmhash.Sum([]byte("header"))
+	height := suite.ctx.BlockHeight()
+	ctx := suite.ctx.WithHeaderHash(hash)
+
+	suite.app.EvmKeeper.BeginBlock(ctx, types.RequestBeginBlock{})
+
+	storedHash, found := suite.app.EvmKeeper.GetBlockHash(ctx, uint64(height))
+	suite.Require().True(found)
+	suite.Require().Equal(common.BytesToHash(hash), storedHash)
+
+	// Hash is available for the next blocks
+	nextCtx := ctx.WithBlockHeight(height + 1)
+	suite.Require().Equal(common.BytesToHash(hash), suite.app.EvmKeeper.GetHashFn(nextCtx)(uint64(height)))
 }
@@ -0,0 +1,70 @@
+package keeper_test
+
+import (
+	"encoding/json"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	"swisstronik/server/config"
+	"swisstronik/tests"
+	"swisstronik/x/evm/types"
+)
+
+func (suite *KeeperTestSuite) TestCreateAccessList() {
+	caller := tests.RandomEthAddress()
+	callee := tests.RandomEthAddress()
+
+	// callee returns value of storage slot 1:
+	// PUSH1 1 SLOAD PUSH1 0 MSTORE PUSH1 0x20 PUSH1 0 RETURN
+	calleeCode := hexutil.Bytes(hexutil.MustDecode("0x60015460005260206000f3"))
+	// caller makes static call to callee:
+	// PUSH1 0 PUSH1 0 PUSH1 0 PUSH1 0 PUSH20 <callee> GAS STATICCALL STOP
+	callerCode := hexutil.Bytes(append(append(hexutil.MustDecode("0x600060006000600073"), callee.Bytes()...), 0x5a, 0xfa, 0x00))
+
+	overrides, err := json.Marshal(types.StateOverride{
+		caller: {Code: &callerCode},
+		callee: {Code: &calleeCode},
+	})
+	suite.Require().NoError(err)
+
+	args, err := json.Marshal(&types.CallArgs{To: &caller})
+	suite.Require().NoError(err)
+
+	res, err := suite.queryClient.CreateAccessList(sdk.WrapSDKContext(suite.ctx), &types.EthCallRequest{
+		Args:        args,
+		GasCap:      uint64(config.DefaultGasCap),
+		Unencrypted: true,
+		Overrides:   overrides,
+	})
+	suite.Require().NoError(err)
+	suite.Require().Empty(res.VmError)
+
+	// Sender and recipient are not included
+	expAccessList := ethtypes.AccessList{{Address: callee, StorageKeys: []common.Hash{common.BigToHash(common.Big1)}}}
+	suite.Require().Equal(expAccessList, *res.AccessList.ToEthAccessList())
+
+	// Access list saves 100 gas for each warmed address and storage slot
+	callRes, err := suite.queryClient.EthCall(sdk.WrapSDKContext(suite.ctx), &types.EthCallRequest{
+		Args:        args,
+		GasCap:      uint64(config.DefaultGasCap),
+		Unencrypted: true,
+		Overrides:   overrides,
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(callRes.GasUsed-200, res.GasUsed)
+
+	// Access list of the request is used during the first execution
+	argsWithAccessList, err := json.Marshal(&types.CallArgs{To: &caller, AccessList: &expAccessList})
+	suite.Require().NoError(err)
+	resWithAccessList, err := suite.queryClient.CreateAccessList(sdk.WrapSDKContext(suite.ctx), &types.EthCallRequest{
+		Args:        argsWithAccessList,
+		GasCap:      uint64(config.DefaultGasCap),
+		Unencrypted: true,
+		Overrides:   overrides,
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(res, resWithAccessList)
+}
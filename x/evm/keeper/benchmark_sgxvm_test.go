@@ -5,14 +5,18 @@ import (
 	"testing"
 
 	sdkmath "cosmossdk.io/math"
+	"github.com/SigmaGmbH/librustgo"
+	"github.com/golang/protobuf/proto"
 	"github.com/stretchr/testify/require"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authante "github.com/cosmos/cosmos-sdk/x/auth/ante"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
 
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	evmcommontypes "swisstronik/types"
+	evmkeeper "swisstronik/x/evm/keeper"
 	"swisstronik/x/evm/types"
 )
 
@@ -88,6 +92,28 @@ func BenchmarkTokenTransferSGXVM(b *testing.B) {
 	})
 }
 
+// erc20BalanceSlot returns storage slot of the balance of the holder in test ERC20 contract,
+// which keeps balances in the mapping at slot 0
+func erc20BalanceSlot(holder common.Address) common.Hash {
+	return crypto.Keccak256Hash(common.LeftPadBytes(holder.Bytes(), 32), common.Hash{}.Bytes())
+}
+
+// BenchmarkTokenTransferWithAccessListSGXVM executes the same transfer as BenchmarkTokenTransferSGXVM, but
+// with access list, so contract code and balances are prefetched by SGXVM in a single batch
+func BenchmarkTokenTransferWithAccessListSGXVM(b *testing.B) {
+	DoBenchmarkSGXVM(b, func(suite *KeeperTestSuite, contract common.Address) *types.MsgHandleTx {
+		recipient := common.HexToAddress("0x378c50D9264C63F3F92B806d4ee56E9D86FfB3Ec")
+		input, err := types.ERC20Contract.ABI.Pack("transfer", recipient, big.NewInt(1000))
+		require.NoError(b, err)
+		accessList := ethtypes.AccessList{{
+			Address:     contract,
+			StorageKeys: []common.Hash{erc20BalanceSlot(suite.address), erc20BalanceSlot(recipient)},
+		}}
+		nonce := suite.app.EvmKeeper.GetNonce(suite.ctx, suite.address)
+		return types.NewSGXVMTx(suite.app.EvmKeeper.ChainID(), nonce, &contract, big.NewInt(0), 410000, big.NewInt(1), nil, nil, input, &accessList, suite.privateKey, suite.nodePublicKey)
+	})
+}
+
 func BenchmarkEmitLogsSGXVM(b *testing.B) {
 	DoBenchmarkSGXVM(b, func(suite *KeeperTestSuite, contract common.Address) *types.MsgHandleTx {
 		input, err := types.ERC20Contract.ABI.Pack("benchmarkLogs", big.NewInt(1000))
@@ -145,3 +171,86 @@ func BenchmarkMessageCallSGXVM(b *testing.B) {
 		require.False(b, rsp.Failed())
 	}
 }
+
+// connectorBenchmarkCells is amount of storage cells, accessed by single transaction in connector benchmarks
+const connectorBenchmarkCells = 1000
+
+func encodeStorageRequests(b *testing.B, contract common.Address, write bool) [][]byte {
+	requests := make([][]byte, connectorBenchmarkCells)
+	for i := range requests {
+		index := common.BigToHash(big.NewInt(int64(i)))
+
+		var request *librustgo.CosmosRequest
+		if write {
+			request = &librustgo.CosmosRequest{
+				Req: &librustgo.CosmosRequest_InsertStorageCell{
+					InsertStorageCell: &librustgo.QueryInsertStorageCell{
+						Address: contract.Bytes(),
+						Index:   index.Bytes(),
+						Value:   index.Bytes(),
+					},
+				},
+			}
+		} else {
+			request = &librustgo.CosmosRequest{
+				Req: &librustgo.CosmosRequest_StorageCell{
+					StorageCell: &librustgo.QueryGetAccountStorageCell{
+						Address: contract.Bytes(),
+						Index:   index.Bytes(),
+					},
+				},
+			}
+		}
+
+		encoded, err := proto.Marshal(request)
+		require.NoError(b, err)
+		requests[i] = encoded
+	}
+	return requests
+}
+
+func DoBenchmarkConnector(b *testing.B, write, batched bool) {
+	suite, contractAddr := SetupContractSGXVM(b)
+	requests := encodeStorageRequests(b, contractAddr, write)
+
+	batch, err := proto.Marshal(&librustgo.CosmosRequest{
+		Req: &librustgo.CosmosRequest_Batch{
+			Batch: &librustgo.QueryBatch{Requests: requests},
+		},
+	})
+	require.NoError(b, err)
+
+	b.ResetTimer()
+	b.StartTimer()
+	for i := 0; i < b.N; i++ {
+		ctx, _ := suite.ctx.CacheContext()
+		connector := evmkeeper.Connector{Context: ctx, EVMKeeper: suite.app.EvmKeeper}
+
+		if batched {
+			_, err := connector.Query(batch)
+			require.NoError(b, err)
+			continue
+		}
+
+		for _, request := range requests {
+			_, err := connector.Query(request)
+			require.NoError(b, err)
+		}
+	}
+}
+
+func BenchmarkConnectorStorageReads(b *testing.B) {
+	DoBenchmarkConnector(b, false, false)
+}
+
+func BenchmarkConnectorStorageReadsBatched(b *testing.B) {
+	DoBenchmarkConnector(b, false, true)
+}
+
+func BenchmarkConnectorStorageWrites(b *testing.B) {
+	DoBenchmarkConnector(b, true, false)
+}
+
+func BenchmarkConnectorStorageWritesBatched(b *testing.B) {
+	DoBenchmarkConnector(b, true, true)
+}
@@ -0,0 +1,38 @@
+package keeper
+
+import (
+	"encoding/binary"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"swisstronik/x/evm/types"
+)
+
+// SetBlockHash writes hash of block with provided height to the ring buffer of latest block hashes.
+// Each slot keeps height together with hash, so overwritten slots are not returned for older heights
+func (k Keeper) SetBlockHash(ctx sdk.Context, height uint64, hash common.Hash) {
+	value := binary.BigEndian.AppendUint64(make([]byte, 0, 8+common.HashLength), height)
+	value = append(value, hash.Bytes()...)
+	ctx.KVStore(k.storeKey).Set(types.BlockHashKey(height), value)
+}
+
+// GetBlockHash returns hash of block with provided height from the ring buffer of latest block hashes.
+// Returns false if there is no such block in the buffer
+func (k Keeper) GetBlockHash(ctx sdk.Context, height uint64) (common.Hash, bool) {
+	value := ctx.KVStore(k.storeKey).Get(types.BlockHashKey(height))
+	if len(value) != 8+common.HashLength || binary.BigEndian.Uint64(value[:8]) != height {
+		return common.Hash{}, false
+	}
+	return common.BytesToHash(value[8:]), true
+}
+
+// storeBlockHash records hash of current block. It is called in BeginBlock, so hashes of all blocks
+// in BLOCKHASH window are available for transactions, `eth_call` and traces
+func (k Keeper) storeBlockHash(ctx sdk.Context) {
+	hash := k.currentBlockHash(ctx)
+	if hash == (common.Hash{}) {
+		return
+	}
+	k.SetBlockHash(ctx, uint64(ctx.BlockHeight()), hash)
+}
@@ -0,0 +1,57 @@
+package keeper
+
+import (
+	"math/big"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	"swisstronik/x/evm/types"
+)
+
+// CallEVMWithData executes unencrypted call of the contract on behalf of the provided account, for example,
+// a module account. Call is not paid and gas, used by the EVM, is consumed from the context gas meter.
+// State changes are persisted only if commit is set and the call succeeded
+func (k *Keeper) CallEVMWithData(
+	ctx sdk.Context,
+	from, contract common.Address,
+	data []byte,
+	gasLimit uint64,
+	commit bool,
+) (*types.MsgEthereumTxResponse, error) {
+	cfg, err := k.EVMConfig(ctx, ctx.BlockHeader().ProposerAddress, k.eip155ChainID)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "failed to load evm config")
+	}
+
+	msg := ethtypes.NewMessage(
+		from,
+		&contract,
+		k.GetNonce(ctx, from),
+		new(big.Int),
+		gasLimit,
+		new(big.Int),
+		new(big.Int),
+		new(big.Int),
+		data,
+		nil,
+		// sender is authenticated by the calling module
+		false,
+	)
+
+	txContext, err := CreateSGXVMContextFromMessage(ctx, k, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	txConfig := types.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash()))
+	res, err := k.ApplyMessageWithConfig(ctx, msg, commit, cfg, txConfig, txContext, true)
+	if err != nil {
+		return nil, err
+	}
+	ctx.GasMeter().ConsumeGas(res.GasUsed, "evm call")
+
+	return res, nil
+}
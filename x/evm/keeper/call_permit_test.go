@@ -0,0 +1,346 @@
+package keeper_test
+
+import (
+	"encoding/json"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+
+	"swisstronik/ethereum/eip712"
+	"swisstronik/server/config"
+	"swisstronik/tests"
+	"swisstronik/x/evm/types"
+)
+
+// callerTestCode returns address of the caller:
+// CALLER PUSH1 0 MSTORE PUSH1 0x20 PUSH1 0 RETURN
+var callerTestCode = hexutil.MustDecode("0x3360005260206000f3")
+
+// signPermit signs call permit of the holder for the contract with the suite private key
+func (suite *KeeperTestSuite) signPermit(holder, contract common.Address, expiry uint64) hexutil.Bytes {
+	key, err := crypto.ToECDSA(suite.privateKey)
+	suite.Require().NoError(err)
+	typedData := eip712.WrapCallPermitToTypedData(suite.app.EvmKeeper.ChainID().Uint64(), holder, contract, expiry)
+	sigHash, _, err := apitypes.TypedDataAndHash(typedData)
+	suite.Require().NoError(err)
+	signature, err := crypto.Sign(sigHash, key)
+	suite.Require().NoError(err)
+	return signature
+}
+
+func (suite *KeeperTestSuite) TestEthCallWithPermit() {
+	contract := tests.RandomEthAddress()
+	code := hexutil.Bytes(callerTestCode)
+
+	testCases := []struct {
+		name     string
+		malleate func(expiry uint64) *types.CallArgs
+		expPass  bool
+		expRet   common.Address
+	}{
+		{
+			"no permit - sender is not exposed",
+			func(uint64) *types.CallArgs {
+				return &types.CallArgs{From: &suite.address, To: &contract}
+			},
+			true,
+			common.Address{},
+		},
+		{
+			"valid permit",
+			func(expiry uint64) *types.CallArgs {
+				return &types.CallArgs{
+					From:   &suite.address,
+					To:     &contract,
+					Permit: &types.CallPermit{Expiry: hexutil.Uint64(expiry), Signature: suite.signPermit(suite.address, contract, expiry)},
+				}
+			},
+			true,
+			suite.address,
+		},
+		{
+			"expired permit",
+			func(uint64) *types.CallArgs {
+				expiry := uint64(suite.ctx.BlockTime().Unix())
+				return &types.CallArgs{
+					From:   &suite.address,
+					To:     &contract,
+					Permit: &types.CallPermit{Expiry: hexutil.Uint64(expiry), Signature: suite.signPermit(suite.address, contract, expiry)},
+				}
+			},
+			false,
+			common.Address{},
+		},
+		{
+			"permit for another contract",
+			func(expiry uint64) *types.CallArgs {
+				return &types.CallArgs{
+					From:   &suite.address,
+					To:     &contract,
+					Permit: &types.CallPermit{Expiry: hexutil.Uint64(expiry), Signature: suite.signPermit(suite.address, tests.RandomEthAddress(), expiry)},
+				}
+			},
+			false,
+			common.Address{},
+		},
+		{
+			"permit of another sender",
+			func(expiry uint64) *types.CallArgs {
+				from := tests.RandomEthAddress()
+				return &types.CallArgs{
+					From:   &from,
+					To:     &contract,
+					Permit: &types.CallPermit{Expiry: hexutil.Uint64(expiry), Signature: suite.signPermit(from, contract, expiry)},
+				}
+			},
+			false,
+			common.Address{},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			expiry := uint64(suite.ctx.BlockTime().Unix()) + 3600
+			args, err := json.Marshal(tc.malleate(expiry))
+			suite.Require().NoError(err)
+			overrides, err := json.Marshal(types.StateOverride{contract: {Code: &code}})
+			suite.Require().NoError(err)
+
+			res, err := suite.queryClient.EthCall(sdk.WrapSDKContext(suite.ctx), &types.EthCallRequest{
+				Args:        args,
+				GasCap:      uint64(config.DefaultGasCap),
+				Unencrypted: true,
+				Overrides:   overrides,
+			})
+			if !tc.expPass {
+				suite.Require().Error(err)
+				return
+			}
+			suite.Require().NoError(err)
+			suite.Require().Empty(res.VmError)
+			suite.Require().Equal(common.BytesToHash(tc.expRet.Bytes()).Bytes(), res.Ret)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestEstimateGasWithPermit() {
+	contract := tests.RandomEthAddress()
+	// reverts unless the caller is the suite address:
+	// CALLER PUSH20 <address> EQ PUSH1 0x1e JUMPI PUSH1 0 DUP1 REVERT JUMPDEST STOP
+	code := hexutil.Bytes(append(append([]byte{0x33, 0x73}, suite.address.Bytes()...), 0x14, 0x60, 0x1e, 0x57, 0x60, 0x00, 0x80, 0xfd, 0x5b, 0x00))
+
+	testCases := []struct {
+		name     string
+		malleate func(expiry uint64) *types.CallArgs
+		expPass  bool
+	}{
+		{
+			"no permit - sender is not exposed",
+			func(uint64) *types.CallArgs {
+				return &types.CallArgs{From: &suite.address, To: &contract}
+			},
+			false,
+		},
+		{
+			"valid permit",
+			func(expiry uint64) *types.CallArgs {
+				return &types.CallArgs{
+					From:   &suite.address,
+					To:     &contract,
+					Permit: &types.CallPermit{Expiry: hexutil.Uint64(expiry), Signature: suite.signPermit(suite.address, contract, expiry)},
+				}
+			},
+			true,
+		},
+		{
+			"expired permit",
+			func(uint64) *types.CallArgs {
+				expiry := uint64(suite.ctx.BlockTime().Unix())
+				return &types.CallArgs{
+					From:   &suite.address,
+					To:     &contract,
+					Permit: &types.CallPermit{Expiry: hexutil.Uint64(expiry), Signature: suite.signPermit(suite.address, contract, expiry)},
+				}
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			expiry := uint64(suite.ctx.BlockTime().Unix()) + 3600
+			args, err := json.Marshal(tc.malleate(expiry))
+			suite.Require().NoError(err)
+			overrides, err := json.Marshal(types.StateOverride{contract: {Code: &code}})
+			suite.Require().NoError(err)
+
+			res, err := suite.queryClient.EstimateGas(sdk.WrapSDKContext(suite.ctx), &types.EthCallRequest{
+				Args:        args,
+				GasCap:      uint64(config.DefaultGasCap),
+				Unencrypted: true,
+				Overrides:   overrides,
+			})
+			if !tc.expPass {
+				suite.Require().Error(err)
+				return
+			}
+			suite.Require().NoError(err)
+			suite.Require().Greater(res.Gas, uint64(21000))
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestEthSimulateWithPermit() {
+	contract := tests.RandomEthAddress()
+	code := hexutil.Bytes(callerTestCode)
+
+	testCases := []struct {
+		name     string
+		malleate func(expiry uint64) *types.CallArgs
+		expPass  bool
+	}{
+		{
+			"valid permit",
+			func(expiry uint64) *types.CallArgs {
+				return &types.CallArgs{
+					From:   &suite.address,
+					To:     &contract,
+					Permit: &types.CallPermit{Expiry: hexutil.Uint64(expiry), Signature: suite.signPermit(suite.address, contract, expiry)},
+				}
+			},
+			true,
+		},
+		{
+			"expired permit",
+			func(uint64) *types.CallArgs {
+				expiry := uint64(suite.ctx.BlockTime().Unix())
+				return &types.CallArgs{
+					From:   &suite.address,
+					To:     &contract,
+					Permit: &types.CallPermit{Expiry: hexutil.Uint64(expiry), Signature: suite.signPermit(suite.address, contract, expiry)},
+				}
+			},
+			false,
+		},
+		{
+			"permit of another sender",
+			func(expiry uint64) *types.CallArgs {
+				from := tests.RandomEthAddress()
+				return &types.CallArgs{
+					From:   &from,
+					To:     &contract,
+					Permit: &types.CallPermit{Expiry: hexutil.Uint64(expiry), Signature: suite.signPermit(from, contract, expiry)},
+				}
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			expiry := uint64(suite.ctx.BlockTime().Unix()) + 3600
+			// permit of each call in the bundle is verified, not only the first one
+			first, err := json.Marshal(&types.CallArgs{To: &contract})
+			suite.Require().NoError(err)
+			second, err := json.Marshal(tc.malleate(expiry))
+			suite.Require().NoError(err)
+			overrides, err := json.Marshal(types.StateOverride{contract: {Code: &code}})
+			suite.Require().NoError(err)
+
+			res, err := suite.queryClient.EthSimulate(sdk.WrapSDKContext(suite.ctx), &types.EthSimulateRequest{
+				Calls:       [][]byte{first, second},
+				GasCap:      uint64(config.DefaultGasCap),
+				Unencrypted: true,
+				Overrides:   overrides,
+			})
+			if !tc.expPass {
+				suite.Require().Error(err)
+				return
+			}
+			suite.Require().NoError(err)
+			suite.Require().Len(res.Results, 2)
+			suite.Require().Equal(common.Hash{}.Bytes(), res.Results[0].Ret)
+			suite.Require().Equal(common.BytesToHash(suite.address.Bytes()).Bytes(), res.Results[1].Ret)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestCreateAccessListWithPermit() {
+	contract := tests.RandomEthAddress()
+	code := hexutil.Bytes(callerTestCode)
+
+	testCases := []struct {
+		name     string
+		malleate func(expiry uint64) *types.CallArgs
+		expPass  bool
+	}{
+		{
+			"valid permit",
+			func(expiry uint64) *types.CallArgs {
+				return &types.CallArgs{
+					From:   &suite.address,
+					To:     &contract,
+					Permit: &types.CallPermit{Expiry: hexutil.Uint64(expiry), Signature: suite.signPermit(suite.address, contract, expiry)},
+				}
+			},
+			true,
+		},
+		{
+			"expired permit",
+			func(uint64) *types.CallArgs {
+				expiry := uint64(suite.ctx.BlockTime().Unix())
+				return &types.CallArgs{
+					From:   &suite.address,
+					To:     &contract,
+					Permit: &types.CallPermit{Expiry: hexutil.Uint64(expiry), Signature: suite.signPermit(suite.address, contract, expiry)},
+				}
+			},
+			false,
+		},
+		{
+			"permit for another contract",
+			func(expiry uint64) *types.CallArgs {
+				return &types.CallArgs{
+					From:   &suite.address,
+					To:     &contract,
+					Permit: &types.CallPermit{Expiry: hexutil.Uint64(expiry), Signature: suite.signPermit(suite.address, tests.RandomEthAddress(), expiry)},
+				}
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			expiry := uint64(suite.ctx.BlockTime().Unix()) + 3600
+			args, err := json.Marshal(tc.malleate(expiry))
+			suite.Require().NoError(err)
+			overrides, err := json.Marshal(types.StateOverride{contract: {Code: &code}})
+			suite.Require().NoError(err)
+
+			res, err := suite.queryClient.CreateAccessList(sdk.WrapSDKContext(suite.ctx), &types.EthCallRequest{
+				Args:        args,
+				GasCap:      uint64(config.DefaultGasCap),
+				Unencrypted: true,
+				Overrides:   overrides,
+			})
+			if !tc.expPass {
+				suite.Require().Error(err)
+				return
+			}
+			suite.Require().NoError(err)
+			suite.Require().Empty(res.VmError)
+		})
+	}
+}
@@ -0,0 +1,162 @@
+package keeper
+
+import (
+	"errors"
+
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"swisstronik/x/evm/types"
+)
+
+// SetContractSource stores contract sources, submitted for verification
+func (k Keeper) SetContractSource(ctx sdk.Context, source types.ContractSource) {
+	key := types.ContractSourceKey(common.HexToAddress(source.Address), common.HexToHash(source.SourceHash))
+	ctx.KVStore(k.storeKey).Set(key, k.cdc.MustMarshal(&source))
+}
+
+// HasContractSource checks if sources with provided hash were already submitted for the contract
+func (k Keeper) HasContractSource(ctx sdk.Context, address common.Address, sourceHash common.Hash) bool {
+	return ctx.KVStore(k.storeKey).Has(types.ContractSourceKey(address, sourceHash))
+}
+
+// GetContractSources returns all sources, submitted for the contract, ordered by source hash
+func (k Keeper) GetContractSources(ctx sdk.Context, address common.Address) []types.ContractSource {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.AddressContractSourcePrefix(address))
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	var sources []types.ContractSource
+	for ; iterator.Valid(); iterator.Next() {
+		var source types.ContractSource
+		k.cdc.MustUnmarshal(iterator.Value(), &source)
+		sources = append(sources, source)
+	}
+	return sources
+}
+
+// checkContractSourcesLimit checks that sources, submitted for the contract by accounts other than its
+// deployer, don't exceed types.MaxContractSources in total and types.MaxContractSourcesPerSubmitter for
+// provided submitter
+func (k Keeper) checkContractSourcesLimit(ctx sdk.Context, address common.Address, submitter string) error {
+	var deployer string
+	if deployerAddress, found := k.GetContractDeployer(ctx, address); found {
+		deployer = sdk.AccAddress(deployerAddress.Bytes()).String()
+	}
+
+	total, submitted := 0, 0
+	for _, source := range k.GetContractSources(ctx, address) {
+		if source.Submitter == deployer {
+			continue
+		}
+		total++
+		if source.Submitter == submitter {
+			submitted++
+		}
+	}
+
+	if total >= types.MaxContractSources {
+		return errorsmod.Wrapf(types.ErrInvalidContractSource, "maximum number of sources is already submitted for %s", address.Hex())
+	}
+	if submitted >= types.MaxContractSourcesPerSubmitter {
+		return errorsmod.Wrapf(types.ErrInvalidContractSource, "maximum number of sources is already submitted for %s by %s", address.Hex(), submitter)
+	}
+	return nil
+}
+
+// SetContractSourceTransient records sources, submitted in current block, to pass them to the contract
+// verifier at the end of the block
+func (k Keeper) SetContractSourceTransient(ctx sdk.Context, address common.Address, sourceHash common.Hash) {
+	ctx.TransientStore(k.transientKey).Set(types.TransientContractSourceKey(address, sourceHash), []byte{1})
+}
+
+// EnqueueSubmittedContractSources passes sources, submitted in current block, together with deployed code
+// of the contracts to the node-local verifier. Verification is performed in background and doesn't
+// affect consensus
+func (k Keeper) EnqueueSubmittedContractSources(ctx sdk.Context) {
+	if k.contractVerifier == nil {
+		return
+	}
+
+	store := prefix.NewStore(ctx.TransientStore(k.transientKey), types.KeyPrefixTransientContractSource)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		address := common.BytesToAddress(iterator.Key()[:common.AddressLength])
+		bz := ctx.KVStore(k.storeKey).Get(types.ContractSourceKey(address, common.BytesToHash(iterator.Key()[common.AddressLength:])))
+		if bz == nil {
+			continue
+		}
+		var source types.ContractSource
+		k.cdc.MustUnmarshal(bz, &source)
+
+		account := k.GetAccountWithoutBalance(ctx, address)
+		if account == nil || !account.IsContract() {
+			continue
+		}
+		k.contractVerifier.Enqueue(source, k.GetCode(ctx, common.BytesToHash(account.CodeHash)))
+	}
+}
+
+// VerifyContract returns verified ABI and source hash of the contract, using results of verification of
+// submitted sources against its deployed bytecode, stored by the node-local verifier. Sources, which match
+// deployed bytecode exactly, including metadata hash, are preferred. If there are no exact matches, the
+// earliest submitted partial match is returned, unless partial matches disagree on contract name or ABI.
+// Sources, which were not verified yet, are enqueued for verification
+func (k Keeper) VerifyContract(ctx sdk.Context, address common.Address) (*types.VerifiedContract, error) {
+	if k.contractVerifier == nil {
+		return nil, types.ErrContractVerificationDisabled
+	}
+
+	account := k.GetAccountWithoutBalance(ctx, address)
+	if account == nil || !account.IsContract() {
+		return nil, types.ErrContractNotVerified.Wrapf("%s is not a contract", address.Hex())
+	}
+	codeHash := common.BytesToHash(account.CodeHash)
+
+	var (
+		partialMatch       *types.VerifiedContract
+		partialMatchHeight int64
+		pending            int
+	)
+	for _, source := range k.GetContractSources(ctx, address) {
+		verified, found, err := k.contractVerifier.Result(address, codeHash, common.HexToHash(source.SourceHash))
+		switch {
+		case !found && err == nil:
+			k.contractVerifier.Enqueue(source, k.GetCode(ctx, codeHash))
+			pending++
+			continue
+		case errors.Is(err, types.ErrContractNotVerified):
+			continue
+		case err != nil:
+			return nil, err
+		}
+
+		if verified.ExactMatch {
+			return verified, nil
+		}
+		if partialMatch == nil {
+			partialMatch, partialMatchHeight = verified, source.Height
+			continue
+		}
+		if partialMatch.ContractName != verified.ContractName || partialMatch.Abi != verified.Abi {
+			return nil, types.ErrContractNotVerified.Wrapf("submitted sources of %s are conflicting partial matches", address.Hex())
+		}
+		if source.Height < partialMatchHeight {
+			partialMatch, partialMatchHeight = verified, source.Height
+		}
+	}
+
+	switch {
+	case pending > 0:
+		// partial match can be superseded by exact match or conflict with pending sources
+		return nil, types.ErrContractNotVerified.Wrapf("verification of %d sources of %s is pending", pending, address.Hex())
+	case partialMatch == nil:
+		return nil, types.ErrContractNotVerified.Wrapf("no submitted sources match bytecode of %s", address.Hex())
+	}
+	return partialMatch, nil
+}
@@ -0,0 +1,344 @@
+package keeper_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	dbm "github.com/cometbft/cometbft-db"
+	"github.com/cometbft/cometbft/libs/log"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"swisstronik/tests"
+	"swisstronik/x/evm/keeper"
+	"swisstronik/x/evm/types"
+)
+
+const (
+	testCompilerVersion = "0.8.20"
+	testContractName    = "contracts/Token.sol:Token"
+	testContractABI     = `[{"inputs":[],"name":"totalSupply","outputs":[{"internalType":"uint256","name":"","type":"uint256"}],"stateMutability":"view","type":"function"}]`
+)
+
+// compiledCode is runtime bytecode, returned by fake solc: code, followed by 3 bytes of metadata and its length
+var compiledCode = hexutil.MustDecode("0x6080604052a1a2a30003")
+
+// fakeSolcOutput is compiled contract, returned by fake solc for sources containing the marker
+type fakeSolcOutput struct {
+	marker     string
+	code       []byte
+	abi        string
+	immutables string
+}
+
+// newFakeSolc creates script, which imitates solc binary returning runtime bytecode of the first output,
+// whose marker is contained in compiled sources
+func (suite *KeeperTestSuite) newFakeSolc(outputs ...fakeSolcOutput) string {
+	dir := suite.T().TempDir()
+
+	script := fmt.Sprintf(`#!/bin/sh
+if [ "$1" = "--version" ]; then
+	echo "solc, the solidity compiler commandline interface"
+	echo "Version: %s+commit.a1b79de6.Linux.g++"
+	exit 0
+fi
+input=$(cat)
+`, testCompilerVersion)
+	for i, out := range outputs {
+		output, err := json.Marshal(map[string]interface{}{
+			"contracts": map[string]interface{}{
+				"contracts/Token.sol": map[string]interface{}{
+					"Token": map[string]interface{}{
+						"abi": json.RawMessage(out.abi),
+						"evm": map[string]interface{}{
+							"deployedBytecode": map[string]interface{}{
+								"object":              common.Bytes2Hex(out.code),
+								"immutableReferences": json.RawMessage(out.immutables),
+							},
+						},
+					},
+				},
+			},
+		})
+		suite.Require().NoError(err)
+		outputPath := filepath.Join(dir, fmt.Sprintf("output%d.json", i))
+		suite.Require().NoError(os.WriteFile(outputPath, output, 0o600))
+		script += fmt.Sprintf("case \"$input\" in *%q*) cat %s; exit 0;; esac\n", out.marker, outputPath)
+	}
+
+	solcPath := filepath.Join(dir, "solc")
+	suite.Require().NoError(os.WriteFile(solcPath, []byte(script), 0o700)) //#nosec G306 -- script must be executable
+	return solcPath
+}
+
+func (suite *KeeperTestSuite) submitTestContractSource(submitter, address common.Address, content string) *types.MsgSubmitContractSourceResponse {
+	res, err := suite.app.EvmKeeper.SubmitContractSource(sdk.WrapSDKContext(suite.ctx), &types.MsgSubmitContractSource{
+		Submitter:       sdk.AccAddress(submitter.Bytes()).String(),
+		Address:         address.Hex(),
+		CompilerVersion: testCompilerVersion,
+		ContractName:    testContractName,
+		Sources:         []types.ContractSourceFile{{Path: "contracts/Token.sol", Content: content}},
+		Settings:        `{"optimizer":{"enabled":true,"runs":200}}`,
+	})
+	suite.Require().NoError(err)
+	return res
+}
+
+func (suite *KeeperTestSuite) TestSubmitContractSource() {
+	contract := tests.RandomEthAddress()
+
+	testCases := []struct {
+		name      string
+		malleate  func()
+		expectErr bool
+	}{
+		{
+			"fail - address is not a contract",
+			func() {},
+			true,
+		},
+		{
+			"fail - sources are already submitted",
+			func() {
+				suite.Require().NoError(suite.app.EvmKeeper.SetAccountCode(suite.ctx, contract, compiledCode))
+				suite.submitTestContractSource(suite.address, contract, "contract Token {}")
+			},
+			true,
+		},
+		{
+			"fail - maximum number of sources is submitted",
+			func() {
+				suite.Require().NoError(suite.app.EvmKeeper.SetAccountCode(suite.ctx, contract, compiledCode))
+				for i := 0; i < types.MaxContractSources; i++ {
+					suite.submitTestContractSource(tests.RandomEthAddress(), contract, fmt.Sprintf("contract Token {} // %d", i))
+				}
+			},
+			true,
+		},
+		{
+			"fail - maximum number of sources is submitted by the sender",
+			func() {
+				suite.Require().NoError(suite.app.EvmKeeper.SetAccountCode(suite.ctx, contract, compiledCode))
+				for i := 0; i < types.MaxContractSourcesPerSubmitter; i++ {
+					suite.submitTestContractSource(suite.address, contract, fmt.Sprintf("contract Token {} // %d", i))
+				}
+			},
+			true,
+		},
+		{
+			"pass - sources of the deployer are accepted after the maximum number of sources",
+			func() {
+				suite.Require().NoError(suite.app.EvmKeeper.SetAccountCode(suite.ctx, contract, compiledCode))
+				suite.app.EvmKeeper.SetContractDeployer(suite.ctx, contract, suite.address)
+				for i := 0; i < types.MaxContractSources; i++ {
+					suite.submitTestContractSource(tests.RandomEthAddress(), contract, fmt.Sprintf("contract Token {} // %d", i))
+				}
+			},
+			false,
+		},
+		{
+			"pass - sources are submitted",
+			func() {
+				suite.Require().NoError(suite.app.EvmKeeper.SetAccountCode(suite.ctx, contract, compiledCode))
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			tc.malleate()
+
+			msg := &types.MsgSubmitContractSource{
+				Submitter:       sdk.AccAddress(suite.address.Bytes()).String(),
+				Address:         contract.Hex(),
+				CompilerVersion: testCompilerVersion,
+				ContractName:    testContractName,
+				Sources:         []types.ContractSourceFile{{Path: "contracts/Token.sol", Content: "contract Token {}"}},
+			}
+			res, err := suite.app.EvmKeeper.SubmitContractSource(sdk.WrapSDKContext(suite.ctx), msg)
+			if tc.expectErr {
+				suite.Require().Error(err)
+				return
+			}
+			suite.Require().NoError(err)
+
+			suite.Require().Equal(types.ContractSourceHash(msg.Sources).Hex(), res.SourceHash)
+			var submitted *types.ContractSource
+			sources := suite.app.EvmKeeper.GetContractSources(suite.ctx, contract)
+			for i := range sources {
+				if sources[i].SourceHash == res.SourceHash {
+					submitted = &sources[i]
+				}
+			}
+			suite.Require().NotNil(submitted)
+			suite.Require().Equal(msg.Submitter, submitted.Submitter)
+			suite.Require().Equal(suite.ctx.BlockHeight(), submitted.Height)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestVerifiedContract() {
+	contract := tests.RandomEthAddress()
+	partialCode := hexutil.MustDecode("0x6080604052b1b2b30003")
+	ownableABI := `[{"inputs":[],"name":"owner","outputs":[{"internalType":"address","name":"","type":"address"}],"stateMutability":"view","type":"function"}]`
+
+	testCases := []struct {
+		name         string
+		deployedCode []byte
+		outputs      []fakeSolcOutput
+		verifier     bool
+		expCode      codes.Code
+		expSource    string
+		expExact     bool
+	}{
+		{
+			name:         "fail - verification is disabled",
+			deployedCode: compiledCode,
+			outputs:      []fakeSolcOutput{{marker: "Token", code: compiledCode, abi: testContractABI, immutables: "{}"}},
+			verifier:     false,
+			expCode:      codes.Unavailable,
+		},
+		{
+			name:         "fail - bytecode doesn't match",
+			deployedCode: hexutil.MustDecode("0x6080604053a1a2a30003"),
+			outputs:      []fakeSolcOutput{{marker: "Token", code: compiledCode, abi: testContractABI, immutables: "{}"}},
+			verifier:     true,
+			expCode:      codes.NotFound,
+		},
+		{
+			name:         "pass - exact match",
+			deployedCode: compiledCode,
+			outputs:      []fakeSolcOutput{{marker: "Token", code: compiledCode, abi: testContractABI, immutables: "{}"}},
+			verifier:     true,
+			expCode:      codes.OK,
+			expSource:    "contract Token {}",
+			expExact:     true,
+		},
+		{
+			name:         "pass - partial match with different metadata",
+			deployedCode: partialCode,
+			outputs:      []fakeSolcOutput{{marker: "Token", code: compiledCode, abi: testContractABI, immutables: "{}"}},
+			verifier:     true,
+			expCode:      codes.OK,
+			expSource:    "contract Token {}",
+			expExact:     false,
+		},
+		{
+			name:         "pass - exact match with immutable variables",
+			deployedCode: hexutil.MustDecode("0x60ffff4052a1a2a30003"),
+			outputs:      []fakeSolcOutput{{marker: "Token", code: compiledCode, abi: testContractABI, immutables: `{"3":[{"start":1,"length":2}]}`}},
+			verifier:     true,
+			expCode:      codes.OK,
+			expSource:    "contract Token {}",
+			expExact:     true,
+		},
+		{
+			name:         "pass - exact match is preferred over partial match",
+			deployedCode: partialCode,
+			outputs: []fakeSolcOutput{
+				{marker: "Exact", code: partialCode, abi: testContractABI, immutables: "{}"},
+				{marker: "Token", code: compiledCode, abi: testContractABI, immutables: "{}"},
+			},
+			verifier:  true,
+			expCode:   codes.OK,
+			expSource: "contract Token {} // Exact",
+			expExact:  true,
+		},
+		{
+			name:         "fail - partial matches have different ABI",
+			deployedCode: partialCode,
+			outputs: []fakeSolcOutput{
+				{marker: "Ownable", code: compiledCode, abi: ownableABI, immutables: "{}"},
+				{marker: "Token", code: compiledCode, abi: testContractABI, immutables: "{}"},
+			},
+			verifier: true,
+			expCode:  codes.NotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			var verifier *keeper.ContractVerifier
+			if tc.verifier {
+				verifier = keeper.NewContractVerifier(suite.newFakeSolc(tc.outputs...), dbm.NewMemDB(), log.NewNopLogger())
+				suite.app.EvmKeeper.SetContractVerifier(verifier)
+				defer func() {
+					suite.app.EvmKeeper.SetContractVerifier(nil)
+					suite.Require().NoError(verifier.Close())
+				}()
+			}
+
+			suite.Require().NoError(suite.app.EvmKeeper.SetAccountCode(suite.ctx, contract, tc.deployedCode))
+			submitted := make(map[string]string)
+			for _, output := range tc.outputs {
+				content := "contract Token {}"
+				if output.marker != "Token" {
+					content += " // " + output.marker
+				}
+				submitted[content] = suite.submitTestContractSource(suite.address, contract, content).SourceHash
+			}
+
+			// sources are verified in background after the end of the block
+			suite.app.EvmKeeper.EnqueueSubmittedContractSources(suite.ctx)
+			if verifier != nil {
+				verifier.Wait()
+			}
+
+			res, err := suite.queryClient.VerifiedContract(
+				sdk.WrapSDKContext(suite.ctx),
+				&types.QueryVerifiedContractRequest{Address: contract.Hex()},
+			)
+			if tc.expCode != codes.OK {
+				suite.Require().Error(err)
+				suite.Require().Equal(tc.expCode, status.Code(err))
+				return
+			}
+			suite.Require().NoError(err)
+			suite.Require().Equal(contract.Hex(), res.Contract.Address)
+			suite.Require().Equal(submitted[tc.expSource], res.Contract.SourceHash)
+			suite.Require().Equal(testContractName, res.Contract.ContractName)
+			suite.Require().Equal(tc.expExact, res.Contract.ExactMatch)
+			suite.Require().JSONEq(testContractABI, res.Contract.Abi)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestContractVerifierClose() {
+	solcPath := suite.newFakeSolc(fakeSolcOutput{marker: "Token", code: compiledCode, abi: testContractABI, immutables: "{}"})
+	verifier := keeper.NewContractVerifier(solcPath, dbm.NewMemDB(), log.NewNopLogger())
+
+	for i := 0; i < 10; i++ {
+		source := types.NewContractSource(&types.MsgSubmitContractSource{
+			Address:         tests.RandomEthAddress().Hex(),
+			CompilerVersion: testCompilerVersion,
+			ContractName:    testContractName,
+			Sources:         []types.ContractSourceFile{{Path: "contracts/Token.sol", Content: "contract Token {}"}},
+		}, suite.ctx.BlockHeight())
+		verifier.Enqueue(source, compiledCode)
+	}
+	suite.Require().NoError(verifier.Close())
+
+	// verifications, discarded by Close or enqueued after it, don't block Wait
+	verifier.Enqueue(types.ContractSource{Address: tests.RandomEthAddress().Hex()}, compiledCode)
+	waited := make(chan struct{})
+	go func() {
+		verifier.Wait()
+		close(waited)
+	}()
+	select {
+	case <-waited:
+	case <-time.After(10 * time.Second):
+		suite.FailNow("Wait is blocked after Close")
+	}
+}
@@ -0,0 +1,416 @@
+package keeper
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os/exec"
+	"strings"
+	"sync"
+	"time"
+
+	dbm "github.com/cometbft/cometbft-db"
+	"github.com/cometbft/cometbft/libs/log"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"swisstronik/x/evm/types"
+)
+
+const (
+	// solcTimeout limits duration of a single solc invocation
+	solcTimeout = 2 * time.Minute
+	// verificationQueueSize limits number of verifications, waiting to be processed by the worker
+	verificationQueueSize = 256
+)
+
+// ContractVerifier verifies submitted contract sources by compiling them with solc binary, configured by
+// the node operator, and comparing resulting runtime bytecode with the deployed one. Verification is not
+// a part of consensus, so it is performed by a background worker and its results are persisted in the
+// node-local database. ContractVerifier is safe for concurrent use
+type ContractVerifier struct {
+	// path of solc binary, "{version}" is replaced with requested compiler version
+	solcPath string
+	// results of completed verifications, keyed by contract address, code hash and source hash
+	db     dbm.DB
+	logger log.Logger
+
+	jobs chan verificationJob
+	quit chan struct{}
+	// closed when the background worker is stopped
+	done chan struct{}
+	// tracks enqueued verifications, which are not processed yet
+	wg sync.WaitGroup
+
+	mu sync.Mutex
+	// keys of enqueued verifications, used to avoid verifying the same sources concurrently
+	pending map[string]struct{}
+	// set by Close, new verifications are not accepted after that
+	closed bool
+}
+
+// verificationJob is a submitted source, which should be verified against deployed code of the contract
+type verificationJob struct {
+	source types.ContractSource
+	code   []byte
+}
+
+// verificationResult is a result of completed verification, stored in the database
+type verificationResult struct {
+	Contract *types.VerifiedContract `json:"contract,omitempty"`
+	Error    string                  `json:"error,omitempty"`
+}
+
+// NewContractVerifier returns verifier, which uses solc binary with provided path and stores verification
+// results in provided database. It starts the background worker, which is stopped by Close
+func NewContractVerifier(solcPath string, db dbm.DB, logger log.Logger) *ContractVerifier {
+	v := &ContractVerifier{
+		solcPath: solcPath,
+		db:       db,
+		logger:   logger,
+		jobs:     make(chan verificationJob, verificationQueueSize),
+		quit:     make(chan struct{}),
+		done:     make(chan struct{}),
+		pending:  make(map[string]struct{}),
+	}
+	go v.run()
+	return v
+}
+
+// Close stops the background worker, waits for the verification in progress and closes the database.
+// Verifications, which are still enqueued, are discarded
+func (v *ContractVerifier) Close() error {
+	v.mu.Lock()
+	v.closed = true
+	v.mu.Unlock()
+
+	close(v.quit)
+	<-v.done
+
+	// discarded verifications are marked as processed, so Wait doesn't block
+	for {
+		select {
+		case <-v.jobs:
+			v.wg.Done()
+		default:
+			return v.db.Close()
+		}
+	}
+}
+
+// Enqueue schedules verification of submitted sources against provided deployed code of the contract.
+// Sources, which are already verified or enqueued, are skipped. If the queue is full, sources are
+// skipped as well and will be enqueued again by the next query of the contract
+func (v *ContractVerifier) Enqueue(source types.ContractSource, code []byte) {
+	key := verificationKey(common.HexToAddress(source.Address), crypto.Keccak256Hash(code), common.HexToHash(source.SourceHash))
+
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	if v.closed {
+		return
+	}
+	if has, err := v.db.Has(key); err != nil || has {
+		return
+	}
+	if _, found := v.pending[string(key)]; found {
+		return
+	}
+
+	v.wg.Add(1)
+	select {
+	case v.jobs <- verificationJob{source: source, code: code}:
+		v.pending[string(key)] = struct{}{}
+	default:
+		v.wg.Done()
+		v.logger.Error("contract verification queue is full", "address", source.Address, "source", source.SourceHash)
+	}
+}
+
+// Wait blocks until all enqueued verifications are processed
+func (v *ContractVerifier) Wait() {
+	v.wg.Wait()
+}
+
+// Result returns stored result of verification of sources with provided hash against code of the
+// contract with provided hash. If sources were not verified yet, found is false
+func (v *ContractVerifier) Result(address common.Address, codeHash, sourceHash common.Hash) (verified *types.VerifiedContract, found bool, err error) {
+	value, err := v.db.Get(verificationKey(address, codeHash, sourceHash))
+	if err != nil || value == nil {
+		return nil, false, err
+	}
+
+	var result verificationResult
+	if err := json.Unmarshal(value, &result); err != nil {
+		return nil, false, fmt.Errorf("failed to decode verification result: %w", err)
+	}
+	if result.Contract == nil {
+		return nil, true, types.ErrContractNotVerified.Wrap(result.Error)
+	}
+	return result.Contract, true, nil
+}
+
+// run processes enqueued verifications until the verifier is closed
+func (v *ContractVerifier) run() {
+	defer close(v.done)
+	for {
+		select {
+		case <-v.quit:
+			return
+		case job := <-v.jobs:
+			v.process(job)
+			v.wg.Done()
+		}
+	}
+}
+
+// process verifies sources of the job and stores the result
+func (v *ContractVerifier) process(job verificationJob) {
+	key := verificationKey(common.HexToAddress(job.source.Address), crypto.Keccak256Hash(job.code), common.HexToHash(job.source.SourceHash))
+	defer func() {
+		v.mu.Lock()
+		delete(v.pending, string(key))
+		v.mu.Unlock()
+	}()
+
+	verified, err := v.verify(job.source, job.code)
+	result := verificationResult{Contract: verified}
+	switch {
+	case errors.Is(err, types.ErrInvalidContractSource), errors.Is(err, types.ErrContractNotVerified):
+		result.Error = err.Error()
+	case err != nil:
+		// other errors can be caused by node configuration, so they are not stored
+		v.logger.Error("failed to verify contract source", "address", job.source.Address, "source", job.source.SourceHash, "error", err.Error())
+		return
+	}
+
+	value, err := json.Marshal(result)
+	if err != nil {
+		v.logger.Error("failed to encode verification result", "error", err.Error())
+		return
+	}
+	if err := v.db.Set(key, value); err != nil {
+		v.logger.Error("failed to store verification result", "error", err.Error())
+	}
+}
+
+// verify compiles submitted sources and compares resulting runtime bytecode with deployed code of the
+// contract. Immutable variables are ignored during comparison. If bytecode differs only in the embedded
+// metadata hash, sources are verified as partial match
+func (v *ContractVerifier) verify(source types.ContractSource, code []byte) (*types.VerifiedContract, error) {
+	compiled, err := v.compile(source)
+	if err != nil {
+		return nil, err
+	}
+
+	exactMatch, match := compareBytecode(code, compiled.contract.Bin, compiled.immutables)
+	if !match {
+		return nil, types.ErrContractNotVerified.Wrapf("bytecode of %s doesn't match deployed code", source.ContractName)
+	}
+	return &types.VerifiedContract{
+		Address:         common.HexToAddress(source.Address).Hex(),
+		SourceHash:      source.SourceHash,
+		ContractName:    source.ContractName,
+		CompilerVersion: source.CompilerVersion,
+		Abi:             string(compiled.abi),
+		ExactMatch:      exactMatch,
+	}, nil
+}
+
+// verificationKey defines the key under which result of verification of sources with provided hash
+// against code with provided hash is stored
+func verificationKey(address common.Address, codeHash, sourceHash common.Hash) []byte {
+	key := make([]byte, 0, common.AddressLength+2*common.HashLength)
+	key = append(key, address.Bytes()...)
+	key = append(key, codeHash.Bytes()...)
+	return append(key, sourceHash.Bytes()...)
+}
+
+// solcSource is a source file of solc standard JSON input
+type solcSource struct {
+	Content string `json:"content"`
+}
+
+// solcInput is solc standard JSON input
+type solcInput struct {
+	Language string                     `json:"language"`
+	Sources  map[string]solcSource      `json:"sources"`
+	Settings map[string]json.RawMessage `json:"settings"`
+}
+
+// solcImmutableReference is a location of immutable variable in runtime bytecode
+type solcImmutableReference struct {
+	Start  int `json:"start"`
+	Length int `json:"length"`
+}
+
+// solcContract is compiled contract of solc standard JSON output
+type solcContract struct {
+	ABI json.RawMessage `json:"abi"`
+	EVM struct {
+		DeployedBytecode struct {
+			Object              string                              `json:"object"`
+			ImmutableReferences map[string][]solcImmutableReference `json:"immutableReferences"`
+		} `json:"deployedBytecode"`
+	} `json:"evm"`
+}
+
+// compilationResult is compiled contract together with its ABI in the form, returned by solc, and
+// locations of immutable variables in runtime bytecode
+type compilationResult struct {
+	contract   types.CompiledContract
+	abi        []byte
+	immutables []solcImmutableReference
+}
+
+// solcOutput is solc standard JSON output
+type solcOutput struct {
+	Errors []struct {
+		Severity         string `json:"severity"`
+		FormattedMessage string `json:"formattedMessage"`
+	} `json:"errors"`
+	Contracts map[string]map[string]solcContract `json:"contracts"`
+}
+
+// compile compiles provided sources and returns compiled contract with runtime bytecode
+func (v *ContractVerifier) compile(source types.ContractSource) (*compilationResult, error) {
+	sourcePath, contractName, err := types.SplitContractName(source.ContractName)
+	if err != nil {
+		return nil, err
+	}
+
+	input := solcInput{
+		Language: "Solidity",
+		Sources:  make(map[string]solcSource, len(source.Sources)),
+		Settings: make(map[string]json.RawMessage),
+	}
+	for _, file := range source.Sources {
+		input.Sources[file.Path] = solcSource{Content: file.Content}
+	}
+	if source.Settings != "" {
+		if err := json.Unmarshal([]byte(source.Settings), &input.Settings); err != nil {
+			return nil, types.ErrInvalidContractSource.Wrapf("invalid compiler settings: %s", err)
+		}
+	}
+	// only runtime bytecode and ABI of verified contract are required
+	outputSelection, err := json.Marshal(map[string]map[string][]string{
+		sourcePath: {contractName: {"abi", "evm.deployedBytecode.object", "evm.deployedBytecode.immutableReferences"}},
+	})
+	if err != nil {
+		return nil, err
+	}
+	input.Settings["outputSelection"] = outputSelection
+
+	encodedInput, err := json.Marshal(input)
+	if err != nil {
+		return nil, err
+	}
+
+	solcPath := strings.ReplaceAll(v.solcPath, "{version}", source.CompilerVersion)
+	if err := checkSolcVersion(solcPath, source.CompilerVersion); err != nil {
+		return nil, err
+	}
+	encodedOutput, err := runSolc(solcPath, encodedInput, "--standard-json")
+	if err != nil {
+		return nil, err
+	}
+
+	var output solcOutput
+	if err := json.Unmarshal(encodedOutput, &output); err != nil {
+		return nil, fmt.Errorf("failed to decode solc output: %w", err)
+	}
+	for _, outputErr := range output.Errors {
+		if outputErr.Severity == "error" {
+			return nil, types.ErrInvalidContractSource.Wrapf("compilation failed: %s", outputErr.FormattedMessage)
+		}
+	}
+
+	contract, found := output.Contracts[sourcePath][contractName]
+	if !found {
+		return nil, types.ErrInvalidContractSource.Wrapf("contract %s is not found in compiler output", source.ContractName)
+	}
+
+	compiled := &compilationResult{}
+	// ABI is decoded to ensure, that it is valid, but returned as is
+	if err := json.Unmarshal(contract.ABI, &compiled.contract.ABI); err != nil {
+		return nil, fmt.Errorf("failed to decode ABI: %w", err)
+	}
+	// unlinked libraries are represented by placeholders, which are not valid hex
+	if err := json.Unmarshal([]byte(fmt.Sprintf("%q", contract.EVM.DeployedBytecode.Object)), &compiled.contract.Bin); err != nil {
+		return nil, types.ErrInvalidContractSource.Wrapf("invalid runtime bytecode, unlinked libraries are not supported: %s", err)
+	}
+
+	var abiJSON bytes.Buffer
+	if err := json.Compact(&abiJSON, contract.ABI); err != nil {
+		return nil, fmt.Errorf("failed to decode ABI: %w", err)
+	}
+	compiled.abi = abiJSON.Bytes()
+
+	for _, references := range contract.EVM.DeployedBytecode.ImmutableReferences {
+		compiled.immutables = append(compiled.immutables, references...)
+	}
+	return compiled, nil
+}
+
+// checkSolcVersion checks that solc binary has requested version
+func checkSolcVersion(solcPath, version string) error {
+	output, err := runSolc(solcPath, nil, "--version")
+	if err != nil {
+		return err
+	}
+	// version output contains line like "Version: 0.8.20+commit.a1b79de6.Linux.g++"
+	if !strings.Contains(string(output), "Version: "+version) {
+		return fmt.Errorf("solc binary %s doesn't have requested version %s", solcPath, version)
+	}
+	return nil
+}
+
+// runSolc runs solc binary with provided input and arguments and returns its output
+func runSolc(solcPath string, input []byte, args ...string) ([]byte, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), solcTimeout)
+	defer cancel()
+
+	var stdout, stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, solcPath, args...) // #nosec G204 -- path is configured by node operator
+	cmd.Stdin = bytes.NewReader(input)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		return nil, fmt.Errorf("failed to run solc: %w: %s", err, stderr.String())
+	}
+	return stdout.Bytes(), nil
+}
+
+// compareBytecode compares deployed and compiled runtime bytecode. Immutable variables, which are set
+// during deployment, are excluded from comparison. If bytecode differs only in CBOR-encoded metadata,
+// appended by solc, it is reported as partial match
+func compareBytecode(deployed, compiled []byte, immutables []solcImmutableReference) (exactMatch bool, match bool) {
+	if len(deployed) != len(compiled) || len(compiled) == 0 {
+		return false, false
+	}
+
+	deployed = common.CopyBytes(deployed)
+	for _, immutable := range immutables {
+		if immutable.Start < 0 || immutable.Length < 0 || immutable.Start+immutable.Length > len(deployed) {
+			return false, false
+		}
+		copy(deployed[immutable.Start:immutable.Start+immutable.Length], compiled[immutable.Start:immutable.Start+immutable.Length])
+	}
+
+	if bytes.Equal(deployed, compiled) {
+		return true, true
+	}
+
+	// the last two bytes contain length of CBOR-encoded metadata, which precedes them
+	if len(compiled) < 2 {
+		return false, false
+	}
+	metadataLength := int(binary.BigEndian.Uint16(compiled[len(compiled)-2:])) + 2
+	if metadataLength > len(compiled) {
+		return false, false
+	}
+	codeLength := len(compiled) - metadataLength
+	return false, bytes.Equal(deployed[:codeLength], compiled[:codeLength])
+}
@@ -0,0 +1,140 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"sort"
+	"strconv"
+	"sync"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/SigmaGmbH/librustgo"
+	rustgotypes "github.com/SigmaGmbH/librustgo/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"swisstronik/x/evm/types"
+)
+
+// epochCache keeps list of epochs, obtained from the node enclave. Since keeper is copied by value
+// and epochs are read by gRPC query goroutines, cache is shared by pointer and guarded by mutex
+type epochCache struct {
+	mtx    sync.RWMutex
+	epochs []*rustgotypes.EpochData
+}
+
+func newEpochCache(epochs []*rustgotypes.EpochData) *epochCache {
+	cache := &epochCache{}
+	cache.set(epochs)
+	return cache
+}
+
+// set replaces cached epochs. Epochs are sorted by starting block
+func (c *epochCache) set(epochs []*rustgotypes.EpochData) {
+	sorted := make([]*rustgotypes.EpochData, len(epochs))
+	copy(sorted, epochs)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].GetStartingBlock() < sorted[j].GetStartingBlock()
+	})
+
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	c.epochs = sorted
+}
+
+// list returns cached epochs. Returned slice must not be modified
+func (c *epochCache) list() []*rustgotypes.EpochData {
+	c.mtx.RLock()
+	defer c.mtx.RUnlock()
+	return c.epochs
+}
+
+// GetEpochs returns epochs, known by the node enclave, sorted by starting block
+func (k Keeper) GetEpochs() []*rustgotypes.EpochData {
+	return k.epochs.list()
+}
+
+// RefreshEpochs reloads epochs from the node enclave. It is called in BeginBlock, so epochs,
+// added to the enclave while node is running, become available without restart
+func (k *Keeper) RefreshEpochs() error {
+	epochs, err := librustgo.ListEpochs()
+	if err != nil {
+		return err
+	}
+	k.epochs.set(epochs)
+	return nil
+}
+
+// refreshEpochs reloads epochs and logs an error, if enclave is not available. In such case
+// previously loaded epochs are kept
+func (k *Keeper) refreshEpochs(ctx sdk.Context) {
+	if err := k.RefreshEpochs(); err != nil {
+		k.Logger(ctx).Error("cannot reload epochs from enclave", "error", err)
+	}
+}
+
+// HasScheduledEpoch returns true if epoch with provided starting block is scheduled
+func (k Keeper) HasScheduledEpoch(ctx sdk.Context, startingBlock uint64) bool {
+	return ctx.KVStore(k.storeKey).Has(types.ScheduledEpochKey(startingBlock))
+}
+
+// SetScheduledEpoch records epoch, which should be added to the enclave at provided block
+func (k Keeper) SetScheduledEpoch(ctx sdk.Context, startingBlock uint64) {
+	ctx.KVStore(k.storeKey).Set(types.ScheduledEpochKey(startingBlock), []byte{0x01})
+}
+
+// DeleteScheduledEpoch removes scheduled epoch with provided starting block
+func (k Keeper) DeleteScheduledEpoch(ctx sdk.Context, startingBlock uint64) {
+	ctx.KVStore(k.storeKey).Delete(types.ScheduledEpochKey(startingBlock))
+}
+
+// GetScheduledEpochs returns starting blocks of scheduled epochs in ascending order
+func (k Keeper) GetScheduledEpochs(ctx sdk.Context) []uint64 {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixScheduledEpoch)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	startingBlocks := []uint64{}
+	for ; iterator.Valid(); iterator.Next() {
+		startingBlocks = append(startingBlocks, binary.BigEndian.Uint64(iterator.Key()))
+	}
+	return startingBlocks
+}
+
+// applyScheduledEpoch adds epoch, scheduled for current block, to the enclave. Epoch key is created by
+// the key manager and provisioned to the node enclave in advance. If the enclave already contains such
+// epoch (for example, block is replayed), it is not changed. If epoch cannot be added, the failure is
+// logged and scheduled epoch is dropped, so the chain keeps using the current epoch instead of halting
+func (k *Keeper) applyScheduledEpoch(ctx sdk.Context) {
+	startingBlock := uint64(ctx.BlockHeight())
+	if !k.HasScheduledEpoch(ctx, startingBlock) {
+		return
+	}
+	k.DeleteScheduledEpoch(ctx, startingBlock)
+
+	eventType := types.EventTypeAddEpoch
+	if err := librustgo.AddEpoch(startingBlock); err != nil {
+		err = errorsmod.Wrapf(types.ErrAddEpoch, "starting block %d: %s", startingBlock, err)
+		k.Logger(ctx).Error("scheduled epoch is dropped", "error", err.Error())
+		eventType = types.EventTypeDropEpoch
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			eventType,
+			sdk.NewAttribute(types.AttributeKeyStartingBlock, strconv.FormatUint(startingBlock, 10)),
+		),
+	)
+}
+
+// validateEpochStartingBlock checks that epoch with provided starting block can be added after epochs,
+// known by the node enclave. Starting blocks of epochs should be strictly increasing
+func (k Keeper) validateEpochStartingBlock(startingBlock uint64) error {
+	epochs := k.GetEpochs()
+	if len(epochs) == 0 {
+		return nil
+	}
+	if latest := epochs[len(epochs)-1].StartingBlock; startingBlock <= latest {
+		return errorsmod.Wrapf(types.ErrInvalidEpoch, "starting block %d must be greater than starting block %d of the latest epoch", startingBlock, latest)
+	}
+	return nil
+}
@@ -16,8 +16,11 @@
 package keeper
 
 import (
+	"errors"
+	"fmt"
 	"math/big"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core"
 	"github.com/ethereum/go-ethereum/params"
 
@@ -26,6 +29,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/feegrant"
 
 	"swisstronik/x/evm/types"
 )
@@ -39,11 +43,12 @@ func (k *Keeper) GetEthIntrinsicGas(ctx sdk.Context, msg core.Message, cfg *para
 	return core.IntrinsicGas(msg.Data(), msg.AccessList(), isContractCreation, homestead, istanbul)
 }
 
-// RefundGas transfers the leftover gas to the sender of the message, caped to half of the total gas
-// consumed in the transaction. Additionally, the function sets the total gas consumed to the value
-// returned by the EVM execution, thus ignoring the previous intrinsic gas consumed during in the
-// AnteHandler.
-func (k *Keeper) RefundGas(ctx sdk.Context, msg core.Message, leftoverGas uint64, denom string) error {
+// RefundGas transfers the leftover gas to the fee payer of the message, caped to half of the total gas
+// consumed in the transaction. Fee payer is the sender, unless fees of the transaction are delegated. If
+// fees are spent from the allowance, granted by the fee payer to the sender, refunded fees are restored to it.
+// Additionally, the function sets the total gas consumed to the value returned by the EVM execution,
+// thus ignoring the previous intrinsic gas consumed during in the AnteHandler.
+func (k *Keeper) RefundGas(ctx sdk.Context, msg core.Message, feePayer common.Address, leftoverGas uint64, denom string) error {
 	// Return EVM tokens for remaining gas, exchanged at the original rate.
 	remaining := new(big.Int).Mul(new(big.Int).SetUint64(leftoverGas), msg.GasPrice())
 
@@ -55,13 +60,19 @@ func (k *Keeper) RefundGas(ctx sdk.Context, msg core.Message, leftoverGas uint64
 		// positive amount refund
 		refundedCoins := sdk.Coins{sdk.NewCoin(denom, sdkmath.NewIntFromBigInt(remaining))}
 
-		// refund to sender from the fee collector module account, which is the escrow account in charge of collecting tx fees
+		// refund to fee payer from the fee collector module account, which is the escrow account in charge of collecting tx fees
 
-		err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, authtypes.FeeCollectorName, msg.From().Bytes(), refundedCoins)
+		err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, authtypes.FeeCollectorName, feePayer.Bytes(), refundedCoins)
 		if err != nil {
 			err = errorsmod.Wrapf(errortypes.ErrInsufficientFunds, "fee collector account failed to refund fees: %s", err.Error())
 			return errorsmod.Wrapf(err, "failed to refund %d leftover gas (%s)", leftoverGas, refundedCoins.String())
 		}
+
+		if feePayer != msg.From() {
+			if err := k.restoreFeeAllowance(ctx, feePayer.Bytes(), msg.From().Bytes(), refundedCoins); err != nil {
+				return errorsmod.Wrapf(err, "failed to restore %s to fee allowance", refundedCoins.String())
+			}
+		}
 	default:
 		// no refund, consume gas and update the tx gas meter
 	}
@@ -69,6 +80,62 @@ func (k *Keeper) RefundGas(ctx sdk.Context, msg core.Message, leftoverGas uint64
 	return nil
 }
 
+// restoreFeeAllowance returns refunded fees of fee delegated transaction to the allowance, granted by the fee
+// payer to the sender through x/feegrant module, since fees for the whole gas limit are spent from it by the
+// ante handler. If there is no allowance or it was removed after being exhausted, nothing is restored
+func (k *Keeper) restoreFeeAllowance(ctx sdk.Context, granter, grantee sdk.AccAddress, refund sdk.Coins) error {
+	if k.feegrantKeeper == nil {
+		return nil
+	}
+
+	allowance, err := k.feegrantKeeper.GetAllowance(ctx, granter, grantee)
+	if err != nil {
+		if errors.Is(err, errortypes.ErrNotFound) {
+			return nil
+		}
+		return err
+	}
+
+	restored, err := addToFeeAllowance(allowance, refund)
+	if err != nil {
+		return err
+	}
+	return k.feegrantKeeper.GrantAllowance(ctx, granter, grantee, restored)
+}
+
+// addToFeeAllowance increases amount, which can be spent from the allowance, by provided coins. Empty spend
+// limit means that the allowance is unlimited, so it is kept empty
+func addToFeeAllowance(allowance feegrant.FeeAllowanceI, coins sdk.Coins) (feegrant.FeeAllowanceI, error) {
+	switch allowance := allowance.(type) {
+	case *feegrant.BasicAllowance:
+		if !allowance.SpendLimit.Empty() {
+			allowance.SpendLimit = allowance.SpendLimit.Add(coins...)
+		}
+		return allowance, nil
+	case *feegrant.PeriodicAllowance:
+		if !allowance.Basic.SpendLimit.Empty() {
+			allowance.Basic.SpendLimit = allowance.Basic.SpendLimit.Add(coins...)
+		}
+		allowance.PeriodCanSpend = allowance.PeriodCanSpend.Add(coins...).Min(allowance.PeriodSpendLimit)
+		return allowance, nil
+	case *feegrant.AllowedMsgAllowance:
+		inner, err := allowance.GetAllowance()
+		if err != nil {
+			return nil, err
+		}
+		restored, err := addToFeeAllowance(inner, coins)
+		if err != nil {
+			return nil, err
+		}
+		if err := allowance.SetAllowance(restored); err != nil {
+			return nil, err
+		}
+		return allowance, nil
+	default:
+		return nil, fmt.Errorf("unsupported fee allowance type %T", allowance)
+	}
+}
+
 // ResetGasMeterAndConsumeGas reset first the gas meter consumed value to zero and set it back to the new value
 // 'gasUsed'
 func (k *Keeper) ResetGasMeterAndConsumeGas(ctx sdk.Context, gasUsed uint64) {
@@ -21,22 +21,29 @@ import (
 	"errors"
 	"fmt"
 	"math/big"
+	"reflect"
 	"time"
 
 	"github.com/ethereum/go-ethereum/eth/tracers"
 	"github.com/ethereum/go-ethereum/eth/tracers/logger"
+	// Force-load native and js tracers, to trigger registration
+	_ "github.com/ethereum/go-ethereum/eth/tracers/js"
+	_ "github.com/ethereum/go-ethereum/eth/tracers/native"
 
+	"golang.org/x/crypto/curve25519"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
 	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	"github.com/SigmaGmbH/librustgo"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/core"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
 	ethparams "github.com/ethereum/go-ethereum/params"
 
 	evmcommontypes "swisstronik/types"
@@ -47,6 +54,12 @@ var _ types.QueryServer = Keeper{}
 
 const (
 	defaultTraceTimeout = 5 * time.Second
+	// maxSimulateCalls limits amount of calls in a single `eth_simulateV1` request
+	maxSimulateCalls = 256
+	// maxPrivateStorageKeys limits amount of storage keys in a single private storage request
+	maxPrivateStorageKeys = 256
+	// maxAccessListIterations limits amount of executions in `eth_createAccessList`
+	maxAccessListIterations = 16
 )
 
 // Account implements the Query/Account gRPC method
@@ -161,7 +174,54 @@ func (k Keeper) Balance(c context.Context, req *types.QueryBalanceRequest) (*typ
 
 // Storage implements the Query/Storage gRPC method
 func (k Keeper) Storage(c context.Context, req *types.QueryStorageRequest) (*types.QueryStorageResponse, error) {
-	return nil, status.Error(codes.Unavailable, "Storage request was disabled, since storage is encrypted. Check docs at https://swisstronik.gitbook.io/swisstronik-docs/ for more information")
+	return nil, status.Error(codes.Unavailable, "Storage request was disabled, since storage is encrypted. Use PrivateStorage request, signed by the contract deployer, instead. Check docs at https://swisstronik.gitbook.io/swisstronik-docs/ for more information")
+}
+
+// PrivateStorage implements the Query/PrivateStorage gRPC method. Request should be signed by the account,
+// which is allowed to read storage of the contract. Storage values are decrypted by SGXVM and encrypted
+// to the public key of the requester, so they are not revealed to the node operator
+func (k Keeper) PrivateStorage(c context.Context, req *types.QueryPrivateStorageRequest) (*types.QueryPrivateStorageResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := evmcommontypes.ValidateAddress(req.Address); err != nil {
+		return nil, status.Error(
+			codes.InvalidArgument,
+			types.ErrZeroAddress.Error(),
+		)
+	}
+	if len(req.Keys) == 0 || len(req.Keys) > maxPrivateStorageKeys {
+		return nil, status.Errorf(codes.InvalidArgument, "expected from 1 to %d keys, got %d", maxPrivateStorageKeys, len(req.Keys))
+	}
+	if len(req.PublicKey) != curve25519.PointSize {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid public key length, expected %d, got %d", curve25519.PointSize, len(req.PublicKey))
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	keys := make([][]byte, 0, len(req.Keys))
+	for _, key := range req.Keys {
+		keys = append(keys, common.HexToHash(key).Bytes())
+	}
+
+	blockNumber := uint64(ctx.BlockHeight())
+	nodePublicKey, err := k.GetNodePublicKey(blockNumber)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	// Signature and access of the signer are verified by SGXVM, which decrypts storage cells only if the signer
+	// is the deployer of the contract or is allowed by the contract to read its storage
+	encryptedValues, err := k.readPrivateStorage(ctx, common.HexToAddress(req.Address), keys, req.PublicKey, req.Expiry, req.Signature)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryPrivateStorageResponse{
+		Values:        encryptedValues,
+		NodePublicKey: nodePublicKey.Hex(),
+	}, nil
 }
 
 // Code implements the Query/Code gRPC method
@@ -224,6 +284,20 @@ func (k Keeper) EthCall(c context.Context, req *types.EthCallRequest) (*types.Ms
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
+	// Overrides are applied to the cached context, which is never committed
+	ctx, err = k.contextWithStateOverride(ctx, req.Overrides)
+	if err != nil {
+		return nil, err
+	}
+
+	// Permit should be verified before the sender is used, since only permitted sender
+	// is taken from `from` field of call arguments
+	if args.Permit != nil {
+		if err := args.VerifyPermit(chainID, ctx.BlockTime()); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+
 	// ApplyMessageWithConfig expect correct nonce set in msg
 	nonce := k.GetNonce(ctx, args.GetFrom())
 	args.Nonce = (*hexutil.Uint64)(&nonce)
@@ -239,6 +313,7 @@ func (k Keeper) EthCall(c context.Context, req *types.EthCallRequest) (*types.Ms
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
+	txContext.AuthenticatedSender = args.IsAuthenticated()
 	// pass false to not commit StateDB
 	res, err := k.ApplyMessageWithConfig(ctx, msg, false, cfg, txConfig, txContext, req.Unencrypted)
 	if err != nil {
@@ -248,6 +323,208 @@ func (k Keeper) EthCall(c context.Context, req *types.EthCallRequest) (*types.Ms
 	return res, nil
 }
 
+// EthSimulate implements eth_simulateV1 rpc api. Calls are executed one by one in the shared cached
+// context, so each call observes state changes of the previous successful calls. Gas cap limits the
+// total gas used by all calls
+func (k Keeper) EthSimulate(c context.Context, req *types.EthSimulateRequest) (*types.EthSimulateResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if len(req.Calls) > maxSimulateCalls {
+		return nil, status.Errorf(codes.InvalidArgument, "too many calls: %d, limit is %d", len(req.Calls), maxSimulateCalls)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	chainID, err := getChainID(ctx, req.ChainId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	cfg, err := k.EVMConfig(ctx, GetProposerAddress(ctx, req.ProposerAddress), chainID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	// Calls are applied to the cached context, which is never committed
+	ctx, _ = ctx.CacheContext()
+	ctx, err = k.contextWithStateOverride(ctx, req.Overrides)
+	if err != nil {
+		return nil, err
+	}
+
+	gasCap := req.GasCap
+	txConfig := types.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash()))
+	results := make([]*types.MsgEthereumTxResponse, 0, len(req.Calls))
+	for i, bz := range req.Calls {
+		var args types.CallArgs
+		if err := json.Unmarshal(bz, &args); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "call %d: %s", i, err.Error())
+		}
+
+		// Permit should be verified before the sender is used, since only permitted sender
+		// is taken from `from` field of call arguments
+		if args.Permit != nil {
+			if err := args.VerifyPermit(chainID, ctx.BlockTime()); err != nil {
+				return nil, status.Errorf(codes.InvalidArgument, "call %d: %s", i, err.Error())
+			}
+		}
+
+		// ApplyMessageWithConfig expect correct nonce set in msg
+		nonce := k.GetNonce(ctx, args.GetFrom())
+		args.Nonce = (*hexutil.Uint64)(&nonce)
+
+		msg, err := args.ToMessage(gasCap, cfg.BaseFee)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "call %d: %s", i, err.Error())
+		}
+
+		txContext, err := CreateSGXVMContextFromMessage(ctx, &k, msg)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		txContext.AuthenticatedSender = args.IsAuthenticated()
+
+		txConfig.TxIndex = uint(i)
+		// pass true to write state changes of the call to the cached context, so they are observed
+		// by the next calls
+		res, err := k.ApplyMessageWithConfig(ctx, msg, true, cfg, txConfig, txContext, req.Unencrypted)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "call %d: %s", i, err.Error())
+		}
+		txConfig.LogIndex += uint(len(res.Logs))
+		results = append(results, res)
+
+		if req.GasCap != 0 {
+			if gasCap <= res.GasUsed && i != len(req.Calls)-1 {
+				return nil, status.Errorf(codes.InvalidArgument, "gas cap %d exhausted after call %d", req.GasCap, i)
+			}
+			gasCap -= res.GasUsed
+		}
+	}
+
+	return &types.EthSimulateResponse{Results: results}, nil
+}
+
+// CreateAccessList implements eth_createAccessList rpc api. The call is executed repeatedly with access
+// list, reported by SGXVM during previous execution, until the list stops changing, since provided
+// access list may change the execution path. Sender, recipient and precompiles are excluded from the list
+func (k Keeper) CreateAccessList(c context.Context, req *types.EthCallRequest) (*types.CreateAccessListResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var args types.CallArgs
+	err := json.Unmarshal(req.Args, &args)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	chainID, err := getChainID(ctx, req.ChainId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	cfg, err := k.EVMConfig(ctx, GetProposerAddress(ctx, req.ProposerAddress), chainID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	// Overrides are applied to the cached context, which is never committed
+	ctx, err = k.contextWithStateOverride(ctx, req.Overrides)
+	if err != nil {
+		return nil, err
+	}
+
+	// Permit should be verified before the sender is used, since only permitted sender
+	// is taken from `from` field of call arguments
+	if args.Permit != nil {
+		if err := args.VerifyPermit(chainID, ctx.BlockTime()); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+
+	from := args.GetFrom()
+	nonce := k.GetNonce(ctx, from)
+	args.Nonce = (*hexutil.Uint64)(&nonce)
+
+	excluded := map[common.Address]struct{}{from: {}}
+	if args.To != nil {
+		excluded[*args.To] = struct{}{}
+	} else {
+		excluded[crypto.CreateAddress(from, nonce)] = struct{}{}
+	}
+
+	accessList := ethtypes.AccessList{}
+	if args.AccessList != nil {
+		accessList = *args.AccessList
+	}
+
+	txConfig := types.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash()))
+	for i := 0; i < maxAccessListIterations; i++ {
+		args.AccessList = &accessList
+		msg, err := args.ToMessage(req.GasCap, cfg.BaseFee)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+
+		txContext, err := CreateSGXVMContextFromMessage(ctx, &k, msg)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		txContext.AuthenticatedSender = args.IsAuthenticated()
+
+		// pass false to not commit StateDB
+		res, sgxvmRes, err := k.applyMessage(ctx, msg, false, cfg, txConfig, txContext, req.Unencrypted, nil, nil)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+
+		nextAccessList := filterAccessList(accessListFromSGXVM(sgxvmRes.AccessList), excluded)
+		if reflect.DeepEqual(accessList, nextAccessList) {
+			return &types.CreateAccessListResponse{
+				AccessList: types.NewAccessList(&accessList),
+				GasUsed:    res.GasUsed,
+				VmError:    res.VmError,
+			}, nil
+		}
+		accessList = nextAccessList
+	}
+
+	return nil, status.Errorf(codes.Internal, "access list didn't converge after %d iterations", maxAccessListIterations)
+}
+
+// filterAccessList removes excluded addresses from the access list, reported by SGXVM
+func filterAccessList(accessList ethtypes.AccessList, excluded map[common.Address]struct{}) ethtypes.AccessList {
+	filtered := make(ethtypes.AccessList, 0, len(accessList))
+	for _, tuple := range accessList {
+		if _, found := excluded[tuple.Address]; found {
+			continue
+		}
+		filtered = append(filtered, tuple)
+	}
+	return filtered
+}
+
+// contextWithStateOverride decodes state override from the request and applies it to the cached copy
+// of provided context. Original context is returned if there is no override
+func (k Keeper) contextWithStateOverride(ctx sdk.Context, bz []byte) (sdk.Context, error) {
+	overrides, err := unmarshalStateOverride(bz)
+	if err != nil {
+		return ctx, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if len(overrides) == 0 {
+		return ctx, nil
+	}
+
+	cacheCtx, _ := ctx.CacheContext()
+	if err := k.ApplyStateOverride(cacheCtx, overrides); err != nil {
+		if errors.Is(err, types.ErrInvalidStateOverride) {
+			return ctx, status.Error(codes.InvalidArgument, err.Error())
+		}
+		return ctx, status.Error(codes.Internal, err.Error())
+	}
+	return cacheCtx, nil
+}
+
 // EstimateGas implements eth_estimateGas rpc api.
 func (k Keeper) EstimateGas(c context.Context, req *types.EthCallRequest) (*types.EstimateGasResponse, error) {
 	if req == nil {
@@ -264,7 +541,7 @@ func (k Keeper) EstimateGas(c context.Context, req *types.EthCallRequest) (*type
 		return nil, status.Error(codes.InvalidArgument, "gas cap cannot be lower than 21,000")
 	}
 
-	var args types.TransactionArgs
+	var args types.CallArgs
 	err = json.Unmarshal(req.Args, &args)
 	if err != nil {
 		return nil, status.Error(codes.InvalidArgument, err.Error())
@@ -302,6 +579,20 @@ func (k Keeper) EstimateGas(c context.Context, req *types.EthCallRequest) (*type
 		return nil, status.Error(codes.Internal, "failed to load evm config")
 	}
 
+	// Overrides are applied to the cached context, which is never committed
+	ctx, err = k.contextWithStateOverride(ctx, req.Overrides)
+	if err != nil {
+		return nil, err
+	}
+
+	// Permit should be verified before the sender is used, since only permitted sender
+	// is taken from `from` field of call arguments
+	if args.Permit != nil {
+		if err := args.VerifyPermit(chainID, ctx.BlockTime()); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+
 	// ApplyMessageWithConfig expect correct nonce set in msg
 	nonce := k.GetNonce(ctx, args.GetFrom())
 	args.Nonce = (*hexutil.Uint64)(&nonce)
@@ -338,6 +629,7 @@ func (k Keeper) EstimateGas(c context.Context, req *types.EthCallRequest) (*type
 		if err != nil {
 			return true, nil, err
 		}
+		txContext.AuthenticatedSender = args.IsAuthenticated()
 
 		// pass false to not commit StateDB
 		rsp, err = k.ApplyMessageWithConfig(ctx, msg, false, cfg, txConfig, txContext, req.Unencrypted)
@@ -389,6 +681,92 @@ func (k Keeper) TraceTx(c context.Context, req *types.QueryTraceTxRequest) (*typ
 		return nil, status.Errorf(codes.InvalidArgument, "output limit cannot be negative, got %d", req.TraceConfig.Limit)
 	}
 
+	ctx, cfg, signer, txConfig, err := k.prepareTraceTx(c, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var tracerConfig json.RawMessage
+	if req.TraceConfig != nil && req.TraceConfig.TracerJsonConfig != "" {
+		// ignore error. default to no traceConfig
+		_ = json.Unmarshal([]byte(req.TraceConfig.TracerJsonConfig), &tracerConfig)
+	}
+
+	result, _, err := k.traceTx(ctx, cfg, txConfig, signer, req.Msg.AsTransaction(), req.TraceConfig, false, tracerConfig, req.Unencrypted)
+	if err != nil {
+		// error will be returned with detail status from traceTx
+		return nil, err
+	}
+
+	resultData, err := json.Marshal(result)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryTraceTxResponse{
+		Data: resultData,
+	}, nil
+}
+
+// TraceTxPrivate re-executes the given encrypted transaction and returns call tree of its decrypted execution
+// together with revert reasons. Tracing should be authorized by EIP-712 `TraceTransaction` signature of the
+// transaction sender, which is verified by SGXVM. Call tree is collected inside SGXVM and returned encrypted
+// with the key of the sender, so it can be decrypted using `DecryptECDH` with node public key
+func (k Keeper) TraceTxPrivate(c context.Context, req *types.QueryTraceTxRequest) (*types.QueryTraceTxResponse, error) {
+	if req == nil || req.Msg == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	tx := req.Msg.AsTransaction()
+	if tx.To() == nil || len(tx.Data()) < curve25519.PointSize {
+		return nil, status.Error(codes.InvalidArgument, "transaction is not encrypted, use regular tracing instead")
+	}
+	txBytes, err := tx.MarshalBinary()
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	// transaction is decrypted by SGXVM, therefore it cannot be treated as unencrypted
+	req.Unencrypted = false
+	ctx, cfg, signer, txConfig, err := k.prepareTraceTx(c, req)
+	if err != nil {
+		return nil, err
+	}
+
+	msg, err := tx.AsMessage(signer, cfg.BaseFee)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	txContext, err := CreateSGXVMContextFromMessage(ctx, &k, msg)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	traceAuthorization := &librustgo.TraceAuthorization{
+		Transaction: txBytes,
+		Signature:   req.TraceSignature,
+	}
+	res, sgxvmRes, err := k.applyMessage(ctx, msg, false, cfg, txConfig, txContext, false, nil, traceAuthorization)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	// SGXVM returns call tree only if tracing was authorized by the sender of the transaction
+	if len(sgxvmRes.EncryptedTrace) == 0 {
+		return nil, status.Errorf(codes.PermissionDenied, "tracing is not authorized: %s", res.VmError)
+	}
+
+	return &types.QueryTraceTxResponse{
+		Data: sgxvmRes.EncryptedTrace,
+	}, nil
+}
+
+// prepareTraceTx returns the context of block beginning with all predecessors of traced transaction
+// applied, and transaction config of traced transaction
+func (k Keeper) prepareTraceTx(
+	c context.Context,
+	req *types.QueryTraceTxRequest,
+) (sdk.Context, *types.EVMConfig, ethtypes.Signer, types.TxConfig, error) {
 	// get the context of block beginning
 	contextHeight := req.BlockNumber
 	if contextHeight < 1 {
@@ -402,11 +780,11 @@ func (k Keeper) TraceTx(c context.Context, req *types.QueryTraceTxRequest) (*typ
 	ctx = ctx.WithHeaderHash(common.Hex2Bytes(req.BlockHash))
 	chainID, err := getChainID(ctx, req.ChainId)
 	if err != nil {
-		return nil, status.Error(codes.InvalidArgument, err.Error())
+		return ctx, nil, nil, types.TxConfig{}, status.Error(codes.InvalidArgument, err.Error())
 	}
 	cfg, err := k.EVMConfig(ctx, GetProposerAddress(ctx, req.ProposerAddress), chainID)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to load evm config: %s", err.Error())
+		return ctx, nil, nil, types.TxConfig{}, status.Errorf(codes.Internal, "failed to load evm config: %s", err.Error())
 	}
 	signer := ethtypes.MakeSigner(cfg.ChainConfig, big.NewInt(ctx.BlockHeight()))
 
@@ -431,21 +809,67 @@ func (k Keeper) TraceTx(c context.Context, req *types.QueryTraceTxRequest) (*typ
 		txConfig.LogIndex += uint(len(rsp.Logs))
 	}
 
-	tx := req.Msg.AsTransaction()
-	txConfig.TxHash = tx.Hash()
+	txConfig.TxHash = req.Msg.AsTransaction().Hash()
 	if len(req.Predecessors) > 0 {
 		txConfig.TxIndex++
 	}
 
+	return ctx, cfg, signer, txConfig, nil
+}
+
+// TraceCall configures a new tracer according to the provided configuration, and executes the given
+// call arguments on top of the queried state. State changes are not persisted.
+// The return value will be tracer dependent.
+func (k Keeper) TraceCall(c context.Context, req *types.QueryTraceCallRequest) (*types.QueryTraceTxResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.TraceConfig != nil && req.TraceConfig.Limit < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "output limit cannot be negative, got %d", req.TraceConfig.Limit)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var args types.CallArgs
+	err := json.Unmarshal(req.Args, &args)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	chainID, err := getChainID(ctx, req.ChainId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	cfg, err := k.EVMConfig(ctx, GetProposerAddress(ctx, req.ProposerAddress), chainID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to load evm config: %s", err.Error())
+	}
+
+	// ApplyMessageWithConfig expect correct nonce set in msg
+	nonce := k.GetNonce(ctx, args.GetFrom())
+	args.Nonce = (*hexutil.Uint64)(&nonce)
+
+	msg, err := args.ToMessage(req.GasCap, cfg.BaseFee)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
 	var tracerConfig json.RawMessage
 	if req.TraceConfig != nil && req.TraceConfig.TracerJsonConfig != "" {
 		// ignore error. default to no traceConfig
 		_ = json.Unmarshal([]byte(req.TraceConfig.TracerJsonConfig), &tracerConfig)
 	}
 
-	result, _, err := k.traceTx(ctx, cfg, txConfig, signer, tx, req.TraceConfig, false, tracerConfig, req.Unencrypted)
+	txContext, err := CreateSGXVMContextFromMessage(ctx, &k, msg)
 	if err != nil {
-		// error will be returned with detail status from traceTx
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	txContext.AuthenticatedSender = args.IsAuthenticated()
+
+	txConfig := types.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash()))
+	result, _, err := k.traceMsg(ctx, cfg, txConfig, msg, txContext, req.TraceConfig, false, tracerConfig, req.Unencrypted)
+	if err != nil {
+		// error will be returned with detail status from traceMsg
 		return nil, err
 	}
 
@@ -532,6 +956,32 @@ func (k *Keeper) traceTx(
 	commitMessage bool,
 	tracerJSONConfig json.RawMessage,
 	isUnencrypted bool,
+) (*interface{}, uint, error) {
+	msg, err := tx.AsMessage(signer, cfg.BaseFee)
+	if err != nil {
+		return nil, 0, status.Error(codes.Internal, err.Error())
+	}
+
+	txContext, err := CreateSGXVMContextFromMessage(ctx, k, msg)
+	if err != nil {
+		return nil, 0, status.Error(codes.Internal, err.Error())
+	}
+
+	return k.traceMsg(ctx, cfg, txConfig, msg, txContext, traceConfig, commitMessage, tracerJSONConfig, isUnencrypted)
+}
+
+// traceMsg do trace on one message, it returns a tuple: (traceResult, nextLogIndex, error).
+// Opcodes and call frames are reported by SGXVM only for unencrypted execution
+func (k *Keeper) traceMsg(
+	ctx sdk.Context,
+	cfg *types.EVMConfig,
+	txConfig types.TxConfig,
+	msg core.Message,
+	txContext *librustgo.TransactionContext,
+	traceConfig *types.TraceConfig,
+	commitMessage bool,
+	tracerJSONConfig json.RawMessage,
+	isUnencrypted bool,
 ) (*interface{}, uint, error) {
 	// Assemble the structured logger or the JavaScript tracer
 	var (
@@ -540,10 +990,6 @@ func (k *Keeper) traceTx(
 		err       error
 		timeout   = defaultTraceTimeout
 	)
-	msg, err := tx.AsMessage(signer, cfg.BaseFee)
-	if err != nil {
-		return nil, 0, status.Error(codes.Internal, err.Error())
-	}
 
 	if traceConfig == nil {
 		traceConfig = &types.TraceConfig{}
@@ -595,11 +1041,7 @@ func (k *Keeper) traceTx(
 		}
 	}()
 
-	txContext, err := CreateSGXVMContextFromMessage(ctx, k, msg)
-	if err != nil {
-		return nil, 0, status.Error(codes.Internal, err.Error())
-	}
-	res, err := k.ApplyMessageWithConfig(ctx, msg, commitMessage, cfg, txConfig, txContext, isUnencrypted)
+	res, _, err := k.applyMessage(ctx, msg, commitMessage, cfg, txConfig, txContext, isUnencrypted, tracer, nil)
 	if err != nil {
 		return nil, 0, status.Error(codes.Internal, err.Error())
 	}
@@ -641,6 +1083,69 @@ func (k Keeper) NodePublicKey(ctx context.Context, req *types.QueryNodePublicKey
 	return res, nil
 }
 
+// Epochs implements the Query/Epochs gRPC method
+func (k Keeper) Epochs(_ context.Context, _ *types.QueryEpochsRequest) (*types.QueryEpochsResponse, error) {
+	epochs := k.GetEpochs()
+
+	res := &types.QueryEpochsResponse{Epochs: make([]types.EpochData, 0, len(epochs))}
+	for _, epoch := range epochs {
+		res.Epochs = append(res.Epochs, types.EpochData{
+			EpochNumber:   epoch.GetEpochNumber(),
+			StartingBlock: epoch.GetStartingBlock(),
+			NodePublicKey: common.BytesToHash(epoch.GetNodePublicKey()).Hex(),
+		})
+	}
+	return res, nil
+}
+
+// EpochSchedule implements the Query/EpochSchedule gRPC method
+func (k Keeper) EpochSchedule(c context.Context, _ *types.QueryEpochScheduleRequest) (*types.QueryEpochScheduleResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	return &types.QueryEpochScheduleResponse{StartingBlocks: k.GetScheduledEpochs(ctx)}, nil
+}
+
+// ContractSources implements the Query/ContractSources gRPC method
+func (k Keeper) ContractSources(c context.Context, req *types.QueryContractSourcesRequest) (*types.QueryContractSourcesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := evmcommontypes.ValidateAddress(req.Address); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	return &types.QueryContractSourcesResponse{
+		Sources: k.GetContractSources(ctx, common.HexToAddress(req.Address)),
+	}, nil
+}
+
+// VerifiedContract implements the Query/VerifiedContract gRPC method. It is served from verification
+// results, stored by the node in background, so the query is not available on nodes without configured
+// solc binary.
+func (k Keeper) VerifiedContract(c context.Context, req *types.QueryVerifiedContractRequest) (*types.QueryVerifiedContractResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := evmcommontypes.ValidateAddress(req.Address); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	verified, err := k.VerifyContract(ctx, common.HexToAddress(req.Address))
+	switch {
+	case errors.Is(err, types.ErrContractVerificationDisabled):
+		return nil, status.Error(codes.Unavailable, err.Error())
+	case errors.Is(err, types.ErrContractNotVerified):
+		return nil, status.Error(codes.NotFound, err.Error())
+	case err != nil:
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryVerifiedContractResponse{Contract: *verified}, nil
+}
+
 // getChainID parse chainID from current context if not provided
 func getChainID(ctx sdk.Context, chainID int64) (*big.Int, error) {
 	if chainID == 0 {
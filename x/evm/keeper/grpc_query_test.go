@@ -352,6 +352,22 @@ func (suite *KeeperTestSuite) TestQueryParams() {
 	suite.Require().Equal(expParams, res.Params)
 }
 
+func (suite *KeeperTestSuite) TestQueryEpochs() {
+	ctx := sdk.WrapSDKContext(suite.ctx)
+
+	res, err := suite.queryClient.Epochs(ctx, &types.QueryEpochsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().NotEmpty(res.Epochs)
+
+	epochs := suite.app.EvmKeeper.GetEpochs()
+	suite.Require().Len(res.Epochs, len(epochs))
+	for i, epoch := range epochs {
+		suite.Require().Equal(epoch.EpochNumber, res.Epochs[i].EpochNumber)
+		suite.Require().Equal(epoch.StartingBlock, res.Epochs[i].StartingBlock)
+		suite.Require().Equal(common.BytesToHash(epoch.NodePublicKey).Hex(), res.Epochs[i].NodePublicKey)
+	}
+}
+
 func (suite *KeeperTestSuite) TestQueryValidatorAccount() {
 	var (
 		req        *types.QueryValidatorAccountRequest
@@ -531,7 +547,14 @@ func (suite *KeeperTestSuite) TestEstimateGas() {
 				suite.Require().NoError(err)
 				encryptedTransferData, err := deoxys.EncryptECDH(suite.privateKey, suite.nodePublicKey, transferData)
 				suite.Require().NoError(err)
-				args = types.TransactionArgs{To: &contractAddr, From: &suite.address, Data: (*hexutil.Bytes)(&encryptedTransferData)}
+				// sender of the estimated call is authenticated by the permit
+				expiry := uint64(suite.ctx.BlockTime().Unix()) + 3600
+				args = types.CallArgs{
+					To:     &contractAddr,
+					From:   &suite.address,
+					Data:   (*hexutil.Bytes)(&encryptedTransferData),
+					Permit: &types.CallPermit{Expiry: hexutil.Uint64(expiry), Signature: suite.signPermit(suite.address, contractAddr, expiry)},
+				}
 			},
 			true,
 			49080,
@@ -609,7 +632,14 @@ func (suite *KeeperTestSuite) TestEstimateGas() {
 				suite.Require().NoError(err)
 				encryptedTransferData, err := deoxys.EncryptECDH(suite.privateKey, suite.nodePublicKey, transferData)
 				suite.Require().NoError(err)
-				args = types.TransactionArgs{To: &contractAddr, From: &suite.address, Data: (*hexutil.Bytes)(&encryptedTransferData)}
+				// sender of the estimated call is authenticated by the permit
+				expiry := uint64(suite.ctx.BlockTime().Unix()) + 3600
+				args = types.CallArgs{
+					To:     &contractAddr,
+					From:   &suite.address,
+					Data:   (*hexutil.Bytes)(&encryptedTransferData),
+					Permit: &types.CallPermit{Expiry: hexutil.Uint64(expiry), Signature: suite.signPermit(suite.address, contractAddr, expiry)},
+				}
 			},
 			true,
 			49080,
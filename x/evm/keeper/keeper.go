@@ -22,13 +22,17 @@ import (
 	errorsmod "cosmossdk.io/errors"
 	sdkmath "cosmossdk.io/math"
 	"github.com/SigmaGmbH/librustgo"
-	rustgotypes "github.com/SigmaGmbH/librustgo/types"
 	"github.com/cometbft/cometbft/libs/log"
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
 	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
@@ -66,6 +70,19 @@ type Keeper struct {
 	feeMarketKeeper types.FeeMarketKeeper
 	// access to x/compliance module
 	ComplianceKeeper types.ComplianceKeeper
+	// handle delegations and withdrawal of rewards, requested by staking and distribution precompiles
+	stakingMsgServer stakingtypes.MsgServer
+	distrMsgServer   distrtypes.MsgServer
+	// handle coin transfers, requested by bank and ICS-20 precompiles
+	bankMsgServer     banktypes.MsgServer
+	transferMsgServer ibctransfertypes.MsgServer
+	// handle votes and deposits, requested by governance precompile, and provide access to proposals
+	govMsgServer govv1.MsgServer
+	govKeeper    types.GovKeeper
+	// restores refunded fees of fee delegated transactions to allowances of fee payers
+	feegrantKeeper types.FeegrantKeeper
+	// verifies submitted contract sources. If nil, verification is disabled on this node
+	contractVerifier *ContractVerifier
 
 	// chain ID number obtained from the context's chain id
 	eip155ChainID *big.Int
@@ -76,8 +93,9 @@ type Keeper struct {
 	// Legacy subspace
 	ss paramstypes.Subspace
 
-	// list of epoch data which includes epoch number, starting block and relevant node public key
-	epochs []*rustgotypes.EpochData
+	// list of epoch data which includes epoch number, starting block and relevant node public key.
+	// It is reloaded from the enclave in BeginBlock
+	epochs *epochCache
 }
 
 // NewKeeper generates new evm module keeper
@@ -119,7 +137,7 @@ func NewKeeper(
 		storeKey:         storeKey,
 		transientKey:     transientKey,
 		ss:               ss,
-		epochs:           epochs,
+		epochs:           newEpochCache(epochs),
 	}
 }
 
@@ -162,6 +180,17 @@ func (k Keeper) EmitBlockBloomEvent(ctx sdk.Context, bloom ethtypes.Bloom) {
 	)
 }
 
+// EmitBlockStateRootEvent emits intermediate state root after the last EVM transaction of the block. It is
+// a commitment over EVM store writes, see types.IntermediateStateRoot
+func (k Keeper) EmitBlockStateRootEvent(ctx sdk.Context, stateRoot common.Hash) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeBlockStateRoot,
+			sdk.NewAttribute(types.AttributeKeyStateRoot, stateRoot.Hex()),
+		),
+	)
+}
+
 // GetAuthority returns the x/evm module authority address
 func (k Keeper) GetAuthority() sdk.AccAddress {
 	return k.authority
@@ -230,6 +259,24 @@ func (k Keeper) SetLogSizeTransient(ctx sdk.Context, logSize uint64) {
 	store.Set(types.KeyPrefixTransientLogSize, sdk.Uint64ToBigEndian(logSize))
 }
 
+// GetStateRootTransient returns intermediate state root after the last EVM transaction of the current
+// block. If there were no EVM transactions yet, app hash of the block header is returned
+func (k Keeper) GetStateRootTransient(ctx sdk.Context) common.Hash {
+	store := ctx.TransientStore(k.transientKey)
+	bz := store.Get(types.KeyPrefixTransientStateRoot)
+	if len(bz) == 0 {
+		return common.BytesToHash(ctx.BlockHeader().AppHash)
+	}
+
+	return common.BytesToHash(bz)
+}
+
+// SetStateRootTransient sets intermediate state root after the last EVM transaction of the current block
+func (k Keeper) SetStateRootTransient(ctx sdk.Context, stateRoot common.Hash) {
+	store := ctx.TransientStore(k.transientKey)
+	store.Set(types.KeyPrefixTransientStateRoot, stateRoot.Bytes())
+}
+
 // ----------------------------------------------------------------------------
 // Storage
 // ----------------------------------------------------------------------------
@@ -261,6 +308,51 @@ func (k *Keeper) SetHooks(eh types.EvmHooks) *Keeper {
 	return k
 }
 
+// SetStakingMsgServers sets message servers of x/staking and x/distribution modules, which handle
+// requests of staking and distribution precompiles. Message servers should be created after staking hooks
+// are set, otherwise delegations, made by contracts, won't be tracked by other modules
+func (k *Keeper) SetStakingMsgServers(stakingMsgServer stakingtypes.MsgServer, distrMsgServer distrtypes.MsgServer) *Keeper {
+	k.stakingMsgServer = stakingMsgServer
+	k.distrMsgServer = distrMsgServer
+	return k
+}
+
+// SetTransferMsgServers sets message servers of x/bank and ICS-20 transfer modules, which handle
+// requests of bank and ICS-20 precompiles
+func (k *Keeper) SetTransferMsgServers(bankMsgServer banktypes.MsgServer, transferMsgServer ibctransfertypes.MsgServer) *Keeper {
+	k.bankMsgServer = bankMsgServer
+	k.transferMsgServer = transferMsgServer
+	return k
+}
+
+// SetGovKeepers sets message server and keeper of x/gov module, which handle requests of governance precompile
+func (k *Keeper) SetGovKeepers(govMsgServer govv1.MsgServer, govKeeper types.GovKeeper) *Keeper {
+	k.govMsgServer = govMsgServer
+	k.govKeeper = govKeeper
+	return k
+}
+
+// SetFeegrantKeeper sets keeper of x/feegrant module, which holds allowances, granted by fee payers of fee
+// delegated transactions
+func (k *Keeper) SetFeegrantKeeper(feegrantKeeper types.FeegrantKeeper) *Keeper {
+	k.feegrantKeeper = feegrantKeeper
+	return k
+}
+
+// SetContractVerifier sets verifier of submitted contract sources. Verification is performed in background
+// and its results are node-local, so they don't affect consensus
+func (k *Keeper) SetContractVerifier(contractVerifier *ContractVerifier) *Keeper {
+	k.contractVerifier = contractVerifier
+	return k
+}
+
+// CleanHooks resets the hooks for the EVM module
+// NOTE: Should only be used for testing purposes
+func (k *Keeper) CleanHooks() *Keeper {
+	k.hooks = nil
+	return k
+}
+
 // PostTxProcessing delegate the call to the hooks. If no hook has been registered, this function returns with a `nil` error
 func (k *Keeper) PostTxProcessing(ctx sdk.Context, msg core.Message, receipt *ethtypes.Receipt) error {
 	if k.hooks == nil {
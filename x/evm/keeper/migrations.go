@@ -0,0 +1,23 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"swisstronik/x/evm/migrations/v1_0_4"
+)
+
+// Migrator handles in-place store migrations of the evm module
+type Migrator struct {
+	keeper *Keeper
+}
+
+func NewMigrator(keeper *Keeper) Migrator {
+	return Migrator{
+		keeper: keeper,
+	}
+}
+
+// Migrate5to6 migrates the store of the evm module from consensus version 5 to 6
+func (m Migrator) Migrate5to6(ctx sdk.Context) error {
+	return v1_0_4.MigrateStore(ctx, m.keeper, m.keeper.stakingKeeper)
+}
@@ -0,0 +1,36 @@
+package keeper_test
+
+import (
+	tmtypes "github.com/cometbft/cometbft/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"swisstronik/x/evm/keeper"
+	"swisstronik/x/evm/types"
+)
+
+func (suite *KeeperTestSuite) TestMigrate5to6() {
+	header := suite.ctx.BlockHeader()
+	h, err := tmtypes.HeaderFromProto(&header)
+	suite.Require().NoError(err)
+	hash := common.BytesToHash(h.Hash())
+
+	// historical info of blocks in BLOCKHASH window and older one
+	height := int64(2 + types.BlockHashRingSize)
+	for _, histHeight := range []int64{1, 2, height - 1} {
+		suite.app.StakingKeeper.SetHistoricalInfo(suite.ctx, histHeight, &stakingtypes.HistoricalInfo{Header: header})
+	}
+	ctx := suite.ctx.WithBlockHeight(height)
+
+	suite.Require().NoError(keeper.NewMigrator(suite.app.EvmKeeper).Migrate5to6(ctx))
+
+	for _, histHeight := range []uint64{2, uint64(height - 1)} {
+		migrated, found := suite.app.EvmKeeper.GetBlockHash(ctx, histHeight)
+		suite.Require().True(found)
+		suite.Require().Equal(hash, migrated)
+	}
+	_, found := suite.app.EvmKeeper.GetBlockHash(ctx, 1)
+	suite.Require().False(found)
+	_, found = suite.app.EvmKeeper.GetBlockHash(ctx, 3)
+	suite.Require().False(found)
+}
@@ -17,10 +17,13 @@ package keeper
 
 import (
 	"context"
+	"strconv"
+
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 
 	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
 
 	"swisstronik/x/evm/types"
 )
@@ -43,3 +46,83 @@ func (k *Keeper) UpdateParams(goCtx context.Context, req *types.MsgUpdateParams)
 
 	return &types.MsgUpdateParamsResponse{}, nil
 }
+
+// ScheduleEpoch implements the gRPC MsgServer interface. When a ScheduleEpoch
+// proposal passes, it records starting block of new epoch, which should be after
+// all epochs, known by the enclave. New epoch is added to the enclave in BeginBlock
+// of the starting block. The update can only be performed if the requested
+// authority is the Cosmos SDK governance module account.
+func (k *Keeper) ScheduleEpoch(goCtx context.Context, req *types.MsgScheduleEpoch) (*types.MsgScheduleEpochResponse, error) {
+	if k.authority.String() != req.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority, expected %s, got %s", k.authority.String(), req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if req.StartingBlock <= uint64(ctx.BlockHeight()) {
+		return nil, errorsmod.Wrapf(types.ErrInvalidEpoch, "starting block %d must be greater than current block %d", req.StartingBlock, ctx.BlockHeight())
+	}
+
+	if k.HasScheduledEpoch(ctx, req.StartingBlock) {
+		return nil, errorsmod.Wrapf(types.ErrInvalidEpoch, "epoch with starting block %d is already scheduled", req.StartingBlock)
+	}
+
+	if err := k.validateEpochStartingBlock(req.StartingBlock); err != nil {
+		return nil, err
+	}
+
+	k.SetScheduledEpoch(ctx, req.StartingBlock)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeScheduleEpoch,
+			sdk.NewAttribute(types.AttributeKeyStartingBlock, strconv.FormatUint(req.StartingBlock, 10)),
+		),
+	)
+
+	return &types.MsgScheduleEpochResponse{}, nil
+}
+
+// SubmitContractSource implements the gRPC MsgServer interface. It stores contract sources, compiler
+// settings and metadata, which are verified against deployed bytecode of the contract in background by
+// nodes with configured solc binary. Verification is not performed during transaction execution. Number
+// of sources, submitted for a single contract by accounts other than its deployer, is limited by
+// types.MaxContractSources and types.MaxContractSourcesPerSubmitter.
+func (k *Keeper) SubmitContractSource(goCtx context.Context, req *types.MsgSubmitContractSource) (*types.MsgSubmitContractSourceResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	address := common.HexToAddress(req.Address)
+	account := k.GetAccountWithoutBalance(ctx, address)
+	if account == nil || !account.IsContract() {
+		return nil, errorsmod.Wrapf(types.ErrInvalidContractSource, "%s is not a contract", address.Hex())
+	}
+
+	source := types.NewContractSource(req, ctx.BlockHeight())
+	sourceHash := common.HexToHash(source.SourceHash)
+	if k.HasContractSource(ctx, address, sourceHash) {
+		return nil, errorsmod.Wrapf(types.ErrInvalidContractSource, "sources %s are already submitted for %s", source.SourceHash, address.Hex())
+	}
+
+	submitter, err := sdk.AccAddressFromBech32(req.Submitter)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "invalid submitter address")
+	}
+	// sources of the deployer are always accepted, so other accounts cannot exhaust the limit
+	if deployer, found := k.GetContractDeployer(ctx, address); !found || deployer != common.BytesToAddress(submitter) {
+		if err := k.checkContractSourcesLimit(ctx, address, req.Submitter); err != nil {
+			return nil, err
+		}
+	}
+
+	k.SetContractSource(ctx, source)
+	k.SetContractSourceTransient(ctx, address, sourceHash)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSubmitContractSource,
+			sdk.NewAttribute(types.AttributeKeyContractAddress, address.Hex()),
+			sdk.NewAttribute(types.AttributeKeySourceHash, source.SourceHash),
+		),
+	)
+
+	return &types.MsgSubmitContractSourceResponse{SourceHash: source.SourceHash}, nil
+}
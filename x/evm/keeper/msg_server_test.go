@@ -1,6 +1,7 @@
 package keeper_test
 
 import (
+	"github.com/SigmaGmbH/librustgo"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	"math/big"
@@ -113,3 +114,75 @@ func (suite *KeeperTestSuite) TestUpdateParams() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestScheduleEpoch() {
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	currentBlock := uint64(suite.ctx.BlockHeight())
+
+	testCases := []struct {
+		name      string
+		malleate  func()
+		request   *types.MsgScheduleEpoch
+		expectErr bool
+	}{
+		{
+			name:      "fail - invalid authority",
+			malleate:  func() {},
+			request:   &types.MsgScheduleEpoch{Authority: "foobar", StartingBlock: currentBlock + 10},
+			expectErr: true,
+		},
+		{
+			name:      "fail - starting block is not in the future",
+			malleate:  func() {},
+			request:   &types.MsgScheduleEpoch{Authority: authority, StartingBlock: currentBlock},
+			expectErr: true,
+		},
+		{
+			name: "fail - epoch is already scheduled",
+			malleate: func() {
+				suite.app.EvmKeeper.SetScheduledEpoch(suite.ctx, currentBlock+10)
+			},
+			request:   &types.MsgScheduleEpoch{Authority: authority, StartingBlock: currentBlock + 10},
+			expectErr: true,
+		},
+		{
+			name: "fail - starting block is not after the latest epoch",
+			malleate: func() {
+				suite.Require().NoError(librustgo.AddEpoch(currentBlock + 20))
+				suite.Require().NoError(suite.app.EvmKeeper.RefreshEpochs())
+			},
+			request:   &types.MsgScheduleEpoch{Authority: authority, StartingBlock: currentBlock + 10},
+			expectErr: true,
+		},
+		{
+			name:      "pass - valid ScheduleEpoch msg",
+			malleate:  func() {},
+			request:   &types.MsgScheduleEpoch{Authority: authority, StartingBlock: currentBlock + 10},
+			expectErr: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			epochs := len(suite.app.EvmKeeper.GetEpochs())
+			tc.malleate()
+			defer func() {
+				// remove epochs, added to the enclave by the test case
+				for i := epochs; i < len(suite.app.EvmKeeper.GetEpochs()); i++ {
+					suite.Require().NoError(librustgo.RemoveLatestEpoch())
+				}
+				suite.Require().NoError(suite.app.EvmKeeper.RefreshEpochs())
+			}()
+
+			_, err := suite.app.EvmKeeper.ScheduleEpoch(suite.ctx, tc.request)
+			if tc.expectErr {
+				suite.Require().Error(err)
+			} else {
+				suite.Require().NoError(err)
+				suite.Require().Equal([]uint64{tc.request.StartingBlock}, suite.app.EvmKeeper.GetScheduledEpochs(suite.ctx))
+			}
+		})
+	}
+}
@@ -17,6 +17,7 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/params"
 	"github.com/pkg/errors"
@@ -45,7 +46,8 @@ func (k *Keeper) HandleTx(goCtx context.Context, msg *types.MsgHandleTx) (*types
 		labels = append(labels, telemetry.NewLabel("execution", "call"))
 	}
 
-	response, err := k.ApplySGXVMTransaction(ctx, tx, msg.Unencrypted)
+	feePayer := common.BytesToAddress(msg.GetFeePayer())
+	response, err := k.ApplySGXVMTransaction(ctx, tx, feePayer, msg.Unencrypted)
 	if err != nil {
 		return nil, errorsmod.Wrap(err, "failed to apply transaction")
 	}
@@ -86,6 +88,8 @@ func (k *Keeper) HandleTx(goCtx context.Context, msg *types.MsgHandleTx) (*types
 		sdk.NewAttribute(types.AttributeKeyTxIndex, strconv.FormatUint(txIndex, 10)),
 		// add event for eth tx gas used, we can't get it from cosmos tx result when it contains multiple eth tx msgs.
 		sdk.NewAttribute(types.AttributeKeyTxGasUsed, strconv.FormatUint(response.GasUsed, 10)),
+		// add event for intermediate state root after eth tx
+		sdk.NewAttribute(types.AttributeKeyStateRoot, response.StateRoot),
 	}
 
 	if len(ctx.TxBytes()) > 0 {
@@ -132,9 +136,12 @@ func (k *Keeper) HandleTx(goCtx context.Context, msg *types.MsgHandleTx) (*types
 	return response, nil
 }
 
+// ApplySGXVMTransaction executes the Ethereum transaction using SGXVM and refunds leftover gas to the
+// fee payer. If fee payer is empty, leftover gas is refunded to the sender of the transaction
 func (k *Keeper) ApplySGXVMTransaction(
 	ctx sdk.Context,
 	tx *ethtypes.Transaction,
+	feePayer common.Address,
 	isUnencrypted bool,
 ) (*types.MsgEthereumTxResponse, error) {
 	var (
@@ -201,7 +208,7 @@ func (k *Keeper) ApplySGXVMTransaction(
 
 	receipt := &ethtypes.Receipt{
 		Type:              tx.Type(),
-		PostState:         nil, // TODO: intermediate state root
+		PostState:         common.HexToHash(res.StateRoot).Bytes(),
 		CumulativeGasUsed: cumulativeGasUsed,
 		Bloom:             bloomReceipt,
 		Logs:              logs,
@@ -221,8 +228,12 @@ func (k *Keeper) ApplySGXVMTransaction(
 			res.VmError = types.ErrPostTxProcessing.Error()
 			k.Logger(ctx).Error("tx post processing failed", "error", err)
 
-			// If the tx failed in post-processing hooks, we should clear the logs
+			// If the tx failed in post-processing hooks, we should clear the logs, so they are not
+			// included into the block bloom and don't occupy log indices of the block
 			res.Logs = nil
+			receipt.Logs = nil
+			// State changes of the tx are reverted, so it commits to empty set of writes
+			res.StateRoot = types.IntermediateStateRoot(k.GetStateRootTransient(ctx), types.NewStateWrites().Hash()).Hex()
 		} else if commit != nil {
 			// PostTxProcessing is successful, commit the tmpCtx
 			commit()
@@ -233,8 +244,11 @@ func (k *Keeper) ApplySGXVMTransaction(
 	}
 
 	// refund gas in order to match the Ethereum gas consumption instead of the default SDK one.
-	if err = k.RefundGas(ctx, msg, msg.Gas()-res.GasUsed, cfg.Params.EvmDenom); err != nil {
-		return nil, errorsmod.Wrapf(err, "failed to refund gas leftover gas to sender %s", msg.From())
+	if feePayer == (common.Address{}) {
+		feePayer = msg.From()
+	}
+	if err = k.RefundGas(ctx, msg, feePayer, msg.Gas()-res.GasUsed, cfg.Params.EvmDenom); err != nil {
+		return nil, errorsmod.Wrapf(err, "failed to refund gas leftover gas to fee payer %s", feePayer)
 	}
 
 	if len(receipt.Logs) > 0 {
@@ -244,6 +258,7 @@ func (k *Keeper) ApplySGXVMTransaction(
 	}
 
 	k.SetTxIndexTransient(ctx, uint64(txConfig.TxIndex)+1)
+	k.SetStateRootTransient(ctx, common.HexToHash(res.StateRoot))
 
 	totalGasUsed, err := k.AddTransientGasUsed(ctx, res.GasUsed)
 	if err != nil {
@@ -264,11 +279,31 @@ func (k *Keeper) ApplyMessageWithConfig(
 	txContext *librustgo.TransactionContext,
 	isUnencrypted bool,
 ) (*types.MsgEthereumTxResponse, error) {
+	res, _, err := k.applyMessage(ctx, msg, commit, cfg, txConfig, txContext, isUnencrypted, nil, nil)
+	return res, err
+}
+
+// applyMessage executes message in SGXVM in the same way as ApplyMessageWithConfig and additionally
+// returns raw SGXVM response, which contains addresses and storage slots, accessed during execution.
+// If tracer is provided, it receives opcodes and call frames, reported by SGXVM. SGXVM reports them
+// only for unencrypted execution. If traceAuthorization is provided, SGXVM verifies it and returns
+// call tree of encrypted execution, encrypted with the key of the sender
+func (k *Keeper) applyMessage(
+	ctx sdk.Context,
+	msg core.Message,
+	commit bool,
+	cfg *types.EVMConfig,
+	txConfig types.TxConfig,
+	txContext *librustgo.TransactionContext,
+	isUnencrypted bool,
+	tracer vm.EVMLogger,
+	traceAuthorization *librustgo.TraceAuthorization,
+) (*types.MsgEthereumTxResponse, *librustgo.HandleTransactionResponse, error) {
 	// return error if contract creation or call are disabled through governance
 	if !cfg.Params.EnableCreate && msg.To() == nil {
-		return nil, errorsmod.Wrap(types.ErrCreateDisabled, "failed to create new contract")
+		return nil, nil, errorsmod.Wrap(types.ErrCreateDisabled, "failed to create new contract")
 	} else if !cfg.Params.EnableCall && msg.To() != nil {
-		return nil, errorsmod.Wrap(types.ErrCallDisabled, "failed to call contract")
+		return nil, nil, errorsmod.Wrap(types.ErrCallDisabled, "failed to call contract")
 	}
 
 	leftoverGas := msg.Gas()
@@ -276,18 +311,30 @@ func (k *Keeper) ApplyMessageWithConfig(
 	intrinsicGas, err := k.GetEthIntrinsicGas(ctx, msg, cfg.ChainConfig, contractCreation)
 	if err != nil {
 		// should have already been checked on Ante Handler
-		return nil, errorsmod.Wrap(err, "intrinsic gas failed")
+		return nil, nil, errorsmod.Wrap(err, "intrinsic gas failed")
 	}
 
 	// Should check again even if it is checked on Ante Handler, because eth_call don't go through Ante Handler.
 	if leftoverGas < intrinsicGas {
 		// eth_estimateGas will check for this exact error
-		return nil, errorsmod.Wrap(core.ErrIntrinsicGas, "apply message")
+		return nil, nil, errorsmod.Wrap(core.ErrIntrinsicGas, "apply message")
 	}
 
+	// Cosmos messages, executed by precompiles, are written to the cached context, which is committed
+	// only if transaction was executed successfully. SGXVM takes snapshot of Cosmos state before precompile
+	// changes it and reverts to the snapshot if the call frame fails, so changes of reverted nested calls
+	// are rolled back
+	connectorCtx, writeConnectorCtx := ctx.CacheContext()
 	connector := Connector{
-		Context:   ctx,
+		GetHashFn: k.GetHashFn(ctx),
+		Context:   connectorCtx,
 		EVMKeeper: k,
+		Cache:     NewConnectorCache(),
+		Writes:    types.NewStateWrites(),
+	}
+	if tracer != nil {
+		connector.Tracer = newSGXVMTracer(ctx, k, cfg, msg, txContext, tracer)
+		connector.Tracer.captureStart(msg, leftoverGas-intrinsicGas)
 	}
 
 	var res *librustgo.HandleTransactionResponse
@@ -302,6 +349,7 @@ func (k *Keeper) ApplyMessageWithConfig(
 			msg.Nonce(),
 			txContext,
 			commit,
+			tracer != nil,
 		)
 	} else {
 		res, err = librustgo.Call(
@@ -316,35 +364,79 @@ func (k *Keeper) ApplyMessageWithConfig(
 			txContext,
 			commit,
 			isUnencrypted,
+			tracer != nil,
+			traceAuthorization,
 		)
 	}
 
 	if err != nil {
-		return nil, err
+		return nil, nil, err
+	}
+
+	// Enclave applies state changes only if transaction was executed successfully
+	if commit && res.VmError == "" {
+		connector.Flush()
+		writeConnectorCtx()
+		if contractCreation {
+			k.SetContractDeployer(ctx, crypto.CreateAddress(msg.From(), msg.Nonce()), msg.From())
+		}
+	} else {
+		connector.Discard()
 	}
 
-	// calculate gas refund
-	if msg.Gas() < leftoverGas {
-		return nil, errorsmod.Wrap(types.ErrGasOverflow, "apply message")
+	// intermediate state root is computed only for committed transactions. Reverted transaction
+	// commits to empty set of writes
+	var stateRoot string
+	if commit {
+		stateRoot = types.IntermediateStateRoot(k.GetStateRootTransient(ctx), connector.WritesHash()).Hex()
 	}
-	// refund gas
-	temporaryGasUsed := msg.Gas() - leftoverGas
+
+	if msg.Gas() < res.GasUsed {
+		return nil, nil, errorsmod.Wrap(types.ErrGasOverflow, "apply message")
+	}
+	// refund gas according to the refund counter, accumulated by SGXVM
 	refundQuotient := params.RefundQuotientEIP3529
-	leftoverGas += GasToRefund(0, temporaryGasUsed, refundQuotient) // TODO: SGXVM should return gas to refund
+	gasUsed := res.GasUsed - GasToRefund(res.GasRefund, res.GasUsed, refundQuotient)
+
+	if connector.Tracer != nil {
+		// gas used by top-level frame doesn't include intrinsic gas
+		var frameGasUsed uint64
+		if res.GasUsed > intrinsicGas {
+			frameGasUsed = res.GasUsed - intrinsicGas
+		}
+		connector.Tracer.captureEnd(res.Ret, frameGasUsed, res.VmError, msg.Gas()-gasUsed)
+	}
 
 	logs := SGXVMLogsToEthereum(res.Logs, txConfig, txContext.BlockNumber)
 	return &types.MsgEthereumTxResponse{
-		GasUsed: res.GasUsed,
-		VmError: res.VmError,
-		Ret:     res.Ret,
-		Logs:    types.NewLogsFromEth(logs),
-		Hash:    txConfig.TxHash.Hex(),
-	}, nil
+		GasUsed:   gasUsed,
+		VmError:   res.VmError,
+		Ret:       res.Ret,
+		Logs:      types.NewLogsFromEth(logs),
+		Hash:      txConfig.TxHash.Hex(),
+		StateRoot: stateRoot,
+	}, res, nil
+}
+
+// accessListFromSGXVM converts access list, reported by SGXVM, to ethereum format
+func accessListFromSGXVM(items []*librustgo.AccessListItem) ethtypes.AccessList {
+	accessList := make(ethtypes.AccessList, 0, len(items))
+	for _, item := range items {
+		storageKeys := make([]common.Hash, 0, len(item.StorageSlot))
+		for _, slot := range item.StorageSlot {
+			storageKeys = append(storageKeys, common.BytesToHash(slot))
+		}
+		accessList = append(accessList, ethtypes.AccessTuple{
+			Address:     common.BytesToAddress(item.Address),
+			StorageKeys: storageKeys,
+		})
+	}
+	return accessList
 }
 
 func (k *Keeper) GetNodePublicKey(blockNumber uint64) (common.Hash, error) {
 	nodePublicKey := common.Hash{}
-	for _, epoch := range k.epochs {
+	for _, epoch := range k.GetEpochs() {
 		if epoch.GetStartingBlock() > blockNumber {
 			break
 		}
@@ -370,6 +462,8 @@ func CreateSGXVMContext(ctx sdk.Context, k *Keeper, tx *ethtypes.Transaction) (*
 		BlockGasLimit:      evmcommontypes.BlockGasLimit(ctx),
 		ChainId:            k.eip155ChainID.Uint64(),
 		GasPrice:           tx.GasPrice().Bytes(),
+		// sender of the transaction is authenticated by its signature
+		AuthenticatedSender: true,
 	}, nil
 }
 
@@ -387,19 +481,23 @@ func CreateSGXVMContextFromMessage(ctx sdk.Context, k *Keeper, msg core.Message)
 		BlockGasLimit:      evmcommontypes.BlockGasLimit(ctx),
 		ChainId:            k.eip155ChainID.Uint64(),
 		GasPrice:           msg.GasPrice().Bytes(),
+		// fake messages are built from call arguments, so their sender is not authenticated
+		AuthenticatedSender: !msg.IsFake(),
 	}, nil
 }
 
-// SGXVMLogsToEthereum converts logs from SGXVM to ethereum format
+// SGXVMLogsToEthereum converts logs from SGXVM to ethereum format. Logs are indexed within the block,
+// starting from the log index of the transaction
 func SGXVMLogsToEthereum(logs []*librustgo.Log, txConfig types.TxConfig, blockNumber uint64) []*ethtypes.Log {
 	var ethLogs []*ethtypes.Log
 	for i := range logs {
-		ethLogs = append(ethLogs, SGXVMLogToEthereum(logs[i], txConfig, blockNumber))
+		ethLogs = append(ethLogs, SGXVMLogToEthereum(logs[i], txConfig, blockNumber, txConfig.LogIndex+uint(i)))
 	}
 	return ethLogs
 }
 
-func SGXVMLogToEthereum(log *librustgo.Log, txConfig types.TxConfig, blockNumber uint64) *ethtypes.Log {
+// SGXVMLogToEthereum converts log from SGXVM to ethereum format with provided index within the block
+func SGXVMLogToEthereum(log *librustgo.Log, txConfig types.TxConfig, blockNumber uint64, index uint) *ethtypes.Log {
 	var topics []common.Hash
 	for _, topic := range log.Topics {
 		topics = append(topics, common.BytesToHash(topic.Inner))
@@ -413,7 +511,7 @@ func SGXVMLogToEthereum(log *librustgo.Log, txConfig types.TxConfig, blockNumber
 		TxHash:      txConfig.TxHash,
 		TxIndex:     txConfig.TxIndex,
 		BlockHash:   txConfig.BlockHash,
-		Index:       txConfig.LogIndex,
+		Index:       index,
 		Removed:     false,
 	}
 }
@@ -1,16 +1,30 @@
 package keeper
 
 import (
+	"bytes"
 	"errors"
+	"fmt"
 	"math/big"
+	"sort"
+	"time"
 
+	sdkmath "cosmossdk.io/math"
 	"github.com/SigmaGmbH/librustgo"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+	ibcclienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/golang/protobuf/proto"
 
 	compliancetypes "swisstronik/x/compliance/types"
+	"swisstronik/x/evm/types"
 )
 
 // Connector allows our VM interact with existing Cosmos application.
@@ -20,8 +34,71 @@ type Connector struct {
 	GetHashFn vm.GetHashFunc
 	// Keeper used to store and obtain state
 	EVMKeeper *Keeper
-	// Context used to make Keeper calls available
+	// Context used to make Keeper calls available. Requests are handled in the context of the latest
+	// Cosmos snapshot, if there is one, see currentContext
 	Context sdk.Context
+	// Cache keeps state, accessed during current transaction. If nil, all requests go directly to Keeper
+	Cache *ConnectorCache
+	// Tracer receives opcodes and call frames, reported by SGXVM. If nil, tracing is disabled
+	Tracer *sgxvmTracer
+	// Writes records writes to the EVM store, made during transaction, to compute intermediate
+	// state root. If nil, writes are not recorded
+	Writes *types.StateWrites
+	// gasMeter limits gas, consumed by metered request of precompile. If nil, gas meter of Context is used
+	gasMeter sdk.GasMeter
+	// balanceChanges collects changes of EVM denom balances, made by Cosmos messages of metered request.
+	// They are returned to precompile, which applies them to EVM state
+	balanceChanges map[common.Address]*big.Int
+}
+
+// Flush writes Cosmos state snapshots and storage cells, modified during transaction, to the keeper.
+// It should be called only if transaction was executed successfully
+func (q Connector) Flush() {
+	if q.Cache == nil {
+		return
+	}
+
+	q.Cache.commitSnapshots()
+
+	for _, address := range q.Cache.dirtyAddresses() {
+		for _, index := range q.Cache.dirtyIndices(address) {
+			value, _ := q.Cache.getState(address, index)
+			q.EVMKeeper.SetState(q.Context, address, index, value)
+		}
+	}
+	q.Cache.reset()
+}
+
+// Discard drops cached state without writing it to the keeper. It is used if transaction was reverted
+func (q Connector) Discard() {
+	if q.Cache != nil {
+		q.Cache.reset()
+	}
+	if q.Writes != nil {
+		q.Writes.Reset()
+	}
+}
+
+// WritesHash returns commitment over writes to the EVM store, made during transaction. If writes
+// are not recorded, commitment over empty set of writes is returned
+func (q Connector) WritesHash() common.Hash {
+	if q.Writes == nil {
+		return types.NewStateWrites().Hash()
+	}
+	return q.Writes.Hash()
+}
+
+// currentContext returns context, in which requests should be handled. If Cosmos state snapshots were
+// taken, it is the context of the latest one. Metered requests are handled with their own gas meter
+func (q Connector) currentContext() sdk.Context {
+	ctx := q.Context
+	if q.Cache != nil {
+		ctx = q.Cache.context(q.Context)
+	}
+	if q.gasMeter != nil {
+		ctx = ctx.WithGasMeter(q.gasMeter)
+	}
+	return ctx
 }
 
 func (q Connector) Query(req []byte) ([]byte, error) {
@@ -31,6 +108,11 @@ func (q Connector) Query(req []byte) ([]byte, error) {
 		return nil, err
 	}
 
+	return q.handle(decodedRequest)
+}
+
+// handle dispatches decoded request to corresponding handler
+func (q Connector) handle(decodedRequest *librustgo.CosmosRequest) ([]byte, error) {
 	switch request := decodedRequest.Req.(type) {
 	// Handle request for account data such as balance and nonce
 	case *librustgo.CosmosRequest_GetAccount:
@@ -62,23 +144,177 @@ func (q Connector) Query(req []byte) ([]byte, error) {
 	// Returns block hash
 	case *librustgo.CosmosRequest_BlockHash:
 		return q.BlockHash(request)
+	// Returns deployer of the contract
+	case *librustgo.CosmosRequest_ContractDeployer:
+		return q.ContractDeployer(request)
 	case *librustgo.CosmosRequest_AddVerificationDetails:
 		return q.AddVerificationDetails(request)
 	case *librustgo.CosmosRequest_HasVerification:
 		return q.HasVerification(request)
 	case *librustgo.CosmosRequest_GetVerificationData:
 		return q.GetVerificationData(request)
+	// Handles requests of staking and distribution precompiles
+	case *librustgo.CosmosRequest_Delegate:
+		return q.Delegate(request)
+	case *librustgo.CosmosRequest_Undelegate:
+		return q.Undelegate(request)
+	case *librustgo.CosmosRequest_Redelegate:
+		return q.Redelegate(request)
+	case *librustgo.CosmosRequest_Delegation:
+		return q.Delegation(request)
+	case *librustgo.CosmosRequest_WithdrawDelegatorReward:
+		return q.WithdrawDelegatorReward(request)
+	// Handles requests of bank and ICS-20 precompiles
+	case *librustgo.CosmosRequest_BankBalance:
+		return q.BankBalance(request)
+	case *librustgo.CosmosRequest_BankSend:
+		return q.BankSend(request)
+	case *librustgo.CosmosRequest_IbcTransfer:
+		return q.IBCTransfer(request)
+	// Handles requests of governance precompile
+	case *librustgo.CosmosRequest_GovVote:
+		return q.GovVote(request)
+	case *librustgo.CosmosRequest_GovVoteWeighted:
+		return q.GovVoteWeighted(request)
+	case *librustgo.CosmosRequest_GovDeposit:
+		return q.GovDeposit(request)
+	case *librustgo.CosmosRequest_GovProposal:
+		return q.GovProposal(request)
+	case *librustgo.CosmosRequest_GovTally:
+		return q.GovTally(request)
+	// Handles snapshots of Cosmos state, used to revert changes of failed call frames
+	case *librustgo.CosmosRequest_CosmosSnapshot:
+		return q.CosmosSnapshot(request)
+	case *librustgo.CosmosRequest_RevertCosmosSnapshot:
+		return q.RevertCosmosSnapshot(request)
+	// Handles requests of precompiles, which are charged for consumed gas
+	case *librustgo.CosmosRequest_Metered:
+		return q.Metered(request)
+	// Handles several requests at once
+	case *librustgo.CosmosRequest_Batch:
+		return q.Batch(request)
+	// Handles opcodes and call frames, reported during tracing
+	case *librustgo.CosmosRequest_TraceEvents:
+		return q.TraceEvents(request)
 	}
 
 	return nil, errors.New("wrong query received")
 }
 
+// Batch handles incoming protobuf-encoded batch of requests. Requests are handled in order, so later
+// requests observe changes made by previous ones. If any request fails, an error is returned and the
+// rest of the batch is not handled. Changes made by already handled requests are not reverted here,
+// since failed transactions are discarded by the keeper together with the whole transaction context
+func (q Connector) Batch(req *librustgo.CosmosRequest_Batch) ([]byte, error) {
+	responses := make([][]byte, 0, len(req.Batch.Requests))
+	for _, encodedRequest := range req.Batch.Requests {
+		decodedRequest := &librustgo.CosmosRequest{}
+		if err := proto.Unmarshal(encodedRequest, decodedRequest); err != nil {
+			return nil, err
+		}
+
+		if _, ok := decodedRequest.Req.(*librustgo.CosmosRequest_Batch); ok {
+			return nil, errors.New("nested batch requests are not allowed")
+		}
+
+		response, err := q.handle(decodedRequest)
+		if err != nil {
+			return nil, err
+		}
+		responses = append(responses, response)
+	}
+
+	return proto.Marshal(&librustgo.QueryBatchResponse{Responses: responses})
+}
+
+// Metered handles incoming protobuf-encoded request of precompile with gas meter, limited by gas, available
+// to precompile. Consumed gas is returned together with response or error of the request, since precompile
+// charges it in both cases
+func (q Connector) Metered(req *librustgo.CosmosRequest_Metered) ([]byte, error) {
+	decodedRequest := &librustgo.CosmosRequest{}
+	if err := proto.Unmarshal(req.Metered.Request, decodedRequest); err != nil {
+		return nil, err
+	}
+
+	switch decodedRequest.Req.(type) {
+	case *librustgo.CosmosRequest_Metered, *librustgo.CosmosRequest_Batch:
+		return nil, errors.New("nested metered and batch requests are not allowed")
+	}
+
+	q.gasMeter = sdk.NewGasMeter(req.Metered.GasLimit)
+	q.balanceChanges = make(map[common.Address]*big.Int)
+	response, err := q.handleMetered(decodedRequest)
+	res := &librustgo.QueryMeteredResponse{GasUsed: q.gasMeter.GasConsumedToLimit()}
+	if err != nil {
+		res.Error = err.Error()
+	} else {
+		res.Response = response
+		res.BalanceChanges = encodeBalanceChanges(q.balanceChanges)
+	}
+	return proto.Marshal(res)
+}
+
+// encodeBalanceChanges converts non-zero balance changes to protobuf format. Changes are sorted by address,
+// since they are applied by precompile in the provided order
+func encodeBalanceChanges(changes map[common.Address]*big.Int) []*librustgo.BalanceChange {
+	addresses := make([]common.Address, 0, len(changes))
+	for address, delta := range changes {
+		if delta.Sign() != 0 {
+			addresses = append(addresses, address)
+		}
+	}
+	sort.Slice(addresses, func(i, j int) bool {
+		return bytes.Compare(addresses[i].Bytes(), addresses[j].Bytes()) < 0
+	})
+
+	encoded := make([]*librustgo.BalanceChange, 0, len(addresses))
+	for _, address := range addresses {
+		delta := changes[address]
+		encoded = append(encoded, &librustgo.BalanceChange{
+			Address:  address.Bytes(),
+			Amount:   new(big.Int).Abs(delta).Bytes(),
+			Decrease: delta.Sign() < 0,
+		})
+	}
+	return encoded
+}
+
+// handleMetered handles request with gas meter of the connector and converts running out of gas to error
+func (q Connector) handleMetered(decodedRequest *librustgo.CosmosRequest) (response []byte, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			outOfGas, ok := r.(sdk.ErrorOutOfGas)
+			if !ok {
+				panic(r)
+			}
+			err = fmt.Errorf("out of gas in location: %s", outOfGas.Descriptor)
+		}
+	}()
+
+	return q.handle(decodedRequest)
+}
+
 // GetAccount handles incoming protobuf-encoded request for account data such as balance and nonce.
 // Returns data in protobuf-encoded format
 func (q Connector) GetAccount(req *librustgo.CosmosRequest_GetAccount) ([]byte, error) {
 	//println("Connector::Query GetAccount invoked")
 	ethAddress := common.BytesToAddress(req.GetAccount.Address)
-	account := q.EVMKeeper.GetAccountOrEmpty(q.Context, ethAddress)
+	if q.Cache != nil {
+		if account, found := q.Cache.getAccount(ethAddress); found {
+			return proto.Marshal(&librustgo.QueryGetAccountResponse{
+				Balance: account.balance.Bytes(),
+				Nonce:   account.nonce,
+			})
+		}
+	}
+
+	account := q.EVMKeeper.GetAccountOrEmpty(q.currentContext(), ethAddress)
+	if q.Cache != nil {
+		// changes, made by Cosmos messages during transaction, are applied by precompiles on top of
+		// balances, loaded by SGXVM, so they are excluded from the returned balance
+		account.Balance = new(big.Int).Sub(account.Balance, q.Cache.balanceChange(ethAddress))
+		q.Cache.setAccount(ethAddress, account.Balance, account.Nonce)
+	}
 
 	return proto.Marshal(&librustgo.QueryGetAccountResponse{
 		Balance: account.Balance.Bytes(),
@@ -90,7 +326,7 @@ func (q Connector) GetAccount(req *librustgo.CosmosRequest_GetAccount) ([]byte,
 func (q Connector) ContainsKey(req *librustgo.CosmosRequest_ContainsKey) ([]byte, error) {
 	//println("Connector::Query ContainsKey invoked")
 	ethAddress := common.BytesToAddress(req.ContainsKey.Key)
-	account := q.EVMKeeper.GetAccountWithoutBalance(q.Context, ethAddress)
+	account := q.EVMKeeper.GetAccountWithoutBalance(q.currentContext(), ethAddress)
 	return proto.Marshal(&librustgo.QueryContainsKeyResponse{Contains: account != nil})
 }
 
@@ -99,9 +335,12 @@ func (q Connector) ContainsKey(req *librustgo.CosmosRequest_ContainsKey) ([]byte
 func (q Connector) InsertAccountCode(req *librustgo.CosmosRequest_InsertAccountCode) ([]byte, error) {
 	//println("Connector::Query InsertAccountCode invoked")
 	ethAddress := common.BytesToAddress(req.InsertAccountCode.Address)
-	if err := q.EVMKeeper.SetAccountCode(q.Context, ethAddress, req.InsertAccountCode.Code); err != nil {
+	if err := q.EVMKeeper.SetAccountCode(q.currentContext(), ethAddress, req.InsertAccountCode.Code); err != nil {
 		return nil, err
 	}
+	if q.Writes != nil {
+		q.Writes.SetCode(ethAddress, req.InsertAccountCode.Code)
+	}
 
 	return proto.Marshal(&librustgo.QueryInsertAccountCodeResponse{})
 }
@@ -112,7 +351,14 @@ func (q Connector) RemoveStorageCell(req *librustgo.CosmosRequest_RemoveStorageC
 	address := common.BytesToAddress(req.RemoveStorageCell.Address)
 	index := common.BytesToHash(req.RemoveStorageCell.Index)
 
-	q.EVMKeeper.SetState(q.Context, address, index, common.Hash{}.Bytes())
+	if q.Cache != nil {
+		q.Cache.setState(address, index, common.Hash{}.Bytes())
+	} else {
+		q.EVMKeeper.SetState(q.currentContext(), address, index, common.Hash{}.Bytes())
+	}
+	if q.Writes != nil {
+		q.Writes.SetState(address, index, common.Hash{}.Bytes())
+	}
 
 	return proto.Marshal(&librustgo.QueryRemoveStorageCellResponse{})
 }
@@ -121,9 +367,15 @@ func (q Connector) RemoveStorageCell(req *librustgo.CosmosRequest_RemoveStorageC
 func (q Connector) Remove(req *librustgo.CosmosRequest_Remove) ([]byte, error) {
 	//println("Connector::Query Remove invoked")
 	ethAddress := common.BytesToAddress(req.Remove.Address)
-	if err := q.EVMKeeper.DeleteAccount(q.Context, ethAddress); err != nil {
+	if err := q.EVMKeeper.DeleteAccount(q.currentContext(), ethAddress); err != nil {
 		return nil, err
 	}
+	if q.Cache != nil {
+		q.Cache.removeAccount(ethAddress)
+	}
+	if q.Writes != nil {
+		q.Writes.RemoveAccount(ethAddress)
+	}
 
 	return proto.Marshal(&librustgo.QueryRemoveResponse{})
 }
@@ -139,12 +391,39 @@ func (q Connector) BlockHash(req *librustgo.CosmosRequest_BlockHash) ([]byte, er
 	return proto.Marshal(&librustgo.QueryBlockHashResponse{Hash: blockHash.Bytes()})
 }
 
+// ContractDeployer returns the account, which deployed the contract by a transaction. Deployer is empty,
+// if contract was created by another contract
+func (q Connector) ContractDeployer(req *librustgo.CosmosRequest_ContractDeployer) ([]byte, error) {
+	var deployer []byte
+	if address, found := q.EVMKeeper.GetContractDeployer(q.currentContext(), common.BytesToAddress(req.ContractDeployer.Contract)); found {
+		deployer = address.Bytes()
+	}
+	return proto.Marshal(&librustgo.QueryContractDeployerResponse{Deployer: deployer})
+}
+
+// TraceEvents passes opcodes and call frames, reported by SGXVM, to the tracer
+func (q Connector) TraceEvents(req *librustgo.CosmosRequest_TraceEvents) ([]byte, error) {
+	if q.Tracer == nil {
+		return nil, errors.New("tracing is not enabled")
+	}
+
+	q.Tracer.onEvents(req.TraceEvents.Events)
+	return proto.Marshal(&librustgo.QueryTraceEventsResponse{})
+}
+
 // InsertStorageCell handles incoming protobuf-encoded request for updating state of storage cell
 func (q Connector) InsertStorageCell(req *librustgo.CosmosRequest_InsertStorageCell) ([]byte, error) {
 	ethAddress := common.BytesToAddress(req.InsertStorageCell.Address)
 	index := common.BytesToHash(req.InsertStorageCell.Index)
 
-	q.EVMKeeper.SetState(q.Context, ethAddress, index, req.InsertStorageCell.Value)
+	if q.Cache != nil {
+		q.Cache.setState(ethAddress, index, req.InsertStorageCell.Value)
+	} else {
+		q.EVMKeeper.SetState(q.currentContext(), ethAddress, index, req.InsertStorageCell.Value)
+	}
+	if q.Writes != nil {
+		q.Writes.SetState(ethAddress, index, req.InsertStorageCell.Value)
+	}
 	return proto.Marshal(&librustgo.QueryInsertStorageCellResponse{})
 }
 
@@ -153,7 +432,16 @@ func (q Connector) GetStorageCell(req *librustgo.CosmosRequest_StorageCell) ([]b
 	//println("Connector::Query Request value of storage cell")
 	ethAddress := common.BytesToAddress(req.StorageCell.Address)
 	index := common.BytesToHash(req.StorageCell.Index)
-	value := q.EVMKeeper.GetState(q.Context, ethAddress, index)
+	if q.Cache != nil {
+		if value, found := q.Cache.getState(ethAddress, index); found {
+			return proto.Marshal(&librustgo.QueryGetAccountStorageCellResponse{Value: value})
+		}
+	}
+
+	value := q.EVMKeeper.GetState(q.currentContext(), ethAddress, index)
+	if q.Cache != nil {
+		q.Cache.loadState(ethAddress, index, value)
+	}
 
 	return proto.Marshal(&librustgo.QueryGetAccountStorageCellResponse{Value: value})
 }
@@ -163,14 +451,14 @@ func (q Connector) GetStorageCell(req *librustgo.CosmosRequest_StorageCell) ([]b
 func (q Connector) GetAccountCode(req *librustgo.CosmosRequest_AccountCode) ([]byte, error) {
 	//println("Connector::Query Request account code")
 	ethAddress := common.BytesToAddress(req.AccountCode.Address)
-	account := q.EVMKeeper.GetAccountWithoutBalance(q.Context, ethAddress)
+	account := q.EVMKeeper.GetAccountWithoutBalance(q.currentContext(), ethAddress)
 	if account == nil {
 		return proto.Marshal(&librustgo.QueryGetAccountCodeResponse{
 			Code: nil,
 		})
 	}
 
-	code := q.EVMKeeper.GetCode(q.Context, common.BytesToHash(account.CodeHash))
+	code := q.EVMKeeper.GetCode(q.currentContext(), common.BytesToHash(account.CodeHash))
 	return proto.Marshal(&librustgo.QueryGetAccountCodeResponse{
 		Code: code,
 	})
@@ -187,16 +475,23 @@ func (q Connector) InsertAccount(req *librustgo.CosmosRequest_InsertAccount) ([]
 	balance.SetBytes(req.InsertAccount.Balance)
 	nonce := req.InsertAccount.Nonce
 
-	account := q.EVMKeeper.GetAccountOrEmpty(q.Context, ethAddress)
-	if err := q.EVMKeeper.SetBalance(q.Context, ethAddress, balance); err != nil {
+	account := q.EVMKeeper.GetAccountOrEmpty(q.currentContext(), ethAddress)
+	if err := q.EVMKeeper.SetBalance(q.currentContext(), ethAddress, balance); err != nil {
 		return nil, err
 	}
 
 	account.Balance = balance
 	account.Nonce = nonce
-	if err := q.EVMKeeper.SetAccount(q.Context, ethAddress, account); err != nil {
+	if err := q.EVMKeeper.SetAccount(q.currentContext(), ethAddress, account); err != nil {
 		return nil, err
 	}
+	if q.Cache != nil {
+		// balance, written by SGXVM, includes changes made by Cosmos messages, while cached balance excludes them
+		q.Cache.setAccount(ethAddress, new(big.Int).Sub(balance, q.Cache.balanceChange(ethAddress)), nonce)
+	}
+	if q.Writes != nil {
+		q.Writes.SetAccount(ethAddress, balance, nonce)
+	}
 
 	return proto.Marshal(&librustgo.QueryInsertAccountResponse{})
 }
@@ -219,7 +514,7 @@ func (q Connector) AddVerificationDetails(req *librustgo.CosmosRequest_AddVerifi
 		Version:              req.AddVerificationDetails.Version,
 	}
 
-	verificationID, err := q.EVMKeeper.ComplianceKeeper.AddVerificationDetails(q.Context, userAddress, verificationType, verificationDetails)
+	verificationID, err := q.EVMKeeper.ComplianceKeeper.AddVerificationDetails(q.currentContext(), userAddress, verificationType, verificationDetails)
 	if err != nil {
 		return nil, err
 	}
@@ -240,7 +535,7 @@ func (q Connector) HasVerification(req *librustgo.CosmosRequest_HasVerification)
 		allowedIssuers = append(allowedIssuers, sdk.AccAddress(issuer))
 	}
 
-	hasVerification, err := q.EVMKeeper.ComplianceKeeper.HasVerificationOfType(q.Context, userAddress, verificationType, expirationTimestamp, allowedIssuers)
+	hasVerification, err := q.EVMKeeper.ComplianceKeeper.HasVerificationOfType(q.currentContext(), userAddress, verificationType, expirationTimestamp, allowedIssuers)
 	if err != nil {
 		return nil, err
 	}
@@ -254,7 +549,7 @@ func (q Connector) GetVerificationData(req *librustgo.CosmosRequest_GetVerificat
 	userAddress := sdk.AccAddress(req.GetVerificationData.UserAddress)
 	issuerAddress := sdk.AccAddress(req.GetVerificationData.IssuerAddress)
 
-	verifications, verificationsDetails, err := q.EVMKeeper.ComplianceKeeper.GetVerificationDetailsByIssuer(q.Context, userAddress, issuerAddress)
+	verifications, verificationsDetails, err := q.EVMKeeper.ComplianceKeeper.GetVerificationDetailsByIssuer(q.currentContext(), userAddress, issuerAddress)
 	if err != nil {
 		return nil, err
 	}
@@ -287,3 +582,567 @@ func (q Connector) GetVerificationData(req *librustgo.CosmosRequest_GetVerificat
 		Data: resData,
 	})
 }
+
+// Delegate delegates coins of the delegator to the validator through x/staking module.
+// Delegator is the caller of staking precompile
+func (q Connector) Delegate(req *librustgo.CosmosRequest_Delegate) ([]byte, error) {
+	if q.EVMKeeper.stakingMsgServer == nil {
+		return nil, errors.New("staking precompile is not enabled")
+	}
+
+	msg := &stakingtypes.MsgDelegate{
+		DelegatorAddress: sdk.AccAddress(req.Delegate.Delegator).String(),
+		ValidatorAddress: req.Delegate.ValidatorAddress,
+		Amount:           q.bondCoin(req.Delegate.Amount),
+	}
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	logs, err := q.executeCosmosMsg(func(ctx sdk.Context) error {
+		_, err := q.EVMKeeper.stakingMsgServer.Delegate(sdk.WrapSDKContext(ctx), msg)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return proto.Marshal(&librustgo.QueryDelegateResponse{Logs: logs})
+}
+
+// Undelegate starts unbonding of coins, delegated to the validator, through x/staking module.
+// Returns unix timestamp of unbonding completion
+func (q Connector) Undelegate(req *librustgo.CosmosRequest_Undelegate) ([]byte, error) {
+	if q.EVMKeeper.stakingMsgServer == nil {
+		return nil, errors.New("staking precompile is not enabled")
+	}
+
+	msg := &stakingtypes.MsgUndelegate{
+		DelegatorAddress: sdk.AccAddress(req.Undelegate.Delegator).String(),
+		ValidatorAddress: req.Undelegate.ValidatorAddress,
+		Amount:           q.bondCoin(req.Undelegate.Amount),
+	}
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	var res *stakingtypes.MsgUndelegateResponse
+	logs, err := q.executeCosmosMsg(func(ctx sdk.Context) (err error) {
+		res, err = q.EVMKeeper.stakingMsgServer.Undelegate(sdk.WrapSDKContext(ctx), msg)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return proto.Marshal(&librustgo.QueryUndelegateResponse{
+		CompletionTime: res.CompletionTime.Unix(),
+		Logs:           logs,
+	})
+}
+
+// Redelegate moves delegated coins from one validator to another through x/staking module.
+// Returns unix timestamp of redelegation completion
+func (q Connector) Redelegate(req *librustgo.CosmosRequest_Redelegate) ([]byte, error) {
+	if q.EVMKeeper.stakingMsgServer == nil {
+		return nil, errors.New("staking precompile is not enabled")
+	}
+
+	msg := &stakingtypes.MsgBeginRedelegate{
+		DelegatorAddress:    sdk.AccAddress(req.Redelegate.Delegator).String(),
+		ValidatorSrcAddress: req.Redelegate.ValidatorSrcAddress,
+		ValidatorDstAddress: req.Redelegate.ValidatorDstAddress,
+		Amount:              q.bondCoin(req.Redelegate.Amount),
+	}
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	var res *stakingtypes.MsgBeginRedelegateResponse
+	logs, err := q.executeCosmosMsg(func(ctx sdk.Context) (err error) {
+		res, err = q.EVMKeeper.stakingMsgServer.BeginRedelegate(sdk.WrapSDKContext(ctx), msg)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return proto.Marshal(&librustgo.QueryRedelegateResponse{
+		CompletionTime: res.CompletionTime.Unix(),
+		Logs:           logs,
+	})
+}
+
+// Delegation returns amount of coins, delegated by the delegator to the validator.
+// Returns zero amount if there is no such delegation
+func (q Connector) Delegation(req *librustgo.CosmosRequest_Delegation) ([]byte, error) {
+	delegator := sdk.AccAddress(req.Delegation.Delegator)
+	validatorAddress, err := sdk.ValAddressFromBech32(req.Delegation.ValidatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	amount := sdkmath.ZeroInt()
+	if validator, found := q.EVMKeeper.stakingKeeper.GetValidator(q.currentContext(), validatorAddress); found {
+		if delegation, found := q.EVMKeeper.stakingKeeper.GetDelegation(q.currentContext(), delegator, validatorAddress); found {
+			amount = validator.TokensFromShares(delegation.Shares).TruncateInt()
+		}
+	}
+
+	return proto.Marshal(&librustgo.QueryDelegationResponse{Amount: amount.BigInt().Bytes()})
+}
+
+// WithdrawDelegatorReward withdraws rewards of the delegator from the validator through x/distribution
+// module. Rewards are sent to the withdraw address of the delegator. Returns withdrawn amount of bond denom
+func (q Connector) WithdrawDelegatorReward(req *librustgo.CosmosRequest_WithdrawDelegatorReward) ([]byte, error) {
+	if q.EVMKeeper.distrMsgServer == nil {
+		return nil, errors.New("distribution precompile is not enabled")
+	}
+
+	msg := &distrtypes.MsgWithdrawDelegatorReward{
+		DelegatorAddress: sdk.AccAddress(req.WithdrawDelegatorReward.Delegator).String(),
+		ValidatorAddress: req.WithdrawDelegatorReward.ValidatorAddress,
+	}
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	var res *distrtypes.MsgWithdrawDelegatorRewardResponse
+	logs, err := q.executeCosmosMsg(func(ctx sdk.Context) (err error) {
+		res, err = q.EVMKeeper.distrMsgServer.WithdrawDelegatorReward(sdk.WrapSDKContext(ctx), msg)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	amount := res.Amount.AmountOf(q.EVMKeeper.stakingKeeper.BondDenom(q.currentContext()))
+	return proto.Marshal(&librustgo.QueryWithdrawDelegatorRewardResponse{
+		Amount: amount.BigInt().Bytes(),
+		Logs:   logs,
+	})
+}
+
+// BankBalance returns balance of the account in provided denom
+func (q Connector) BankBalance(req *librustgo.CosmosRequest_BankBalance) ([]byte, error) {
+	if err := sdk.ValidateDenom(req.BankBalance.Denom); err != nil {
+		return nil, err
+	}
+
+	address := sdk.AccAddress(req.BankBalance.Address)
+	balance := q.EVMKeeper.bankKeeper.GetBalance(q.currentContext(), address, req.BankBalance.Denom)
+	return proto.Marshal(&librustgo.QueryBankBalanceResponse{Amount: balance.Amount.BigInt().Bytes()})
+}
+
+// BankSend sends coins from the caller of bank precompile through x/bank module
+func (q Connector) BankSend(req *librustgo.CosmosRequest_BankSend) ([]byte, error) {
+	if q.EVMKeeper.bankMsgServer == nil {
+		return nil, errors.New("bank precompile is not enabled")
+	}
+
+	amount, err := coinFromBytes(req.BankSend.Denom, req.BankSend.Amount)
+	if err != nil {
+		return nil, err
+	}
+	msg := &banktypes.MsgSend{
+		FromAddress: sdk.AccAddress(req.BankSend.From).String(),
+		ToAddress:   sdk.AccAddress(req.BankSend.To).String(),
+		Amount:      sdk.NewCoins(amount),
+	}
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	logs, err := q.executeCosmosMsg(func(ctx sdk.Context) error {
+		_, err := q.EVMKeeper.bankMsgServer.Send(sdk.WrapSDKContext(ctx), msg)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return proto.Marshal(&librustgo.QueryBankSendResponse{Logs: logs})
+}
+
+// IBCTransfer starts ICS-20 transfer of coins of the caller of ICS-20 precompile. Returns sequence of sent packet
+func (q Connector) IBCTransfer(req *librustgo.CosmosRequest_IbcTransfer) ([]byte, error) {
+	if q.EVMKeeper.transferMsgServer == nil {
+		return nil, errors.New("ics20 precompile is not enabled")
+	}
+
+	token, err := coinFromBytes(req.IbcTransfer.Denom, req.IbcTransfer.Amount)
+	if err != nil {
+		return nil, err
+	}
+	msg := &ibctransfertypes.MsgTransfer{
+		SourcePort:    req.IbcTransfer.SourcePort,
+		SourceChannel: req.IbcTransfer.SourceChannel,
+		Token:         token,
+		Sender:        sdk.AccAddress(req.IbcTransfer.Sender).String(),
+		Receiver:      req.IbcTransfer.Receiver,
+		TimeoutHeight: ibcclienttypes.NewHeight(
+			req.IbcTransfer.TimeoutRevisionNumber,
+			req.IbcTransfer.TimeoutRevisionHeight,
+		),
+		TimeoutTimestamp: req.IbcTransfer.TimeoutTimestamp,
+		Memo:             req.IbcTransfer.Memo,
+	}
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	var res *ibctransfertypes.MsgTransferResponse
+	logs, err := q.executeCosmosMsg(func(ctx sdk.Context) (err error) {
+		res, err = q.EVMKeeper.transferMsgServer.Transfer(sdk.WrapSDKContext(ctx), msg)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return proto.Marshal(&librustgo.QueryIBCTransferResponse{
+		Sequence: res.Sequence,
+		Logs:     logs,
+	})
+}
+
+// GovVote casts vote of the caller of governance precompile on the proposal through x/gov module
+func (q Connector) GovVote(req *librustgo.CosmosRequest_GovVote) ([]byte, error) {
+	if q.EVMKeeper.govMsgServer == nil {
+		return nil, errors.New("governance precompile is not enabled")
+	}
+
+	msg := &govv1.MsgVote{
+		ProposalId: req.GovVote.ProposalId,
+		Voter:      sdk.AccAddress(req.GovVote.Voter).String(),
+		Option:     govv1.VoteOption(req.GovVote.Option),
+		Metadata:   req.GovVote.Metadata,
+	}
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	logs, err := q.executeCosmosMsg(func(ctx sdk.Context) error {
+		_, err := q.EVMKeeper.govMsgServer.Vote(sdk.WrapSDKContext(ctx), msg)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return proto.Marshal(&librustgo.QueryGovVoteResponse{Logs: logs})
+}
+
+// GovVoteWeighted casts weighted vote of the caller of governance precompile on the proposal through x/gov module
+func (q Connector) GovVoteWeighted(req *librustgo.CosmosRequest_GovVoteWeighted) ([]byte, error) {
+	if q.EVMKeeper.govMsgServer == nil {
+		return nil, errors.New("governance precompile is not enabled")
+	}
+
+	options := make([]*govv1.WeightedVoteOption, 0, len(req.GovVoteWeighted.Options))
+	for _, option := range req.GovVoteWeighted.Options {
+		options = append(options, &govv1.WeightedVoteOption{
+			Option: govv1.VoteOption(option.Option),
+			Weight: option.Weight,
+		})
+	}
+	msg := &govv1.MsgVoteWeighted{
+		ProposalId: req.GovVoteWeighted.ProposalId,
+		Voter:      sdk.AccAddress(req.GovVoteWeighted.Voter).String(),
+		Options:    options,
+		Metadata:   req.GovVoteWeighted.Metadata,
+	}
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	logs, err := q.executeCosmosMsg(func(ctx sdk.Context) error {
+		_, err := q.EVMKeeper.govMsgServer.VoteWeighted(sdk.WrapSDKContext(ctx), msg)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return proto.Marshal(&librustgo.QueryGovVoteWeightedResponse{Logs: logs})
+}
+
+// GovDeposit deposits coins of the caller of governance precompile on the proposal through x/gov module
+func (q Connector) GovDeposit(req *librustgo.CosmosRequest_GovDeposit) ([]byte, error) {
+	if q.EVMKeeper.govMsgServer == nil {
+		return nil, errors.New("governance precompile is not enabled")
+	}
+
+	amount, err := coinFromBytes(req.GovDeposit.Denom, req.GovDeposit.Amount)
+	if err != nil {
+		return nil, err
+	}
+	msg := &govv1.MsgDeposit{
+		ProposalId: req.GovDeposit.ProposalId,
+		Depositor:  sdk.AccAddress(req.GovDeposit.Depositor).String(),
+		Amount:     sdk.NewCoins(amount),
+	}
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	logs, err := q.executeCosmosMsg(func(ctx sdk.Context) error {
+		_, err := q.EVMKeeper.govMsgServer.Deposit(sdk.WrapSDKContext(ctx), msg)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return proto.Marshal(&librustgo.QueryGovDepositResponse{Logs: logs})
+}
+
+// GovProposal returns status, proposer, title and timestamps of the governance proposal
+func (q Connector) GovProposal(req *librustgo.CosmosRequest_GovProposal) ([]byte, error) {
+	if q.EVMKeeper.govKeeper == nil {
+		return nil, errors.New("governance precompile is not enabled")
+	}
+
+	proposal, found := q.EVMKeeper.govKeeper.GetProposal(q.currentContext(), req.GovProposal.ProposalId)
+	if !found {
+		return nil, fmt.Errorf("proposal %d not found", req.GovProposal.ProposalId)
+	}
+
+	// Proposer may be empty for proposals, migrated from v1beta1
+	var proposer []byte
+	if proposal.Proposer != "" {
+		address, err := sdk.AccAddressFromBech32(proposal.Proposer)
+		if err != nil {
+			return nil, err
+		}
+		proposer = address.Bytes()
+	}
+
+	unixOrZero := func(t *time.Time) int64 {
+		if t == nil {
+			return 0
+		}
+		return t.Unix()
+	}
+
+	return proto.Marshal(&librustgo.QueryGovProposalResponse{
+		Id:              proposal.Id,
+		Status:          int32(proposal.Status),
+		Proposer:        proposer,
+		Title:           proposal.Title,
+		SubmitTime:      unixOrZero(proposal.SubmitTime),
+		DepositEndTime:  unixOrZero(proposal.DepositEndTime),
+		VotingStartTime: unixOrZero(proposal.VotingStartTime),
+		VotingEndTime:   unixOrZero(proposal.VotingEndTime),
+	})
+}
+
+// GovTally returns tally of the governance proposal. For proposals in voting period, current tally is
+// calculated, otherwise final tally result is returned. Calculation reads every vote of the proposal and
+// delegations of the voters, so it is charged by gas meter of the request in proportion to number of votes
+func (q Connector) GovTally(req *librustgo.CosmosRequest_GovTally) ([]byte, error) {
+	if q.EVMKeeper.govKeeper == nil {
+		return nil, errors.New("governance precompile is not enabled")
+	}
+
+	proposal, found := q.EVMKeeper.govKeeper.GetProposal(q.currentContext(), req.GovTally.ProposalId)
+	if !found {
+		return nil, fmt.Errorf("proposal %d not found", req.GovTally.ProposalId)
+	}
+
+	var tally govv1.TallyResult
+	switch {
+	case proposal.Status == govv1.StatusVotingPeriod:
+		// Tally removes counted votes from the store, so it is calculated in a discarded context
+		cacheCtx, _ := q.currentContext().CacheContext()
+		_, _, tally = q.EVMKeeper.govKeeper.Tally(cacheCtx, proposal)
+	case proposal.FinalTallyResult != nil:
+		tally = *proposal.FinalTallyResult
+	default:
+		tally = govv1.EmptyTallyResult()
+	}
+
+	counts := make([][]byte, 0, 4)
+	for _, count := range []string{tally.YesCount, tally.AbstainCount, tally.NoCount, tally.NoWithVetoCount} {
+		amount, ok := sdkmath.NewIntFromString(count)
+		if !ok {
+			return nil, fmt.Errorf("invalid tally count %q", count)
+		}
+		counts = append(counts, amount.BigInt().Bytes())
+	}
+
+	return proto.Marshal(&librustgo.QueryGovTallyResponse{
+		Yes:        counts[0],
+		Abstain:    counts[1],
+		No:         counts[2],
+		NoWithVeto: counts[3],
+	})
+}
+
+// CosmosSnapshot handles incoming protobuf-encoded request for snapshot of Cosmos state. It is requested
+// before precompile changes Cosmos state within call frame, which doesn't have a snapshot yet
+func (q Connector) CosmosSnapshot(_ *librustgo.CosmosRequest_CosmosSnapshot) ([]byte, error) {
+	if q.Cache == nil {
+		return nil, errors.New("cosmos state snapshots cannot be taken without connector cache")
+	}
+
+	snapshot := q.Cache.snapshot(q.Context)
+	return proto.Marshal(&librustgo.QueryCosmosSnapshotResponse{Snapshot: snapshot})
+}
+
+// RevertCosmosSnapshot handles incoming protobuf-encoded request to revert Cosmos state to the snapshot.
+// It is requested if call frame, which changed Cosmos state, failed
+func (q Connector) RevertCosmosSnapshot(req *librustgo.CosmosRequest_RevertCosmosSnapshot) ([]byte, error) {
+	if q.Cache == nil {
+		return nil, errors.New("cosmos state snapshots cannot be reverted without connector cache")
+	}
+
+	if err := q.Cache.revertToSnapshot(req.RevertCosmosSnapshot.Snapshot); err != nil {
+		return nil, err
+	}
+	return proto.Marshal(&librustgo.QueryRevertCosmosSnapshotResponse{})
+}
+
+// coinFromBytes converts denom and big-endian encoded amount to the coin
+func coinFromBytes(denom string, amount []byte) (sdk.Coin, error) {
+	if err := sdk.ValidateDenom(denom); err != nil {
+		return sdk.Coin{}, err
+	}
+	return sdk.NewCoin(denom, sdkmath.NewIntFromBigInt(new(big.Int).SetBytes(amount))), nil
+}
+
+// bondCoin converts big-endian encoded amount to the coin of staking bond denom
+func (q Connector) bondCoin(amount []byte) sdk.Coin {
+	return sdk.NewCoin(q.EVMKeeper.stakingKeeper.BondDenom(q.currentContext()), sdkmath.NewIntFromBigInt(new(big.Int).SetBytes(amount)))
+}
+
+// executeCosmosMsg executes Cosmos message, requested by precompile, in a cached context, so changes are
+// written to the current Cosmos snapshot only if message was handled successfully. Changes of EVM denom balances
+// are calculated using bank events. They are recorded in connector cache and returned in response to metered
+// request, so precompile applies them to EVM state. Emitted events are returned as logs, which are emitted by precompile
+func (q Connector) executeCosmosMsg(handler func(ctx sdk.Context) error) ([]*librustgo.Log, error) {
+	if q.Cache == nil {
+		return nil, errors.New("cosmos messages cannot be executed without connector cache")
+	}
+
+	cacheCtx, writeCache := q.currentContext().CacheContext()
+	if err := handler(cacheCtx); err != nil {
+		return nil, err
+	}
+
+	events := cacheCtx.EventManager().Events()
+	evmDenom := q.EVMKeeper.GetParams(q.currentContext()).EvmDenom
+	changes, err := balanceChangesFromEvents(events, evmDenom)
+	if err != nil {
+		return nil, err
+	}
+	logs, err := cosmosEventsToLogs(events)
+	if err != nil {
+		return nil, err
+	}
+	for address, delta := range changes {
+		q.Cache.addBalanceChange(address, delta)
+		if q.balanceChanges != nil {
+			if q.balanceChanges[address] == nil {
+				q.balanceChanges[address] = new(big.Int)
+			}
+			q.balanceChanges[address].Add(q.balanceChanges[address], delta)
+		}
+	}
+
+	writeCache()
+	return logs, nil
+}
+
+// balanceChangesFromEvents calculates net changes of balances in provided denom, using coin_spent
+// and coin_received events, emitted by x/bank module
+func balanceChangesFromEvents(events sdk.Events, denom string) (map[common.Address]*big.Int, error) {
+	changes := make(map[common.Address]*big.Int)
+	for _, event := range events {
+		var accountKey string
+		var sign int64
+		switch event.Type {
+		case banktypes.EventTypeCoinSpent:
+			accountKey, sign = banktypes.AttributeKeySpender, -1
+		case banktypes.EventTypeCoinReceived:
+			accountKey, sign = banktypes.AttributeKeyReceiver, 1
+		default:
+			continue
+		}
+
+		var account sdk.AccAddress
+		var amount sdk.Coins
+		for _, attr := range event.Attributes {
+			var err error
+			switch attr.Key {
+			case accountKey:
+				account, err = sdk.AccAddressFromBech32(attr.Value)
+			case sdk.AttributeKeyAmount:
+				amount, err = sdk.ParseCoinsNormalized(attr.Value)
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+		if account.Empty() {
+			return nil, fmt.Errorf("%s event without account", event.Type)
+		}
+		// accounts with longer addresses, such as interchain accounts, are not accessible from EVM
+		if len(account) != common.AddressLength {
+			continue
+		}
+
+		address := common.BytesToAddress(account)
+		if changes[address] == nil {
+			changes[address] = new(big.Int)
+		}
+		delta := new(big.Int).Mul(amount.AmountOf(denom).BigInt(), big.NewInt(sign))
+		changes[address].Add(changes[address], delta)
+	}
+	return changes, nil
+}
+
+// cosmosEventSignature is the signature of EVM event, which represents Cosmos event, emitted by precompile:
+// `event CosmosEvent(string indexed eventType, string[] keys, string[] values)`
+var cosmosEventSignature = crypto.Keccak256([]byte("CosmosEvent(string,string[],string[])"))
+
+// cosmosEventArguments are non-indexed arguments of CosmosEvent
+var cosmosEventArguments = abi.Arguments{
+	{Name: "keys", Type: mustNewABIType("string[]")},
+	{Name: "values", Type: mustNewABIType("string[]")},
+}
+
+func mustNewABIType(t string) abi.Type {
+	typ, err := abi.NewType(t, "", nil)
+	if err != nil {
+		panic(err)
+	}
+	return typ
+}
+
+// cosmosEventsToLogs converts Cosmos events to logs of CosmosEvent EVM event. Address of logs is set
+// by SGXVM to the address of precompile, which emits them
+func cosmosEventsToLogs(events sdk.Events) ([]*librustgo.Log, error) {
+	logs := make([]*librustgo.Log, 0, len(events))
+	for _, event := range events {
+		keys := make([]string, 0, len(event.Attributes))
+		values := make([]string, 0, len(event.Attributes))
+		for _, attr := range event.Attributes {
+			keys = append(keys, attr.Key)
+			values = append(values, attr.Value)
+		}
+
+		data, err := cosmosEventArguments.Pack(keys, values)
+		if err != nil {
+			return nil, err
+		}
+		logs = append(logs, &librustgo.Log{
+			Topics: []*librustgo.Topic{
+				{Inner: cosmosEventSignature},
+				{Inner: crypto.Keccak256([]byte(event.Type))},
+			},
+			Data: data,
+		})
+	}
+	return logs, nil
+}
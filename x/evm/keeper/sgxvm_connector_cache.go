@@ -0,0 +1,179 @@
+package keeper
+
+import (
+	"bytes"
+	"fmt"
+	"math/big"
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// cachedAccount contains account data, returned to the enclave in response to account request
+type cachedAccount struct {
+	balance *big.Int
+	nonce   uint64
+}
+
+// ConnectorCache is a transaction-scoped cache of EVM state, used by Connector. Repeated reads of
+// accounts and storage cells are served from memory, storage writes are kept in memory until
+// Connector.Flush is called. Account writes go directly to the keeper, since they can fail
+// (for example, because of locked vesting coins) and such error should be returned to the enclave
+// at the moment of write. Cache is not safe for concurrent use
+type ConnectorCache struct {
+	accounts map[common.Address]cachedAccount
+	storage  map[common.Address]map[common.Hash][]byte
+	dirty    map[common.Address]map[common.Hash]struct{}
+	// balanceChanges contains net changes of EVM denom balances, made by Cosmos messages, which were
+	// executed by precompiles during transaction. Precompiles apply these changes to EVM state inside
+	// the enclave, so they are excluded from balances, returned to the enclave, to be applied only once
+	balanceChanges map[common.Address]*big.Int
+	// snapshots are cached layers of Cosmos state, requested by SGXVM before precompiles change it.
+	// Changes of Cosmos state, made within failed call frame, are dropped together with the layers
+	// of the frame
+	snapshots []cosmosSnapshot
+}
+
+// cosmosSnapshot is a cached layer of Cosmos state, which contains changes made after snapshot was taken
+type cosmosSnapshot struct {
+	ctx   sdk.Context
+	write func()
+	// balanceChanges contains balance changes at the moment snapshot was taken
+	balanceChanges map[common.Address]*big.Int
+}
+
+// NewConnectorCache returns empty cache, which should be used for a single transaction
+func NewConnectorCache() *ConnectorCache {
+	return &ConnectorCache{
+		accounts: make(map[common.Address]cachedAccount),
+		storage:  make(map[common.Address]map[common.Hash][]byte),
+		dirty:    make(map[common.Address]map[common.Hash]struct{}),
+
+		balanceChanges: make(map[common.Address]*big.Int),
+	}
+}
+
+func (c *ConnectorCache) getAccount(address common.Address) (cachedAccount, bool) {
+	account, found := c.accounts[address]
+	return account, found
+}
+
+func (c *ConnectorCache) setAccount(address common.Address, balance *big.Int, nonce uint64) {
+	c.accounts[address] = cachedAccount{balance: new(big.Int).Set(balance), nonce: nonce}
+}
+
+// balanceChange returns net change of account balance, made by Cosmos messages during transaction
+func (c *ConnectorCache) balanceChange(address common.Address) *big.Int {
+	if change, found := c.balanceChanges[address]; found {
+		return new(big.Int).Set(change)
+	}
+	return new(big.Int)
+}
+
+// addBalanceChange records change of account balance, made by Cosmos message
+func (c *ConnectorCache) addBalanceChange(address common.Address, delta *big.Int) {
+	c.balanceChanges[address] = new(big.Int).Add(c.balanceChange(address), delta)
+}
+
+func (c *ConnectorCache) getState(address common.Address, index common.Hash) ([]byte, bool) {
+	value, found := c.storage[address][index]
+	return value, found
+}
+
+// loadState records value of storage cell, which was read from the keeper
+func (c *ConnectorCache) loadState(address common.Address, index common.Hash, value []byte) {
+	if c.storage[address] == nil {
+		c.storage[address] = make(map[common.Hash][]byte)
+	}
+	c.storage[address][index] = value
+}
+
+// setState records new value of storage cell, which should be written to the keeper on flush
+func (c *ConnectorCache) setState(address common.Address, index common.Hash, value []byte) {
+	c.loadState(address, index, value)
+	if c.dirty[address] == nil {
+		c.dirty[address] = make(map[common.Hash]struct{})
+	}
+	c.dirty[address][index] = struct{}{}
+}
+
+// removeAccount drops all cached data of provided account, including not flushed storage writes.
+// It is called after account was removed from the keeper together with its storage
+func (c *ConnectorCache) removeAccount(address common.Address) {
+	delete(c.accounts, address)
+	delete(c.storage, address)
+	delete(c.dirty, address)
+}
+
+// dirtyAddresses returns addresses with not flushed storage writes in deterministic order
+func (c *ConnectorCache) dirtyAddresses() []common.Address {
+	addresses := make([]common.Address, 0, len(c.dirty))
+	for address := range c.dirty {
+		addresses = append(addresses, address)
+	}
+	sort.Slice(addresses, func(i, j int) bool {
+		return bytes.Compare(addresses[i].Bytes(), addresses[j].Bytes()) < 0
+	})
+	return addresses
+}
+
+// dirtyIndices returns indices of not flushed storage cells of provided account in deterministic order
+func (c *ConnectorCache) dirtyIndices(address common.Address) []common.Hash {
+	indices := make([]common.Hash, 0, len(c.dirty[address]))
+	for index := range c.dirty[address] {
+		indices = append(indices, index)
+	}
+	sort.Slice(indices, func(i, j int) bool {
+		return bytes.Compare(indices[i].Bytes(), indices[j].Bytes()) < 0
+	})
+	return indices
+}
+
+// context returns context of the latest snapshot or provided base context if there are no snapshots
+func (c *ConnectorCache) context(base sdk.Context) sdk.Context {
+	if len(c.snapshots) == 0 {
+		return base
+	}
+	return c.snapshots[len(c.snapshots)-1].ctx
+}
+
+// snapshot starts new layer of Cosmos state on top of the current one and returns its identifier
+func (c *ConnectorCache) snapshot(base sdk.Context) uint32 {
+	ctx, write := c.context(base).CacheContext()
+	balanceChanges := make(map[common.Address]*big.Int, len(c.balanceChanges))
+	for address, change := range c.balanceChanges {
+		balanceChanges[address] = new(big.Int).Set(change)
+	}
+
+	c.snapshots = append(c.snapshots, cosmosSnapshot{ctx: ctx, write: write, balanceChanges: balanceChanges})
+	return uint32(len(c.snapshots) - 1)
+}
+
+// revertToSnapshot drops changes of Cosmos state, made after snapshot with provided identifier was taken
+func (c *ConnectorCache) revertToSnapshot(id uint32) error {
+	if int(id) >= len(c.snapshots) {
+		return fmt.Errorf("snapshot %d doesn't exist", id)
+	}
+
+	c.balanceChanges = c.snapshots[id].balanceChanges
+	c.snapshots = c.snapshots[:id]
+	return nil
+}
+
+// commitSnapshots writes all layers of Cosmos state to the base context
+func (c *ConnectorCache) commitSnapshots() {
+	for i := len(c.snapshots) - 1; i >= 0; i-- {
+		c.snapshots[i].write()
+	}
+	c.snapshots = nil
+}
+
+// reset drops all cached data
+func (c *ConnectorCache) reset() {
+	c.accounts = make(map[common.Address]cachedAccount)
+	c.storage = make(map[common.Address]map[common.Hash][]byte)
+	c.dirty = make(map[common.Address]map[common.Hash]struct{})
+	c.balanceChanges = make(map[common.Address]*big.Int)
+	c.snapshots = nil
+}
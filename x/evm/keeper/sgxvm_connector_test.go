@@ -1,20 +1,28 @@
 package keeper_test
 
 import (
+	"bytes"
 	"math/big"
 	"math/rand"
 	"time"
 
+	sdkmath "cosmossdk.io/math"
 	"github.com/SigmaGmbH/librustgo"
 	"github.com/SigmaGmbH/librustgo/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/golang/protobuf/proto"
 
 	"swisstronik/tests"
+	"swisstronik/utils"
 	compliancetypes "swisstronik/x/compliance/types"
 	evmkeeper "swisstronik/x/evm/keeper"
+	evmtypes "swisstronik/x/evm/types"
 )
 
 func insertAccount(
@@ -239,6 +247,78 @@ func (suite *KeeperTestSuite) TestSGXVMConnector() {
 				suite.Require().Equal(bytecode, accountCodeResponse.Code)
 			},
 		},
+		{
+			"Should be able to handle batch of requests",
+			func() {
+				addressToSet := common.BigToAddress(big.NewInt(rand.Int63n(100000)))
+				index := common.BigToHash(big.NewInt(1))
+				value := make([]byte, 32)
+				rand.Read(value)
+
+				insertRequest, err := proto.Marshal(&librustgo.CosmosRequest{
+					Req: &librustgo.CosmosRequest_InsertStorageCell{
+						InsertStorageCell: &librustgo.QueryInsertStorageCell{
+							Address: addressToSet.Bytes(),
+							Index:   index.Bytes(),
+							Value:   value,
+						},
+					},
+				})
+				suite.Require().NoError(err)
+
+				getRequest, err := proto.Marshal(&librustgo.CosmosRequest{
+					Req: &librustgo.CosmosRequest_StorageCell{
+						StorageCell: &librustgo.QueryGetAccountStorageCell{
+							Address: addressToSet.Bytes(),
+							Index:   index.Bytes(),
+						},
+					},
+				})
+				suite.Require().NoError(err)
+
+				request, err := proto.Marshal(&librustgo.CosmosRequest{
+					Req: &librustgo.CosmosRequest_Batch{
+						Batch: &librustgo.QueryBatch{Requests: [][]byte{insertRequest, getRequest}},
+					},
+				})
+				suite.Require().NoError(err)
+
+				responseBytes, err := connector.Query(request)
+				suite.Require().NoError(err)
+
+				response := &librustgo.QueryBatchResponse{}
+				err = proto.Unmarshal(responseBytes, response)
+				suite.Require().NoError(err)
+				suite.Require().Len(response.Responses, 2)
+
+				// Storage cell, inserted by first request, should be visible for the second one
+				storageCellResponse := &librustgo.QueryGetAccountStorageCellResponse{}
+				err = proto.Unmarshal(response.Responses[1], storageCellResponse)
+				suite.Require().NoError(err)
+				suite.Require().Equal(value, storageCellResponse.Value)
+			},
+		},
+		{
+			"Should reject nested batch requests",
+			func() {
+				nestedRequest, err := proto.Marshal(&librustgo.CosmosRequest{
+					Req: &librustgo.CosmosRequest_Batch{
+						Batch: &librustgo.QueryBatch{},
+					},
+				})
+				suite.Require().NoError(err)
+
+				request, err := proto.Marshal(&librustgo.CosmosRequest{
+					Req: &librustgo.CosmosRequest_Batch{
+						Batch: &librustgo.QueryBatch{Requests: [][]byte{nestedRequest}},
+					},
+				})
+				suite.Require().NoError(err)
+
+				_, err = connector.Query(request)
+				suite.Require().Error(err)
+			},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -615,3 +695,931 @@ func (suite *KeeperTestSuite) TestMultipleVerificationDetails() {
 		suite.Require().Equal(expected[i].Version, details.Version)
 	}
 }
+
+func (suite *KeeperTestSuite) TestSGXVMConnectorCache() {
+	address := common.BigToAddress(big.NewInt(rand.Int63n(100000)))
+	index := common.BigToHash(big.NewInt(1))
+	value := common.BigToHash(big.NewInt(42)).Bytes()
+
+	getStorageCell := func(connector evmkeeper.Connector) []byte {
+		request, err := proto.Marshal(&librustgo.CosmosRequest{
+			Req: &librustgo.CosmosRequest_StorageCell{
+				StorageCell: &librustgo.QueryGetAccountStorageCell{
+					Address: address.Bytes(),
+					Index:   index.Bytes(),
+				},
+			},
+		})
+		suite.Require().NoError(err)
+
+		responseBytes, err := connector.Query(request)
+		suite.Require().NoError(err)
+
+		response := &librustgo.QueryGetAccountStorageCellResponse{}
+		suite.Require().NoError(proto.Unmarshal(responseBytes, response))
+		return response.Value
+	}
+
+	insertStorageCell := func(connector evmkeeper.Connector) {
+		request, err := proto.Marshal(&librustgo.CosmosRequest{
+			Req: &librustgo.CosmosRequest_InsertStorageCell{
+				InsertStorageCell: &librustgo.QueryInsertStorageCell{
+					Address: address.Bytes(),
+					Index:   index.Bytes(),
+					Value:   value,
+				},
+			},
+		})
+		suite.Require().NoError(err)
+
+		_, err = connector.Query(request)
+		suite.Require().NoError(err)
+	}
+
+	testCases := []struct {
+		name   string
+		action func(connector evmkeeper.Connector)
+	}{
+		{
+			"Should serve written storage cell from cache and write it on flush",
+			func(connector evmkeeper.Connector) {
+				insertStorageCell(connector)
+				suite.Require().Equal(value, getStorageCell(connector))
+				suite.Require().Nil(suite.app.EvmKeeper.GetState(suite.ctx, address, index))
+
+				connector.Flush()
+				suite.Require().Equal(value, suite.app.EvmKeeper.GetState(suite.ctx, address, index))
+			},
+		},
+		{
+			"Should serve repeated reads from cache",
+			func(connector evmkeeper.Connector) {
+				suite.Require().Nil(getStorageCell(connector))
+
+				// Value, written outside of connector, is not observed during the same transaction
+				suite.app.EvmKeeper.SetState(suite.ctx, address, index, value)
+				suite.Require().Nil(getStorageCell(connector))
+			},
+		},
+		{
+			"Should drop cached writes on discard",
+			func(connector evmkeeper.Connector) {
+				insertStorageCell(connector)
+				connector.Discard()
+				connector.Flush()
+
+				suite.Require().Nil(suite.app.EvmKeeper.GetState(suite.ctx, address, index))
+				suite.Require().Nil(getStorageCell(connector))
+			},
+		},
+		{
+			"Should return cached account after insert",
+			func(connector evmkeeper.Connector) {
+				err := insertAccount(&connector, address, big.NewInt(1000), big.NewInt(3))
+				suite.Require().NoError(err)
+
+				request, err := proto.Marshal(&librustgo.CosmosRequest{
+					Req: &librustgo.CosmosRequest_GetAccount{
+						GetAccount: &librustgo.QueryGetAccount{Address: address.Bytes()},
+					},
+				})
+				suite.Require().NoError(err)
+
+				responseBytes, err := connector.Query(request)
+				suite.Require().NoError(err)
+
+				response := &librustgo.QueryGetAccountResponse{}
+				suite.Require().NoError(proto.Unmarshal(responseBytes, response))
+				suite.Require().Equal(big.NewInt(1000), new(big.Int).SetBytes(response.Balance))
+				suite.Require().Equal(uint64(3), response.Nonce)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			tc.action(evmkeeper.Connector{
+				Context:   suite.ctx,
+				EVMKeeper: suite.app.EvmKeeper,
+				Cache:     evmkeeper.NewConnectorCache(),
+			})
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestSGXVMConnectorStateWrites() {
+	address := common.BigToAddress(big.NewInt(rand.Int63n(100000)))
+	index := common.BigToHash(big.NewInt(1))
+	value := common.BigToHash(big.NewInt(42)).Bytes()
+	code := []byte{0x60, 0x00}
+
+	newConnector := func() evmkeeper.Connector {
+		return evmkeeper.Connector{
+			Context:   suite.ctx,
+			EVMKeeper: suite.app.EvmKeeper,
+			Cache:     evmkeeper.NewConnectorCache(),
+			Writes:    evmtypes.NewStateWrites(),
+		}
+	}
+
+	testCases := []struct {
+		name     string
+		action   func(connector evmkeeper.Connector)
+		expected func() common.Hash
+	}{
+		{
+			"Should commit to account, code and storage writes",
+			func(connector evmkeeper.Connector) {
+				suite.Require().NoError(insertAccount(&connector, address, big.NewInt(1000), big.NewInt(1)))
+				suite.Require().NoError(queryConnector(connector, &librustgo.CosmosRequest{
+					Req: &librustgo.CosmosRequest_InsertAccountCode{
+						InsertAccountCode: &librustgo.QueryInsertAccountCode{Address: address.Bytes(), Code: code},
+					},
+				}, &librustgo.QueryInsertAccountCodeResponse{}))
+				suite.Require().NoError(queryConnector(connector, &librustgo.CosmosRequest{
+					Req: &librustgo.CosmosRequest_InsertStorageCell{
+						InsertStorageCell: &librustgo.QueryInsertStorageCell{Address: address.Bytes(), Index: index.Bytes(), Value: value},
+					},
+				}, &librustgo.QueryInsertStorageCellResponse{}))
+			},
+			func() common.Hash {
+				writes := evmtypes.NewStateWrites()
+				writes.SetAccount(address, big.NewInt(1000), 1)
+				writes.SetCode(address, code)
+				writes.SetState(address, index, value)
+				return writes.Hash()
+			},
+		},
+		{
+			"Should commit to removed storage cell as zero value",
+			func(connector evmkeeper.Connector) {
+				suite.Require().NoError(queryConnector(connector, &librustgo.CosmosRequest{
+					Req: &librustgo.CosmosRequest_RemoveStorageCell{
+						RemoveStorageCell: &librustgo.QueryRemoveStorageCell{Address: address.Bytes(), Index: index.Bytes()},
+					},
+				}, &librustgo.QueryRemoveStorageCellResponse{}))
+			},
+			func() common.Hash {
+				writes := evmtypes.NewStateWrites()
+				writes.SetState(address, index, common.Hash{}.Bytes())
+				return writes.Hash()
+			},
+		},
+		{
+			"Should commit to empty set of writes after discard",
+			func(connector evmkeeper.Connector) {
+				suite.Require().NoError(insertAccount(&connector, address, big.NewInt(1000), big.NewInt(1)))
+				connector.Discard()
+			},
+			func() common.Hash {
+				return evmtypes.NewStateWrites().Hash()
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			connector := newConnector()
+			tc.action(connector)
+			suite.Require().Equal(tc.expected(), connector.WritesHash())
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestStateRootTransient() {
+	suite.SetupTest()
+	header := suite.ctx.BlockHeader()
+	header.AppHash = common.BigToHash(big.NewInt(1)).Bytes()
+	ctx := suite.ctx.WithBlockHeader(header)
+
+	// state root chain of the block starts from app hash
+	suite.Require().Equal(common.BytesToHash(header.AppHash), suite.app.EvmKeeper.GetStateRootTransient(ctx))
+
+	stateRoot := common.BigToHash(big.NewInt(2))
+	suite.app.EvmKeeper.SetStateRootTransient(ctx, stateRoot)
+	suite.Require().Equal(stateRoot, suite.app.EvmKeeper.GetStateRootTransient(ctx))
+}
+
+// queryConnector sends encoded request to the connector and decodes the response
+func queryConnector(connector evmkeeper.Connector, req *librustgo.CosmosRequest, res proto.Message) error {
+	request, err := proto.Marshal(req)
+	if err != nil {
+		return err
+	}
+
+	responseBytes, err := connector.Query(request)
+	if err != nil {
+		return err
+	}
+	return proto.Unmarshal(responseBytes, res)
+}
+
+// queryMetered sends request to the connector as metered request of precompile with provided gas limit
+func queryMetered(connector evmkeeper.Connector, req *librustgo.CosmosRequest, gasLimit uint64) (*librustgo.QueryMeteredResponse, error) {
+	encodedRequest, err := proto.Marshal(req)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &librustgo.QueryMeteredResponse{}
+	err = queryConnector(connector, &librustgo.CosmosRequest{
+		Req: &librustgo.CosmosRequest_Metered{
+			Metered: &librustgo.QueryMetered{Request: encodedRequest, GasLimit: gasLimit},
+		},
+	}, res)
+	return res, err
+}
+
+func (suite *KeeperTestSuite) TestSGXVMConnectorStaking() {
+	var (
+		connector evmkeeper.Connector
+		delegator common.Address
+		validator stakingtypes.Validator
+	)
+
+	initialBalance := big.NewInt(1_000_000)
+	amount := big.NewInt(400_000)
+
+	getAccountBalance := func() *big.Int {
+		res := &librustgo.QueryGetAccountResponse{}
+		err := queryConnector(connector, &librustgo.CosmosRequest{
+			Req: &librustgo.CosmosRequest_GetAccount{
+				GetAccount: &librustgo.QueryGetAccount{Address: delegator.Bytes()},
+			},
+		}, res)
+		suite.Require().NoError(err)
+		return new(big.Int).SetBytes(res.Balance)
+	}
+
+	delegateRequest := func(validatorAddress string, amount *big.Int) *librustgo.CosmosRequest {
+		return &librustgo.CosmosRequest{
+			Req: &librustgo.CosmosRequest_Delegate{
+				Delegate: &librustgo.QueryDelegate{
+					Delegator:        delegator.Bytes(),
+					ValidatorAddress: validatorAddress,
+					Amount:           amount.Bytes(),
+				},
+			},
+		}
+	}
+
+	delegate := func(validatorAddress string, amount *big.Int) error {
+		return queryConnector(connector, delegateRequest(validatorAddress, amount), &librustgo.QueryDelegateResponse{})
+	}
+
+	delegation := func() *big.Int {
+		res := &librustgo.QueryDelegationResponse{}
+		err := queryConnector(connector, &librustgo.CosmosRequest{
+			Req: &librustgo.CosmosRequest_Delegation{
+				Delegation: &librustgo.QueryDelegation{
+					Delegator:        delegator.Bytes(),
+					ValidatorAddress: validator.OperatorAddress,
+				},
+			},
+		}, res)
+		suite.Require().NoError(err)
+		return new(big.Int).SetBytes(res.Amount)
+	}
+
+	testCases := []struct {
+		name   string
+		action func()
+	}{
+		{
+			"Should delegate and keep balance, observed by SGXVM, unchanged",
+			func() {
+				suite.Require().NoError(delegate(validator.OperatorAddress, amount))
+				suite.Require().Equal(amount, delegation())
+
+				expBalance := new(big.Int).Sub(initialBalance, amount)
+				suite.Require().Equal(expBalance, suite.app.EvmKeeper.GetBalance(suite.ctx, delegator))
+				suite.Require().Equal(initialBalance, getAccountBalance())
+
+				// balance, written by SGXVM, includes delegated amount, since it was applied by precompile,
+				// while returned balance still excludes it
+				err := insertAccount(&connector, delegator, big.NewInt(500_000), big.NewInt(1))
+				suite.Require().NoError(err)
+				suite.Require().Equal(big.NewInt(500_000), suite.app.EvmKeeper.GetBalance(suite.ctx, delegator))
+				suite.Require().Equal(big.NewInt(900_000), getAccountBalance())
+			},
+		},
+		{
+			"Should return balance changes of delegation to precompile",
+			func() {
+				res, err := queryMetered(connector, delegateRequest(validator.OperatorAddress, amount), 1_000_000)
+				suite.Require().NoError(err)
+				suite.Require().Empty(res.Error)
+
+				// delegated coins are moved to bonded pool, so total supply is unchanged
+				var delegatorChange *librustgo.BalanceChange
+				total := new(big.Int)
+				for _, change := range res.BalanceChanges {
+					if bytes.Equal(delegator.Bytes(), change.Address) {
+						delegatorChange = change
+					}
+					if change.Decrease {
+						total.Sub(total, new(big.Int).SetBytes(change.Amount))
+					} else {
+						total.Add(total, new(big.Int).SetBytes(change.Amount))
+					}
+				}
+				suite.Require().NotNil(delegatorChange)
+				suite.Require().True(delegatorChange.Decrease)
+				suite.Require().Equal(amount, new(big.Int).SetBytes(delegatorChange.Amount))
+				suite.Require().Equal(0, total.Sign())
+
+				// failed delegation doesn't change balances
+				overBalance := new(big.Int).Add(initialBalance, big.NewInt(1))
+				res, err = queryMetered(connector, delegateRequest(validator.OperatorAddress, overBalance), 1_000_000)
+				suite.Require().NoError(err)
+				suite.Require().NotEmpty(res.Error)
+				suite.Require().Empty(res.BalanceChanges)
+			},
+		},
+		{
+			"Should not change state if delegation failed",
+			func() {
+				suite.Require().Error(delegate(validator.OperatorAddress, new(big.Int).Add(initialBalance, big.NewInt(1))))
+				suite.Require().Error(delegate("invalid", amount))
+				suite.Require().Error(delegate(validator.OperatorAddress, big.NewInt(0)))
+
+				suite.Require().Equal(initialBalance, suite.app.EvmKeeper.GetBalance(suite.ctx, delegator))
+				suite.Require().Equal(0, delegation().Sign())
+			},
+		},
+		{
+			"Should not delegate without connector cache",
+			func() {
+				connector.Cache = nil
+				suite.Require().Error(delegate(validator.OperatorAddress, amount))
+			},
+		},
+		{
+			"Should undelegate",
+			func() {
+				suite.Require().NoError(delegate(validator.OperatorAddress, amount))
+
+				res := &librustgo.QueryUndelegateResponse{}
+				err := queryConnector(connector, &librustgo.CosmosRequest{
+					Req: &librustgo.CosmosRequest_Undelegate{
+						Undelegate: &librustgo.QueryUndelegate{
+							Delegator:        delegator.Bytes(),
+							ValidatorAddress: validator.OperatorAddress,
+							Amount:           amount.Bytes(),
+						},
+					},
+				}, res)
+				suite.Require().NoError(err)
+				suite.Require().Greater(res.CompletionTime, suite.ctx.BlockTime().Unix())
+				suite.Require().Equal(0, delegation().Sign())
+			},
+		},
+		{
+			"Should not redelegate to unknown validator",
+			func() {
+				suite.Require().NoError(delegate(validator.OperatorAddress, amount))
+
+				err := queryConnector(connector, &librustgo.CosmosRequest{
+					Req: &librustgo.CosmosRequest_Redelegate{
+						Redelegate: &librustgo.QueryRedelegate{
+							Delegator:           delegator.Bytes(),
+							ValidatorSrcAddress: validator.OperatorAddress,
+							ValidatorDstAddress: sdk.ValAddress(delegator.Bytes()).String(),
+							Amount:              amount.Bytes(),
+						},
+					},
+				}, &librustgo.QueryRedelegateResponse{})
+				suite.Require().Error(err)
+				suite.Require().Equal(amount, delegation())
+			},
+		},
+		{
+			"Should withdraw delegation rewards",
+			func() {
+				suite.Require().NoError(delegate(validator.OperatorAddress, amount))
+
+				// delegation receives rewards starting from the next block
+				suite.ctx = suite.ctx.WithBlockHeight(suite.ctx.BlockHeight() + 1)
+				connector.Context = suite.ctx
+
+				// allocate rewards to the validator
+				rewards := sdk.NewCoins(sdk.NewCoin(utils.BaseDenom, sdkmath.NewIntWithDecimal(1, 24)))
+				suite.Require().NoError(suite.app.BankKeeper.MintCoins(suite.ctx, evmtypes.ModuleName, rewards))
+				suite.Require().NoError(suite.app.BankKeeper.SendCoinsFromModuleToModule(suite.ctx, evmtypes.ModuleName, distrtypes.ModuleName, rewards))
+				validator, _ = suite.app.StakingKeeper.GetValidator(suite.ctx, validator.GetOperator())
+				suite.app.DistrKeeper.AllocateTokensToValidator(suite.ctx, validator, sdk.NewDecCoinsFromCoins(rewards...))
+
+				res := &librustgo.QueryWithdrawDelegatorRewardResponse{}
+				err := queryConnector(connector, &librustgo.CosmosRequest{
+					Req: &librustgo.CosmosRequest_WithdrawDelegatorReward{
+						WithdrawDelegatorReward: &librustgo.QueryWithdrawDelegatorReward{
+							Delegator:        delegator.Bytes(),
+							ValidatorAddress: validator.OperatorAddress,
+						},
+					},
+				}, res)
+				suite.Require().NoError(err)
+
+				withdrawn := new(big.Int).SetBytes(res.Amount)
+				suite.Require().Equal(1, withdrawn.Sign())
+
+				expBalance := new(big.Int).Sub(initialBalance, amount)
+				expBalance.Add(expBalance, withdrawn)
+				suite.Require().Equal(expBalance, suite.app.EvmKeeper.GetBalance(suite.ctx, delegator))
+				suite.Require().Equal(initialBalance, getAccountBalance())
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			validators := suite.app.StakingKeeper.GetBondedValidatorsByPower(suite.ctx)
+			suite.Require().NotEmpty(validators)
+			validator = validators[0]
+
+			delegator = common.BigToAddress(big.NewInt(rand.Int63n(100000) + 1))
+			suite.Require().NoError(suite.app.EvmKeeper.SetBalance(suite.ctx, delegator, initialBalance))
+
+			connector = evmkeeper.Connector{
+				Context:   suite.ctx,
+				EVMKeeper: suite.app.EvmKeeper,
+				Cache:     evmkeeper.NewConnectorCache(),
+			}
+			tc.action()
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestSGXVMConnectorBankAndIBCTransfer() {
+	var (
+		connector evmkeeper.Connector
+		sender    common.Address
+	)
+
+	const denom = "utest"
+	initialBalance := big.NewInt(1_000_000)
+	amount := big.NewInt(400_000)
+	recipient := common.BigToAddress(big.NewInt(rand.Int63n(100000) + 100001))
+
+	bankBalance := func(address common.Address, denom string) *big.Int {
+		res := &librustgo.QueryBankBalanceResponse{}
+		err := queryConnector(connector, &librustgo.CosmosRequest{
+			Req: &librustgo.CosmosRequest_BankBalance{
+				BankBalance: &librustgo.QueryBankBalance{Address: address.Bytes(), Denom: denom},
+			},
+		}, res)
+		suite.Require().NoError(err)
+		return new(big.Int).SetBytes(res.Amount)
+	}
+
+	send := func(denom string, amount *big.Int) (*librustgo.QueryBankSendResponse, error) {
+		res := &librustgo.QueryBankSendResponse{}
+		err := queryConnector(connector, &librustgo.CosmosRequest{
+			Req: &librustgo.CosmosRequest_BankSend{
+				BankSend: &librustgo.QueryBankSend{
+					From:   sender.Bytes(),
+					To:     recipient.Bytes(),
+					Denom:  denom,
+					Amount: amount.Bytes(),
+				},
+			},
+		}, res)
+		return res, err
+	}
+
+	snapshot := func() uint32 {
+		res := &librustgo.QueryCosmosSnapshotResponse{}
+		err := queryConnector(connector, &librustgo.CosmosRequest{
+			Req: &librustgo.CosmosRequest_CosmosSnapshot{CosmosSnapshot: &librustgo.QueryCosmosSnapshot{}},
+		}, res)
+		suite.Require().NoError(err)
+		return res.Snapshot
+	}
+
+	revert := func(snapshot uint32) error {
+		return queryConnector(connector, &librustgo.CosmosRequest{
+			Req: &librustgo.CosmosRequest_RevertCosmosSnapshot{
+				RevertCosmosSnapshot: &librustgo.QueryRevertCosmosSnapshot{Snapshot: snapshot},
+			},
+		}, &librustgo.QueryRevertCosmosSnapshotResponse{})
+	}
+
+	testCases := []struct {
+		name   string
+		action func()
+	}{
+		{
+			"Should revert sent coins to snapshot",
+			func() {
+				id := snapshot()
+				_, err := send(utils.BaseDenom, amount)
+				suite.Require().NoError(err)
+				suite.Require().Equal(amount, bankBalance(recipient, utils.BaseDenom))
+				suite.Require().Equal(0, suite.app.EvmKeeper.GetBalance(suite.ctx, recipient).Sign())
+
+				suite.Require().NoError(revert(id))
+				suite.Require().Equal(initialBalance, bankBalance(sender, utils.BaseDenom))
+				suite.Require().Equal(0, bankBalance(recipient, utils.BaseDenom).Sign())
+
+				// tracked balance change is reverted together with the balance
+				res := &librustgo.QueryGetAccountResponse{}
+				err = queryConnector(connector, &librustgo.CosmosRequest{
+					Req: &librustgo.CosmosRequest_GetAccount{
+						GetAccount: &librustgo.QueryGetAccount{Address: sender.Bytes()},
+					},
+				}, res)
+				suite.Require().NoError(err)
+				suite.Require().Equal(initialBalance, new(big.Int).SetBytes(res.Balance))
+			},
+		},
+		{
+			"Should revert only changes of nested snapshot and flush the rest",
+			func() {
+				suite.Require().Equal(uint32(0), snapshot())
+				_, err := send(denom, amount)
+				suite.Require().NoError(err)
+				nested := snapshot()
+				suite.Require().Equal(uint32(1), nested)
+				_, err = send(denom, amount)
+				suite.Require().NoError(err)
+				suite.Require().Equal(new(big.Int).Mul(amount, big.NewInt(2)), bankBalance(recipient, denom))
+
+				suite.Require().NoError(revert(nested))
+				suite.Require().Equal(amount, bankBalance(recipient, denom))
+				suite.Require().Error(revert(nested))
+
+				connector.Flush()
+				balance := suite.app.BankKeeper.GetBalance(suite.ctx, sdk.AccAddress(recipient.Bytes()), denom)
+				suite.Require().Equal(amount, balance.Amount.BigInt())
+			},
+		},
+		{
+			"Should not take snapshot without connector cache",
+			func() {
+				connector.Cache = nil
+				err := queryConnector(connector, &librustgo.CosmosRequest{
+					Req: &librustgo.CosmosRequest_CosmosSnapshot{CosmosSnapshot: &librustgo.QueryCosmosSnapshot{}},
+				}, &librustgo.QueryCosmosSnapshotResponse{})
+				suite.Require().Error(err)
+				suite.Require().Error(revert(0))
+			},
+		},
+		{
+			"Should send coins of any denom and return events as logs",
+			func() {
+				res, err := send(denom, amount)
+				suite.Require().NoError(err)
+
+				suite.Require().Equal(new(big.Int).Sub(initialBalance, amount), bankBalance(sender, denom))
+				suite.Require().Equal(amount, bankBalance(recipient, denom))
+
+				suite.Require().NotEmpty(res.Logs)
+				eventSignature := crypto.Keccak256([]byte("CosmosEvent(string,string[],string[])"))
+				transferTopic := crypto.Keccak256([]byte(banktypes.EventTypeTransfer))
+				var found bool
+				for _, log := range res.Logs {
+					suite.Require().Len(log.Topics, 2)
+					suite.Require().Equal(eventSignature, log.Topics[0].Inner)
+					found = found || bytes.Equal(transferTopic, log.Topics[1].Inner)
+				}
+				suite.Require().True(found)
+			},
+		},
+		{
+			"Should track sent EVM denom coins",
+			func() {
+				_, err := send(utils.BaseDenom, amount)
+				suite.Require().NoError(err)
+
+				res := &librustgo.QueryGetAccountResponse{}
+				err = queryConnector(connector, &librustgo.CosmosRequest{
+					Req: &librustgo.CosmosRequest_GetAccount{
+						GetAccount: &librustgo.QueryGetAccount{Address: recipient.Bytes()},
+					},
+				}, res)
+				suite.Require().NoError(err)
+				suite.Require().Equal(0, new(big.Int).SetBytes(res.Balance).Sign())
+				suite.Require().Equal(amount, suite.app.EvmKeeper.GetBalance(suite.ctx, recipient))
+			},
+		},
+		{
+			"Should not change state if sending failed",
+			func() {
+				_, err := send(denom, new(big.Int).Add(initialBalance, big.NewInt(1)))
+				suite.Require().Error(err)
+				_, err = send("invalid denom", amount)
+				suite.Require().Error(err)
+
+				suite.Require().Equal(initialBalance, bankBalance(sender, denom))
+				suite.Require().Equal(0, bankBalance(recipient, denom).Sign())
+			},
+		},
+		{
+			"Should not start ICS-20 transfer over unknown channel",
+			func() {
+				err := queryConnector(connector, &librustgo.CosmosRequest{
+					Req: &librustgo.CosmosRequest_IbcTransfer{
+						IbcTransfer: &librustgo.QueryIBCTransfer{
+							Sender:                sender.Bytes(),
+							SourcePort:            "transfer",
+							SourceChannel:         "channel-0",
+							Denom:                 denom,
+							Amount:                amount.Bytes(),
+							Receiver:              "cosmos1receiver",
+							TimeoutRevisionNumber: 1,
+							TimeoutRevisionHeight: 100,
+						},
+					},
+				}, &librustgo.QueryIBCTransferResponse{})
+				suite.Require().Error(err)
+				suite.Require().Equal(initialBalance, bankBalance(sender, denom))
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			sender = common.BigToAddress(big.NewInt(rand.Int63n(100000) + 1))
+			coins := sdk.NewCoins(
+				sdk.NewCoin(denom, sdkmath.NewIntFromBigInt(initialBalance)),
+				sdk.NewCoin(utils.BaseDenom, sdkmath.NewIntFromBigInt(initialBalance)),
+			)
+			suite.Require().NoError(suite.app.BankKeeper.MintCoins(suite.ctx, evmtypes.ModuleName, coins))
+			suite.Require().NoError(suite.app.BankKeeper.SendCoinsFromModuleToAccount(suite.ctx, evmtypes.ModuleName, sdk.AccAddress(sender.Bytes()), coins))
+
+			connector = evmkeeper.Connector{
+				Context:   suite.ctx,
+				EVMKeeper: suite.app.EvmKeeper,
+				Cache:     evmkeeper.NewConnectorCache(),
+			}
+			tc.action()
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestSGXVMConnectorGov() {
+	var (
+		connector evmkeeper.Connector
+		voter     common.Address
+		proposer  common.Address
+		proposal  govv1.Proposal
+		validator stakingtypes.Validator
+	)
+
+	initialBalance := big.NewInt(1_000_000)
+	amount := big.NewInt(400_000)
+
+	activateVotingPeriod := func() {
+		suite.app.GovKeeper.ActivateVotingPeriod(suite.ctx, proposal)
+		proposal, _ = suite.app.GovKeeper.GetProposal(suite.ctx, proposal.Id)
+	}
+
+	vote := func(proposalID uint64, option govv1.VoteOption) error {
+		return queryConnector(connector, &librustgo.CosmosRequest{
+			Req: &librustgo.CosmosRequest_GovVote{
+				GovVote: &librustgo.QueryGovVote{
+					Voter:      voter.Bytes(),
+					ProposalId: proposalID,
+					Option:     int32(option),
+				},
+			},
+		}, &librustgo.QueryGovVoteResponse{})
+	}
+
+	tally := func() *librustgo.QueryGovTallyResponse {
+		res := &librustgo.QueryGovTallyResponse{}
+		err := queryConnector(connector, &librustgo.CosmosRequest{
+			Req: &librustgo.CosmosRequest_GovTally{
+				GovTally: &librustgo.QueryGovTally{ProposalId: proposal.Id},
+			},
+		}, res)
+		suite.Require().NoError(err)
+		return res
+	}
+
+	testCases := []struct {
+		name   string
+		action func()
+	}{
+		{
+			"Should return proposal",
+			func() {
+				res := &librustgo.QueryGovProposalResponse{}
+				err := queryConnector(connector, &librustgo.CosmosRequest{
+					Req: &librustgo.CosmosRequest_GovProposal{
+						GovProposal: &librustgo.QueryGovProposal{ProposalId: proposal.Id},
+					},
+				}, res)
+				suite.Require().NoError(err)
+				suite.Require().Equal(proposal.Id, res.Id)
+				suite.Require().Equal(int32(govv1.StatusDepositPeriod), res.Status)
+				suite.Require().Equal(proposer.Bytes(), res.Proposer)
+				suite.Require().Equal("title", res.Title)
+				suite.Require().Equal(proposal.SubmitTime.Unix(), res.SubmitTime)
+				suite.Require().Equal(proposal.DepositEndTime.Unix(), res.DepositEndTime)
+				suite.Require().Zero(res.VotingStartTime)
+				suite.Require().Zero(res.VotingEndTime)
+
+				err = queryConnector(connector, &librustgo.CosmosRequest{
+					Req: &librustgo.CosmosRequest_GovProposal{
+						GovProposal: &librustgo.QueryGovProposal{ProposalId: proposal.Id + 1},
+					},
+				}, &librustgo.QueryGovProposalResponse{})
+				suite.Require().Error(err)
+			},
+		},
+		{
+			"Should deposit on proposal",
+			func() {
+				err := queryConnector(connector, &librustgo.CosmosRequest{
+					Req: &librustgo.CosmosRequest_GovDeposit{
+						GovDeposit: &librustgo.QueryGovDeposit{
+							Depositor:  voter.Bytes(),
+							ProposalId: proposal.Id,
+							Denom:      utils.BaseDenom,
+							Amount:     amount.Bytes(),
+						},
+					},
+				}, &librustgo.QueryGovDepositResponse{})
+				suite.Require().NoError(err)
+
+				deposit, found := suite.app.GovKeeper.GetDeposit(suite.ctx, proposal.Id, sdk.AccAddress(voter.Bytes()))
+				suite.Require().True(found)
+				suite.Require().Equal(amount, sdk.NewCoins(deposit.Amount...).AmountOf(utils.BaseDenom).BigInt())
+				suite.Require().Equal(new(big.Int).Sub(initialBalance, amount), suite.app.EvmKeeper.GetBalance(suite.ctx, voter))
+			},
+		},
+		{
+			"Should vote and calculate tally without removing votes",
+			func() {
+				activateVotingPeriod()
+				suite.Require().NoError(vote(proposal.Id, govv1.OptionYes))
+
+				_, found := suite.app.GovKeeper.GetVote(suite.ctx, proposal.Id, sdk.AccAddress(voter.Bytes()))
+				suite.Require().True(found)
+
+				res := tally()
+				suite.Require().Equal(amount, new(big.Int).SetBytes(res.Yes))
+				suite.Require().Equal(0, new(big.Int).SetBytes(res.No).Sign())
+
+				_, found = suite.app.GovKeeper.GetVote(suite.ctx, proposal.Id, sdk.AccAddress(voter.Bytes()))
+				suite.Require().True(found)
+			},
+		},
+		{
+			"Should cast weighted vote",
+			func() {
+				activateVotingPeriod()
+				err := queryConnector(connector, &librustgo.CosmosRequest{
+					Req: &librustgo.CosmosRequest_GovVoteWeighted{
+						GovVoteWeighted: &librustgo.QueryGovVoteWeighted{
+							Voter:      voter.Bytes(),
+							ProposalId: proposal.Id,
+							Options: []*librustgo.WeightedVoteOption{
+								{Option: int32(govv1.OptionYes), Weight: "0.25"},
+								{Option: int32(govv1.OptionNo), Weight: "0.75"},
+							},
+						},
+					},
+				}, &librustgo.QueryGovVoteWeightedResponse{})
+				suite.Require().NoError(err)
+
+				res := tally()
+				suite.Require().Equal(big.NewInt(100_000), new(big.Int).SetBytes(res.Yes))
+				suite.Require().Equal(big.NewInt(300_000), new(big.Int).SetBytes(res.No))
+			},
+		},
+		{
+			"Should charge tally of proposal in voting period in proportion to number of votes",
+			func() {
+				activateVotingPeriod()
+				suite.Require().NoError(vote(proposal.Id, govv1.OptionYes))
+
+				tallyRequest := &librustgo.CosmosRequest{
+					Req: &librustgo.CosmosRequest_GovTally{
+						GovTally: &librustgo.QueryGovTally{ProposalId: proposal.Id},
+					},
+				}
+				res, err := queryMetered(connector, tallyRequest, 10_000_000)
+				suite.Require().NoError(err)
+				suite.Require().Empty(res.Error)
+				suite.Require().NotEmpty(res.Response)
+				gasUsed := res.GasUsed
+				suite.Require().NotZero(gasUsed)
+
+				for i := int64(1); i <= 10; i++ {
+					address := sdk.AccAddress(common.BigToAddress(big.NewInt(200000 + i)).Bytes())
+					err = suite.app.GovKeeper.AddVote(suite.ctx, proposal.Id, address, govv1.NewNonSplitVoteOption(govv1.OptionNo), "")
+					suite.Require().NoError(err)
+				}
+				res, err = queryMetered(connector, tallyRequest, 10_000_000)
+				suite.Require().NoError(err)
+				suite.Require().Empty(res.Error)
+				suite.Require().Greater(res.GasUsed, gasUsed)
+
+				// running out of gas is returned as error, which consumed the whole limit
+				res, err = queryMetered(connector, tallyRequest, gasUsed)
+				suite.Require().NoError(err)
+				suite.Require().Contains(res.Error, "out of gas")
+				suite.Require().Empty(res.Response)
+				suite.Require().Equal(gasUsed, res.GasUsed)
+			},
+		},
+		{
+			"Should charge gas of failed vote and not change state if it ran out of gas",
+			func() {
+				activateVotingPeriod()
+				voteRequest := &librustgo.CosmosRequest{
+					Req: &librustgo.CosmosRequest_GovVote{
+						GovVote: &librustgo.QueryGovVote{
+							Voter:      voter.Bytes(),
+							ProposalId: proposal.Id,
+							Option:     int32(govv1.OptionYes),
+						},
+					},
+				}
+
+				res, err := queryMetered(connector, voteRequest, 1_000)
+				suite.Require().NoError(err)
+				suite.Require().Contains(res.Error, "out of gas")
+				suite.Require().Equal(uint64(1_000), res.GasUsed)
+				_, found := suite.app.GovKeeper.GetVote(suite.ctx, proposal.Id, sdk.AccAddress(voter.Bytes()))
+				suite.Require().False(found)
+
+				res, err = queryMetered(connector, voteRequest, 1_000_000)
+				suite.Require().NoError(err)
+				suite.Require().Empty(res.Error)
+				suite.Require().NotZero(res.GasUsed)
+				suite.Require().NoError(proto.Unmarshal(res.Response, &librustgo.QueryGovVoteResponse{}))
+				_, found = suite.app.GovKeeper.GetVote(suite.ctx, proposal.Id, sdk.AccAddress(voter.Bytes()))
+				suite.Require().True(found)
+
+				// nested metered requests are not allowed
+				_, err = queryMetered(connector, &librustgo.CosmosRequest{
+					Req: &librustgo.CosmosRequest_Metered{Metered: &librustgo.QueryMetered{GasLimit: 1_000_000}},
+				}, 1_000_000)
+				suite.Require().Error(err)
+			},
+		},
+		{
+			"Should not vote on proposal in deposit period or with invalid option",
+			func() {
+				suite.Require().Error(vote(proposal.Id, govv1.OptionYes))
+
+				activateVotingPeriod()
+				suite.Require().Error(vote(proposal.Id, govv1.OptionEmpty))
+				suite.Require().Error(vote(proposal.Id, govv1.VoteOption(10)))
+				suite.Require().Error(vote(proposal.Id+1, govv1.OptionYes))
+
+				_, found := suite.app.GovKeeper.GetVote(suite.ctx, proposal.Id, sdk.AccAddress(voter.Bytes()))
+				suite.Require().False(found)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			validators := suite.app.StakingKeeper.GetBondedValidatorsByPower(suite.ctx)
+			suite.Require().NotEmpty(validators)
+			validator = validators[0]
+
+			voter = common.BigToAddress(big.NewInt(rand.Int63n(100000) + 1))
+			proposer = common.BigToAddress(big.NewInt(rand.Int63n(100000) + 100001))
+			suite.Require().NoError(suite.app.EvmKeeper.SetBalance(suite.ctx, voter, initialBalance))
+
+			var err error
+			proposal, err = suite.app.GovKeeper.SubmitProposal(suite.ctx, nil, "", "title", "summary", sdk.AccAddress(proposer.Bytes()))
+			suite.Require().NoError(err)
+
+			connector = evmkeeper.Connector{
+				Context:   suite.ctx,
+				EVMKeeper: suite.app.EvmKeeper,
+				Cache:     evmkeeper.NewConnectorCache(),
+			}
+
+			// voting power of the voter is defined by its delegations
+			err = queryConnector(connector, &librustgo.CosmosRequest{
+				Req: &librustgo.CosmosRequest_Delegate{
+					Delegate: &librustgo.QueryDelegate{
+						Delegator:        voter.Bytes(),
+						ValidatorAddress: validator.OperatorAddress,
+						Amount:           amount.Bytes(),
+					},
+				},
+			}, &librustgo.QueryDelegateResponse{})
+			suite.Require().NoError(err)
+			suite.Require().NoError(suite.app.EvmKeeper.SetBalance(suite.ctx, voter, initialBalance))
+			connector.Cache = evmkeeper.NewConnectorCache()
+
+			tc.action()
+		})
+	}
+}
@@ -385,3 +385,36 @@ func (suite *KeeperTestSuite) TestEmptyDataWithPublicKey() {
 	suite.Require().Empty(rsp.VmError)
 	suite.Require().True(len(rsp.Ret) != 0)
 }
+
+func (suite *KeeperTestSuite) TestBlockLogIndices() {
+	suite.SetupSGXVMTest()
+
+	// emits two empty logs: PUSH1 0 PUSH1 0 LOG0 PUSH1 0 PUSH1 0 LOG0 STOP
+	contract := common.BigToAddress(big.NewInt(0x10000))
+	suite.Require().NoError(suite.app.EvmKeeper.SetAccountCode(suite.ctx, contract, hexutil.MustDecode("0x60006000a060006000a000")))
+
+	ethSigner := ethtypes.LatestSignerForChainID(suite.app.EvmKeeper.ChainID())
+	for i := 0; i < 3; i++ {
+		tx, err := newSignedEthTx(
+			&ethtypes.LegacyTx{GasPrice: big.NewInt(0), Gas: 100_000, To: &contract, Value: big.NewInt(0)},
+			suite.app.EvmKeeper.GetNonce(suite.ctx, suite.address),
+			sdk.AccAddress(suite.address.Bytes()),
+			suite.signer,
+			ethSigner,
+		)
+		suite.Require().NoError(err)
+
+		res, err := suite.app.EvmKeeper.ApplySGXVMTransaction(suite.ctx, tx, common.Address{}, true)
+		suite.Require().NoError(err)
+		suite.Require().Empty(res.VmError)
+
+		// logs are indexed within the block, not within the transaction
+		suite.Require().Len(res.Logs, 2)
+		for j, log := range res.Logs {
+			suite.Require().Equal(uint64(2*i+j), log.Index)
+			suite.Require().Equal(uint64(i), log.TxIndex)
+			suite.Require().Equal(tx.Hash().Hex(), log.TxHash)
+		}
+		suite.Require().Equal(uint64(2*i+2), suite.app.EvmKeeper.GetLogSizeTransient(suite.ctx))
+	}
+}
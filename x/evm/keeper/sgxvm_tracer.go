@@ -0,0 +1,261 @@
+package keeper
+
+import (
+	"bytes"
+	"errors"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/SigmaGmbH/librustgo"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"swisstronik/x/evm/types"
+)
+
+// traceFrame contains caller and value of call frame, which are not reported by SGXVM for each step
+type traceFrame struct {
+	caller common.Address
+	value  *big.Int
+}
+
+// sgxvmTracer feeds go-ethereum tracer with opcodes and call frames, reported by SGXVM during execution.
+// SGXVM reports only nested call frames, top-level frame is reported by the keeper, since it already
+// knows parameters and result of the message
+type sgxvmTracer struct {
+	tracer  vm.EVMLogger
+	env     *vm.EVM
+	stateDB *traceStateDB
+	frames  []traceFrame
+	start   time.Time
+}
+
+// newSGXVMTracer creates tracer for provided message. Go-ethereum tracers obtain block context and state
+// through EVM instance, so it is constructed on top of read-only state
+func newSGXVMTracer(
+	ctx sdk.Context,
+	k *Keeper,
+	cfg *types.EVMConfig,
+	msg core.Message,
+	txContext *librustgo.TransactionContext,
+	tracer vm.EVMLogger,
+) *sgxvmTracer {
+	stateDB := newTraceStateDB(ctx, k)
+	blockContext := vm.BlockContext{
+		CanTransfer: core.CanTransfer,
+		Transfer:    core.Transfer,
+		GetHash:     k.GetHashFn(ctx),
+		Coinbase:    common.BytesToAddress(txContext.BlockCoinbase),
+		GasLimit:    txContext.BlockGasLimit,
+		BlockNumber: new(big.Int).SetUint64(txContext.BlockNumber),
+		Time:        new(big.Int).SetUint64(txContext.Timestamp),
+		Difficulty:  big.NewInt(0),
+		BaseFee:     new(big.Int).SetBytes(txContext.BlockBaseFeePerGas),
+	}
+	evmTxContext := vm.TxContext{
+		Origin:   msg.From(),
+		GasPrice: msg.GasPrice(),
+	}
+
+	return &sgxvmTracer{
+		tracer:  tracer,
+		env:     vm.NewEVM(blockContext, evmTxContext, stateDB, cfg.ChainConfig, vm.Config{Debug: true, Tracer: tracer, NoBaseFee: true}),
+		stateDB: stateDB,
+	}
+}
+
+// captureStart reports start of the transaction and its top-level frame
+func (t *sgxvmTracer) captureStart(msg core.Message, gas uint64) {
+	to := msg.To()
+	if to == nil {
+		address := crypto.CreateAddress(msg.From(), msg.Nonce())
+		to = &address
+	}
+
+	t.frames = append(t.frames, traceFrame{caller: msg.From(), value: msg.Value()})
+	t.start = time.Now()
+	t.tracer.CaptureTxStart(msg.Gas())
+	t.tracer.CaptureStart(t.env, msg.From(), *to, msg.To() == nil, msg.Data(), gas, msg.Value())
+}
+
+// captureEnd reports result of top-level frame and the rest of gas after refund
+func (t *sgxvmTracer) captureEnd(output []byte, gasUsed uint64, vmError string, restGas uint64) {
+	t.tracer.CaptureEnd(output, gasUsed, time.Since(t.start), traceError(vmError))
+	t.tracer.CaptureTxEnd(restGas)
+}
+
+// onEvents passes events, reported by SGXVM, to go-ethereum tracer
+func (t *sgxvmTracer) onEvents(events []*librustgo.TraceEvent) {
+	for _, event := range events {
+		switch event := event.Event.(type) {
+		case *librustgo.TraceEvent_Step:
+			t.onStep(event.Step)
+		case *librustgo.TraceEvent_Enter:
+			t.onEnter(event.Enter)
+		case *librustgo.TraceEvent_Exit:
+			t.onExit(event.Exit)
+		}
+	}
+}
+
+// onStep reports executed opcode. SGXVM doesn't report stack, memory and loaded storage values, since
+// they can be derived from encrypted contract storage, so tracer observes empty stack and memory
+func (t *sgxvmTracer) onStep(step *librustgo.TraceStep) {
+	address := common.BytesToAddress(step.Contract)
+	frame := t.frames[len(t.frames)-1]
+	contract := vm.NewContract(vm.AccountRef(frame.caller), vm.AccountRef(address), frame.value, step.Gas)
+	t.stateDB.refund = step.Refund
+
+	scope := &vm.ScopeContext{Memory: vm.NewMemory(), Stack: &vm.Stack{}, Contract: contract}
+	t.tracer.CaptureState(step.Pc, vm.OpCode(step.Opcode), step.Gas, step.Cost, scope, nil, int(step.Depth), nil)
+}
+
+func (t *sgxvmTracer) onEnter(enter *librustgo.TraceEnter) {
+	from := common.BytesToAddress(enter.From)
+	value := new(big.Int).SetBytes(enter.Value)
+
+	t.frames = append(t.frames, traceFrame{caller: from, value: value})
+	t.tracer.CaptureEnter(vm.OpCode(enter.Opcode), from, common.BytesToAddress(enter.To), enter.Input, enter.Gas, value)
+}
+
+func (t *sgxvmTracer) onExit(exit *librustgo.TraceExit) {
+	if len(t.frames) > 1 {
+		t.frames = t.frames[:len(t.frames)-1]
+	}
+	t.tracer.CaptureExit(exit.Output, exit.GasUsed, traceError(exit.Error))
+}
+
+// traceError converts error message, returned by SGXVM, into error. Revert is converted into
+// go-ethereum error, since tracers use it to decode revert reason
+func traceError(message string) error {
+	switch {
+	case message == "":
+		return nil
+	case strings.HasPrefix(message, "execution reverted"):
+		return vm.ErrExecutionReverted
+	default:
+		return errors.New(message)
+	}
+}
+
+var _ vm.StateDB = &traceStateDB{}
+
+// traceStateDB provides read-only view of the state for go-ethereum tracers. Accounts are loaded from the
+// keeper, storage values are always empty, since storage is encrypted outside of SGXVM.
+// All writes are ignored, since state is modified by SGXVM
+type traceStateDB struct {
+	ctx    sdk.Context
+	keeper *Keeper
+	refund uint64
+}
+
+func newTraceStateDB(ctx sdk.Context, k *Keeper) *traceStateDB {
+	return &traceStateDB{
+		ctx:    ctx,
+		keeper: k,
+	}
+}
+
+func (s *traceStateDB) CreateAccount(common.Address) {}
+
+func (s *traceStateDB) SubBalance(common.Address, *big.Int) {}
+
+func (s *traceStateDB) AddBalance(common.Address, *big.Int) {}
+
+func (s *traceStateDB) GetBalance(addr common.Address) *big.Int {
+	return s.keeper.GetBalance(s.ctx, addr)
+}
+
+func (s *traceStateDB) GetNonce(addr common.Address) uint64 {
+	return s.keeper.GetNonce(s.ctx, addr)
+}
+
+func (s *traceStateDB) SetNonce(common.Address, uint64) {}
+
+func (s *traceStateDB) GetCodeHash(addr common.Address) common.Hash {
+	account := s.keeper.GetAccountWithoutBalance(s.ctx, addr)
+	if account == nil {
+		return common.Hash{}
+	}
+	return common.BytesToHash(account.CodeHash)
+}
+
+func (s *traceStateDB) GetCode(addr common.Address) []byte {
+	code, _ := s.keeper.GetAccountCode(s.ctx, addr)
+	return code
+}
+
+func (s *traceStateDB) SetCode(common.Address, []byte) {}
+
+func (s *traceStateDB) GetCodeSize(addr common.Address) int {
+	return len(s.GetCode(addr))
+}
+
+func (s *traceStateDB) AddRefund(uint64) {}
+
+func (s *traceStateDB) SubRefund(uint64) {}
+
+func (s *traceStateDB) GetRefund() uint64 {
+	return s.refund
+}
+
+func (s *traceStateDB) GetCommittedState(addr common.Address, key common.Hash) common.Hash {
+	return s.GetState(addr, key)
+}
+
+func (s *traceStateDB) GetState(common.Address, common.Hash) common.Hash {
+	return common.Hash{}
+}
+
+func (s *traceStateDB) SetState(common.Address, common.Hash, common.Hash) {}
+
+func (s *traceStateDB) Suicide(common.Address) bool {
+	return false
+}
+
+func (s *traceStateDB) HasSuicided(common.Address) bool {
+	return false
+}
+
+func (s *traceStateDB) Exist(addr common.Address) bool {
+	return s.keeper.GetAccountWithoutBalance(s.ctx, addr) != nil
+}
+
+func (s *traceStateDB) Empty(addr common.Address) bool {
+	account := s.keeper.GetAccountOrEmpty(s.ctx, addr)
+	return account.Nonce == 0 && account.Balance.Sign() == 0 && bytes.Equal(account.CodeHash, types.EmptyCodeHash)
+}
+
+func (s *traceStateDB) PrepareAccessList(common.Address, *common.Address, []common.Address, ethtypes.AccessList) {
+}
+
+func (s *traceStateDB) AddressInAccessList(common.Address) bool {
+	return false
+}
+
+func (s *traceStateDB) SlotInAccessList(common.Address, common.Hash) (bool, bool) {
+	return false, false
+}
+
+func (s *traceStateDB) AddAddressToAccessList(common.Address) {}
+
+func (s *traceStateDB) AddSlotToAccessList(common.Address, common.Hash) {}
+
+func (s *traceStateDB) RevertToSnapshot(int) {}
+
+func (s *traceStateDB) Snapshot() int {
+	return 0
+}
+
+func (s *traceStateDB) AddLog(*ethtypes.Log) {}
+
+func (s *traceStateDB) AddPreimage(common.Hash, []byte) {}
+
+func (s *traceStateDB) ForEachStorage(common.Address, func(common.Hash, common.Hash) bool) error {
+	return errors.New("storage iteration is not supported during tracing")
+}
@@ -0,0 +1,212 @@
+package keeper_test
+
+import (
+	"encoding/json"
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/eth/tracers/logger"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+
+	"swisstronik/crypto/deoxys"
+	"swisstronik/ethereum/eip712"
+	"swisstronik/server/config"
+	"swisstronik/tests"
+	"swisstronik/x/evm/types"
+)
+
+func (suite *KeeperTestSuite) TestTraceCall() {
+	caller := tests.RandomEthAddress()
+	callee := tests.RandomEthAddress()
+
+	// callee returns value of storage slot 1:
+	// PUSH1 1 SLOAD PUSH1 0 MSTORE PUSH1 0x20 PUSH1 0 RETURN
+	calleeCode := hexutil.Bytes(hexutil.MustDecode("0x60015460005260206000f3"))
+	// caller makes static call to callee:
+	// PUSH1 0 PUSH1 0 PUSH1 0 PUSH1 0 PUSH20 <callee> GAS STATICCALL STOP
+	callerCode := hexutil.Bytes(append(append(hexutil.MustDecode("0x600060006000600073"), callee.Bytes()...), 0x5a, 0xfa, 0x00))
+	storage := map[common.Hash]common.Hash{
+		common.BigToHash(common.Big1): common.BigToHash(big.NewInt(42)),
+	}
+
+	args, err := json.Marshal(&types.CallArgs{To: &caller})
+	suite.Require().NoError(err)
+
+	testCases := []struct {
+		name        string
+		traceConfig *types.TraceConfig
+		expFunc     func(data []byte)
+	}{
+		{
+			"struct logger",
+			nil,
+			func(data []byte) {
+				var res logger.ExecutionResult
+				suite.Require().NoError(json.Unmarshal(data, &res))
+				suite.Require().False(res.Failed)
+				suite.Require().NotEmpty(res.StructLogs)
+
+				var staticCall, sload *logger.StructLogRes
+				for i, log := range res.StructLogs {
+					switch log.Op {
+					case "STATICCALL":
+						staticCall = &res.StructLogs[i]
+					case "SLOAD":
+						sload = &res.StructLogs[i]
+					}
+				}
+				suite.Require().NotNil(staticCall)
+				suite.Require().Equal(1, staticCall.Depth)
+				suite.Require().NotNil(sload)
+				suite.Require().Equal(2, sload.Depth)
+				// stack, memory and storage values are derived from encrypted storage, so they are not reported
+				suite.Require().Empty(sload.Stack)
+				suite.Require().Nil(sload.Storage)
+				suite.Require().NotContains(string(data), common.BigToHash(big.NewInt(42)).Hex()[2:])
+			},
+		},
+		{
+			"call tracer",
+			&types.TraceConfig{Tracer: "callTracer"},
+			func(data []byte) {
+				var res struct {
+					Type  string `json:"type"`
+					To    string `json:"to"`
+					Calls []struct {
+						Type   string `json:"type"`
+						To     string `json:"to"`
+						Output string `json:"output"`
+					} `json:"calls"`
+				}
+				suite.Require().NoError(json.Unmarshal(data, &res))
+				suite.Require().Equal("CALL", res.Type)
+				suite.Require().Equal(hexutil.Encode(caller.Bytes()), res.To)
+				suite.Require().Len(res.Calls, 1)
+				suite.Require().Equal("STATICCALL", res.Calls[0].Type)
+				suite.Require().Equal(hexutil.Encode(callee.Bytes()), res.Calls[0].To)
+				suite.Require().Equal(hexutil.Encode(common.BigToHash(big.NewInt(42)).Bytes()), res.Calls[0].Output)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			err := suite.app.EvmKeeper.ApplyStateOverride(suite.ctx, types.StateOverride{
+				caller: {Code: &callerCode},
+				callee: {Code: &calleeCode, State: &storage},
+			})
+			suite.Require().NoError(err)
+
+			res, err := suite.queryClient.TraceCall(sdk.WrapSDKContext(suite.ctx), &types.QueryTraceCallRequest{
+				Args:        args,
+				GasCap:      uint64(config.DefaultGasCap),
+				TraceConfig: tc.traceConfig,
+				Unencrypted: true,
+			})
+			suite.Require().NoError(err)
+			tc.expFunc(res.Data)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestTraceTxPrivate() {
+	suite.SetupTest()
+
+	caller := tests.RandomEthAddress()
+	callee := tests.RandomEthAddress()
+
+	// callee returns value of storage slot 1:
+	// PUSH1 1 SLOAD PUSH1 0 MSTORE PUSH1 0x20 PUSH1 0 RETURN
+	calleeCode := hexutil.Bytes(hexutil.MustDecode("0x60015460005260206000f3"))
+	// caller makes static call to callee:
+	// PUSH1 0 PUSH1 0 PUSH1 0 PUSH1 0 PUSH20 <callee> GAS STATICCALL STOP
+	callerCode := hexutil.Bytes(append(append(hexutil.MustDecode("0x600060006000600073"), callee.Bytes()...), 0x5a, 0xfa, 0x00))
+	storage := map[common.Hash]common.Hash{
+		common.BigToHash(common.Big1): common.BigToHash(big.NewInt(42)),
+	}
+	err := suite.app.EvmKeeper.ApplyStateOverride(suite.ctx, types.StateOverride{
+		caller: {Code: &callerCode},
+		callee: {Code: &calleeCode, State: &storage},
+	})
+	suite.Require().NoError(err)
+
+	chainID := suite.app.EvmKeeper.ChainID()
+	nonce := suite.app.EvmKeeper.GetNonce(suite.ctx, suite.address)
+	newTx := func(to *common.Address, data []byte) *types.MsgHandleTx {
+		tx := types.NewSGXVMTx(chainID, nonce, to, nil, 100_000, nil, nil, nil, data, nil, suite.privateKey, suite.nodePublicKey)
+		tx.From = suite.address.Hex()
+		suite.Require().NoError(tx.Sign(ethtypes.LatestSignerForChainID(chainID), suite.signer))
+		return tx
+	}
+	newRequest := func(tx *types.MsgHandleTx) *types.QueryTraceTxRequest {
+		return &types.QueryTraceTxRequest{
+			Msg:         tx,
+			BlockNumber: suite.ctx.BlockHeight(),
+			BlockTime:   suite.ctx.BlockTime(),
+			ChainId:     chainID.Int64(),
+		}
+	}
+	signTrace := func(tx *types.MsgHandleTx, privateKey []byte) hexutil.Bytes {
+		key, err := crypto.ToECDSA(privateKey)
+		suite.Require().NoError(err)
+		typedData := eip712.WrapTraceTransactionToTypedData(chainID.Uint64(), tx.AsTransaction().Hash())
+		sigHash, _, err := apitypes.TypedDataAndHash(typedData)
+		suite.Require().NoError(err)
+		signature, err := crypto.Sign(sigHash, key)
+		suite.Require().NoError(err)
+		return signature
+	}
+	encryptedTx := newTx(&caller, []byte{0x01})
+
+	// regular tracing doesn't reveal decrypted execution
+	req := newRequest(encryptedTx)
+	req.TraceConfig = &types.TraceConfig{Tracer: "callTracer"}
+	res, err := suite.queryClient.TraceTx(sdk.WrapSDKContext(suite.ctx), req)
+	suite.Require().NoError(err)
+	var publicTrace types.CallFrame
+	suite.Require().NoError(json.Unmarshal(res.Data, &publicTrace))
+	suite.Require().Empty(publicTrace.Calls)
+
+	// tracing should be authorized by the sender of the transaction
+	otherKey, err := crypto.GenerateKey()
+	suite.Require().NoError(err)
+	for _, signature := range []hexutil.Bytes{nil, {0x01}, signTrace(encryptedTx, crypto.FromECDSA(otherKey))} {
+		req = newRequest(encryptedTx)
+		req.TraceSignature = signature
+		_, err = suite.queryClient.TraceTxPrivate(sdk.WrapSDKContext(suite.ctx), req)
+		suite.Require().Error(err)
+	}
+
+	req = newRequest(encryptedTx)
+	req.TraceSignature = signTrace(encryptedTx, suite.privateKey)
+	res, err = suite.queryClient.TraceTxPrivate(sdk.WrapSDKContext(suite.ctx), req)
+	suite.Require().NoError(err)
+
+	// result is encrypted by SGXVM to the key, used to encrypt transaction data
+	suite.Require().NotContains(string(res.Data), "STATICCALL")
+	data, err := deoxys.DecryptECDH(suite.privateKey, suite.nodePublicKey, res.Data)
+	suite.Require().NoError(err)
+
+	var privateTrace types.CallFrame
+	suite.Require().NoError(json.Unmarshal(data, &privateTrace))
+	suite.Require().Equal("CALL", privateTrace.Type)
+	suite.Require().Equal(hexutil.Encode(caller.Bytes()), privateTrace.To)
+	suite.Require().Equal(hexutil.EncodeUint64(100_000), privateTrace.Gas)
+	suite.Require().Len(privateTrace.Calls, 1)
+	suite.Require().Equal("STATICCALL", privateTrace.Calls[0].Type)
+	suite.Require().Equal(hexutil.Encode(callee.Bytes()), privateTrace.Calls[0].To)
+	suite.Require().Equal(hexutil.Encode(common.BigToHash(big.NewInt(42)).Bytes()), privateTrace.Calls[0].Output)
+
+	// unencrypted transactions cannot be traced privately
+	unencryptedTx := newTx(&caller, nil)
+	req = newRequest(unencryptedTx)
+	req.TraceSignature = signTrace(unencryptedTx, suite.privateKey)
+	_, err = suite.queryClient.TraceTxPrivate(sdk.WrapSDKContext(suite.ctx), req)
+	suite.Require().Error(err)
+}
@@ -0,0 +1,115 @@
+package keeper_test
+
+import (
+	"encoding/json"
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	"swisstronik/server/config"
+	"swisstronik/tests"
+	"swisstronik/x/evm/types"
+)
+
+// simulateTestCode increments value in storage slot 0, emits it as a log and returns it:
+// PUSH1 0 SLOAD PUSH1 1 ADD DUP1 PUSH1 0 SSTORE PUSH1 0 MSTORE PUSH1 0x20 PUSH1 0 LOG0 PUSH1 0x20 PUSH1 0 RETURN
+var simulateTestCode = hexutil.MustDecode("0x6000546001018060005560005260206000a060206000f3")
+
+func (suite *KeeperTestSuite) TestEthSimulate() {
+	contract := tests.RandomEthAddress()
+	code := hexutil.Bytes(simulateTestCode)
+	overrides, err := json.Marshal(types.StateOverride{contract: {Code: &code}})
+	suite.Require().NoError(err)
+
+	call, err := json.Marshal(&types.CallArgs{To: &contract})
+	suite.Require().NoError(err)
+	lowGas := hexutil.Uint64(21000)
+	outOfGasCall, err := json.Marshal(&types.CallArgs{To: &contract, Gas: &lowGas})
+	suite.Require().NoError(err)
+
+	testCases := []struct {
+		name    string
+		calls   [][]byte
+		gasCap  uint64
+		expPass bool
+		expRets []int64
+	}{
+		{
+			"calls observe state changes of previous calls",
+			[][]byte{call, call, call},
+			uint64(config.DefaultGasCap),
+			true,
+			[]int64{1, 2, 3},
+		},
+		{
+			"failed call doesn't change state",
+			[][]byte{call, outOfGasCall, call},
+			uint64(config.DefaultGasCap),
+			true,
+			[]int64{1, 0, 2},
+		},
+		{
+			"gas cap exhausted",
+			[][]byte{call, call},
+			30_000,
+			false,
+			nil,
+		},
+		{
+			"invalid call args",
+			[][]byte{call, []byte("invalid args")},
+			uint64(config.DefaultGasCap),
+			false,
+			nil,
+		},
+		{
+			"too many calls",
+			make([][]byte, 257),
+			uint64(config.DefaultGasCap),
+			false,
+			nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			res, err := suite.queryClient.EthSimulate(sdk.WrapSDKContext(suite.ctx), &types.EthSimulateRequest{
+				Calls:       tc.calls,
+				GasCap:      tc.gasCap,
+				Unencrypted: true,
+				Overrides:   overrides,
+			})
+			if !tc.expPass {
+				suite.Require().Error(err)
+				return
+			}
+			suite.Require().NoError(err)
+			suite.Require().Len(res.Results, len(tc.expRets))
+
+			logIndex := uint64(0)
+			for i, result := range res.Results {
+				if tc.expRets[i] == 0 {
+					suite.Require().True(result.Failed())
+					suite.Require().Empty(result.Logs)
+					continue
+				}
+				suite.Require().Empty(result.VmError)
+				suite.Require().Equal(common.BigToHash(big.NewInt(tc.expRets[i])).Bytes(), result.Ret)
+				suite.Require().Greater(result.GasUsed, uint64(21000))
+
+				suite.Require().Len(result.Logs, 1)
+				suite.Require().Equal(logIndex, result.Logs[0].Index)
+				suite.Require().Equal(uint64(i), result.Logs[0].TxIndex)
+				suite.Require().Equal(result.Ret, result.Logs[0].Data)
+				logIndex++
+			}
+
+			// Simulated state changes are never written to the store
+			suite.Require().Empty(suite.app.EvmKeeper.GetState(suite.ctx, contract, common.Hash{}))
+		})
+	}
+}
@@ -0,0 +1,185 @@
+package keeper
+
+import (
+	"bytes"
+	"fmt"
+	"math/big"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	evmcommontypes "swisstronik/types"
+	"swisstronik/x/evm/types"
+)
+
+// StateRecordHandler handles exported record of the EVM state. Cursor points to the record, so export
+// can be resumed after it. Export is stopped if handler returns error
+type StateRecordHandler func(record types.StateRecord, cursor types.StateCursor) error
+
+// statePhasePrefixes contains prefixes of the EVM store, which are exported in corresponding phases
+var statePhasePrefixes = map[types.StatePhase][]byte{
+	types.StatePhaseCode:              types.KeyPrefixCode,
+	types.StatePhaseStorage:           types.KeyPrefixStorage,
+	types.StatePhaseContractDeployers: types.KeyPrefixContractDeployer,
+	types.StatePhaseContractSources:   types.KeyPrefixContractSource,
+}
+
+// ExportState streams accounts, code, storage, contract deployers and contract sources to the handler.
+// Records are exported in the order of their keys, starting after the provided cursor. Storage values
+// are exported as they are stored, so encrypted storage is exported without decryption. Unlike
+// ExportGenesis, ExportState doesn't keep exported state in memory
+func (k *Keeper) ExportState(ctx sdk.Context, cursor types.StateCursor, handler StateRecordHandler) error {
+	startPhase := 0
+	if !cursor.IsEmpty() {
+		if startPhase = cursor.PhaseIndex(); startPhase < 0 {
+			return fmt.Errorf("unknown state phase %q", cursor.Phase)
+		}
+	}
+
+	for i, phase := range types.StatePhases[startPhase:] {
+		var after []byte
+		if i == 0 {
+			after = cursor.Key
+		}
+
+		var err error
+		if phase == types.StatePhaseAccounts {
+			err = k.exportAccounts(ctx, after, handler)
+		} else {
+			err = k.exportStorePhase(ctx, phase, after, handler)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// exportAccounts exports ethereum accounts with addresses greater than provided one
+func (k *Keeper) exportAccounts(ctx sdk.Context, after []byte, handler StateRecordHandler) error {
+	var err error
+	k.accountKeeper.IterateAccounts(ctx, func(account authtypes.AccountI) bool {
+		ethAccount, ok := account.(evmcommontypes.EthAccountI)
+		if !ok {
+			// ignore non EthAccounts
+			return false
+		}
+
+		address := ethAccount.EthAddress()
+		if after != nil && bytes.Compare(address.Bytes(), after) <= 0 {
+			return false
+		}
+
+		record := types.StateRecord{Record: &types.StateRecord_Account{Account: &types.ExportedAccount{
+			Address:  address.Hex(),
+			Nonce:    ethAccount.GetSequence(),
+			Balance:  k.GetBalance(ctx, address).String(),
+			CodeHash: ethAccount.GetCodeHash().Bytes(),
+		}}}
+		err = handler(record, types.StateCursor{Phase: types.StatePhaseAccounts, Key: address.Bytes()})
+		return err != nil
+	})
+	return err
+}
+
+// exportStorePhase exports records of the EVM store, which belong to provided phase and have keys
+// greater than provided one
+func (k *Keeper) exportStorePhase(ctx sdk.Context, phase types.StatePhase, after []byte, handler StateRecordHandler) error {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), statePhasePrefixes[phase])
+
+	var start []byte
+	if after != nil {
+		// iterator start is inclusive, so iteration starts from the next possible key
+		start = append(common.CopyBytes(after), 0)
+	}
+	iterator := store.Iterator(start, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		record, err := k.newStateRecord(phase, iterator.Key(), iterator.Value())
+		if err != nil {
+			return err
+		}
+		if err := handler(record, types.StateCursor{Phase: phase, Key: common.CopyBytes(iterator.Key())}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// newStateRecord returns record of the EVM state, stored under provided key without phase prefix
+func (k *Keeper) newStateRecord(phase types.StatePhase, key, value []byte) (types.StateRecord, error) {
+	switch phase {
+	case types.StatePhaseCode:
+		return types.StateRecord{Record: &types.StateRecord_Code{Code: &types.ExportedCode{
+			CodeHash: common.CopyBytes(key),
+			Code:     common.CopyBytes(value),
+		}}}, nil
+	case types.StatePhaseStorage:
+		if len(key) != common.AddressLength+common.HashLength {
+			return types.StateRecord{}, fmt.Errorf("invalid storage key %x", key)
+		}
+		return types.StateRecord{Record: &types.StateRecord_Storage{Storage: &types.ExportedStorage{
+			Address: common.BytesToAddress(key[:common.AddressLength]).Hex(),
+			Key:     common.CopyBytes(key[common.AddressLength:]),
+			Value:   common.CopyBytes(value),
+		}}}, nil
+	case types.StatePhaseContractDeployers:
+		return types.StateRecord{Record: &types.StateRecord_ContractDeployer{ContractDeployer: &types.ExportedContractDeployer{
+			Contract: common.BytesToAddress(key).Hex(),
+			Deployer: common.BytesToAddress(value).Hex(),
+		}}}, nil
+	case types.StatePhaseContractSources:
+		var source types.ContractSource
+		if err := k.cdc.Unmarshal(value, &source); err != nil {
+			return types.StateRecord{}, err
+		}
+		return types.StateRecord{Record: &types.StateRecord_ContractSource{ContractSource: &source}}, nil
+	default:
+		return types.StateRecord{}, fmt.Errorf("unknown state phase %q", phase)
+	}
+}
+
+// ImportStateRecord writes record, exported by ExportState, to the store. Account balance is minted or
+// burned to match the exported one
+func (k *Keeper) ImportStateRecord(ctx sdk.Context, record types.StateRecord) error {
+	switch record := record.Record.(type) {
+	case *types.StateRecord_Account:
+		balance, ok := new(big.Int).SetString(record.Account.Balance, 10)
+		if !ok || balance.Sign() < 0 {
+			return errorsmod.Wrapf(types.ErrInvalidAmount, "invalid balance %q of account %s", record.Account.Balance, record.Account.Address)
+		}
+		return k.SetAccount(ctx, common.HexToAddress(record.Account.Address), types.Account{
+			Nonce:    record.Account.Nonce,
+			Balance:  balance,
+			CodeHash: record.Account.CodeHash,
+		})
+	case *types.StateRecord_Code:
+		k.SetCode(ctx, record.Code.CodeHash, record.Code.Code)
+	case *types.StateRecord_Storage:
+		k.SetState(ctx, common.HexToAddress(record.Storage.Address), common.BytesToHash(record.Storage.Key), record.Storage.Value)
+	case *types.StateRecord_ContractDeployer:
+		k.SetContractDeployer(ctx, common.HexToAddress(record.ContractDeployer.Contract), common.HexToAddress(record.ContractDeployer.Deployer))
+	case *types.StateRecord_ContractSource:
+		k.SetContractSource(ctx, *record.ContractSource)
+	default:
+		return fmt.Errorf("unknown state record %T", record)
+	}
+	return nil
+}
+
+// HasContractState returns true if the EVM store contains any contract code or storage
+func (k *Keeper) HasContractState(ctx sdk.Context) bool {
+	for _, keyPrefix := range [][]byte{types.KeyPrefixCode, types.KeyPrefixStorage} {
+		iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), keyPrefix)
+		valid := iterator.Valid()
+		iterator.Close()
+		if valid {
+			return true
+		}
+	}
+	return false
+}
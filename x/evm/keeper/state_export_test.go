@@ -0,0 +1,95 @@
+package keeper_test
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"swisstronik/tests"
+	"swisstronik/x/evm/types"
+)
+
+func (suite *KeeperTestSuite) exportState(cursor types.StateCursor) ([]types.StateRecord, []types.StateCursor) {
+	var (
+		records []types.StateRecord
+		cursors []types.StateCursor
+	)
+	err := suite.app.EvmKeeper.ExportState(suite.ctx, cursor, func(record types.StateRecord, cursor types.StateCursor) error {
+		records = append(records, record)
+		cursors = append(cursors, cursor)
+		return nil
+	})
+	suite.Require().NoError(err)
+	return records, cursors
+}
+
+func (suite *KeeperTestSuite) TestExportImportState() {
+	suite.SetupTest()
+
+	contract := tests.RandomEthAddress()
+	deployer := tests.RandomEthAddress()
+	code := []byte{0x60, 0x80, 0x60, 0x40, 0x52}
+	source := types.ContractSource{
+		Address:         contract.Hex(),
+		CompilerVersion: "0.8.20",
+		ContractName:    "Token.sol:Token",
+		Sources:         []types.ContractSourceFile{{Path: "Token.sol", Content: "contract Token {}"}},
+		SourceHash:      common.BigToHash(big.NewInt(1)).Hex(),
+	}
+	storage := map[common.Hash][]byte{
+		common.BigToHash(big.NewInt(1)): []byte("encrypted value 1"),
+		common.BigToHash(big.NewInt(2)): []byte("encrypted value 2"),
+	}
+
+	suite.Require().NoError(suite.app.EvmKeeper.SetAccountCode(suite.ctx, contract, code))
+	suite.Require().NoError(suite.app.EvmKeeper.SetNonce(suite.ctx, contract, 1))
+	suite.Require().NoError(suite.app.EvmKeeper.SetBalance(suite.ctx, contract, big.NewInt(1000)))
+	for key, value := range storage {
+		suite.app.EvmKeeper.SetState(suite.ctx, contract, key, value)
+	}
+	suite.app.EvmKeeper.SetContractDeployer(suite.ctx, contract, deployer)
+	suite.app.EvmKeeper.SetContractSource(suite.ctx, source)
+
+	records, cursors := suite.exportState(types.StateCursor{})
+	suite.Require().Equal(len(records), len(cursors))
+
+	// records are exported in the order of phases
+	phase := 0
+	for _, cursor := range cursors {
+		suite.Require().GreaterOrEqual(cursor.PhaseIndex(), phase)
+		phase = cursor.PhaseIndex()
+	}
+	suite.Require().Equal(types.StatePhaseContractSources, cursors[len(cursors)-1].Phase)
+
+	// export can be resumed after any record
+	for i := range cursors {
+		tail, _ := suite.exportState(cursors[i])
+		suite.Require().Len(tail, len(records)-i-1)
+		for j, record := range tail {
+			suite.Require().Equal(records[i+1+j], record)
+		}
+	}
+
+	// state is imported into fresh store
+	suite.SetupTest()
+	suite.Require().False(suite.app.EvmKeeper.HasContractState(suite.ctx))
+	for _, record := range records {
+		suite.Require().NoError(suite.app.EvmKeeper.ImportStateRecord(suite.ctx, record))
+	}
+	suite.Require().True(suite.app.EvmKeeper.HasContractState(suite.ctx))
+
+	account := suite.app.EvmKeeper.GetAccount(suite.ctx, contract)
+	suite.Require().NotNil(account)
+	suite.Require().Equal(uint64(1), account.Nonce)
+	suite.Require().Equal("1000", account.Balance.String())
+	suite.Require().Equal(crypto.Keccak256(code), account.CodeHash)
+	suite.Require().Equal(code, suite.app.EvmKeeper.GetCode(suite.ctx, common.BytesToHash(account.CodeHash)))
+	for key, value := range storage {
+		suite.Require().Equal(value, suite.app.EvmKeeper.GetState(suite.ctx, contract, key))
+	}
+	importedDeployer, found := suite.app.EvmKeeper.GetContractDeployer(suite.ctx, contract)
+	suite.Require().True(found)
+	suite.Require().Equal(deployer, importedDeployer)
+	suite.Require().Equal([]types.ContractSource{source}, suite.app.EvmKeeper.GetContractSources(suite.ctx, contract))
+}
@@ -0,0 +1,127 @@
+package keeper
+
+import (
+	"bytes"
+	"encoding/json"
+	"sort"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/SigmaGmbH/librustgo"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"swisstronik/x/evm/types"
+)
+
+// unmarshalStateOverride decodes json-encoded state override from `EthCallRequest`.
+// Empty input means there is no override
+func unmarshalStateOverride(bz []byte) (types.StateOverride, error) {
+	if len(bz) == 0 {
+		return nil, nil
+	}
+
+	var overrides types.StateOverride
+	if err := json.Unmarshal(bz, &overrides); err != nil {
+		return nil, err
+	}
+	if err := overrides.Validate(); err != nil {
+		return nil, err
+	}
+	return overrides, nil
+}
+
+// ApplyStateOverride writes overridden balance, nonce, code and storage of accounts to the store.
+// It should be called with cached context, which is never committed. Storage values are encrypted
+// and written by the enclave in the same way as during EVM execution, so connector observes them
+// as regular state. Code and storage of accounts with existing code or storage can be overridden
+// only together with their entire storage, so existing storage cannot be read by the overridden code
+// and access checks of the existing code cannot be bypassed by overriding single storage cells
+func (k *Keeper) ApplyStateOverride(ctx sdk.Context, overrides types.StateOverride) error {
+	var storageOverrides []*librustgo.StorageOverride
+	for _, address := range overrides.Addresses() {
+		account := overrides[address]
+
+		if account.State == nil && (account.Code != nil || account.StateDiff != nil) && k.hasCodeOrStorage(ctx, address) {
+			return errorsmod.Wrapf(types.ErrInvalidStateOverride, "code or storage of %s with existing code or storage cannot be overridden without 'state'", address.Hex())
+		}
+
+		if account.Nonce != nil || account.Balance != nil {
+			// Account is created if it doesn't exist, so overridden balance is visible to the EVM
+			ethAccount := k.GetAccountOrEmpty(ctx, address)
+			if account.Nonce != nil {
+				ethAccount.Nonce = uint64(*account.Nonce)
+			}
+			if account.Balance != nil {
+				ethAccount.Balance = (*account.Balance).ToInt()
+			}
+			if err := k.SetAccount(ctx, address, ethAccount); err != nil {
+				return errorsmod.Wrapf(err, "failed to override account %s", address.Hex())
+			}
+		}
+
+		if account.Code != nil {
+			if err := k.SetAccountCode(ctx, address, *account.Code); err != nil {
+				return errorsmod.Wrapf(err, "failed to override code of %s", address.Hex())
+			}
+		}
+
+		if account.State != nil {
+			// Replace entire storage of the account. Keys are collected first, since the store
+			// should not be modified during iteration
+			var keys []common.Hash
+			k.ForEachStorage(ctx, address, func(key common.Hash, _ []byte) bool {
+				keys = append(keys, key)
+				return true
+			})
+			for _, key := range keys {
+				k.SetState(ctx, address, key, nil)
+			}
+			storageOverrides = append(storageOverrides, newStorageOverride(address, *account.State))
+		}
+
+		if account.StateDiff != nil {
+			storageOverrides = append(storageOverrides, newStorageOverride(address, *account.StateDiff))
+		}
+	}
+
+	if len(storageOverrides) == 0 {
+		return nil
+	}
+	connector := Connector{Context: ctx, EVMKeeper: k}
+	if err := librustgo.ApplyStorageOverride(connector, storageOverrides, uint64(ctx.BlockHeight()), uint64(ctx.BlockTime().Unix())); err != nil {
+		return errorsmod.Wrap(err, "failed to override storage")
+	}
+	return nil
+}
+
+// hasCodeOrStorage returns true if the account has code or at least one storage cell
+func (k *Keeper) hasCodeOrStorage(ctx sdk.Context, address common.Address) bool {
+	if account := k.GetAccount(ctx, address); account != nil && account.IsContract() {
+		return true
+	}
+
+	hasStorage := false
+	k.ForEachStorage(ctx, address, func(common.Hash, []byte) bool {
+		hasStorage = true
+		return false
+	})
+	return hasStorage
+}
+
+// newStorageOverride converts overridden storage cells of the account to the enclave request in deterministic order
+func newStorageOverride(address common.Address, storage map[common.Hash]common.Hash) *librustgo.StorageOverride {
+	keys := make([]common.Hash, 0, len(storage))
+	for key := range storage {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		return bytes.Compare(keys[i].Bytes(), keys[j].Bytes()) < 0
+	})
+
+	cells := make([]*librustgo.StorageCellOverride, 0, len(keys))
+	for _, key := range keys {
+		value := storage[key]
+		cells = append(cells, &librustgo.StorageCellOverride{Index: key.Bytes(), Value: value.Bytes()})
+	}
+	return &librustgo.StorageOverride{Address: address.Bytes(), Cells: cells}
+}
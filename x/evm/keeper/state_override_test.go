@@ -0,0 +1,363 @@
+package keeper_test
+
+import (
+	"encoding/json"
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	"swisstronik/server/config"
+	"swisstronik/tests"
+	"swisstronik/x/evm/types"
+)
+
+// stateOverrideTestCode returns value of storage slot 0 followed by balance of the caller:
+// PUSH1 0 SLOAD PUSH1 0 MSTORE CALLER BALANCE PUSH1 0x20 MSTORE PUSH1 0x40 PUSH1 0 RETURN
+var stateOverrideTestCode = hexutil.MustDecode("0x600054600052333160205260406000f3")
+
+func (suite *KeeperTestSuite) TestEthCallWithStateOverride() {
+	contract := tests.RandomEthAddress()
+	// Sender of unsigned `eth_call` is recovered as zero address
+	caller := common.Address{}
+
+	code := hexutil.Bytes(stateOverrideTestCode)
+	balance := (*hexutil.Big)(big.NewInt(1000))
+	storage := map[common.Hash]common.Hash{
+		{}: common.BigToHash(big.NewInt(42)),
+	}
+
+	args, err := json.Marshal(&types.TransactionArgs{
+		From: &caller,
+		To:   &contract,
+	})
+	suite.Require().NoError(err)
+
+	testCases := []struct {
+		name      string
+		overrides types.StateOverride
+		expPass   bool
+		expRet    []byte
+	}{
+		{
+			"no overrides",
+			nil,
+			true,
+			nil,
+		},
+		{
+			"code, balance and storage diff",
+			types.StateOverride{
+				contract: {Code: &code, StateDiff: &storage},
+				caller:   {Balance: &balance},
+			},
+			true,
+			append(common.BigToHash(big.NewInt(42)).Bytes(), common.BigToHash(big.NewInt(1000)).Bytes()...),
+		},
+		{
+			"code and full storage",
+			types.StateOverride{
+				contract: {Code: &code, State: &storage},
+			},
+			true,
+			append(common.BigToHash(big.NewInt(42)).Bytes(), common.Hash{}.Bytes()...),
+		},
+		{
+			"both state and state diff",
+			types.StateOverride{
+				contract: {Code: &code, State: &storage, StateDiff: &storage},
+			},
+			false,
+			nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			var overrides []byte
+			if tc.overrides != nil {
+				overrides, err = json.Marshal(tc.overrides)
+				suite.Require().NoError(err)
+			}
+
+			res, err := suite.queryClient.EthCall(sdk.WrapSDKContext(suite.ctx), &types.EthCallRequest{
+				Args:        args,
+				GasCap:      uint64(config.DefaultGasCap),
+				Unencrypted: true,
+				Overrides:   overrides,
+			})
+			if !tc.expPass {
+				suite.Require().Error(err)
+				return
+			}
+			suite.Require().NoError(err)
+			suite.Require().Empty(res.VmError)
+			suite.Require().Equal(tc.expRet, res.Ret)
+
+			// Overrides are never written to the store
+			suite.Require().Empty(suite.app.EvmKeeper.GetCode(suite.ctx, common.BytesToHash(suite.app.EvmKeeper.GetAccountOrEmpty(suite.ctx, contract).CodeHash)))
+			suite.Require().Empty(suite.app.EvmKeeper.GetState(suite.ctx, contract, common.Hash{}))
+			suite.Require().Equal(int64(0), suite.app.EvmKeeper.GetBalance(suite.ctx, caller).Int64())
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestEstimateGasWithStateOverride() {
+	contract := tests.RandomEthAddress()
+	caller := tests.RandomEthAddress()
+	code := hexutil.Bytes(stateOverrideTestCode)
+
+	args, err := json.Marshal(&types.TransactionArgs{
+		From: &caller,
+		To:   &contract,
+	})
+	suite.Require().NoError(err)
+
+	res, err := suite.queryClient.EstimateGas(sdk.WrapSDKContext(suite.ctx), &types.EthCallRequest{
+		Args:        args,
+		GasCap:      uint64(config.DefaultGasCap),
+		Unencrypted: true,
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(21000), res.Gas)
+
+	overrides, err := json.Marshal(types.StateOverride{contract: {Code: &code}})
+	suite.Require().NoError(err)
+
+	res, err = suite.queryClient.EstimateGas(sdk.WrapSDKContext(suite.ctx), &types.EthCallRequest{
+		Args:        args,
+		GasCap:      uint64(config.DefaultGasCap),
+		Unencrypted: true,
+		Overrides:   overrides,
+	})
+	suite.Require().NoError(err)
+	suite.Require().Greater(res.Gas, uint64(21000))
+}
+
+func (suite *KeeperTestSuite) TestStateOverrideOfExistingContract() {
+	code := hexutil.Bytes(stateOverrideTestCode)
+	balance := (*hexutil.Big)(big.NewInt(1))
+	storage := map[common.Hash]common.Hash{
+		{}:                            common.BigToHash(big.NewInt(42)),
+		common.BigToHash(common.Big1): common.BigToHash(big.NewInt(43)),
+		common.BigToHash(common.Big2): common.BigToHash(big.NewInt(44)),
+	}
+	emptyState := map[common.Hash]common.Hash{}
+
+	testCases := []struct {
+		name      string
+		existing  types.OverrideAccount
+		overrides func(contract common.Address) types.StateOverride
+		expPass   bool
+		expValue  int64
+	}{
+		{
+			"code of account without code and storage",
+			types.OverrideAccount{Balance: &balance},
+			func(contract common.Address) types.StateOverride {
+				return types.StateOverride{contract: {Code: &code}}
+			},
+			true,
+			0,
+		},
+		{
+			"code of account with code",
+			types.OverrideAccount{Code: &code},
+			func(contract common.Address) types.StateOverride {
+				return types.StateOverride{contract: {Code: &code}}
+			},
+			false,
+			0,
+		},
+		{
+			"code of account with storage",
+			types.OverrideAccount{StateDiff: &storage},
+			func(contract common.Address) types.StateOverride {
+				return types.StateOverride{contract: {Code: &code, StateDiff: &emptyState}}
+			},
+			false,
+			0,
+		},
+		{
+			"code of contract with entire storage",
+			types.OverrideAccount{Code: &code, StateDiff: &storage},
+			func(contract common.Address) types.StateOverride {
+				return types.StateOverride{contract: {Code: &code, State: &emptyState}}
+			},
+			true,
+			0,
+		},
+		{
+			"storage diff of contract",
+			types.OverrideAccount{Code: &code, StateDiff: &storage},
+			func(contract common.Address) types.StateOverride {
+				return types.StateOverride{contract: {StateDiff: &emptyState}}
+			},
+			false,
+			0,
+		},
+		{
+			"storage diff of account with storage",
+			types.OverrideAccount{StateDiff: &storage},
+			func(contract common.Address) types.StateOverride {
+				return types.StateOverride{contract: {StateDiff: &emptyState}}
+			},
+			false,
+			0,
+		},
+		{
+			"entire storage of contract",
+			types.OverrideAccount{Code: &code, StateDiff: &storage},
+			func(contract common.Address) types.StateOverride {
+				return types.StateOverride{contract: {State: &emptyState}}
+			},
+			true,
+			0,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			contract := tests.RandomEthAddress()
+			err := suite.app.EvmKeeper.ApplyStateOverride(suite.ctx, types.StateOverride{contract: tc.existing})
+			suite.Require().NoError(err)
+
+			args, err := json.Marshal(&types.TransactionArgs{To: &contract})
+			suite.Require().NoError(err)
+			overrides, err := json.Marshal(tc.overrides(contract))
+			suite.Require().NoError(err)
+
+			res, err := suite.queryClient.EthCall(sdk.WrapSDKContext(suite.ctx), &types.EthCallRequest{
+				Args:        args,
+				GasCap:      uint64(config.DefaultGasCap),
+				Unencrypted: true,
+				Overrides:   overrides,
+			})
+			if !tc.expPass {
+				suite.Require().ErrorContains(err, types.ErrInvalidStateOverride.Error())
+				return
+			}
+			suite.Require().NoError(err)
+			suite.Require().Empty(res.VmError)
+			suite.Require().Equal(common.BigToHash(big.NewInt(tc.expValue)).Bytes(), res.Ret[:32])
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestStateOverrideOfOwnerSlot() {
+	contract := tests.RandomEthAddress()
+	// contract returns value of storage slot 1 only to the owner, stored in slot 0:
+	// PUSH1 0 SLOAD CALLER EQ PUSH1 0x0c JUMPI PUSH1 0 DUP1 REVERT
+	// JUMPDEST PUSH1 1 SLOAD PUSH1 0 MSTORE PUSH1 0x20 PUSH1 0 RETURN
+	code := hexutil.Bytes(hexutil.MustDecode("0x6000543314600c57600080fd5b60015460005260206000f3"))
+	storage := map[common.Hash]common.Hash{
+		{}:                            common.BytesToHash(tests.RandomEthAddress().Bytes()),
+		common.BigToHash(common.Big1): common.BigToHash(big.NewInt(42)),
+	}
+	// caller tries to make itself the owner of the contract
+	ownerSlot := map[common.Hash]common.Hash{
+		{}: common.BytesToHash(suite.address.Bytes()),
+	}
+
+	testCases := []struct {
+		name      string
+		overrides types.StateOverride
+		expPass   bool
+		expRevert bool
+		expRet    []byte
+	}{
+		{
+			"no overrides - caller is not the owner",
+			nil,
+			true,
+			true,
+			nil,
+		},
+		{
+			"owner slot diff",
+			types.StateOverride{contract: {StateDiff: &ownerSlot}},
+			false,
+			false,
+			nil,
+		},
+		{
+			"entire storage with owner slot - existing storage is removed",
+			types.StateOverride{contract: {State: &ownerSlot}},
+			true,
+			false,
+			common.Hash{}.Bytes(),
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			err := suite.app.EvmKeeper.ApplyStateOverride(suite.ctx, types.StateOverride{contract: {Code: &code, StateDiff: &storage}})
+			suite.Require().NoError(err)
+
+			var overrides []byte
+			if tc.overrides != nil {
+				overrides, err = json.Marshal(tc.overrides)
+				suite.Require().NoError(err)
+			}
+			expiry := uint64(suite.ctx.BlockTime().Unix()) + 3600
+			args, err := json.Marshal(&types.CallArgs{
+				From:   &suite.address,
+				To:     &contract,
+				Permit: &types.CallPermit{Expiry: hexutil.Uint64(expiry), Signature: suite.signPermit(suite.address, contract, expiry)},
+			})
+			suite.Require().NoError(err)
+
+			res, err := suite.queryClient.EthCall(sdk.WrapSDKContext(suite.ctx), &types.EthCallRequest{
+				Args:        args,
+				GasCap:      uint64(config.DefaultGasCap),
+				Unencrypted: true,
+				Overrides:   overrides,
+			})
+			if !tc.expPass {
+				suite.Require().ErrorContains(err, types.ErrInvalidStateOverride.Error())
+				return
+			}
+			suite.Require().NoError(err)
+			if tc.expRevert {
+				suite.Require().NotEmpty(res.VmError)
+				return
+			}
+			suite.Require().Empty(res.VmError)
+			suite.Require().Equal(tc.expRet, res.Ret)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestApplyStateOverrideReplacesStorage() {
+	suite.SetupTest()
+
+	contract := tests.RandomEthAddress()
+	storage := map[common.Hash]common.Hash{
+		common.BigToHash(common.Big1): common.BigToHash(big.NewInt(1)),
+		common.BigToHash(common.Big2): common.BigToHash(big.NewInt(2)),
+		common.BigToHash(common.Big3): common.BigToHash(big.NewInt(3)),
+	}
+	err := suite.app.EvmKeeper.ApplyStateOverride(suite.ctx, types.StateOverride{contract: {StateDiff: &storage}})
+	suite.Require().NoError(err)
+
+	state := map[common.Hash]common.Hash{
+		common.BigToHash(common.Big2): common.BigToHash(big.NewInt(20)),
+	}
+	err = suite.app.EvmKeeper.ApplyStateOverride(suite.ctx, types.StateOverride{contract: {State: &state}})
+	suite.Require().NoError(err)
+
+	// All previous cells are removed, only overridden cell is kept
+	var keys []common.Hash
+	suite.app.EvmKeeper.ForEachStorage(suite.ctx, contract, func(key common.Hash, value []byte) bool {
+		keys = append(keys, key)
+		return true
+	})
+	suite.Require().Equal([]common.Hash{common.BigToHash(common.Big2)}, keys)
+}
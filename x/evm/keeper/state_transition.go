@@ -23,12 +23,14 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/vm"
 	evmcommontypes "swisstronik/types"
+	"swisstronik/x/evm/types"
 )
 
-// GetHashFn implements vm.GetHashFunc for Ethermint. It handles 3 cases:
+// GetHashFn implements vm.GetHashFunc for Ethermint. It handles 4 cases:
 //  1. The requested height matches the current height from context (and thus same epoch number)
-//  2. The requested height is from an previous height from the same chain epoch
-//  3. The requested height is from a height greater than the latest one
+//  2. The requested height is one of the latest blocks, kept in the x/evm block hash store
+//  3. The requested height is older than latest blocks, kept in the x/evm block hash store
+//  4. The requested height is from a height greater than the latest one
 func (k Keeper) GetHashFn(ctx sdk.Context) vm.GetHashFunc {
 	return func(height uint64) common.Hash {
 		h, err := evmcommontypes.SafeInt64(height)
@@ -41,42 +43,59 @@ func (k Keeper) GetHashFn(ctx sdk.Context) vm.GetHashFunc {
 		case ctx.BlockHeight() == h:
 			// Case 1: The requested height matches the one from the context so we can retrieve the header
 			// hash directly from the context.
-			// Note: The headerHash is only set at begin block, it will be nil in case of a query context
-			headerHash := ctx.HeaderHash()
-			if len(headerHash) != 0 {
-				return common.BytesToHash(headerHash)
-			}
+			return k.currentBlockHash(ctx)
 
-			// only recompute the hash if not set (eg: checkTxState)
-			contextBlockHeader := ctx.BlockHeader()
-			header, err := tmtypes.HeaderFromProto(&contextBlockHeader)
-			if err != nil {
-				k.Logger(ctx).Error("failed to cast tendermint header from proto", "error", err)
-				return common.Hash{}
+		case ctx.BlockHeight() > h && ctx.BlockHeight()-h <= types.BlockHashRingSize:
+			// Case 2: the hash is read from the block hash store, filled in BeginBlock. Blocks, produced before
+			// the store was introduced, are resolved using staking historical info.
+			if hash, found := k.GetBlockHash(ctx, height); found {
+				return hash
 			}
-
-			headerHash = header.Hash()
-			return common.BytesToHash(headerHash)
+			return k.historicalBlockHash(ctx, h)
 
 		case ctx.BlockHeight() > h:
-			// Case 2: if the chain is not the current height we need to retrieve the hash from the store for the
-			// current chain epoch. This only applies if the current height is greater than the requested height.
-			histInfo, found := k.stakingKeeper.GetHistoricalInfo(ctx, h)
-			if !found {
-				k.Logger(ctx).Debug("historical info not found", "height", h)
-				return common.Hash{}
-			}
-
-			header, err := tmtypes.HeaderFromProto(&histInfo.Header)
-			if err != nil {
-				k.Logger(ctx).Error("failed to cast tendermint header from proto", "error", err)
-				return common.Hash{}
-			}
+			// Case 3: as in Ethereum, only hashes of the latest blocks are available
+			return common.Hash{}
 
-			return common.BytesToHash(header.Hash())
 		default:
-			// Case 3: heights greater than the current one returns an empty hash.
+			// Case 4: heights greater than the current one returns an empty hash.
 			return common.Hash{}
 		}
 	}
 }
+
+// currentBlockHash returns hash of the block from context
+func (k Keeper) currentBlockHash(ctx sdk.Context) common.Hash {
+	// Note: The headerHash is only set at begin block, it will be nil in case of a query context
+	headerHash := ctx.HeaderHash()
+	if len(headerHash) != 0 {
+		return common.BytesToHash(headerHash)
+	}
+
+	// only recompute the hash if not set (eg: checkTxState)
+	contextBlockHeader := ctx.BlockHeader()
+	header, err := tmtypes.HeaderFromProto(&contextBlockHeader)
+	if err != nil {
+		k.Logger(ctx).Error("failed to cast tendermint header from proto", "error", err)
+		return common.Hash{}
+	}
+
+	return common.BytesToHash(header.Hash())
+}
+
+// historicalBlockHash returns hash of the block with provided height, using staking historical info
+func (k Keeper) historicalBlockHash(ctx sdk.Context, height int64) common.Hash {
+	histInfo, found := k.stakingKeeper.GetHistoricalInfo(ctx, height)
+	if !found {
+		k.Logger(ctx).Debug("historical info not found", "height", height)
+		return common.Hash{}
+	}
+
+	header, err := tmtypes.HeaderFromProto(&histInfo.Header)
+	if err != nil {
+		k.Logger(ctx).Error("failed to cast tendermint header from proto", "error", err)
+		return common.Hash{}
+	}
+
+	return common.BytesToHash(header.Hash())
+}
@@ -187,7 +187,7 @@ func BenchmarkApplyTransaction(b *testing.B) {
 		require.NoError(b, err)
 
 		b.StartTimer()
-		resp, err := suite.app.EvmKeeper.ApplySGXVMTransaction(suite.ctx, tx, false)
+		resp, err := suite.app.EvmKeeper.ApplySGXVMTransaction(suite.ctx, tx, common.Address{}, false)
 		b.StopTimer()
 
 		require.NoError(b, err)
@@ -214,7 +214,7 @@ func BenchmarkApplyTransactionWithLegacyTx(b *testing.B) {
 		require.NoError(b, err)
 
 		b.StartTimer()
-		resp, err := suite.app.EvmKeeper.ApplySGXVMTransaction(suite.ctx, tx, false)
+		resp, err := suite.app.EvmKeeper.ApplySGXVMTransaction(suite.ctx, tx, common.Address{}, false)
 		b.StopTimer()
 
 		require.NoError(b, err)
@@ -241,7 +241,7 @@ func BenchmarkApplyTransactionWithDynamicFeeTx(b *testing.B) {
 		require.NoError(b, err)
 
 		b.StartTimer()
-		resp, err := suite.app.EvmKeeper.ApplySGXVMTransaction(suite.ctx, tx, false)
+		resp, err := suite.app.EvmKeeper.ApplySGXVMTransaction(suite.ctx, tx, common.Address{}, false)
 		b.StopTimer()
 
 		require.NoError(b, err)
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"math"
 	"math/big"
+	"time"
 
 	"swisstronik/tests"
 	"swisstronik/x/evm/keeper"
@@ -15,9 +16,16 @@ import (
 	tmtypes "github.com/cometbft/cometbft/types"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/feegrant"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/state"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
 	"github.com/ethereum/go-ethereum/params"
 )
 
@@ -88,7 +96,38 @@ func (suite *KeeperTestSuite) TestGetHashFn() {
 			common.BytesToHash(hash),
 		},
 		{
-			"case 3: height greater than current one",
+			"case 2.4: height lower than current one, read from block hash store",
+			1,
+			func() {
+				suite.app.EvmKeeper.SetBlockHash(suite.ctx, 1, common.BytesToHash(hash))
+				suite.ctx = suite.ctx.WithBlockHeight(10)
+			},
+			common.BytesToHash(hash),
+		},
+		{
+			"case 2.5: block hash store slot overwritten by newer block",
+			1,
+			func() {
+				suite.app.EvmKeeper.SetBlockHash(suite.ctx, 1+types.BlockHashRingSize, common.BytesToHash(hash))
+				suite.ctx = suite.ctx.WithBlockHeight(10)
+			},
+			common.Hash{},
+		},
+		{
+			"case 3: height older than block hash window",
+			1,
+			func() {
+				histInfo := &stakingtypes.HistoricalInfo{
+					Header: header,
+				}
+				suite.app.StakingKeeper.SetHistoricalInfo(suite.ctx, 1, histInfo)
+				suite.app.EvmKeeper.SetBlockHash(suite.ctx, 1, common.BytesToHash(hash))
+				suite.ctx = suite.ctx.WithBlockHeight(2 + types.BlockHashRingSize)
+			},
+			common.Hash{},
+		},
+		{
+			"case 4: height greater than current one",
 			200,
 			func() {},
 			common.Hash{},
@@ -419,3 +458,180 @@ func (suite *KeeperTestSuite) TestGetProposerAddress() {
 		})
 	}
 }
+
+// eip3529Tests contains SSTORE test vectors from EIP-3529 for storage slots with zero original value.
+// Slot is in the access list, so it is warm. SGXVM treats every slot as dirty, since original
+// value is not tracked, therefore vectors with non-zero original value are not included
+var eip3529Tests = []struct {
+	input  string
+	used   uint64
+	refund uint64
+}{
+	{"0x60006000556000600055", 212, 0},                 // 0 -> 0 -> 0
+	{"0x60006000556001600055", 20112, 0},               // 0 -> 0 -> 1
+	{"0x60016000556000600055", 20112, 19900},           // 0 -> 1 -> 0
+	{"0x60016000556002600055", 20112, 0},               // 0 -> 1 -> 2
+	{"0x60016000556001600055", 20112, 0},               // 0 -> 1 -> 1
+	{"0x600160005560006000556001600055", 40118, 19900}, // 0 -> 1 -> 0 -> 1
+}
+
+func (suite *KeeperTestSuite) TestEIP3529Refunds() {
+	chainID := suite.app.EvmKeeper.ChainID()
+	chainConfig := suite.app.EvmKeeper.GetParams(suite.ctx).ChainConfig.EthereumConfig(chainID)
+
+	for i, tc := range eip3529Tests {
+		suite.Run(fmt.Sprintf("vector %d", i), func() {
+			suite.SetupTest()
+
+			code := hexutil.MustDecode(tc.input)
+			contract := tests.RandomEthAddress()
+			accessList := ethtypes.AccessList{{Address: contract, StorageKeys: []common.Hash{{}}}}
+
+			// Cross-check test vector with go-ethereum
+			statedb, err := state.New(common.Hash{}, state.NewDatabase(rawdb.NewMemoryDatabase()), nil)
+			suite.Require().NoError(err)
+			statedb.CreateAccount(contract)
+			statedb.SetCode(contract, code)
+			statedb.Finalise(true)
+			statedb.AddSlotToAccessList(contract, common.Hash{})
+
+			blockContext := vm.BlockContext{
+				CanTransfer: func(vm.StateDB, common.Address, *big.Int) bool { return true },
+				Transfer:    func(vm.StateDB, common.Address, common.Address, *big.Int) {},
+				BlockNumber: big.NewInt(suite.ctx.BlockHeight()),
+			}
+			evm := vm.NewEVM(blockContext, vm.TxContext{}, statedb, chainConfig, vm.Config{})
+			_, leftoverGas, err := evm.Call(vm.AccountRef(suite.address), contract, nil, math.MaxUint64, new(big.Int))
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.used, math.MaxUint64-leftoverGas)
+			suite.Require().Equal(tc.refund, statedb.GetRefund())
+
+			// Execute the same code in SGXVM
+			suite.Require().NoError(suite.app.EvmKeeper.SetAccountCode(suite.ctx, contract, code))
+
+			nonce := suite.app.EvmKeeper.GetNonce(suite.ctx, suite.address)
+			msg := types.NewSGXVMTx(chainID, nonce, &contract, nil, 100_000, nil, nil, nil, nil, &accessList, suite.privateKey, suite.nodePublicKey)
+			msg.From = suite.address.Hex()
+			suite.Require().NoError(msg.Sign(ethtypes.LatestSignerForChainID(chainID), suite.signer))
+
+			txData, err := types.UnpackTxData(msg.Data)
+			suite.Require().NoError(err)
+			intrinsicGas, err := core.IntrinsicGas(txData.GetData(), accessList, false, true, true)
+			suite.Require().NoError(err)
+
+			rsp, err := suite.app.EvmKeeper.HandleTx(sdk.WrapSDKContext(suite.ctx), msg)
+			suite.Require().NoError(err)
+			suite.Require().Empty(rsp.VmError)
+
+			gasUsed := intrinsicGas + tc.used
+			suite.Require().Equal(gasUsed-keeper.GasToRefund(tc.refund, gasUsed, params.RefundQuotientEIP3529), rsp.GasUsed)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestRefundGasRestoresFeeAllowance() {
+	sender := tests.RandomEthAddress()
+	feePayer := tests.RandomEthAddress()
+	gasPrice := big.NewInt(10)
+	leftoverGas := uint64(1000)
+
+	denom := suite.app.EvmKeeper.GetParams(suite.ctx).EvmDenom
+	coins := func(amount int64) sdk.Coins {
+		return sdk.NewCoins(sdk.NewInt64Coin(denom, amount))
+	}
+	refund := coins(10000)
+	basic := func(spendLimit sdk.Coins) *feegrant.BasicAllowance {
+		return &feegrant.BasicAllowance{SpendLimit: spendLimit}
+	}
+	allowedMsg := func(allowance feegrant.FeeAllowanceI) *feegrant.AllowedMsgAllowance {
+		wrapped, err := feegrant.NewAllowedMsgAllowance(allowance, []string{sdk.MsgTypeURL(&types.MsgHandleTx{})})
+		suite.Require().NoError(err)
+		return wrapped
+	}
+	periodic := func(spendLimit, canSpend sdk.Coins) *feegrant.PeriodicAllowance {
+		return &feegrant.PeriodicAllowance{
+			Basic:            *basic(spendLimit),
+			Period:           time.Hour,
+			PeriodSpendLimit: coins(15000),
+			PeriodCanSpend:   canSpend,
+			PeriodReset:      suite.ctx.BlockTime().Add(time.Hour),
+		}
+	}
+
+	testCases := []struct {
+		name     string
+		feePayer common.Address
+		granted  feegrant.FeeAllowanceI
+		expected feegrant.FeeAllowanceI
+	}{
+		{
+			"no allowance",
+			feePayer,
+			nil,
+			nil,
+		},
+		{
+			"basic allowance",
+			feePayer,
+			basic(coins(500)),
+			basic(coins(10500)),
+		},
+		{
+			"unlimited basic allowance",
+			feePayer,
+			basic(nil),
+			basic(nil),
+		},
+		{
+			"periodic allowance - restored amount is capped by period limit",
+			feePayer,
+			periodic(coins(500), coins(7000)),
+			periodic(coins(10500), coins(15000)),
+		},
+		{
+			"allowed msg allowance",
+			feePayer,
+			allowedMsg(basic(coins(500))),
+			allowedMsg(basic(coins(10500))),
+		},
+		{
+			"fees are not delegated",
+			sender,
+			basic(coins(500)),
+			basic(coins(500)),
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			// fees for the whole gas limit are collected by the ante handler
+			suite.Require().NoError(suite.app.EvmKeeper.SetBalance(suite.ctx, tc.feePayer, refund.AmountOf(denom).BigInt()))
+			suite.Require().NoError(suite.app.BankKeeper.SendCoinsFromAccountToModule(suite.ctx, tc.feePayer.Bytes(), authtypes.FeeCollectorName, refund))
+			if tc.granted != nil {
+				suite.Require().NoError(suite.app.FeeGrantKeeper.GrantAllowance(suite.ctx, tc.feePayer.Bytes(), sender.Bytes(), tc.granted))
+			}
+
+			msg := ethtypes.NewMessage(sender, &common.Address{}, 0, nil, 21000+leftoverGas, gasPrice, gasPrice, gasPrice, nil, nil, true)
+			suite.Require().NoError(suite.app.EvmKeeper.RefundGas(suite.ctx, msg, tc.feePayer, leftoverGas, denom))
+			suite.Require().Equal(refund.AmountOf(denom).BigInt(), suite.app.EvmKeeper.GetBalance(suite.ctx, tc.feePayer))
+
+			allowance, err := suite.app.FeeGrantKeeper.GetAllowance(suite.ctx, tc.feePayer.Bytes(), sender.Bytes())
+			if tc.expected == nil {
+				suite.Require().Error(err)
+				return
+			}
+			suite.Require().NoError(err)
+			if wrapped, ok := allowance.(*feegrant.AllowedMsgAllowance); ok {
+				inner, err := wrapped.GetAllowance()
+				suite.Require().NoError(err)
+				expected, err := tc.expected.(*feegrant.AllowedMsgAllowance).GetAllowance()
+				suite.Require().NoError(err)
+				suite.Require().Equal(expected, inner)
+				return
+			}
+			suite.Require().Equal(tc.expected, allowance)
+		})
+	}
+}
@@ -0,0 +1,76 @@
+package keeper
+
+import (
+	"math/big"
+
+	"github.com/SigmaGmbH/librustgo"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"swisstronik/x/evm/types"
+)
+
+// SetContractDeployer records the account, which deployed the contract with provided address
+func (k Keeper) SetContractDeployer(ctx sdk.Context, contract, deployer common.Address) {
+	ctx.KVStore(k.storeKey).Set(types.ContractDeployerKey(contract), deployer.Bytes())
+}
+
+// GetContractDeployer returns the account, which deployed the contract with provided address.
+// Returns false if contract was not deployed by a transaction, for example, if it was created by another contract
+func (k Keeper) GetContractDeployer(ctx sdk.Context, contract common.Address) (common.Address, bool) {
+	value := ctx.KVStore(k.storeKey).Get(types.ContractDeployerKey(contract))
+	if len(value) != common.AddressLength {
+		return common.Address{}, false
+	}
+	return common.BytesToAddress(value), true
+}
+
+// readPrivateStorage passes signed request for decrypted storage cells of the contract to SGXVM. Values are
+// encrypted to provided public key. `isStorageViewer(address)` function of the contract is called on the cached
+// context, so its changes are discarded
+func (k *Keeper) readPrivateStorage(ctx sdk.Context, contract common.Address, keys [][]byte, publicKey []byte, expiry uint64, signature []byte) ([][]byte, error) {
+	msg := ethtypes.NewMessage(
+		common.Address{},
+		&contract,
+		0,
+		new(big.Int),
+		0,
+		new(big.Int),
+		new(big.Int),
+		new(big.Int),
+		nil,
+		nil,
+		true,
+	)
+	txContext, err := CreateSGXVMContextFromMessage(ctx, k, msg)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	cacheCtx, _ := ctx.CacheContext()
+	connector := Connector{
+		GetHashFn: k.GetHashFn(cacheCtx),
+		Context:   cacheCtx,
+		EVMKeeper: k,
+		Cache:     NewConnectorCache(),
+		Writes:    types.NewStateWrites(),
+	}
+	res, err := librustgo.PrivateStorage(connector, &librustgo.PrivateStorageRequest{
+		ContractAddress: contract.Bytes(),
+		Keys:            keys,
+		UserPublicKey:   publicKey,
+		Expiry:          expiry,
+		Signature:       signature,
+		Context:         txContext,
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if res.Error != "" {
+		return nil, status.Errorf(codes.PermissionDenied, "cannot read storage of %s: %s", contract.Hex(), res.Error)
+	}
+	return res.EncryptedValues, nil
+}
@@ -0,0 +1,198 @@
+package keeper_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/rand"
+	"encoding/json"
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+
+	"swisstronik/crypto/deoxys"
+	"swisstronik/ethereum/eip712"
+	"swisstronik/server/config"
+	"swisstronik/x/evm/types"
+)
+
+// storageViewerTestCode stores 42 in slot 0 and constructor argument in slot 1. Deployed code returns true
+// if called with the address, stored in slot 1:
+// PUSH1 0x2a PUSH1 0 SSTORE PUSH1 0x20 PUSH1 0x2d PUSH1 0 CODECOPY PUSH1 0 MLOAD PUSH1 1 SSTORE
+// PUSH1 0x0f PUSH1 0x1e PUSH1 0 CODECOPY PUSH1 0x0f PUSH1 0 RETURN
+// deployed code: PUSH1 1 SLOAD PUSH1 4 CALLDATALOAD EQ PUSH1 0 MSTORE PUSH1 0x20 PUSH1 0 RETURN
+var storageViewerTestCode = hexutil.MustDecode("0x602a6000556020602d600039600051600155600f601e600039600f6000f36001546004351460005260206000f3")
+
+// deployStorageViewerTestContract deploys contract, which allows viewer to read its storage
+func (suite *KeeperTestSuite) deployStorageViewerTestContract(viewer common.Address) common.Address {
+	chainID := suite.app.EvmKeeper.ChainID()
+	nonce := suite.app.EvmKeeper.GetNonce(suite.ctx, suite.address)
+
+	data := append(common.CopyBytes(storageViewerTestCode), common.LeftPadBytes(viewer.Bytes(), 32)...)
+	args, err := json.Marshal(&types.TransactionArgs{
+		From: &suite.address,
+		Data: (*hexutil.Bytes)(&data),
+	})
+	suite.Require().NoError(err)
+	res, err := suite.queryClient.EstimateGas(sdk.WrapSDKContext(suite.ctx), &types.EthCallRequest{
+		Args:   args,
+		GasCap: uint64(config.DefaultGasCap),
+	})
+	suite.Require().NoError(err)
+
+	tx := types.NewTxContract(chainID, nonce, nil, res.Gas, nil, suite.app.FeeMarketKeeper.GetBaseFee(suite.ctx), big.NewInt(1), data, &ethtypes.AccessList{})
+	tx.From = suite.address.Hex()
+	suite.Require().NoError(tx.Sign(ethtypes.LatestSignerForChainID(chainID), suite.signer))
+
+	rsp, err := suite.app.EvmKeeper.HandleTx(sdk.WrapSDKContext(suite.ctx), tx)
+	suite.Require().NoError(err)
+	suite.Require().Empty(rsp.VmError)
+	return crypto.CreateAddress(suite.address, nonce)
+}
+
+func (suite *KeeperTestSuite) TestPrivateStorage() {
+	var (
+		contract     common.Address
+		viewerKey    *ecdsa.PrivateKey
+		userKey      [32]byte
+		userPubKey   [32]byte
+		expiry       uint64
+		requesterKey *ecdsa.PrivateKey
+		req          *types.QueryPrivateStorageRequest
+	)
+
+	keys := []string{common.BigToHash(big.NewInt(0)).Hex(), common.BigToHash(big.NewInt(1)).Hex(), common.BigToHash(big.NewInt(5)).Hex()}
+
+	sign := func(key *ecdsa.PrivateKey, contract common.Address, publicKey []byte, expiry uint64) []byte {
+		typedData := eip712.WrapStorageAccessToTypedData(suite.app.EvmKeeper.ChainID().Uint64(), contract, publicKey, expiry)
+		sigHash, _, err := apitypes.TypedDataAndHash(typedData)
+		suite.Require().NoError(err)
+		signature, err := crypto.Sign(sigHash, key)
+		suite.Require().NoError(err)
+		return signature
+	}
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success - signed by deployer",
+			func() {
+				req.Signature = sign(requesterKey, contract, userPubKey[:], expiry)
+			},
+			true,
+		},
+		{
+			"success - signed by account, authorized by contract",
+			func() {
+				req.Signature = sign(viewerKey, contract, userPubKey[:], expiry)
+			},
+			true,
+		},
+		{
+			"fail - signed by unauthorized account",
+			func() {
+				key, err := crypto.GenerateKey()
+				suite.Require().NoError(err)
+				req.Signature = sign(key, contract, userPubKey[:], expiry)
+			},
+			false,
+		},
+		{
+			"fail - signed for another public key",
+			func() {
+				req.Signature = sign(requesterKey, contract, make([]byte, 32), expiry)
+			},
+			false,
+		},
+		{
+			"fail - expired signature",
+			func() {
+				req.Expiry = uint64(suite.ctx.BlockTime().Unix())
+				req.Signature = sign(requesterKey, contract, userPubKey[:], req.Expiry)
+			},
+			false,
+		},
+		{
+			"fail - invalid public key",
+			func() {
+				req.PublicKey = userPubKey[:31]
+				req.Signature = sign(requesterKey, contract, req.PublicKey, expiry)
+			},
+			false,
+		},
+		{
+			"fail - no keys",
+			func() {
+				req.Keys = nil
+				req.Signature = sign(requesterKey, contract, userPubKey[:], expiry)
+			},
+			false,
+		},
+		{
+			"fail - not a contract",
+			func() {
+				req.Address = suite.address.Hex()
+				req.Signature = sign(requesterKey, suite.address, userPubKey[:], expiry)
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			var err error
+			requesterKey, err = crypto.ToECDSA(suite.privateKey)
+			suite.Require().NoError(err)
+			viewerKey, err = crypto.GenerateKey()
+			suite.Require().NoError(err)
+			viewer := crypto.PubkeyToAddress(viewerKey.PublicKey)
+
+			contract = suite.deployStorageViewerTestContract(viewer)
+			deployer, found := suite.app.EvmKeeper.GetContractDeployer(suite.ctx, contract)
+			suite.Require().True(found)
+			suite.Require().Equal(suite.address, deployer)
+
+			_, err = rand.Read(userKey[:])
+			suite.Require().NoError(err)
+			userPubKey = deoxys.GetCurve25519PublicKey(userKey)
+			expiry = uint64(suite.ctx.BlockTime().Unix()) + 60
+
+			req = &types.QueryPrivateStorageRequest{
+				Address:   contract.Hex(),
+				Keys:      keys,
+				PublicKey: userPubKey[:],
+				Expiry:    expiry,
+			}
+			tc.malleate()
+
+			res, err := suite.queryClient.PrivateStorage(sdk.WrapSDKContext(suite.ctx), req)
+			if !tc.expPass {
+				suite.Require().Error(err)
+				return
+			}
+			suite.Require().NoError(err)
+			suite.Require().Len(res.Values, len(keys))
+
+			nodePublicKey, err := hexutil.Decode(res.NodePublicKey)
+			suite.Require().NoError(err)
+			expValues := [][]byte{
+				common.BigToHash(big.NewInt(42)).Bytes(),
+				common.LeftPadBytes(viewer.Bytes(), 32),
+				common.Hash{}.Bytes(),
+			}
+			for i, encryptedValue := range res.Values {
+				value, err := deoxys.DecryptECDH(userKey[:], nodePublicKey, encryptedValue)
+				suite.Require().NoError(err)
+				suite.Require().Equal(expValues[i], value)
+			}
+		})
+	}
+}
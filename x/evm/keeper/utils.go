@@ -150,3 +150,35 @@ func CheckSenderBalance(
 	}
 	return nil
 }
+
+// CheckFeeDelegatedBalances validates that the tx cost value is positive, that the sender of fee delegated
+// transaction has enough funds to pay for the value of the transaction and that the fee payer has enough
+// funds to pay for the fees.
+func CheckFeeDelegatedBalances(
+	senderBalance, feePayerBalance sdkmath.Int,
+	txData types.TxData,
+) error {
+	value, fee := txData.GetValue(), txData.Fee()
+
+	if value.Sign() < 0 || fee.Sign() < 0 {
+		return errorsmod.Wrapf(
+			errortypes.ErrInvalidCoins,
+			"tx value (%s) or fee (%s) is negative and invalid", value, fee,
+		)
+	}
+
+	if senderBalance.IsNegative() || senderBalance.BigInt().Cmp(value) < 0 {
+		return errorsmod.Wrapf(
+			errortypes.ErrInsufficientFunds,
+			"sender balance < tx value (%s < %s)", senderBalance, value,
+		)
+	}
+
+	if feePayerBalance.IsNegative() || feePayerBalance.BigInt().Cmp(fee) < 0 {
+		return errorsmod.Wrapf(
+			errortypes.ErrInsufficientFunds,
+			"fee payer balance < tx fee (%s < %s)", feePayerBalance, fee,
+		)
+	}
+	return nil
+}
@@ -0,0 +1,34 @@
+package v1_0_4
+
+import (
+	tmtypes "github.com/cometbft/cometbft/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"swisstronik/x/evm/types"
+)
+
+// BlockHashKeeper writes hashes of the latest blocks, available for BLOCKHASH opcode
+type BlockHashKeeper interface {
+	SetBlockHash(ctx sdk.Context, height uint64, hash common.Hash)
+}
+
+// MigrateStore fills the ring buffer of latest block hashes from staking historical info, so BLOCKHASH of
+// blocks, produced before the upgrade, doesn't depend on pruning of historical entries.
+// Contract deployers and contract sources are not migrated: deployers of existing contracts are not recorded
+// in the store, so only deployers of contracts, created after the upgrade, can read their private storage
+// without `isStorageViewer(address)` function
+func MigrateStore(ctx sdk.Context, k BlockHashKeeper, sk types.StakingKeeper) error {
+	for height := ctx.BlockHeight() - 1; height > 0 && ctx.BlockHeight()-height <= types.BlockHashRingSize; height-- {
+		histInfo, found := sk.GetHistoricalInfo(ctx, height)
+		if !found {
+			continue
+		}
+		header, err := tmtypes.HeaderFromProto(&histInfo.Header)
+		if err != nil {
+			return err
+		}
+		k.SetBlockHash(ctx, uint64(height), common.BytesToHash(header.Hash()))
+	}
+	return nil
+}
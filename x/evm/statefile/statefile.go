@@ -0,0 +1,346 @@
+// Package statefile implements sharded, checksummed file format of the EVM state, exported by
+// export-state command and imported by import-state command.
+//
+// Exported state is a directory with manifest file and shard files. Each shard contains gzipped
+// sequence of protobuf-encoded state records, each prefixed with its uvarint-encoded length. Manifest
+// contains SHA-256 checksum of every shard and cursor of the last record in it, so interrupted export
+// can be resumed after the last completed shard.
+package statefile
+
+import (
+	"bufio"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"os"
+	"path/filepath"
+
+	"swisstronik/x/evm/types"
+)
+
+const (
+	// FormatVersion is the version of the exported state format
+	FormatVersion = 1
+
+	// ManifestFile is the name of the manifest file in the export directory
+	ManifestFile = "manifest.json"
+
+	// maxRecordSize limits size of a single record, so corrupted length prefix doesn't cause huge allocation
+	maxRecordSize = 64 << 20
+)
+
+// Shard describes a single completed shard of the exported state
+type Shard struct {
+	// File is the name of the shard file in the export directory
+	File string `json:"file"`
+	// Records is the amount of records in the shard
+	Records uint64 `json:"records"`
+	// Checksum is the hex-encoded SHA-256 hash of the shard file
+	Checksum string `json:"checksum"`
+	// Last points to the last record in the shard
+	Last types.StateCursor `json:"last"`
+}
+
+// Manifest describes exported state
+type Manifest struct {
+	Version int    `json:"version"`
+	ChainID string `json:"chain_id"`
+	// Height is the height of the block, after which the state is exported
+	Height int64 `json:"height"`
+	// Shards contains completed shards in the order of export
+	Shards []Shard `json:"shards"`
+	// Complete is true if all state records were exported
+	Complete bool `json:"complete"`
+}
+
+// Cursor returns resume point of the export, which points to the last record of the last completed shard
+func (m Manifest) Cursor() types.StateCursor {
+	if len(m.Shards) == 0 {
+		return types.StateCursor{}
+	}
+	return m.Shards[len(m.Shards)-1].Last
+}
+
+// Records returns total amount of records in completed shards
+func (m Manifest) Records() uint64 {
+	var records uint64
+	for _, shard := range m.Shards {
+		records += shard.Records
+	}
+	return records
+}
+
+// ReadManifest reads manifest from the export directory
+func ReadManifest(dir string) (Manifest, error) {
+	var manifest Manifest
+	bz, err := os.ReadFile(filepath.Join(dir, ManifestFile))
+	if err != nil {
+		return manifest, err
+	}
+	if err := json.Unmarshal(bz, &manifest); err != nil {
+		return manifest, fmt.Errorf("failed to decode manifest: %w", err)
+	}
+	if manifest.Version != FormatVersion {
+		return manifest, fmt.Errorf("unsupported format version %d, expected %d", manifest.Version, FormatVersion)
+	}
+	return manifest, nil
+}
+
+// writeManifest atomically replaces manifest in the export directory
+func writeManifest(dir string, manifest Manifest) error {
+	bz, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	tmp := filepath.Join(dir, ManifestFile+".tmp")
+	if err := os.WriteFile(tmp, bz, 0o600); err != nil {
+		return err
+	}
+	return os.Rename(tmp, filepath.Join(dir, ManifestFile))
+}
+
+// shardFileName returns name of the shard file with provided index
+func shardFileName(index int) string {
+	return fmt.Sprintf("shard-%06d.pb.gz", index)
+}
+
+// Writer writes exported state records to shard files. Writer is not safe for concurrent use
+type Writer struct {
+	dir       string
+	shardSize uint64
+	manifest  Manifest
+
+	// current shard, which is not yet completed
+	file    *os.File
+	hasher  hash.Hash
+	buf     *bufio.Writer
+	gz      *gzip.Writer
+	records uint64
+	last    types.StateCursor
+}
+
+// NewWriter creates new export in provided directory. Each shard contains up to shardSize records
+func NewWriter(dir, chainID string, height int64, shardSize uint64) (*Writer, error) {
+	if shardSize == 0 {
+		return nil, errors.New("shard size must be positive")
+	}
+	if err := os.MkdirAll(dir, 0o750); err != nil {
+		return nil, err
+	}
+	if _, err := os.Stat(filepath.Join(dir, ManifestFile)); err == nil {
+		return nil, fmt.Errorf("export already exists in %s", dir)
+	}
+
+	w := &Writer{
+		dir:       dir,
+		shardSize: shardSize,
+		manifest:  Manifest{Version: FormatVersion, ChainID: chainID, Height: height, Shards: []Shard{}},
+	}
+	if err := writeManifest(dir, w.manifest); err != nil {
+		return nil, err
+	}
+	return w, nil
+}
+
+// ResumeWriter continues interrupted export in provided directory. Completed shards are verified
+// against their checksums, records of the incomplete shard are exported again
+func ResumeWriter(dir string, shardSize uint64) (*Writer, error) {
+	if shardSize == 0 {
+		return nil, errors.New("shard size must be positive")
+	}
+	manifest, err := ReadManifest(dir)
+	if err != nil {
+		return nil, err
+	}
+	if manifest.Complete {
+		return nil, fmt.Errorf("export in %s is already complete", dir)
+	}
+	for _, shard := range manifest.Shards {
+		if err := verifyShard(dir, shard); err != nil {
+			return nil, err
+		}
+	}
+
+	return &Writer{dir: dir, shardSize: shardSize, manifest: manifest}, nil
+}
+
+// Manifest returns manifest of the export
+func (w *Writer) Manifest() Manifest {
+	return w.manifest
+}
+
+// Write appends record to the current shard. Cursor must point to the record. Shard is completed
+// and recorded in the manifest, once it contains shardSize records
+func (w *Writer) Write(record types.StateRecord, cursor types.StateCursor) error {
+	if w.manifest.Complete {
+		return errors.New("export is already complete")
+	}
+	if w.file == nil {
+		if err := w.openShard(); err != nil {
+			return err
+		}
+	}
+
+	bz, err := record.Marshal()
+	if err != nil {
+		return err
+	}
+	if _, err := w.gz.Write(binary.AppendUvarint(nil, uint64(len(bz)))); err != nil {
+		return err
+	}
+	if _, err := w.gz.Write(bz); err != nil {
+		return err
+	}
+
+	w.records++
+	w.last = cursor
+	if w.records >= w.shardSize {
+		return w.closeShard()
+	}
+	return nil
+}
+
+// Close completes the last shard and marks export as complete
+func (w *Writer) Close() error {
+	if w.file != nil {
+		if err := w.closeShard(); err != nil {
+			return err
+		}
+	}
+	w.manifest.Complete = true
+	return writeManifest(w.dir, w.manifest)
+}
+
+func (w *Writer) openShard() error {
+	// shard file may be left by interrupted export, it is overwritten
+	file, err := os.Create(filepath.Join(w.dir, shardFileName(len(w.manifest.Shards))))
+	if err != nil {
+		return err
+	}
+
+	w.file = file
+	w.hasher = sha256.New()
+	w.buf = bufio.NewWriter(io.MultiWriter(file, w.hasher))
+	w.gz = gzip.NewWriter(w.buf)
+	w.records = 0
+	return nil
+}
+
+func (w *Writer) closeShard() error {
+	if err := w.gz.Close(); err != nil {
+		return err
+	}
+	if err := w.buf.Flush(); err != nil {
+		return err
+	}
+	if err := w.file.Sync(); err != nil {
+		return err
+	}
+	if err := w.file.Close(); err != nil {
+		return err
+	}
+
+	w.manifest.Shards = append(w.manifest.Shards, Shard{
+		File:     filepath.Base(w.file.Name()),
+		Records:  w.records,
+		Checksum: hex.EncodeToString(w.hasher.Sum(nil)),
+		Last:     w.last,
+	})
+	w.file = nil
+	return writeManifest(w.dir, w.manifest)
+}
+
+// verifyShard checks that shard file matches its checksum
+func verifyShard(dir string, shard Shard) error {
+	file, err := os.Open(filepath.Join(dir, filepath.Base(shard.File)))
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	hasher := sha256.New()
+	if _, err := io.Copy(hasher, file); err != nil {
+		return err
+	}
+	if checksum := hex.EncodeToString(hasher.Sum(nil)); checksum != shard.Checksum {
+		return fmt.Errorf("checksum mismatch of shard %s: expected %s, got %s", shard.File, shard.Checksum, checksum)
+	}
+	return nil
+}
+
+// ReadState reads records of the complete export in provided directory and passes them to the handler
+// in the order of export. Every shard is verified against its checksum before its records are read,
+// so handler doesn't receive records of corrupted shards
+func ReadState(dir string, handler func(record types.StateRecord) error) (Manifest, error) {
+	manifest, err := ReadManifest(dir)
+	if err != nil {
+		return manifest, err
+	}
+	if !manifest.Complete {
+		return manifest, fmt.Errorf("export in %s is not complete", dir)
+	}
+
+	for _, shard := range manifest.Shards {
+		if err := verifyShard(dir, shard); err != nil {
+			return manifest, err
+		}
+		if err := readShard(dir, shard, handler); err != nil {
+			return manifest, fmt.Errorf("failed to read shard %s: %w", shard.File, err)
+		}
+	}
+	return manifest, nil
+}
+
+func readShard(dir string, shard Shard, handler func(record types.StateRecord) error) error {
+	file, err := os.Open(filepath.Join(dir, filepath.Base(shard.File)))
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	gz, err := gzip.NewReader(bufio.NewReader(file))
+	if err != nil {
+		return err
+	}
+	defer gz.Close()
+	reader := bufio.NewReader(gz)
+
+	var records uint64
+	for {
+		size, err := binary.ReadUvarint(reader)
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		if size > maxRecordSize {
+			return fmt.Errorf("record size %d exceeds limit", size)
+		}
+
+		bz := make([]byte, size)
+		if _, err := io.ReadFull(reader, bz); err != nil {
+			return err
+		}
+		var record types.StateRecord
+		if err := record.Unmarshal(bz); err != nil {
+			return err
+		}
+		if err := handler(record); err != nil {
+			return err
+		}
+		records++
+	}
+
+	if records != shard.Records {
+		return fmt.Errorf("expected %d records, got %d", shard.Records, records)
+	}
+	return nil
+}
@@ -0,0 +1,140 @@
+package statefile
+
+import (
+	"fmt"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"swisstronik/x/evm/types"
+)
+
+// testRecords returns storage records with cursors, pointing to them
+func testRecords(n int) ([]types.StateRecord, []types.StateCursor) {
+	records := make([]types.StateRecord, 0, n)
+	cursors := make([]types.StateCursor, 0, n)
+	for i := 0; i < n; i++ {
+		key := common.BigToHash(big.NewInt(int64(i + 1))).Bytes()
+		records = append(records, types.StateRecord{Record: &types.StateRecord_Storage{Storage: &types.ExportedStorage{
+			Address: common.HexToAddress("0x1").Hex(),
+			Key:     key,
+			Value:   []byte(fmt.Sprintf("value %d", i)),
+		}}})
+		cursors = append(cursors, types.StateCursor{Phase: types.StatePhaseStorage, Key: key})
+	}
+	return records, cursors
+}
+
+func readAll(t *testing.T, dir string) []types.StateRecord {
+	var read []types.StateRecord
+	_, err := ReadState(dir, func(record types.StateRecord) error {
+		read = append(read, record)
+		return nil
+	})
+	require.NoError(t, err)
+	return read
+}
+
+func TestWriteReadState(t *testing.T) {
+	dir := t.TempDir()
+	records, cursors := testRecords(5)
+
+	w, err := NewWriter(dir, "swisstronik_1291-1", 10, 2)
+	require.NoError(t, err)
+	for i, record := range records {
+		require.NoError(t, w.Write(record, cursors[i]))
+	}
+	require.NoError(t, w.Close())
+
+	manifest, err := ReadManifest(dir)
+	require.NoError(t, err)
+	require.True(t, manifest.Complete)
+	require.Equal(t, int64(10), manifest.Height)
+	require.Len(t, manifest.Shards, 3)
+	require.Equal(t, uint64(5), manifest.Records())
+	require.Equal(t, cursors[4], manifest.Cursor())
+
+	require.Equal(t, records, readAll(t, dir))
+
+	_, err = NewWriter(dir, "swisstronik_1291-1", 10, 2)
+	require.Error(t, err, "export must not overwrite existing one")
+}
+
+func TestResumeWriter(t *testing.T) {
+	records, cursors := testRecords(5)
+
+	// uninterrupted export
+	expDir := t.TempDir()
+	w, err := NewWriter(expDir, "swisstronik_1291-1", 10, 2)
+	require.NoError(t, err)
+	for i, record := range records {
+		require.NoError(t, w.Write(record, cursors[i]))
+	}
+	require.NoError(t, w.Close())
+
+	// export, interrupted in the middle of the second shard
+	dir := t.TempDir()
+	w, err = NewWriter(dir, "swisstronik_1291-1", 10, 2)
+	require.NoError(t, err)
+	for i, record := range records[:3] {
+		require.NoError(t, w.Write(record, cursors[i]))
+	}
+
+	w, err = ResumeWriter(dir, 2)
+	require.NoError(t, err)
+	require.Equal(t, cursors[1], w.Manifest().Cursor())
+	for i, record := range records[2:] {
+		require.NoError(t, w.Write(record, cursors[i+2]))
+	}
+	require.NoError(t, w.Close())
+
+	require.Equal(t, records, readAll(t, dir))
+
+	// resumed export produces the same files
+	expManifest, err := ReadManifest(expDir)
+	require.NoError(t, err)
+	manifest, err := ReadManifest(dir)
+	require.NoError(t, err)
+	require.Equal(t, expManifest, manifest)
+
+	_, err = ResumeWriter(dir, 2)
+	require.Error(t, err, "complete export cannot be resumed")
+}
+
+func TestReadStateCorrupted(t *testing.T) {
+	dir := t.TempDir()
+	records, cursors := testRecords(4)
+
+	w, err := NewWriter(dir, "swisstronik_1291-1", 10, 2)
+	require.NoError(t, err)
+	for i, record := range records {
+		require.NoError(t, w.Write(record, cursors[i]))
+	}
+
+	_, err = ReadState(dir, func(types.StateRecord) error { return nil })
+	require.Error(t, err, "incomplete export cannot be read")
+	require.NoError(t, w.Close())
+
+	manifest, err := ReadManifest(dir)
+	require.NoError(t, err)
+	path := filepath.Join(dir, manifest.Shards[1].File)
+	bz, err := os.ReadFile(path)
+	require.NoError(t, err)
+	bz[len(bz)/2] ^= 0xff
+	require.NoError(t, os.WriteFile(path, bz, 0o600))
+
+	var read int
+	_, err = ReadState(dir, func(types.StateRecord) error {
+		read++
+		return nil
+	})
+	require.ErrorContains(t, err, "checksum mismatch")
+	require.Equal(t, 2, read, "records of corrupted shard must not be read")
+
+	_, err = ResumeWriter(dir, 2)
+	require.Error(t, err)
+}
@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"math/big"
+	"time"
 
 	sdkmath "cosmossdk.io/math"
 
@@ -11,11 +12,13 @@ import (
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/common/math"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	"swisstronik/ethereum/eip712"
 )
 
 // CallArgs represents the arguments to construct a new message call using JSON-RPC.
-// Main difference between `TransactionArgs` and `CallArgs` is optional `Signature` field.
-// It is used to recover original eth_call sender
+// Main difference between `TransactionArgs` and `CallArgs` is optional `Signature` and `Permit` fields.
+// They are used to authenticate original eth_call sender
 type CallArgs struct {
 	From                 *common.Address `json:"from"`
 	To                   *common.Address `json:"to"`
@@ -40,6 +43,20 @@ type CallArgs struct {
 	V *hexutil.Big `json:"v,omitempty"`
 	R *hexutil.Big `json:"r,omitempty"`
 	S *hexutil.Big `json:"s,omitempty"`
+
+	// Used to authenticate `From` as eth_call sender without signing the call itself
+	Permit *CallPermit `json:"permit,omitempty"`
+
+	// permitted is set if `From` was authenticated with permit
+	permitted bool
+}
+
+// CallPermit is EIP-712 permit, signed by `From`, which authenticates it as the sender of calls to the `To`
+// contract. Permit can be reused until it expires, so it serves as a viewing key for private view functions
+type CallPermit struct {
+	// Expiry is unix timestamp in seconds, after which permit cannot be used
+	Expiry    hexutil.Uint64 `json:"expiry"`
+	Signature hexutil.Bytes  `json:"signature"`
 }
 
 // String return the struct in a string format
@@ -191,7 +208,7 @@ func (args *CallArgs) ToMessage(globalGasCap uint64, baseFee *big.Int) (ethtypes
 		return ethtypes.Message{}, errors.New("both gasPrice and (maxFeePerGas or maxPriorityFeePerGas) specified")
 	}
 
-	// Recover from signature or use zero address if none specified.
+	// Use permitted sender, recover it from signature or use zero address if none specified.
 	addr := args.GetFrom()
 
 	// Set default gas & gas price if none were set
@@ -259,8 +276,46 @@ func (args *CallArgs) ToMessage(globalGasCap uint64, baseFee *big.Int) (ethtypes
 	return msg, nil
 }
 
-// GetFrom retrieves the transaction sender address.
+// VerifyPermit verifies that permit was signed by `From` for the called contract and is not expired
+// at provided block time. If permit is valid, `From` is used as the sender of the call
+func (args *CallArgs) VerifyPermit(chainID *big.Int, blockTime time.Time) error {
+	switch {
+	case args.Permit == nil:
+		return errors.New("permit not provided")
+	case args.From == nil:
+		return errors.New("permit requires sender address")
+	case args.To == nil:
+		return errors.New("permit cannot be used for contract creation")
+	case uint64(args.Permit.Expiry) <= uint64(blockTime.Unix()):
+		return fmt.Errorf("permit expired at %d", uint64(args.Permit.Expiry))
+	}
+
+	typedData := eip712.WrapCallPermitToTypedData(chainID.Uint64(), *args.From, *args.To, uint64(args.Permit.Expiry))
+	signer, err := eip712.RecoverTypedDataSigner(typedData, args.Permit.Signature)
+	if err != nil {
+		return fmt.Errorf("invalid permit signature: %w", err)
+	}
+	if signer != *args.From {
+		return fmt.Errorf("permit is signed by %s instead of %s", signer.Hex(), args.From.Hex())
+	}
+
+	args.permitted = true
+	return nil
+}
+
+// IsAuthenticated returns true if the sender of the call was authenticated either by the signature
+// or by the verified permit. Sender of unauthenticated call is not exposed to executed contracts
+func (args *CallArgs) IsAuthenticated() bool {
+	return args.permitted || (args.ChainID != nil && args.V != nil && args.R != nil && args.S != nil)
+}
+
+// GetFrom retrieves the transaction sender address. Sender, authenticated by the permit, is used
+// if permit was verified. Otherwise sender is recovered from the signature
 func (args *CallArgs) GetFrom() common.Address {
+	if args.permitted {
+		return *args.From
+	}
+
 	tx := args.ToTransaction()
 	if tx == nil {
 		return common.Address{}
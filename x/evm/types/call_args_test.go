@@ -3,11 +3,16 @@ package types
 import (
 	"fmt"
 	"math/big"
+	"time"
 
-	"swisstronik/crypto/ethsecp256k1"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+
+	"swisstronik/crypto/ethsecp256k1"
+	"swisstronik/ethereum/eip712"
 )
 
 func (suite *TxDataTestSuite) TestCallArgsString() {
@@ -287,6 +292,87 @@ func (suite *TxDataTestSuite) TestCallArgsGetFromSignature() {
 	suite.Require().Equal(originalSender, restoredSender)
 }
 
+func (suite *TxDataTestSuite) TestCallArgsVerifyPermit() {
+	chainID := big.NewInt(1291)
+	blockTime := time.Unix(1700000000, 0)
+	expiry := uint64(blockTime.Unix()) + 3600
+
+	privateKey, err := ethsecp256k1.GenerateKey()
+	suite.Require().NoError(err)
+	ecdsaKey, err := privateKey.ToECDSA()
+	suite.Require().NoError(err)
+	holder := crypto.PubkeyToAddress(ecdsaKey.PublicKey)
+	contract := common.BigToAddress(big.NewInt(1))
+
+	signPermit := func(holder, contract common.Address, expiry uint64) hexutil.Bytes {
+		typedData := eip712.WrapCallPermitToTypedData(chainID.Uint64(), holder, contract, expiry)
+		sigHash, _, err := apitypes.TypedDataAndHash(typedData)
+		suite.Require().NoError(err)
+		signature, err := crypto.Sign(sigHash, ecdsaKey)
+		suite.Require().NoError(err)
+		return signature
+	}
+
+	testCases := []struct {
+		name    string
+		args    CallArgs
+		expPass bool
+	}{
+		{
+			"no permit",
+			CallArgs{From: &holder, To: &contract},
+			false,
+		},
+		{
+			"no sender",
+			CallArgs{To: &contract, Permit: &CallPermit{Expiry: hexutil.Uint64(expiry), Signature: signPermit(holder, contract, expiry)}},
+			false,
+		},
+		{
+			"contract creation",
+			CallArgs{From: &holder, Permit: &CallPermit{Expiry: hexutil.Uint64(expiry), Signature: signPermit(holder, contract, expiry)}},
+			false,
+		},
+		{
+			"expired permit",
+			CallArgs{From: &holder, To: &contract, Permit: &CallPermit{Expiry: hexutil.Uint64(blockTime.Unix()), Signature: signPermit(holder, contract, uint64(blockTime.Unix()))}},
+			false,
+		},
+		{
+			"permit for another contract",
+			CallArgs{From: &holder, To: &suite.addr, Permit: &CallPermit{Expiry: hexutil.Uint64(expiry), Signature: signPermit(holder, contract, expiry)}},
+			false,
+		},
+		{
+			"permit signed by another account",
+			CallArgs{From: &suite.addr, To: &contract, Permit: &CallPermit{Expiry: hexutil.Uint64(expiry), Signature: signPermit(suite.addr, contract, expiry)}},
+			false,
+		},
+		{
+			"invalid signature length",
+			CallArgs{From: &holder, To: &contract, Permit: &CallPermit{Expiry: hexutil.Uint64(expiry), Signature: []byte{1, 2, 3}}},
+			false,
+		},
+		{
+			"valid permit",
+			CallArgs{From: &holder, To: &contract, Permit: &CallPermit{Expiry: hexutil.Uint64(expiry), Signature: signPermit(holder, contract, expiry)}},
+			true,
+		},
+	}
+	for _, tc := range testCases {
+		err := tc.args.VerifyPermit(chainID, blockTime)
+		if tc.expPass {
+			suite.Require().NoError(err, tc.name)
+			suite.Require().True(tc.args.IsAuthenticated(), tc.name)
+			suite.Require().Equal(holder, tc.args.GetFrom(), tc.name)
+		} else {
+			suite.Require().Error(err, tc.name)
+			suite.Require().False(tc.args.IsAuthenticated(), tc.name)
+			suite.Require().Equal(common.Address{}, tc.args.GetFrom(), tc.name)
+		}
+	}
+}
+
 func (suite *TxDataTestSuite) TestCallArgsGetData() {
 	testCases := []struct {
 		name           string
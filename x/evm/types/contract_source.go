@@ -0,0 +1,107 @@
+package types
+
+import (
+	"encoding/json"
+	"regexp"
+	"sort"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+const (
+	// MaxContractSources is the maximum number of sources, which can be submitted for a single contract by
+	// accounts other than its deployer. Sources, submitted by the deployer, are not limited, so other accounts
+	// cannot prevent verification of the contract
+	MaxContractSources = 10
+	// MaxContractSourcesPerSubmitter is the maximum number of sources, which can be submitted for a single
+	// contract by a single account other than its deployer
+	MaxContractSourcesPerSubmitter = 3
+)
+
+// solcVersionRegex matches solc versions, such as "0.8.20" or "0.8.20+commit.a1b79de6"
+var solcVersionRegex = regexp.MustCompile(`^\d+\.\d+\.\d+(\+commit\.[0-9a-f]+)?$`)
+
+// ValidateContractSource performs stateless checks of contract sources and compiler settings
+func ValidateContractSource(compilerVersion, contractName string, sources []ContractSourceFile, settings string) error {
+	if !solcVersionRegex.MatchString(compilerVersion) {
+		return errorsmod.Wrapf(ErrInvalidContractSource, "invalid compiler version %q", compilerVersion)
+	}
+
+	if len(sources) == 0 {
+		return errorsmod.Wrap(ErrInvalidContractSource, "sources cannot be empty")
+	}
+
+	paths := make(map[string]struct{}, len(sources))
+	for _, source := range sources {
+		if strings.TrimSpace(source.Path) == "" {
+			return errorsmod.Wrap(ErrInvalidContractSource, "source path cannot be empty")
+		}
+		if _, found := paths[source.Path]; found {
+			return errorsmod.Wrapf(ErrInvalidContractSource, "duplicated source path %s", source.Path)
+		}
+		paths[source.Path] = struct{}{}
+	}
+
+	sourcePath, _, err := SplitContractName(contractName)
+	if err != nil {
+		return err
+	}
+	if _, found := paths[sourcePath]; !found {
+		return errorsmod.Wrapf(ErrInvalidContractSource, "source %s of contract %s is not provided", sourcePath, contractName)
+	}
+
+	if settings != "" {
+		var decoded map[string]interface{}
+		if err := json.Unmarshal([]byte(settings), &decoded); err != nil {
+			return errorsmod.Wrapf(ErrInvalidContractSource, "compiler settings must be JSON object: %s", err)
+		}
+	}
+
+	return nil
+}
+
+// SplitContractName splits fully qualified contract name, such as "contracts/Token.sol:Token",
+// into source path and contract name
+func SplitContractName(contractName string) (string, string, error) {
+	separator := strings.LastIndex(contractName, ":")
+	if separator <= 0 || separator == len(contractName)-1 {
+		return "", "", errorsmod.Wrapf(
+			ErrInvalidContractSource, "contract name %q must be in format <source path>:<contract name>", contractName,
+		)
+	}
+	return contractName[:separator], contractName[separator+1:], nil
+}
+
+// ContractSourceHash returns keccak256 hash of contract sources. Sources are hashed in ascending order
+// of their paths, so order of submitted sources doesn't affect the hash
+func ContractSourceHash(sources []ContractSourceFile) common.Hash {
+	sorted := make([]ContractSourceFile, len(sources))
+	copy(sorted, sources)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].Path < sorted[j].Path
+	})
+
+	hashes := make([][]byte, 0, 2*len(sorted))
+	for _, source := range sorted {
+		hashes = append(hashes, crypto.Keccak256([]byte(source.Path)), crypto.Keccak256([]byte(source.Content)))
+	}
+	return crypto.Keccak256Hash(hashes...)
+}
+
+// NewContractSource returns contract sources, submitted with provided message at provided height
+func NewContractSource(msg *MsgSubmitContractSource, height int64) ContractSource {
+	return ContractSource{
+		Address:         common.HexToAddress(msg.Address).Hex(),
+		Submitter:       msg.Submitter,
+		CompilerVersion: msg.CompilerVersion,
+		ContractName:    msg.ContractName,
+		Sources:         msg.Sources,
+		Settings:        msg.Settings,
+		Metadata:        msg.Metadata,
+		SourceHash:      ContractSourceHash(msg.Sources).Hex(),
+		Height:          height,
+	}
+}
@@ -0,0 +1,54 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateContractSource(t *testing.T) {
+	sources := []ContractSourceFile{
+		{Path: "contracts/Token.sol", Content: "contract Token {}"},
+		{Path: "contracts/IToken.sol", Content: "interface IToken {}"},
+	}
+
+	testCases := []struct {
+		name            string
+		compilerVersion string
+		contractName    string
+		sources         []ContractSourceFile
+		settings        string
+		expPass         bool
+	}{
+		{"valid", "0.8.20", "contracts/Token.sol:Token", sources, `{"optimizer":{"enabled":true}}`, true},
+		{"valid with commit and without settings", "0.8.20+commit.a1b79de6", "contracts/Token.sol:Token", sources, "", true},
+		{"invalid compiler version", "latest", "contracts/Token.sol:Token", sources, "", false},
+		{"contract name without source path", "0.8.20", "Token", sources, "", false},
+		{"source of contract is not provided", "0.8.20", "contracts/Other.sol:Other", sources, "", false},
+		{"empty sources", "0.8.20", "contracts/Token.sol:Token", nil, "", false},
+		{"empty source path", "0.8.20", "contracts/Token.sol:Token", append([]ContractSourceFile{{Path: " "}}, sources...), "", false},
+		{"duplicated source path", "0.8.20", "contracts/Token.sol:Token", append([]ContractSourceFile{sources[0]}, sources...), "", false},
+		{"settings are not JSON object", "0.8.20", "contracts/Token.sol:Token", sources, "[]", false},
+	}
+
+	for _, tc := range testCases {
+		err := ValidateContractSource(tc.compilerVersion, tc.contractName, tc.sources, tc.settings)
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.ErrorIs(t, err, ErrInvalidContractSource, tc.name)
+		}
+	}
+}
+
+func TestContractSourceHash(t *testing.T) {
+	token := ContractSourceFile{Path: "contracts/Token.sol", Content: "contract Token {}"}
+	iface := ContractSourceFile{Path: "contracts/IToken.sol", Content: "interface IToken {}"}
+
+	hash := ContractSourceHash([]ContractSourceFile{token, iface})
+	require.Equal(t, hash, ContractSourceHash([]ContractSourceFile{iface, token}))
+	require.NotEqual(t, hash, ContractSourceHash([]ContractSourceFile{token}))
+	require.NotEqual(t, hash, ContractSourceHash([]ContractSourceFile{
+		token, {Path: iface.Path, Content: iface.Content + " "},
+	}))
+}
@@ -50,6 +50,13 @@ const (
 	codeErrInvalidAccount
 	codeErrInvalidGasLimit
 	codeErrEmptyNodePublicKey
+	codeErrInvalidEpoch
+	codeErrInvalidFeePayer
+	codeErrInvalidContractSource
+	codeErrContractNotVerified
+	codeErrContractVerificationDisabled
+	codeErrAddEpoch
+	codeErrInvalidStateOverride
 )
 
 var ErrPostTxProcessing = errors.New("failed to execute post processing")
@@ -120,6 +127,27 @@ var (
 
 	// ErrEmptyNodePublicKey returns an error if stored node public key for specific block is empty
 	ErrEmptyNodePublicKey = errorsmod.Register(ModuleName, codeErrEmptyNodePublicKey, "empty node public key")
+
+	// ErrInvalidEpoch returns an error if scheduled epoch is invalid
+	ErrInvalidEpoch = errorsmod.Register(ModuleName, codeErrInvalidEpoch, "invalid epoch")
+
+	// ErrInvalidFeePayer returns an error if fee payer of the transaction or its signature is invalid
+	ErrInvalidFeePayer = errorsmod.Register(ModuleName, codeErrInvalidFeePayer, "invalid fee payer")
+
+	// ErrInvalidContractSource returns an error if submitted contract sources or compiler settings are invalid
+	ErrInvalidContractSource = errorsmod.Register(ModuleName, codeErrInvalidContractSource, "invalid contract source")
+
+	// ErrContractNotVerified returns an error if none of submitted sources match bytecode of the contract
+	ErrContractNotVerified = errorsmod.Register(ModuleName, codeErrContractNotVerified, "contract is not verified")
+
+	// ErrContractVerificationDisabled returns an error if solc binary is not configured on the node
+	ErrContractVerificationDisabled = errorsmod.Register(ModuleName, codeErrContractVerificationDisabled, "contract verification is disabled on this node")
+
+	// ErrAddEpoch returns an error if scheduled epoch cannot be added to the node enclave
+	ErrAddEpoch = errorsmod.Register(ModuleName, codeErrAddEpoch, "cannot add epoch to enclave")
+
+	// ErrInvalidStateOverride returns an error if state override cannot be applied
+	ErrInvalidStateOverride = errorsmod.Register(ModuleName, codeErrInvalidStateOverride, "invalid state override")
 )
 
 // NewExecErrorWithReason unpacks the revert return bytes and returns a wrapped error
@@ -20,6 +20,16 @@ const (
 	EventTypeEthereumTx = TypeMsgEthereumTx
 	EventTypeBlockBloom = "block_bloom"
 	EventTypeTxLog      = "tx_log"
+	// EventTypeBlockStateRoot contains intermediate state root after the last transaction of the block, which
+	// commits to EVM store writes of the block transactions only
+	EventTypeBlockStateRoot = "block_state_root"
+
+	EventTypeScheduleEpoch = "schedule_epoch"
+	EventTypeAddEpoch      = "add_epoch"
+	// EventTypeDropEpoch is emitted if scheduled epoch cannot be added to the node enclave
+	EventTypeDropEpoch = "drop_epoch"
+
+	EventTypeSubmitContractSource = "submit_contract_source"
 
 	AttributeKeyContractAddress = "contract"
 	AttributeKeyRecipient       = "recipient"
@@ -33,6 +43,11 @@ const (
 	AttributeKeyEthereumTxFailed = "ethereumTxFailed"
 	AttributeValueCategory       = ModuleName
 	AttributeKeyEthereumBloom    = "bloom"
+	AttributeKeyStartingBlock    = "starting_block"
+	// intermediate state root after execution of eth tx
+	AttributeKeyStateRoot = "stateRoot"
+	// hash of contract sources, submitted for verification
+	AttributeKeySourceHash = "source_hash"
 
 	MetricKeyTransitionDB = "transition_db"
 	MetricKeyStaticCall   = "static_call"
@@ -684,6 +684,277 @@ func (m *TraceConfig) GetTracerJsonConfig() string {
 	return ""
 }
 
+// ContractSourceFile is a single Solidity source file of the contract
+type ContractSourceFile struct {
+	// path of the source file, as it is used in import statements
+	Path string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	// content of the source file
+	Content string `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+}
+
+func (m *ContractSourceFile) Reset()         { *m = ContractSourceFile{} }
+func (m *ContractSourceFile) String() string { return proto.CompactTextString(m) }
+func (*ContractSourceFile) ProtoMessage()    {}
+func (*ContractSourceFile) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d21ecc92c8c8583e, []int{8}
+}
+func (m *ContractSourceFile) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ContractSourceFile) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ContractSourceFile.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ContractSourceFile) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContractSourceFile.Merge(m, src)
+}
+func (m *ContractSourceFile) XXX_Size() int {
+	return m.Size()
+}
+func (m *ContractSourceFile) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContractSourceFile.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ContractSourceFile proto.InternalMessageInfo
+
+func (m *ContractSourceFile) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
+}
+
+func (m *ContractSourceFile) GetContent() string {
+	if m != nil {
+		return m.Content
+	}
+	return ""
+}
+
+// ContractSource contains Solidity sources and compiler settings, submitted
+// for verification of the deployed contract
+type ContractSource struct {
+	// address of the contract in hex format
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// submitter is the bech32 address of the account, which submitted sources
+	Submitter string `protobuf:"bytes,2,opt,name=submitter,proto3" json:"submitter,omitempty"`
+	// compiler_version is the solc version, for example "0.8.20"
+	CompilerVersion string `protobuf:"bytes,3,opt,name=compiler_version,json=compilerVersion,proto3" json:"compiler_version,omitempty"`
+	// contract_name is the fully qualified name of the contract, for example
+	// "contracts/Token.sol:Token"
+	ContractName string `protobuf:"bytes,4,opt,name=contract_name,json=contractName,proto3" json:"contract_name,omitempty"`
+	// sources of the contract and all its imports
+	Sources []ContractSourceFile `protobuf:"bytes,5,rep,name=sources,proto3" json:"sources"`
+	// settings contains `settings` object of solc standard JSON input, for
+	// example optimizer settings and EVM version
+	Settings string `protobuf:"bytes,6,opt,name=settings,proto3" json:"settings,omitempty"`
+	// metadata contains arbitrary information about the contract, for example
+	// license or project url
+	Metadata string `protobuf:"bytes,7,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// source_hash is the keccak256 hash of the sources in hex format
+	SourceHash string `protobuf:"bytes,8,opt,name=source_hash,json=sourceHash,proto3" json:"source_hash,omitempty"`
+	// height at which the sources were submitted
+	Height int64 `protobuf:"varint,9,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *ContractSource) Reset()         { *m = ContractSource{} }
+func (m *ContractSource) String() string { return proto.CompactTextString(m) }
+func (*ContractSource) ProtoMessage()    {}
+func (*ContractSource) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d21ecc92c8c8583e, []int{9}
+}
+func (m *ContractSource) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ContractSource) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ContractSource.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ContractSource) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContractSource.Merge(m, src)
+}
+func (m *ContractSource) XXX_Size() int {
+	return m.Size()
+}
+func (m *ContractSource) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContractSource.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ContractSource proto.InternalMessageInfo
+
+func (m *ContractSource) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *ContractSource) GetSubmitter() string {
+	if m != nil {
+		return m.Submitter
+	}
+	return ""
+}
+
+func (m *ContractSource) GetCompilerVersion() string {
+	if m != nil {
+		return m.CompilerVersion
+	}
+	return ""
+}
+
+func (m *ContractSource) GetContractName() string {
+	if m != nil {
+		return m.ContractName
+	}
+	return ""
+}
+
+func (m *ContractSource) GetSources() []ContractSourceFile {
+	if m != nil {
+		return m.Sources
+	}
+	return nil
+}
+
+func (m *ContractSource) GetSettings() string {
+	if m != nil {
+		return m.Settings
+	}
+	return ""
+}
+
+func (m *ContractSource) GetMetadata() string {
+	if m != nil {
+		return m.Metadata
+	}
+	return ""
+}
+
+func (m *ContractSource) GetSourceHash() string {
+	if m != nil {
+		return m.SourceHash
+	}
+	return ""
+}
+
+func (m *ContractSource) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+// VerifiedContract contains result of successful verification of contract
+// sources
+type VerifiedContract struct {
+	// address of the contract in hex format
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// source_hash is the hash of verified sources in hex format
+	SourceHash string `protobuf:"bytes,2,opt,name=source_hash,json=sourceHash,proto3" json:"source_hash,omitempty"`
+	// contract_name is the fully qualified name of the contract
+	ContractName string `protobuf:"bytes,3,opt,name=contract_name,json=contractName,proto3" json:"contract_name,omitempty"`
+	// compiler_version is the solc version, used for verification
+	CompilerVersion string `protobuf:"bytes,4,opt,name=compiler_version,json=compilerVersion,proto3" json:"compiler_version,omitempty"`
+	// abi is the JSON encoded ABI of the contract
+	Abi string `protobuf:"bytes,5,opt,name=abi,proto3" json:"abi,omitempty"`
+	// exact_match is true if the metadata hash, embedded into the deployed
+	// bytecode, also matches. It means that sources are identical to those used
+	// for deployment, including comments
+	ExactMatch bool `protobuf:"varint,6,opt,name=exact_match,json=exactMatch,proto3" json:"exact_match,omitempty"`
+}
+
+func (m *VerifiedContract) Reset()         { *m = VerifiedContract{} }
+func (m *VerifiedContract) String() string { return proto.CompactTextString(m) }
+func (*VerifiedContract) ProtoMessage()    {}
+func (*VerifiedContract) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d21ecc92c8c8583e, []int{10}
+}
+func (m *VerifiedContract) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VerifiedContract) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VerifiedContract.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VerifiedContract) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VerifiedContract.Merge(m, src)
+}
+func (m *VerifiedContract) XXX_Size() int {
+	return m.Size()
+}
+func (m *VerifiedContract) XXX_DiscardUnknown() {
+	xxx_messageInfo_VerifiedContract.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VerifiedContract proto.InternalMessageInfo
+
+func (m *VerifiedContract) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *VerifiedContract) GetSourceHash() string {
+	if m != nil {
+		return m.SourceHash
+	}
+	return ""
+}
+
+func (m *VerifiedContract) GetContractName() string {
+	if m != nil {
+		return m.ContractName
+	}
+	return ""
+}
+
+func (m *VerifiedContract) GetCompilerVersion() string {
+	if m != nil {
+		return m.CompilerVersion
+	}
+	return ""
+}
+
+func (m *VerifiedContract) GetAbi() string {
+	if m != nil {
+		return m.Abi
+	}
+	return ""
+}
+
+func (m *VerifiedContract) GetExactMatch() bool {
+	if m != nil {
+		return m.ExactMatch
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "ethermint.evm.v1.Params")
 	proto.RegisterType((*ChainConfig)(nil), "ethermint.evm.v1.ChainConfig")
@@ -693,113 +964,130 @@ func init() {
 	proto.RegisterType((*TxResult)(nil), "ethermint.evm.v1.TxResult")
 	proto.RegisterType((*AccessTuple)(nil), "ethermint.evm.v1.AccessTuple")
 	proto.RegisterType((*TraceConfig)(nil), "ethermint.evm.v1.TraceConfig")
+	proto.RegisterType((*ContractSourceFile)(nil), "ethermint.evm.v1.ContractSourceFile")
+	proto.RegisterType((*ContractSource)(nil), "ethermint.evm.v1.ContractSource")
+	proto.RegisterType((*VerifiedContract)(nil), "ethermint.evm.v1.VerifiedContract")
 }
 
 func init() { proto.RegisterFile("ethermint/evm/v1/evm.proto", fileDescriptor_d21ecc92c8c8583e) }
 
 var fileDescriptor_d21ecc92c8c8583e = []byte{
-	// 1608 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x58, 0xdd, 0x6e, 0xe3, 0xc6,
-	0x15, 0xb6, 0x2d, 0xda, 0xa6, 0x46, 0xb2, 0x44, 0x8f, 0xb5, 0x8e, 0xb2, 0x8b, 0x9a, 0x2e, 0x2f,
-	0x0a, 0x17, 0x48, 0xec, 0xd8, 0x81, 0xd1, 0x45, 0x82, 0x16, 0xb5, 0x76, 0x9d, 0xc4, 0xee, 0x36,
-	0x35, 0xc6, 0x0e, 0x0a, 0x14, 0x28, 0x88, 0x11, 0x39, 0xa1, 0x18, 0x93, 0x1c, 0x61, 0x66, 0xa8,
-	0x95, 0xda, 0x3e, 0x40, 0x8b, 0xde, 0xf4, 0x09, 0x8a, 0x3c, 0x4e, 0xd0, 0xab, 0xbd, 0x2c, 0x7a,
-	0x41, 0x14, 0xde, 0x3b, 0x5f, 0xea, 0x09, 0x8a, 0xf9, 0x11, 0xf5, 0x63, 0xa3, 0xad, 0x75, 0xe5,
-	0xf9, 0xce, 0x39, 0xf3, 0x7d, 0x33, 0x67, 0xce, 0x68, 0x0e, 0x0d, 0x9e, 0x13, 0xd1, 0x23, 0x2c,
-	0x8d, 0x33, 0x71, 0x44, 0x06, 0xe9, 0xd1, 0xe0, 0x58, 0xfe, 0x39, 0xec, 0x33, 0x2a, 0x28, 0x74,
-	0x4a, 0xdf, 0xa1, 0x34, 0x0e, 0x8e, 0x9f, 0xb7, 0x22, 0x1a, 0x51, 0xe5, 0x3c, 0x92, 0x23, 0x1d,
-	0xe7, 0xfd, 0xa5, 0x02, 0x36, 0xae, 0x30, 0xc3, 0x29, 0x87, 0xc7, 0xa0, 0x4a, 0x06, 0xa9, 0x1f,
-	0x92, 0x8c, 0xa6, 0xed, 0xd5, 0xfd, 0xd5, 0x83, 0x6a, 0xa7, 0x35, 0x2e, 0x5c, 0x67, 0x84, 0xd3,
-	0xe4, 0x33, 0xaf, 0x74, 0x79, 0xc8, 0x26, 0x83, 0xf4, 0xb5, 0x1c, 0xc2, 0x9f, 0x83, 0x2d, 0x92,
-	0xe1, 0x6e, 0x42, 0xfc, 0x80, 0x11, 0x2c, 0x48, 0x7b, 0x6d, 0x7f, 0xf5, 0xc0, 0xee, 0xb4, 0xc7,
-	0x85, 0xdb, 0x32, 0xd3, 0x66, 0xdd, 0x1e, 0xaa, 0x6b, 0xfc, 0x4a, 0x41, 0xf8, 0x33, 0x50, 0x9b,
-	0xf8, 0x71, 0x92, 0xb4, 0x2b, 0x6a, 0xf2, 0xee, 0xb8, 0x70, 0xe1, 0xfc, 0x64, 0x9c, 0x24, 0x1e,
-	0x02, 0x66, 0x2a, 0x4e, 0x12, 0x78, 0x06, 0x00, 0x19, 0x0a, 0x86, 0x7d, 0x12, 0xf7, 0x79, 0xdb,
-	0xda, 0xaf, 0x1c, 0x54, 0x3a, 0xde, 0x5d, 0xe1, 0x56, 0xcf, 0xa5, 0xf5, 0xfc, 0xe2, 0x8a, 0x8f,
-	0x0b, 0x77, 0xdb, 0x90, 0x94, 0x81, 0x1e, 0xaa, 0x2a, 0x70, 0x1e, 0xf7, 0x39, 0xfc, 0x3d, 0xa8,
-	0x07, 0x3d, 0x1c, 0x67, 0x7e, 0x40, 0xb3, 0x6f, 0xe3, 0xa8, 0xbd, 0xbe, 0xbf, 0x7a, 0x50, 0x3b,
-	0xf9, 0xd1, 0xe1, 0x62, 0xde, 0x0e, 0x5f, 0xc9, 0xa8, 0x57, 0x2a, 0xa8, 0xf3, 0xe2, 0x87, 0xc2,
-	0x5d, 0x19, 0x17, 0xee, 0x8e, 0xa6, 0x9e, 0x25, 0xf0, 0x50, 0x2d, 0x98, 0x46, 0xc2, 0x13, 0xf0,
-	0x0c, 0x27, 0x09, 0x7d, 0xeb, 0xe7, 0x99, 0x4c, 0x34, 0x09, 0x04, 0x09, 0x7d, 0x31, 0xe4, 0xed,
-	0x0d, 0xb9, 0x49, 0xb4, 0xa3, 0x9c, 0xdf, 0x4c, 0x7d, 0x37, 0x43, 0xee, 0xfd, 0x7d, 0x1b, 0xd4,
-	0x66, 0xd4, 0x60, 0x0a, 0x9a, 0x3d, 0x9a, 0x12, 0x2e, 0x08, 0x0e, 0xfd, 0x6e, 0x42, 0x83, 0x5b,
-	0x73, 0x2c, 0xaf, 0xff, 0x55, 0xb8, 0x3f, 0x89, 0x62, 0xd1, 0xcb, 0xbb, 0x87, 0x01, 0x4d, 0x8f,
-	0x02, 0xca, 0x53, 0xca, 0xcd, 0x9f, 0x8f, 0x79, 0x78, 0x7b, 0x24, 0x46, 0x7d, 0xc2, 0x0f, 0x2f,
-	0x32, 0x31, 0x2e, 0xdc, 0x5d, 0xbd, 0xd8, 0x05, 0x2a, 0x0f, 0x35, 0x4a, 0x4b, 0x47, 0x1a, 0xe0,
-	0x08, 0x34, 0x42, 0x4c, 0xfd, 0x6f, 0x29, 0xbb, 0x35, 0x6a, 0x6b, 0x4a, 0xed, 0xfa, 0xff, 0x57,
-	0xbb, 0x2b, 0xdc, 0xfa, 0xeb, 0xb3, 0xdf, 0x7c, 0x41, 0xd9, 0xad, 0xe2, 0x1c, 0x17, 0xee, 0x33,
-	0xad, 0x3e, 0xcf, 0xec, 0xa1, 0x7a, 0x88, 0x69, 0x19, 0x06, 0x7f, 0x0b, 0x9c, 0x32, 0x80, 0xe7,
-	0xfd, 0x3e, 0x65, 0xc2, 0x54, 0xc3, 0xc7, 0x77, 0x85, 0xdb, 0x30, 0x94, 0xd7, 0xda, 0x33, 0x2e,
-	0xdc, 0x0f, 0x16, 0x48, 0xcd, 0x1c, 0x0f, 0x35, 0x0c, 0xad, 0x09, 0x85, 0x1c, 0xd4, 0x49, 0xdc,
-	0x3f, 0x3e, 0xfd, 0xc4, 0xec, 0xc8, 0x52, 0x3b, 0xba, 0x7a, 0xd2, 0x8e, 0x6a, 0xe7, 0x17, 0x57,
-	0xc7, 0xa7, 0x9f, 0x4c, 0x36, 0x64, 0xce, 0x7e, 0x96, 0xd6, 0x43, 0x35, 0x0d, 0xf5, 0x6e, 0x2e,
-	0x80, 0x81, 0x7e, 0x0f, 0xf3, 0x9e, 0xaa, 0xac, 0x6a, 0xe7, 0xe0, 0xae, 0x70, 0x81, 0x66, 0xfa,
-	0x0a, 0xf3, 0xde, 0xf4, 0x5c, 0xba, 0xa3, 0x3f, 0xe0, 0x4c, 0xc4, 0x79, 0x3a, 0xe1, 0x02, 0x7a,
-	0xb2, 0x8c, 0x2a, 0xd7, 0x7f, 0x6a, 0xd6, 0xbf, 0xb1, 0xf4, 0xfa, 0x4f, 0x1f, 0x5b, 0xff, 0xe9,
-	0xfc, 0xfa, 0x75, 0x4c, 0x29, 0xfa, 0xd2, 0x88, 0x6e, 0x2e, 0x2d, 0xfa, 0xf2, 0x31, 0xd1, 0x97,
-	0xf3, 0xa2, 0x3a, 0x46, 0x16, 0xfb, 0x42, 0x26, 0xda, 0xf6, 0xf2, 0xc5, 0xfe, 0x20, 0xa9, 0x8d,
-	0xd2, 0xa2, 0xe5, 0xfe, 0x04, 0x5a, 0x01, 0xcd, 0xb8, 0x90, 0xb6, 0x8c, 0xf6, 0x13, 0x62, 0x34,
-	0xab, 0x4a, 0xf3, 0xe2, 0x49, 0x9a, 0x2f, 0xcc, 0xaf, 0xc1, 0x23, 0x7c, 0x1e, 0xda, 0x99, 0x37,
-	0x6b, 0xf5, 0x3e, 0x70, 0xfa, 0x44, 0x10, 0xc6, 0xbb, 0x39, 0x8b, 0x8c, 0x32, 0x50, 0xca, 0xe7,
-	0x4f, 0x52, 0x36, 0xf7, 0x60, 0x91, 0xcb, 0x43, 0xcd, 0xa9, 0x49, 0x2b, 0x7e, 0x07, 0x1a, 0xb1,
-	0x5c, 0x46, 0x37, 0x4f, 0x8c, 0x5e, 0x4d, 0xe9, 0xbd, 0x7a, 0x92, 0x9e, 0xb9, 0xcc, 0xf3, 0x4c,
-	0x1e, 0xda, 0x9a, 0x18, 0xb4, 0x56, 0x0e, 0x60, 0x9a, 0xc7, 0xcc, 0x8f, 0x12, 0x1c, 0xc4, 0x84,
-	0x19, 0xbd, 0xba, 0xd2, 0xfb, 0xf2, 0x49, 0x7a, 0x1f, 0x6a, 0xbd, 0x87, 0x6c, 0x1e, 0x72, 0xa4,
-	0xf1, 0x4b, 0x6d, 0xd3, 0xb2, 0x21, 0xa8, 0x77, 0x09, 0x4b, 0xe2, 0xcc, 0x08, 0x6e, 0x29, 0xc1,
-	0xb3, 0x27, 0x09, 0x9a, 0x3a, 0x9d, 0xe5, 0xf1, 0x50, 0x4d, 0xc3, 0x52, 0x25, 0xa1, 0x59, 0x48,
-	0x27, 0x2a, 0xdb, 0xcb, 0xab, 0xcc, 0xf2, 0x78, 0xa8, 0xa6, 0xa1, 0x56, 0x19, 0x82, 0x1d, 0xcc,
-	0x18, 0x7d, 0xbb, 0x90, 0x43, 0xa8, 0xc4, 0xbe, 0x7a, 0x92, 0xd8, 0x73, 0x2d, 0xf6, 0x08, 0x9d,
-	0x87, 0xb6, 0x95, 0x75, 0x2e, 0x8b, 0x39, 0x80, 0x11, 0xc3, 0xa3, 0x05, 0xe1, 0xd6, 0xf2, 0x87,
-	0xf7, 0x90, 0xcd, 0x43, 0x8e, 0x34, 0xce, 0xc9, 0xfe, 0x11, 0xb4, 0x52, 0xc2, 0x22, 0xe2, 0x67,
-	0x44, 0xf0, 0x7e, 0x12, 0x0b, 0x23, 0xfc, 0x6c, 0xf9, 0xfb, 0xf8, 0x18, 0x9f, 0x87, 0xa0, 0x32,
-	0x7f, 0x6d, 0xac, 0xe5, 0xe5, 0xe0, 0x3d, 0x9c, 0x45, 0x3d, 0x1c, 0x1b, 0xd9, 0xdd, 0xe5, 0x2f,
-	0xc7, 0x3c, 0x93, 0x87, 0xb6, 0x26, 0x86, 0xb2, 0x7e, 0x02, 0x9c, 0x05, 0xf9, 0xa4, 0x7e, 0x3e,
-	0x58, 0xbe, 0x7e, 0x66, 0x79, 0x64, 0xfb, 0xa1, 0xa0, 0x52, 0xb9, 0xb4, 0xec, 0x86, 0xd3, 0xbc,
-	0xb4, 0xec, 0xa6, 0xe3, 0x5c, 0x5a, 0xb6, 0xe3, 0x6c, 0x5f, 0x5a, 0xf6, 0x8e, 0xd3, 0x42, 0x5b,
-	0x23, 0x9a, 0x50, 0x7f, 0xf0, 0xa9, 0x9e, 0x84, 0x6a, 0xe4, 0x2d, 0xe6, 0xe6, 0x37, 0x12, 0x35,
-	0x02, 0x2c, 0x70, 0x32, 0xe2, 0x26, 0x55, 0xc8, 0xd1, 0x09, 0x9c, 0x79, 0xb5, 0x8f, 0xc0, 0xfa,
-	0xb5, 0x90, 0x8d, 0x9b, 0x03, 0x2a, 0xb7, 0x64, 0xa4, 0xbb, 0x11, 0x24, 0x87, 0xb0, 0x05, 0xd6,
-	0x07, 0x38, 0xc9, 0x75, 0x07, 0x58, 0x45, 0x1a, 0x78, 0x57, 0xa0, 0x79, 0xc3, 0x70, 0xc6, 0x71,
-	0x20, 0x62, 0x9a, 0xbd, 0xa1, 0x11, 0x87, 0x10, 0x58, 0xea, 0x55, 0xd4, 0x73, 0xd5, 0x18, 0xfe,
-	0x14, 0x58, 0x09, 0x8d, 0x78, 0x7b, 0x6d, 0xbf, 0x72, 0x50, 0x3b, 0x79, 0xf6, 0xb0, 0x07, 0x7b,
-	0x43, 0x23, 0xa4, 0x42, 0xbc, 0x7f, 0xac, 0x81, 0xca, 0x1b, 0x1a, 0xc1, 0x36, 0xd8, 0xc4, 0x61,
-	0xc8, 0x08, 0xe7, 0x86, 0x69, 0x02, 0xe1, 0x2e, 0xd8, 0x10, 0xb4, 0x1f, 0x07, 0x9a, 0xae, 0x8a,
-	0x0c, 0x92, 0xc2, 0x21, 0x16, 0x58, 0xf5, 0x15, 0x75, 0xa4, 0xc6, 0xf0, 0x04, 0xd4, 0xd5, 0xce,
-	0xfc, 0x2c, 0x4f, 0xbb, 0x84, 0xa9, 0xf6, 0xc0, 0xea, 0x34, 0xef, 0x0b, 0xb7, 0xa6, 0xec, 0x5f,
-	0x2b, 0x33, 0x9a, 0x05, 0xf0, 0x23, 0xb0, 0x29, 0x86, 0xb3, 0x2f, 0xfb, 0xce, 0x7d, 0xe1, 0x36,
-	0xc5, 0x74, 0x9b, 0xf2, 0xe1, 0x46, 0x1b, 0x62, 0xa8, 0x1e, 0xf0, 0x23, 0x60, 0x8b, 0xa1, 0x1f,
-	0x67, 0x21, 0x19, 0xaa, 0xc7, 0xdb, 0xea, 0xb4, 0xee, 0x0b, 0xd7, 0x99, 0x09, 0xbf, 0x90, 0x3e,
-	0xb4, 0x29, 0x86, 0x6a, 0x00, 0x3f, 0x02, 0x40, 0x2f, 0x49, 0x29, 0xe8, 0xa7, 0x77, 0xeb, 0xbe,
-	0x70, 0xab, 0xca, 0xaa, 0xb8, 0xa7, 0x43, 0xe8, 0x81, 0x75, 0xcd, 0x6d, 0x2b, 0xee, 0xfa, 0x7d,
-	0xe1, 0xda, 0x09, 0x8d, 0x34, 0xa7, 0x76, 0xc9, 0x54, 0x31, 0x92, 0xd2, 0x01, 0x09, 0xd5, 0xeb,
-	0x66, 0xa3, 0x09, 0xf4, 0xfe, 0xba, 0x06, 0xec, 0x9b, 0x21, 0x22, 0x3c, 0x4f, 0x04, 0xfc, 0x02,
-	0x38, 0x01, 0xcd, 0x04, 0xc3, 0x81, 0xf0, 0xe7, 0x52, 0xdb, 0x79, 0x31, 0x7d, 0x69, 0x16, 0x23,
-	0x3c, 0xd4, 0x9c, 0x98, 0xce, 0x4c, 0xfe, 0x5b, 0x60, 0xbd, 0x9b, 0x50, 0x9a, 0xaa, 0x4a, 0xa8,
-	0x23, 0x0d, 0x20, 0x52, 0x59, 0x53, 0xa7, 0x5c, 0x51, 0x9d, 0xf6, 0x8f, 0x1f, 0x9e, 0xf2, 0x42,
-	0xa9, 0x74, 0x76, 0x4d, 0xb7, 0xdd, 0xd0, 0xda, 0x66, 0xbe, 0x27, 0x73, 0xab, 0x4a, 0xc9, 0x01,
-	0x15, 0x46, 0x84, 0x3a, 0xb4, 0x3a, 0x92, 0x43, 0xf8, 0x1c, 0xd8, 0x8c, 0x0c, 0x08, 0x13, 0x24,
-	0x54, 0x87, 0x63, 0xa3, 0x12, 0xc3, 0x0f, 0x81, 0x1d, 0x61, 0xee, 0xe7, 0x9c, 0x84, 0xfa, 0x24,
-	0xd0, 0x66, 0x84, 0xf9, 0x37, 0x9c, 0x84, 0x9f, 0x59, 0x7f, 0xfe, 0xde, 0x5d, 0xf1, 0x30, 0xa8,
-	0x9d, 0x05, 0x01, 0xe1, 0xfc, 0x26, 0xef, 0x27, 0xe4, 0xbf, 0x54, 0xd8, 0x09, 0xa8, 0x73, 0x41,
-	0x19, 0x8e, 0x88, 0x7f, 0x4b, 0x46, 0xa6, 0xce, 0x74, 0xd5, 0x18, 0xfb, 0xaf, 0xc8, 0x88, 0xa3,
-	0x59, 0x60, 0x24, 0xbe, 0xb7, 0x40, 0xed, 0x86, 0xe1, 0x80, 0x98, 0x0e, 0x5f, 0xd6, 0xaa, 0x84,
-	0xcc, 0x48, 0x18, 0x24, 0xb5, 0x45, 0x9c, 0x12, 0x9a, 0x0b, 0x73, 0x9f, 0x26, 0x50, 0xce, 0x60,
-	0x84, 0x0c, 0x49, 0xa0, 0xd2, 0x68, 0x21, 0x83, 0xe0, 0x29, 0xd8, 0x0a, 0x63, 0xae, 0x3e, 0x97,
-	0xb8, 0xc0, 0xc1, 0xad, 0xde, 0x7e, 0xc7, 0xb9, 0x2f, 0xdc, 0xba, 0x71, 0x5c, 0x4b, 0x3b, 0x9a,
-	0x43, 0xf0, 0x73, 0xd0, 0x9c, 0x4e, 0x53, 0xab, 0xd5, 0x1f, 0x28, 0x1d, 0x78, 0x5f, 0xb8, 0x8d,
-	0x32, 0x54, 0x79, 0xd0, 0x02, 0x96, 0x27, 0x1d, 0x92, 0x6e, 0x1e, 0xa9, 0xe2, 0xb3, 0x91, 0x06,
-	0xd2, 0x9a, 0xc4, 0x69, 0x2c, 0x54, 0xb1, 0xad, 0x23, 0x0d, 0xe0, 0xe7, 0xa0, 0x4a, 0x07, 0x84,
-	0xb1, 0x38, 0x24, 0x5c, 0xb5, 0x3a, 0xff, 0xeb, 0x5b, 0x0b, 0x4d, 0xe3, 0xe5, 0xe6, 0xcc, 0xa7,
-	0x60, 0x4a, 0x52, 0xca, 0x46, 0xaa, 0x77, 0x31, 0x9b, 0xd3, 0x8e, 0x5f, 0x2b, 0x3b, 0x9a, 0x43,
-	0xb0, 0x03, 0xa0, 0x99, 0xc6, 0x88, 0xc8, 0x59, 0xe6, 0xab, 0xfb, 0x5f, 0x57, 0x73, 0xd5, 0x2d,
-	0xd4, 0x5e, 0xa4, 0x9c, 0xaf, 0xb1, 0xc0, 0xe8, 0x81, 0x05, 0xfe, 0x02, 0x40, 0x7d, 0x26, 0xfe,
-	0x77, 0x9c, 0x96, 0x1f, 0x8b, 0xba, 0xb5, 0x50, 0xfa, 0xda, 0x6b, 0xd6, 0xec, 0x68, 0x74, 0xc9,
-	0xa9, 0xd9, 0xc5, 0xa5, 0x65, 0x5b, 0xce, 0xfa, 0xa5, 0x65, 0x6f, 0x3a, 0x76, 0x99, 0x3f, 0xb3,
-	0x0b, 0xb4, 0x33, 0xc1, 0x33, 0xcb, 0xeb, 0xfc, 0xf2, 0x87, 0xbb, 0xbd, 0xd5, 0x77, 0x77, 0x7b,
-	0xab, 0xff, 0xbe, 0xdb, 0x5b, 0xfd, 0xdb, 0xfb, 0xbd, 0x95, 0x77, 0xef, 0xf7, 0x56, 0xfe, 0xf9,
-	0x7e, 0x6f, 0xe5, 0x77, 0xb3, 0xef, 0x03, 0x19, 0xc8, 0xe7, 0x61, 0xfa, 0xfd, 0x3f, 0x54, 0xff,
-	0x01, 0x50, 0x6f, 0x44, 0x77, 0x43, 0x7d, 0xd9, 0x7f, 0xfa, 0x9f, 0x00, 0x00, 0x00, 0xff, 0xff,
-	0xe2, 0xe1, 0x18, 0x7a, 0x1f, 0x10, 0x00, 0x00,
+	// 1831 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x58, 0x5d, 0x4f, 0x23, 0xc9,
+	0xd5, 0x06, 0x6c, 0xa0, 0x5d, 0x36, 0x76, 0x53, 0x78, 0x58, 0xef, 0xcc, 0xfb, 0xd2, 0xa4, 0x13,
+	0x45, 0xac, 0xb4, 0x0b, 0x0b, 0x2b, 0x94, 0xd1, 0xae, 0x12, 0x05, 0x03, 0xb3, 0x0b, 0x99, 0x9d,
+	0xa0, 0x82, 0xdd, 0x48, 0x91, 0xa2, 0x56, 0xb9, 0xbb, 0xa6, 0xdd, 0x4b, 0x77, 0x97, 0x55, 0x55,
+	0xed, 0xb1, 0x93, 0xfc, 0x80, 0x44, 0xb9, 0xc9, 0x2f, 0x88, 0xf6, 0xe7, 0xac, 0x72, 0x93, 0xb9,
+	0x8c, 0x72, 0xd1, 0x8a, 0x98, 0x3b, 0x2e, 0xf9, 0x05, 0x51, 0x7d, 0xb8, 0xfd, 0x81, 0x35, 0x5a,
+	0xb8, 0x72, 0x9d, 0xe7, 0x9c, 0x7a, 0x9e, 0xaa, 0x53, 0xa7, 0x3e, 0xda, 0xe0, 0x29, 0x11, 0x5d,
+	0xc2, 0x92, 0x28, 0x15, 0x7b, 0xa4, 0x9f, 0xec, 0xf5, 0xf7, 0xe5, 0xcf, 0x6e, 0x8f, 0x51, 0x41,
+	0xa1, 0x5d, 0xf8, 0x76, 0x25, 0xd8, 0xdf, 0x7f, 0xda, 0x0c, 0x69, 0x48, 0x95, 0x73, 0x4f, 0xb6,
+	0x74, 0x9c, 0xfb, 0xd7, 0x12, 0x58, 0xb9, 0xc0, 0x0c, 0x27, 0x1c, 0xee, 0x83, 0x0a, 0xe9, 0x27,
+	0x5e, 0x40, 0x52, 0x9a, 0xb4, 0x16, 0xb7, 0x17, 0x77, 0x2a, 0xed, 0xe6, 0x5d, 0xee, 0xd8, 0x43,
+	0x9c, 0xc4, 0x9f, 0xbb, 0x85, 0xcb, 0x45, 0x16, 0xe9, 0x27, 0x27, 0xb2, 0x09, 0x7f, 0x09, 0xd6,
+	0x48, 0x8a, 0x3b, 0x31, 0xf1, 0x7c, 0x46, 0xb0, 0x20, 0xad, 0xa5, 0xed, 0xc5, 0x1d, 0xab, 0xdd,
+	0xba, 0xcb, 0x9d, 0xa6, 0xe9, 0x36, 0xe9, 0x76, 0x51, 0x4d, 0xdb, 0xc7, 0xca, 0x84, 0xbf, 0x00,
+	0xd5, 0x91, 0x1f, 0xc7, 0x71, 0xab, 0xa4, 0x3a, 0x6f, 0xde, 0xe5, 0x0e, 0x9c, 0xee, 0x8c, 0xe3,
+	0xd8, 0x45, 0xc0, 0x74, 0xc5, 0x71, 0x0c, 0x8f, 0x00, 0x20, 0x03, 0xc1, 0xb0, 0x47, 0xa2, 0x1e,
+	0x6f, 0x95, 0xb7, 0x4b, 0x3b, 0xa5, 0xb6, 0x7b, 0x93, 0x3b, 0x95, 0x53, 0x89, 0x9e, 0x9e, 0x5d,
+	0xf0, 0xbb, 0xdc, 0x59, 0x37, 0x24, 0x45, 0xa0, 0x8b, 0x2a, 0xca, 0x38, 0x8d, 0x7a, 0x1c, 0xfe,
+	0x01, 0xd4, 0xfc, 0x2e, 0x8e, 0x52, 0xcf, 0xa7, 0xe9, 0xeb, 0x28, 0x6c, 0x2d, 0x6f, 0x2f, 0xee,
+	0x54, 0x0f, 0xfe, 0x7f, 0x77, 0x36, 0x6f, 0xbb, 0xc7, 0x32, 0xea, 0x58, 0x05, 0xb5, 0x9f, 0xfd,
+	0x90, 0x3b, 0x0b, 0x77, 0xb9, 0xb3, 0xa1, 0xa9, 0x27, 0x09, 0x5c, 0x54, 0xf5, 0xc7, 0x91, 0xf0,
+	0x00, 0x3c, 0xc1, 0x71, 0x4c, 0xdf, 0x78, 0x59, 0x2a, 0x13, 0x4d, 0x7c, 0x41, 0x02, 0x4f, 0x0c,
+	0x78, 0x6b, 0x45, 0x4e, 0x12, 0x6d, 0x28, 0xe7, 0x37, 0x63, 0xdf, 0xd5, 0x80, 0xbb, 0xff, 0x58,
+	0x07, 0xd5, 0x09, 0x35, 0x98, 0x80, 0x46, 0x97, 0x26, 0x84, 0x0b, 0x82, 0x03, 0xaf, 0x13, 0x53,
+	0xff, 0xda, 0x2c, 0xcb, 0xc9, 0x7f, 0x72, 0xe7, 0xe7, 0x61, 0x24, 0xba, 0x59, 0x67, 0xd7, 0xa7,
+	0xc9, 0x9e, 0x4f, 0x79, 0x42, 0xb9, 0xf9, 0xf9, 0x84, 0x07, 0xd7, 0x7b, 0x62, 0xd8, 0x23, 0x7c,
+	0xf7, 0x2c, 0x15, 0x77, 0xb9, 0xb3, 0xa9, 0x07, 0x3b, 0x43, 0xe5, 0xa2, 0x7a, 0x81, 0xb4, 0x25,
+	0x00, 0x87, 0xa0, 0x1e, 0x60, 0xea, 0xbd, 0xa6, 0xec, 0xda, 0xa8, 0x2d, 0x29, 0xb5, 0xcb, 0x1f,
+	0xaf, 0x76, 0x93, 0x3b, 0xb5, 0x93, 0xa3, 0xdf, 0xbe, 0xa0, 0xec, 0x5a, 0x71, 0xde, 0xe5, 0xce,
+	0x13, 0xad, 0x3e, 0xcd, 0xec, 0xa2, 0x5a, 0x80, 0x69, 0x11, 0x06, 0x7f, 0x07, 0xec, 0x22, 0x80,
+	0x67, 0xbd, 0x1e, 0x65, 0xc2, 0x54, 0xc3, 0x27, 0x37, 0xb9, 0x53, 0x37, 0x94, 0x97, 0xda, 0x73,
+	0x97, 0x3b, 0x1f, 0xcc, 0x90, 0x9a, 0x3e, 0x2e, 0xaa, 0x1b, 0x5a, 0x13, 0x0a, 0x39, 0xa8, 0x91,
+	0xa8, 0xb7, 0x7f, 0xf8, 0xa9, 0x99, 0x51, 0x59, 0xcd, 0xe8, 0xe2, 0x41, 0x33, 0xaa, 0x9e, 0x9e,
+	0x5d, 0xec, 0x1f, 0x7e, 0x3a, 0x9a, 0x90, 0x59, 0xfb, 0x49, 0x5a, 0x17, 0x55, 0xb5, 0xa9, 0x67,
+	0x73, 0x06, 0x8c, 0xe9, 0x75, 0x31, 0xef, 0xaa, 0xca, 0xaa, 0xb4, 0x77, 0x6e, 0x72, 0x07, 0x68,
+	0xa6, 0xaf, 0x30, 0xef, 0x8e, 0xd7, 0xa5, 0x33, 0xfc, 0x23, 0x4e, 0x45, 0x94, 0x25, 0x23, 0x2e,
+	0xa0, 0x3b, 0xcb, 0xa8, 0x62, 0xfc, 0x87, 0x66, 0xfc, 0x2b, 0x8f, 0x1e, 0xff, 0xe1, 0xbc, 0xf1,
+	0x1f, 0x4e, 0x8f, 0x5f, 0xc7, 0x14, 0xa2, 0xcf, 0x8d, 0xe8, 0xea, 0xa3, 0x45, 0x9f, 0xcf, 0x13,
+	0x7d, 0x3e, 0x2d, 0xaa, 0x63, 0x64, 0xb1, 0xcf, 0x64, 0xa2, 0x65, 0x3d, 0xbe, 0xd8, 0xef, 0x25,
+	0xb5, 0x5e, 0x20, 0x5a, 0xee, 0xcf, 0xa0, 0xe9, 0xd3, 0x94, 0x0b, 0x89, 0xa5, 0xb4, 0x17, 0x13,
+	0xa3, 0x59, 0x51, 0x9a, 0x67, 0x0f, 0xd2, 0x7c, 0x66, 0x4e, 0x83, 0x39, 0x7c, 0x2e, 0xda, 0x98,
+	0x86, 0xb5, 0x7a, 0x0f, 0xd8, 0x3d, 0x22, 0x08, 0xe3, 0x9d, 0x8c, 0x85, 0x46, 0x19, 0x28, 0xe5,
+	0xd3, 0x07, 0x29, 0x9b, 0x7d, 0x30, 0xcb, 0xe5, 0xa2, 0xc6, 0x18, 0xd2, 0x8a, 0xdf, 0x81, 0x7a,
+	0x24, 0x87, 0xd1, 0xc9, 0x62, 0xa3, 0x57, 0x55, 0x7a, 0xc7, 0x0f, 0xd2, 0x33, 0x9b, 0x79, 0x9a,
+	0xc9, 0x45, 0x6b, 0x23, 0x40, 0x6b, 0x65, 0x00, 0x26, 0x59, 0xc4, 0xbc, 0x30, 0xc6, 0x7e, 0x44,
+	0x98, 0xd1, 0xab, 0x29, 0xbd, 0x2f, 0x1f, 0xa4, 0xf7, 0xa1, 0xd6, 0xbb, 0xcf, 0xe6, 0x22, 0x5b,
+	0x82, 0x5f, 0x6a, 0x4c, 0xcb, 0x06, 0xa0, 0xd6, 0x21, 0x2c, 0x8e, 0x52, 0x23, 0xb8, 0xa6, 0x04,
+	0x8f, 0x1e, 0x24, 0x68, 0xea, 0x74, 0x92, 0xc7, 0x45, 0x55, 0x6d, 0x16, 0x2a, 0x31, 0x4d, 0x03,
+	0x3a, 0x52, 0x59, 0x7f, 0xbc, 0xca, 0x24, 0x8f, 0x8b, 0xaa, 0xda, 0xd4, 0x2a, 0x03, 0xb0, 0x81,
+	0x19, 0xa3, 0x6f, 0x66, 0x72, 0x08, 0x95, 0xd8, 0x57, 0x0f, 0x12, 0x7b, 0xaa, 0xc5, 0xe6, 0xd0,
+	0xb9, 0x68, 0x5d, 0xa1, 0x53, 0x59, 0xcc, 0x00, 0x0c, 0x19, 0x1e, 0xce, 0x08, 0x37, 0x1f, 0xbf,
+	0x78, 0xf7, 0xd9, 0x5c, 0x64, 0x4b, 0x70, 0x4a, 0xf6, 0x4f, 0xa0, 0x99, 0x10, 0x16, 0x12, 0x2f,
+	0x25, 0x82, 0xf7, 0xe2, 0x48, 0x18, 0xe1, 0x27, 0x8f, 0xdf, 0x8f, 0xf3, 0xf8, 0x5c, 0x04, 0x15,
+	0xfc, 0xca, 0xa0, 0xc5, 0xe6, 0xe0, 0x5d, 0x9c, 0x86, 0x5d, 0x1c, 0x19, 0xd9, 0xcd, 0xc7, 0x6f,
+	0x8e, 0x69, 0x26, 0x17, 0xad, 0x8d, 0x80, 0xa2, 0x7e, 0x7c, 0x9c, 0xfa, 0xd9, 0xa8, 0x7e, 0x3e,
+	0x78, 0x7c, 0xfd, 0x4c, 0xf2, 0xc8, 0xe7, 0x87, 0x32, 0x95, 0xca, 0x79, 0xd9, 0xaa, 0xdb, 0x8d,
+	0xf3, 0xb2, 0xd5, 0xb0, 0xed, 0xf3, 0xb2, 0x65, 0xdb, 0xeb, 0xe7, 0x65, 0x6b, 0xc3, 0x6e, 0xa2,
+	0xb5, 0x21, 0x8d, 0xa9, 0xd7, 0xff, 0x4c, 0x77, 0x42, 0x55, 0xf2, 0x06, 0x73, 0x73, 0x46, 0xa2,
+	0xba, 0x8f, 0x05, 0x8e, 0x87, 0xdc, 0xa4, 0x0a, 0xd9, 0x3a, 0x81, 0x13, 0xb7, 0xf6, 0x1e, 0x58,
+	0xbe, 0x14, 0xf2, 0xe1, 0x66, 0x83, 0xd2, 0x35, 0x19, 0xea, 0xd7, 0x08, 0x92, 0x4d, 0xd8, 0x04,
+	0xcb, 0x7d, 0x1c, 0x67, 0xfa, 0x05, 0x58, 0x41, 0xda, 0x70, 0x2f, 0x40, 0xe3, 0x8a, 0xe1, 0x94,
+	0x63, 0x5f, 0x44, 0x34, 0x7d, 0x49, 0x43, 0x0e, 0x21, 0x28, 0xab, 0x5b, 0x51, 0xf7, 0x55, 0x6d,
+	0xf8, 0x11, 0x28, 0xc7, 0x34, 0xe4, 0xad, 0xa5, 0xed, 0xd2, 0x4e, 0xf5, 0xe0, 0xc9, 0xfd, 0x37,
+	0xd8, 0x4b, 0x1a, 0x22, 0x15, 0xe2, 0xfe, 0x73, 0x09, 0x94, 0x5e, 0xd2, 0x10, 0xb6, 0xc0, 0x2a,
+	0x0e, 0x02, 0x46, 0x38, 0x37, 0x4c, 0x23, 0x13, 0x6e, 0x82, 0x15, 0x41, 0x7b, 0x91, 0xaf, 0xe9,
+	0x2a, 0xc8, 0x58, 0x52, 0x38, 0xc0, 0x02, 0xab, 0x77, 0x45, 0x0d, 0xa9, 0x36, 0x3c, 0x00, 0x35,
+	0x35, 0x33, 0x2f, 0xcd, 0x92, 0x0e, 0x61, 0xea, 0x79, 0x50, 0x6e, 0x37, 0x6e, 0x73, 0xa7, 0xaa,
+	0xf0, 0x57, 0x0a, 0x46, 0x93, 0x06, 0xfc, 0x18, 0xac, 0x8a, 0xc1, 0xe4, 0xcd, 0xbe, 0x71, 0x9b,
+	0x3b, 0x0d, 0x31, 0x9e, 0xa6, 0xbc, 0xb8, 0xd1, 0x8a, 0x18, 0xc8, 0x5f, 0xb8, 0x07, 0x2c, 0x31,
+	0xf0, 0xa2, 0x34, 0x20, 0x03, 0x75, 0x79, 0x97, 0xdb, 0xcd, 0xdb, 0xdc, 0xb1, 0x27, 0xc2, 0xcf,
+	0xa4, 0x0f, 0xad, 0x8a, 0x81, 0x6a, 0xc0, 0x8f, 0x01, 0xd0, 0x43, 0x52, 0x0a, 0xfa, 0xea, 0x5d,
+	0xbb, 0xcd, 0x9d, 0x8a, 0x42, 0x15, 0xf7, 0xb8, 0x09, 0x5d, 0xb0, 0xac, 0xb9, 0x2d, 0xc5, 0x5d,
+	0xbb, 0xcd, 0x1d, 0x2b, 0xa6, 0xa1, 0xe6, 0xd4, 0x2e, 0x99, 0x2a, 0x46, 0x12, 0xda, 0x27, 0x81,
+	0xba, 0xdd, 0x2c, 0x34, 0x32, 0xdd, 0xbf, 0x2d, 0x01, 0xeb, 0x6a, 0x80, 0x08, 0xcf, 0x62, 0x01,
+	0x5f, 0x00, 0xdb, 0xa7, 0xa9, 0x60, 0xd8, 0x17, 0xde, 0x54, 0x6a, 0xdb, 0xcf, 0xc6, 0x37, 0xcd,
+	0x6c, 0x84, 0x8b, 0x1a, 0x23, 0xe8, 0xc8, 0xe4, 0xbf, 0x09, 0x96, 0x3b, 0x31, 0xa5, 0x89, 0xaa,
+	0x84, 0x1a, 0xd2, 0x06, 0x44, 0x2a, 0x6b, 0x6a, 0x95, 0x4b, 0xea, 0xa5, 0xfd, 0x93, 0xfb, 0xab,
+	0x3c, 0x53, 0x2a, 0xed, 0x4d, 0xf3, 0xda, 0xae, 0x6b, 0x6d, 0xd3, 0xdf, 0x95, 0xb9, 0x55, 0xa5,
+	0x64, 0x83, 0x12, 0x23, 0x42, 0x2d, 0x5a, 0x0d, 0xc9, 0x26, 0x7c, 0x0a, 0x2c, 0x46, 0xfa, 0x84,
+	0x09, 0x12, 0xa8, 0xc5, 0xb1, 0x50, 0x61, 0xc3, 0x0f, 0x81, 0x15, 0x62, 0xee, 0x65, 0x9c, 0x04,
+	0x7a, 0x25, 0xd0, 0x6a, 0x88, 0xf9, 0x37, 0x9c, 0x04, 0x9f, 0x97, 0xff, 0xf2, 0xbd, 0xb3, 0xe0,
+	0x62, 0x50, 0x3d, 0xf2, 0x7d, 0xc2, 0xf9, 0x55, 0xd6, 0x8b, 0xc9, 0x7b, 0x2a, 0xec, 0x00, 0xd4,
+	0xb8, 0xa0, 0x0c, 0x87, 0xc4, 0xbb, 0x26, 0x43, 0x53, 0x67, 0xba, 0x6a, 0x0c, 0xfe, 0x1b, 0x32,
+	0xe4, 0x68, 0xd2, 0x30, 0x12, 0xdf, 0x97, 0x41, 0xf5, 0x8a, 0x61, 0x9f, 0x98, 0x17, 0xbe, 0xac,
+	0x55, 0x69, 0x32, 0x23, 0x61, 0x2c, 0xa9, 0x2d, 0xa2, 0x84, 0xd0, 0x4c, 0x98, 0xfd, 0x34, 0x32,
+	0x65, 0x0f, 0x46, 0xc8, 0x80, 0xf8, 0x2a, 0x8d, 0x65, 0x64, 0x2c, 0x78, 0x08, 0xd6, 0x82, 0x88,
+	0xab, 0xcf, 0x25, 0x2e, 0xb0, 0x7f, 0xad, 0xa7, 0xdf, 0xb6, 0x6f, 0x73, 0xa7, 0x66, 0x1c, 0x97,
+	0x12, 0x47, 0x53, 0x16, 0xfc, 0x02, 0x34, 0xc6, 0xdd, 0xd4, 0x68, 0xf5, 0x07, 0x4a, 0x1b, 0xde,
+	0xe6, 0x4e, 0xbd, 0x08, 0x55, 0x1e, 0x34, 0x63, 0xcb, 0x95, 0x0e, 0x48, 0x27, 0x0b, 0x55, 0xf1,
+	0x59, 0x48, 0x1b, 0x12, 0x8d, 0xa3, 0x24, 0x12, 0xaa, 0xd8, 0x96, 0x91, 0x36, 0xe0, 0x17, 0xa0,
+	0x42, 0xfb, 0x84, 0xb1, 0x28, 0x20, 0xbc, 0x05, 0x7e, 0xc4, 0xb7, 0x16, 0x1a, 0xc7, 0xcb, 0xc9,
+	0x99, 0x4f, 0xc1, 0x84, 0x24, 0x94, 0x0d, 0x5b, 0xd5, 0xf1, 0xe4, 0xb4, 0xe3, 0x6b, 0x85, 0xa3,
+	0x29, 0x0b, 0xb6, 0x01, 0x34, 0xdd, 0x18, 0x11, 0x19, 0x4b, 0x3d, 0xb5, 0xff, 0x6b, 0xaa, 0xaf,
+	0xda, 0x85, 0xda, 0x8b, 0x94, 0xf3, 0x04, 0x0b, 0x8c, 0xee, 0x21, 0xf0, 0x57, 0x00, 0xea, 0x35,
+	0xf1, 0xbe, 0xe3, 0xb4, 0xf8, 0x58, 0xd4, 0x4f, 0x0b, 0xa5, 0xaf, 0xbd, 0x66, 0xcc, 0xb6, 0xb6,
+	0xce, 0x39, 0x35, 0xb3, 0x38, 0x2f, 0x5b, 0x65, 0x7b, 0xf9, 0xbc, 0x6c, 0xad, 0xda, 0x56, 0x91,
+	0x3f, 0x33, 0x0b, 0xb4, 0x31, 0xb2, 0x27, 0x86, 0xe7, 0xb6, 0x01, 0x3c, 0x36, 0x3b, 0xea, 0x92,
+	0x66, 0xcc, 0x27, 0x2f, 0xa2, 0x98, 0xc8, 0xc3, 0xab, 0x87, 0x45, 0x71, 0x6a, 0xca, 0xb6, 0x2c,
+	0x12, 0xb9, 0xf7, 0x48, 0x5a, 0x14, 0x89, 0x31, 0xdd, 0x7f, 0x2d, 0x81, 0xfa, 0x34, 0xc9, 0x7b,
+	0xaa, 0xf9, 0xff, 0x40, 0x85, 0x67, 0x9d, 0x24, 0x12, 0x82, 0x30, 0x43, 0x34, 0x06, 0xe0, 0x47,
+	0xf2, 0x54, 0x48, 0x7a, 0x51, 0x4c, 0x98, 0xd7, 0x27, 0x8c, 0x47, 0x34, 0x55, 0x95, 0x57, 0x41,
+	0x8d, 0x11, 0xfe, 0xad, 0x86, 0xe1, 0x4f, 0xc1, 0x5a, 0x71, 0x3c, 0xa4, 0x38, 0x21, 0xfa, 0x63,
+	0x0b, 0xd5, 0x46, 0xe0, 0x2b, 0x9c, 0x10, 0x78, 0x02, 0x56, 0xb9, 0x1a, 0x11, 0x6f, 0x2d, 0xab,
+	0xd3, 0xfe, 0x67, 0x73, 0xaa, 0xe0, 0xde, 0xfc, 0xdb, 0x65, 0x79, 0x14, 0xa0, 0x51, 0x57, 0xb9,
+	0xcf, 0x39, 0x11, 0x22, 0x4a, 0x43, 0xfd, 0x41, 0x5d, 0x41, 0x85, 0x2d, 0x7d, 0x09, 0x11, 0x58,
+	0xad, 0xf5, 0xaa, 0xf6, 0x8d, 0x6c, 0xe8, 0x80, 0xaa, 0xa6, 0xd0, 0xa7, 0xab, 0xfa, 0xc0, 0x40,
+	0x40, 0x43, 0xea, 0x3c, 0xdd, 0x04, 0x2b, 0x5d, 0x12, 0x85, 0x5d, 0x5d, 0xbd, 0x25, 0x64, 0x2c,
+	0xf7, 0xed, 0x22, 0xb0, 0xbf, 0x25, 0x2c, 0x7a, 0x1d, 0x91, 0x60, 0x34, 0xbc, 0xf7, 0xe4, 0x74,
+	0x46, 0x67, 0xe9, 0x9e, 0xce, 0xbd, 0x5c, 0x95, 0xe6, 0xe4, 0x6a, 0x5e, 0xee, 0xcb, 0xf3, 0x73,
+	0x6f, 0x83, 0x12, 0xee, 0x44, 0xfa, 0x42, 0x42, 0xb2, 0x29, 0x87, 0x40, 0x06, 0x92, 0x3e, 0xc1,
+	0xc2, 0xef, 0x9a, 0xbf, 0x1d, 0x80, 0x82, 0xbe, 0x96, 0x48, 0xfb, 0xd7, 0x3f, 0xdc, 0x6c, 0x2d,
+	0xbe, 0xbd, 0xd9, 0x5a, 0xfc, 0xef, 0xcd, 0xd6, 0xe2, 0xdf, 0xdf, 0x6d, 0x2d, 0xbc, 0x7d, 0xb7,
+	0xb5, 0xf0, 0xef, 0x77, 0x5b, 0x0b, 0xbf, 0x9f, 0x7c, 0x88, 0x90, 0xbe, 0x7c, 0x87, 0x8c, 0xff,
+	0x68, 0x1a, 0x48, 0x44, 0x3f, 0x46, 0x3a, 0x2b, 0xea, 0x2f, 0xa4, 0xcf, 0xfe, 0x37, 0x00, 0xf2,
+	0x02, 0xc7, 0x68, 0x88, 0x12, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -1537,76 +1825,272 @@ func (m *TraceConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintEvm(dAtA []byte, offset int, v uint64) int {
-	offset -= sovEvm(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *ContractSourceFile) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *ContractSourceFile) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContractSourceFile) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.EvmDenom)
-	if l > 0 {
-		n += 1 + l + sovEvm(uint64(l))
-	}
-	if m.EnableCreate {
-		n += 2
-	}
-	if m.EnableCall {
-		n += 2
-	}
-	if len(m.ExtraEIPs) > 0 {
-		l = 0
-		for _, e := range m.ExtraEIPs {
-			l += sovEvm(uint64(e))
-		}
-		n += 1 + sovEvm(uint64(l)) + l
+	if len(m.Content) > 0 {
+		i -= len(m.Content)
+		copy(dAtA[i:], m.Content)
+		i = encodeVarintEvm(dAtA, i, uint64(len(m.Content)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = m.ChainConfig.Size()
-	n += 1 + l + sovEvm(uint64(l))
-	if m.AllowUnprotectedTxs {
-		n += 2
+	if len(m.Path) > 0 {
+		i -= len(m.Path)
+		copy(dAtA[i:], m.Path)
+		i = encodeVarintEvm(dAtA, i, uint64(len(m.Path)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *ChainConfig) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ContractSource) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ContractSource) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContractSource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.HomesteadBlock != nil {
-		l = m.HomesteadBlock.Size()
-		n += 1 + l + sovEvm(uint64(l))
-	}
-	if m.DAOForkBlock != nil {
-		l = m.DAOForkBlock.Size()
-		n += 1 + l + sovEvm(uint64(l))
+	if m.Height != 0 {
+		i = encodeVarintEvm(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x48
 	}
-	if m.DAOForkSupport {
-		n += 2
+	if len(m.SourceHash) > 0 {
+		i -= len(m.SourceHash)
+		copy(dAtA[i:], m.SourceHash)
+		i = encodeVarintEvm(dAtA, i, uint64(len(m.SourceHash)))
+		i--
+		dAtA[i] = 0x42
 	}
-	if m.EIP150Block != nil {
-		l = m.EIP150Block.Size()
-		n += 1 + l + sovEvm(uint64(l))
+	if len(m.Metadata) > 0 {
+		i -= len(m.Metadata)
+		copy(dAtA[i:], m.Metadata)
+		i = encodeVarintEvm(dAtA, i, uint64(len(m.Metadata)))
+		i--
+		dAtA[i] = 0x3a
 	}
-	l = len(m.EIP150Hash)
-	if l > 0 {
-		n += 1 + l + sovEvm(uint64(l))
+	if len(m.Settings) > 0 {
+		i -= len(m.Settings)
+		copy(dAtA[i:], m.Settings)
+		i = encodeVarintEvm(dAtA, i, uint64(len(m.Settings)))
+		i--
+		dAtA[i] = 0x32
 	}
-	if m.EIP155Block != nil {
-		l = m.EIP155Block.Size()
-		n += 1 + l + sovEvm(uint64(l))
+	if len(m.Sources) > 0 {
+		for iNdEx := len(m.Sources) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Sources[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvm(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.ContractName) > 0 {
+		i -= len(m.ContractName)
+		copy(dAtA[i:], m.ContractName)
+		i = encodeVarintEvm(dAtA, i, uint64(len(m.ContractName)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.CompilerVersion) > 0 {
+		i -= len(m.CompilerVersion)
+		copy(dAtA[i:], m.CompilerVersion)
+		i = encodeVarintEvm(dAtA, i, uint64(len(m.CompilerVersion)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Submitter) > 0 {
+		i -= len(m.Submitter)
+		copy(dAtA[i:], m.Submitter)
+		i = encodeVarintEvm(dAtA, i, uint64(len(m.Submitter)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintEvm(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VerifiedContract) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VerifiedContract) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VerifiedContract) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExactMatch {
+		i--
+		if m.ExactMatch {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Abi) > 0 {
+		i -= len(m.Abi)
+		copy(dAtA[i:], m.Abi)
+		i = encodeVarintEvm(dAtA, i, uint64(len(m.Abi)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.CompilerVersion) > 0 {
+		i -= len(m.CompilerVersion)
+		copy(dAtA[i:], m.CompilerVersion)
+		i = encodeVarintEvm(dAtA, i, uint64(len(m.CompilerVersion)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ContractName) > 0 {
+		i -= len(m.ContractName)
+		copy(dAtA[i:], m.ContractName)
+		i = encodeVarintEvm(dAtA, i, uint64(len(m.ContractName)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.SourceHash) > 0 {
+		i -= len(m.SourceHash)
+		copy(dAtA[i:], m.SourceHash)
+		i = encodeVarintEvm(dAtA, i, uint64(len(m.SourceHash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintEvm(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintEvm(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEvm(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.EvmDenom)
+	if l > 0 {
+		n += 1 + l + sovEvm(uint64(l))
+	}
+	if m.EnableCreate {
+		n += 2
+	}
+	if m.EnableCall {
+		n += 2
+	}
+	if len(m.ExtraEIPs) > 0 {
+		l = 0
+		for _, e := range m.ExtraEIPs {
+			l += sovEvm(uint64(e))
+		}
+		n += 1 + sovEvm(uint64(l)) + l
+	}
+	l = m.ChainConfig.Size()
+	n += 1 + l + sovEvm(uint64(l))
+	if m.AllowUnprotectedTxs {
+		n += 2
+	}
+	return n
+}
+
+func (m *ChainConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.HomesteadBlock != nil {
+		l = m.HomesteadBlock.Size()
+		n += 1 + l + sovEvm(uint64(l))
+	}
+	if m.DAOForkBlock != nil {
+		l = m.DAOForkBlock.Size()
+		n += 1 + l + sovEvm(uint64(l))
+	}
+	if m.DAOForkSupport {
+		n += 2
+	}
+	if m.EIP150Block != nil {
+		l = m.EIP150Block.Size()
+		n += 1 + l + sovEvm(uint64(l))
+	}
+	l = len(m.EIP150Hash)
+	if l > 0 {
+		n += 1 + l + sovEvm(uint64(l))
+	}
+	if m.EIP155Block != nil {
+		l = m.EIP155Block.Size()
+		n += 1 + l + sovEvm(uint64(l))
 	}
 	if m.EIP158Block != nil {
 		l = m.EIP158Block.Size()
@@ -1836,6 +2320,101 @@ func (m *TraceConfig) Size() (n int) {
 	return n
 }
 
+func (m *ContractSourceFile) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Path)
+	if l > 0 {
+		n += 1 + l + sovEvm(uint64(l))
+	}
+	l = len(m.Content)
+	if l > 0 {
+		n += 1 + l + sovEvm(uint64(l))
+	}
+	return n
+}
+
+func (m *ContractSource) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovEvm(uint64(l))
+	}
+	l = len(m.Submitter)
+	if l > 0 {
+		n += 1 + l + sovEvm(uint64(l))
+	}
+	l = len(m.CompilerVersion)
+	if l > 0 {
+		n += 1 + l + sovEvm(uint64(l))
+	}
+	l = len(m.ContractName)
+	if l > 0 {
+		n += 1 + l + sovEvm(uint64(l))
+	}
+	if len(m.Sources) > 0 {
+		for _, e := range m.Sources {
+			l = e.Size()
+			n += 1 + l + sovEvm(uint64(l))
+		}
+	}
+	l = len(m.Settings)
+	if l > 0 {
+		n += 1 + l + sovEvm(uint64(l))
+	}
+	l = len(m.Metadata)
+	if l > 0 {
+		n += 1 + l + sovEvm(uint64(l))
+	}
+	l = len(m.SourceHash)
+	if l > 0 {
+		n += 1 + l + sovEvm(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovEvm(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *VerifiedContract) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovEvm(uint64(l))
+	}
+	l = len(m.SourceHash)
+	if l > 0 {
+		n += 1 + l + sovEvm(uint64(l))
+	}
+	l = len(m.ContractName)
+	if l > 0 {
+		n += 1 + l + sovEvm(uint64(l))
+	}
+	l = len(m.CompilerVersion)
+	if l > 0 {
+		n += 1 + l + sovEvm(uint64(l))
+	}
+	l = len(m.Abi)
+	if l > 0 {
+		n += 1 + l + sovEvm(uint64(l))
+	}
+	if m.ExactMatch {
+		n += 2
+	}
+	return n
+}
+
 func sovEvm(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -3982,6 +4561,677 @@ func (m *TraceConfig) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ContractSourceFile) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvm
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ContractSourceFile: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ContractSourceFile: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Path = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Content", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Content = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvm(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ContractSource) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvm
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ContractSource: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ContractSource: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Submitter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Submitter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompilerVersion", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CompilerVersion = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sources", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sources = append(m.Sources, ContractSourceFile{})
+			if err := m.Sources[len(m.Sources)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Settings", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Settings = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Metadata = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourceHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvm(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VerifiedContract) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvm
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VerifiedContract: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VerifiedContract: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourceHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompilerVersion", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CompilerVersion = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Abi", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Abi = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExactMatch", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ExactMatch = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvm(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipEvm(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
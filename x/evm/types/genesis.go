@@ -60,5 +60,13 @@ func (gs GenesisState) Validate() error {
 		seenAccounts[acc.Address] = true
 	}
 
+	seenEpochs := make(map[uint64]bool)
+	for _, startingBlock := range gs.ScheduledEpochs {
+		if seenEpochs[startingBlock] {
+			return fmt.Errorf("duplicated scheduled epoch with starting block %d", startingBlock)
+		}
+		seenEpochs[startingBlock] = true
+	}
+
 	return gs.Params.Validate()
 }
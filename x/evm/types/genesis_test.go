@@ -213,6 +213,22 @@ func (suite *GenesisTestSuite) TestValidateGenesis() {
 			},
 			expPass: false,
 		},
+		{
+			name: "scheduled epochs",
+			genState: &GenesisState{
+				Params:          DefaultParams(),
+				ScheduledEpochs: []uint64{100, 200},
+			},
+			expPass: true,
+		},
+		{
+			name: "duplicated scheduled epoch",
+			genState: &GenesisState{
+				Params:          DefaultParams(),
+				ScheduledEpochs: []uint64{100, 100},
+			},
+			expPass: false,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -16,6 +16,8 @@
 package types
 
 import (
+	"encoding/binary"
+
 	"github.com/ethereum/go-ethereum/common"
 )
 
@@ -34,6 +36,9 @@ const (
 
 	// RouterKey uses module name for routing
 	RouterKey = ModuleName
+
+	// BlockHashRingSize is amount of latest block hashes, kept by the module for BLOCKHASH opcode
+	BlockHashRingSize = 256
 )
 
 // prefix bytes for the EVM persistent store
@@ -41,6 +46,10 @@ const (
 	prefixCode = iota + 1
 	prefixStorage
 	prefixParams
+	prefixScheduledEpoch
+	prefixBlockHash
+	prefixContractDeployer
+	prefixContractSource
 )
 
 // prefix bytes for the EVM transient store
@@ -49,6 +58,8 @@ const (
 	prefixTransientTxIndex
 	prefixTransientLogSize
 	prefixTransientGasUsed
+	prefixTransientStateRoot
+	prefixTransientContractSource
 )
 
 // KVStore key prefixes
@@ -56,6 +67,12 @@ var (
 	KeyPrefixCode    = []byte{prefixCode}
 	KeyPrefixStorage = []byte{prefixStorage}
 	KeyPrefixParams  = []byte{prefixParams}
+
+	KeyPrefixScheduledEpoch = []byte{prefixScheduledEpoch}
+	KeyPrefixBlockHash      = []byte{prefixBlockHash}
+
+	KeyPrefixContractDeployer = []byte{prefixContractDeployer}
+	KeyPrefixContractSource   = []byte{prefixContractSource}
 )
 
 // Transient Store key prefixes
@@ -64,6 +81,9 @@ var (
 	KeyPrefixTransientTxIndex = []byte{prefixTransientTxIndex}
 	KeyPrefixTransientLogSize = []byte{prefixTransientLogSize}
 	KeyPrefixTransientGasUsed = []byte{prefixTransientGasUsed}
+
+	KeyPrefixTransientStateRoot      = []byte{prefixTransientStateRoot}
+	KeyPrefixTransientContractSource = []byte{prefixTransientContractSource}
 )
 
 // AddressStoragePrefix returns a prefix to iterate over a given account storage.
@@ -75,3 +95,34 @@ func AddressStoragePrefix(address common.Address) []byte {
 func StateKey(address common.Address, key []byte) []byte {
 	return append(AddressStoragePrefix(address), key...)
 }
+
+// ScheduledEpochKey defines the key under which epoch, scheduled to start at provided block, is stored.
+func ScheduledEpochKey(startingBlock uint64) []byte {
+	return binary.BigEndian.AppendUint64(KeyPrefixScheduledEpoch, startingBlock)
+}
+
+// BlockHashKey defines the key of ring buffer slot, under which hash of block with provided height is stored.
+func BlockHashKey(height uint64) []byte {
+	return binary.BigEndian.AppendUint64(KeyPrefixBlockHash, height%BlockHashRingSize)
+}
+
+// ContractDeployerKey defines the key under which deployer of the contract with provided address is stored.
+func ContractDeployerKey(address common.Address) []byte {
+	return append(KeyPrefixContractDeployer, address.Bytes()...)
+}
+
+// AddressContractSourcePrefix returns a prefix to iterate over sources, submitted for the contract.
+func AddressContractSourcePrefix(address common.Address) []byte {
+	return append(KeyPrefixContractSource, address.Bytes()...)
+}
+
+// ContractSourceKey defines the key under which contract sources with provided hash are stored.
+func ContractSourceKey(address common.Address, sourceHash common.Hash) []byte {
+	return append(AddressContractSourcePrefix(address), sourceHash.Bytes()...)
+}
+
+// TransientContractSourceKey defines the transient key, under which sources, submitted in current block,
+// are recorded until they are passed to the contract verifier
+func TransientContractSourceKey(address common.Address, sourceHash common.Hash) []byte {
+	return append(append(KeyPrefixTransientContractSource, address.Bytes()...), sourceHash.Bytes()...)
+}
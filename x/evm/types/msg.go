@@ -24,6 +24,8 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/auth/ante"
 	"github.com/ethereum/go-ethereum/common"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	"swisstronik/types"
 )
 
 var (
@@ -31,6 +33,8 @@ var (
 	_ sdk.Tx     = &MsgHandleTx{}
 	_ ante.GasTx = &MsgHandleTx{}
 	_ sdk.Msg    = &MsgUpdateParams{}
+	_ sdk.Msg    = &MsgScheduleEpoch{}
+	_ sdk.Msg    = &MsgSubmitContractSource{}
 
 	_ codectypes.UnpackInterfacesMessage = MsgHandleTx{}
 )
@@ -101,3 +105,53 @@ func (m *MsgUpdateParams) ValidateBasic() error {
 func (m MsgUpdateParams) GetSignBytes() []byte {
 	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
 }
+
+// GetSigners returns the expected signers for a MsgScheduleEpoch message.
+func (m MsgScheduleEpoch) GetSigners() []sdk.AccAddress {
+	//#nosec G703 -- gosec raises a warning about a non-handled error which we deliberately ignore here
+	addr, _ := sdk.AccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgScheduleEpoch) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errortypes.Wrap(err, "invalid authority address")
+	}
+
+	if m.StartingBlock == 0 {
+		return errortypes.Wrap(ErrInvalidEpoch, "starting block cannot be zero")
+	}
+
+	return nil
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgScheduleEpoch) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners returns the expected signers for a MsgSubmitContractSource message.
+func (m MsgSubmitContractSource) GetSigners() []sdk.AccAddress {
+	//#nosec G703 -- gosec raises a warning about a non-handled error which we deliberately ignore here
+	addr, _ := sdk.AccAddressFromBech32(m.Submitter)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgSubmitContractSource) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Submitter); err != nil {
+		return errortypes.Wrap(err, "invalid submitter address")
+	}
+
+	if err := types.ValidateNonZeroAddress(m.Address); err != nil {
+		return errortypes.Wrap(err, "invalid contract address")
+	}
+
+	return ValidateContractSource(m.CompilerVersion, m.ContractName, m.Sources, m.Settings)
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgSubmitContractSource) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
+}
@@ -16,11 +16,14 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/auth/signing"
 	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
 
+	"swisstronik/ethereum/eip712"
 	"swisstronik/types"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
 	"swisstronik/crypto/deoxys"
 )
 
@@ -198,6 +201,17 @@ func (msg MsgHandleTx) ValidateBasic() error {
 		return errorsmod.Wrapf(errortypes.ErrInvalidRequest, "invalid tx hash %s, expected: %s", msg.Hash, txHash)
 	}
 
+	if msg.IsFeeDelegated() {
+		if err := types.ValidateAddress(msg.FeePayer); err != nil {
+			return errorsmod.Wrap(err, "invalid fee payer address")
+		}
+		if len(msg.FeePayerSignature) != crypto.SignatureLength {
+			return errorsmod.Wrapf(ErrInvalidFeePayer, "invalid fee payer signature length %d", len(msg.FeePayerSignature))
+		}
+	} else if len(msg.FeePayerSignature) > 0 {
+		return errorsmod.Wrap(ErrInvalidFeePayer, "fee payer signature provided without fee payer")
+	}
+
 	return nil
 }
 
@@ -300,6 +314,82 @@ func (msg *MsgHandleTx) GetFrom() sdk.AccAddress {
 	return common.HexToAddress(msg.From).Bytes()
 }
 
+// IsFeeDelegated returns true if fees of the transaction are paid by the fee payer instead of the sender
+func (msg MsgHandleTx) IsFeeDelegated() bool {
+	return msg.FeePayer != ""
+}
+
+// GetFeePayer returns the address of the account, which pays fees of the transaction. It is the
+// sender, unless fees are delegated
+func (msg *MsgHandleTx) GetFeePayer() sdk.AccAddress {
+	if !msg.IsFeeDelegated() {
+		return msg.GetFrom()
+	}
+
+	return common.HexToAddress(msg.FeePayer).Bytes()
+}
+
+// FeePayerTypedData returns EIP-712 typed data, which is signed by the fee payer of the transaction. It contains
+// sender, nonce, gas limit and fee cap of the transaction, so the fee payer sees the maximal fee it agrees to pay.
+// The transaction must be signed by the sender, since the sender is recovered from its signature
+func (msg MsgHandleTx) FeePayerTypedData() (apitypes.TypedData, error) {
+	tx := msg.AsTransaction()
+	sender, err := ethtypes.LatestSignerForChainID(tx.ChainId()).Sender(tx)
+	if err != nil {
+		return apitypes.TypedData{}, errorsmod.Wrapf(ErrInvalidFeePayer, "cannot recover sender of the transaction: %s", err.Error())
+	}
+
+	return eip712.WrapFeeDelegationToTypedData(
+		tx.ChainId().Uint64(), common.HexToAddress(msg.FeePayer), sender, tx.Nonce(), tx.Gas(), tx.GasFeeCap(), tx.Hash(),
+	), nil
+}
+
+// VerifyFeePayerSignature checks that the fee payer signature is made by the fee payer over EIP-712 typed data
+// of the signed Ethereum transaction
+func (msg MsgHandleTx) VerifyFeePayerSignature() error {
+	if len(msg.FeePayerSignature) != crypto.SignatureLength {
+		return errorsmod.Wrapf(ErrInvalidFeePayer, "invalid fee payer signature length %d", len(msg.FeePayerSignature))
+	}
+
+	typedData, err := msg.FeePayerTypedData()
+	if err != nil {
+		return err
+	}
+
+	signer, err := eip712.RecoverTypedDataSigner(typedData, msg.FeePayerSignature)
+	if err != nil {
+		return errorsmod.Wrapf(ErrInvalidFeePayer, "cannot recover fee payer from signature: %s", err.Error())
+	}
+
+	if signer != common.HexToAddress(msg.FeePayer) {
+		return errorsmod.Wrapf(ErrInvalidFeePayer, "signer %s doesn't match fee payer %s", signer, msg.FeePayer)
+	}
+	return nil
+}
+
+// SignAsFeePayer signs the transaction on behalf of the fee payer, making it pay fees instead of the sender.
+// The transaction must be signed by the sender first, since the fee payer signs hash of the signed transaction
+func (msg *MsgHandleTx) SignAsFeePayer(feePayer common.Address, keyringSigner keyring.Signer) error {
+	msg.FeePayer = feePayer.Hex()
+	typedData, err := msg.FeePayerTypedData()
+	if err != nil {
+		return err
+	}
+
+	sigHash, _, err := apitypes.TypedDataAndHash(typedData)
+	if err != nil {
+		return err
+	}
+
+	sig, _, err := keyringSigner.SignByAddress(sdk.AccAddress(feePayer.Bytes()), sigHash)
+	if err != nil {
+		return err
+	}
+
+	msg.FeePayerSignature = sig
+	return nil
+}
+
 // AsTransaction creates an Ethereum Transaction type from the msg fields
 func (msg MsgHandleTx) AsTransaction() *ethtypes.Transaction {
 	txData, err := UnpackTxData(msg.Data)
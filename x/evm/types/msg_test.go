@@ -17,6 +17,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/crypto/keyring"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	"github.com/ethereum/go-ethereum/accounts"
 	"github.com/ethereum/go-ethereum/common"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/crypto"
@@ -512,6 +513,139 @@ func (suite *MsgsTestSuite) TestMsgHandleTx_Sign() {
 	}
 }
 
+func (suite *MsgsTestSuite) TestMsgHandleTx_SignAsFeePayer() {
+	feePayer, feePayerKey := tests.RandomEthAddressWithPrivateKey()
+	feePayerSigner := tests.NewTestSigner(feePayerKey)
+
+	testCases := []struct {
+		msg        string
+		malleate   func(tx *types.MsgHandleTx)
+		expectPass bool
+	}{
+		{
+			"pass - signed by fee payer",
+			func(tx *types.MsgHandleTx) {},
+			true,
+		},
+		{
+			"pass - signature with eth_signTypedData recovery id",
+			func(tx *types.MsgHandleTx) { tx.FeePayerSignature[crypto.RecoveryIDOffset] += 27 },
+			true,
+		},
+		{
+			"fee payer ≠ signer address",
+			func(tx *types.MsgHandleTx) { tx.FeePayer = suite.to.Hex() },
+			false,
+		},
+		{
+			"signature of another transaction",
+			func(tx *types.MsgHandleTx) {
+				other := types.NewTx(suite.chainID, 1, &suite.to, nil, 100000, big.NewInt(1), nil, nil, nil, nil, nil, nil)
+				other.From = suite.from.Hex()
+				suite.Require().NoError(other.Sign(ethtypes.LatestSignerForChainID(suite.chainID), suite.signer))
+				suite.Require().NoError(other.SignAsFeePayer(feePayer, feePayerSigner))
+				tx.FeePayerSignature = other.FeePayerSignature
+			},
+			false,
+		},
+		{
+			"invalid signature length",
+			func(tx *types.MsgHandleTx) { tx.FeePayerSignature = tx.FeePayerSignature[:64] },
+			false,
+		},
+		{
+			"signature of the transaction hash",
+			func(tx *types.MsgHandleTx) {
+				key, err := crypto.ToECDSA(feePayerKey.Bytes())
+				suite.Require().NoError(err)
+				tx.FeePayerSignature, err = crypto.Sign(accounts.TextHash(tx.AsTransaction().Hash().Bytes()), key)
+				suite.Require().NoError(err)
+			},
+			false,
+		},
+	}
+
+	for i, tc := range testCases {
+		tx := types.NewTx(suite.chainID, 0, &suite.to, nil, 100000, big.NewInt(1), nil, nil, nil, nil, nil, nil)
+		tx.From = suite.from.Hex()
+		suite.Require().NoError(tx.Sign(ethtypes.LatestSignerForChainID(suite.chainID), suite.signer), tc.msg)
+		suite.Require().False(tx.IsFeeDelegated(), tc.msg)
+		suite.Require().Equal(sdk.AccAddress(suite.from.Bytes()), tx.GetFeePayer(), tc.msg)
+
+		suite.Require().NoError(tx.SignAsFeePayer(feePayer, feePayerSigner), tc.msg)
+		suite.Require().True(tx.IsFeeDelegated(), tc.msg)
+		tc.malleate(tx)
+
+		err := tx.VerifyFeePayerSignature()
+		if tc.expectPass {
+			suite.Require().NoError(err, "valid test %d failed: %s", i, tc.msg)
+			suite.Require().NoError(tx.ValidateBasic(), tc.msg)
+			suite.Require().Equal(sdk.AccAddress(feePayer.Bytes()), tx.GetFeePayer(), tc.msg)
+
+			// fee payer signs sender, nonce, gas limit and fee cap of the transaction
+			typedData, err := tx.FeePayerTypedData()
+			suite.Require().NoError(err, tc.msg)
+			suite.Require().Equal(suite.from.Hex(), typedData.Message["sender"], tc.msg)
+			suite.Require().Equal(feePayer.Hex(), typedData.Message["feePayer"], tc.msg)
+			suite.Require().Equal(tx.AsTransaction().Hash().Hex(), typedData.Message["txHash"], tc.msg)
+		} else {
+			suite.Require().Error(err, "invalid test %d passed: %s", i, tc.msg)
+		}
+	}
+}
+
+func (suite *MsgsTestSuite) TestMsgHandleTx_ValidateBasicFeePayer() {
+	testCases := []struct {
+		msg        string
+		malleate   func(tx *types.MsgHandleTx)
+		expectPass bool
+	}{
+		{
+			"pass - no fee payer",
+			func(tx *types.MsgHandleTx) {},
+			true,
+		},
+		{
+			"pass - fee payer with signature",
+			func(tx *types.MsgHandleTx) {
+				tx.FeePayer = suite.to.Hex()
+				tx.FeePayerSignature = make([]byte, crypto.SignatureLength)
+			},
+			true,
+		},
+		{
+			"invalid fee payer address",
+			func(tx *types.MsgHandleTx) {
+				tx.FeePayer = invalidFromAddress
+				tx.FeePayerSignature = make([]byte, crypto.SignatureLength)
+			},
+			false,
+		},
+		{
+			"fee payer without signature",
+			func(tx *types.MsgHandleTx) { tx.FeePayer = suite.to.Hex() },
+			false,
+		},
+		{
+			"signature without fee payer",
+			func(tx *types.MsgHandleTx) { tx.FeePayerSignature = make([]byte, crypto.SignatureLength) },
+			false,
+		},
+	}
+
+	for i, tc := range testCases {
+		tx := types.NewTx(suite.chainID, 0, &suite.to, nil, 100000, big.NewInt(1), nil, nil, nil, nil, nil, nil)
+		tc.malleate(tx)
+
+		err := tx.ValidateBasic()
+		if tc.expectPass {
+			suite.Require().NoError(err, "valid test %d failed: %s", i, tc.msg)
+		} else {
+			suite.Require().Error(err, "invalid test %d passed: %s", i, tc.msg)
+		}
+	}
+}
+
 func (suite *MsgsTestSuite) TestMsgHandleTx_Getters() {
 	testCases := []struct {
 		name      string
@@ -0,0 +1,49 @@
+package types
+
+import (
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// StatePhase is a part of the EVM state, which is exported as a contiguous range of records
+type StatePhase string
+
+// Parts of the EVM state in the order of export
+const (
+	StatePhaseAccounts          StatePhase = "accounts"
+	StatePhaseCode              StatePhase = "code"
+	StatePhaseStorage           StatePhase = "storage"
+	StatePhaseContractDeployers StatePhase = "contract_deployers"
+	StatePhaseContractSources   StatePhase = "contract_sources"
+)
+
+// StatePhases contains all parts of the EVM state in the order of export
+var StatePhases = []StatePhase{
+	StatePhaseAccounts,
+	StatePhaseCode,
+	StatePhaseStorage,
+	StatePhaseContractDeployers,
+	StatePhaseContractSources,
+}
+
+// StateCursor points to the last exported record of the EVM state, so export can be resumed after it.
+// Key is the key of the record within the phase: account address for accounts and store key without
+// the phase prefix for other phases. Empty cursor points to the beginning of the state
+type StateCursor struct {
+	Phase StatePhase    `json:"phase,omitempty"`
+	Key   hexutil.Bytes `json:"key,omitempty"`
+}
+
+// IsEmpty returns true if cursor points to the beginning of the state
+func (c StateCursor) IsEmpty() bool {
+	return c.Phase == ""
+}
+
+// PhaseIndex returns index of the cursor phase in StatePhases or -1 if cursor is empty or phase is unknown
+func (c StateCursor) PhaseIndex() int {
+	for i, phase := range StatePhases {
+		if phase == c.Phase {
+			return i
+		}
+	}
+	return -1
+}
@@ -0,0 +1,54 @@
+package types
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// StateOverride is the collection of overridden accounts.
+type StateOverride map[common.Address]OverrideAccount
+
+// OverrideAccount indicates the overriding fields of account during the execution of
+// a message call.
+// Note, state and stateDiff can't be specified at the same time. If state is
+// set, message execution will only use the data in the given state. Otherwise
+// if statDiff is set, all diff will be applied first and then execute the call
+// message. Since contract storage is private, storage of accounts with existing code
+// or storage can only be replaced entirely with state, and their code can only be
+// overridden together with it.
+type OverrideAccount struct {
+	Nonce     *hexutil.Uint64              `json:"nonce"`
+	Code      *hexutil.Bytes               `json:"code"`
+	Balance   **hexutil.Big                `json:"balance"`
+	State     *map[common.Hash]common.Hash `json:"state"`
+	StateDiff *map[common.Hash]common.Hash `json:"stateDiff"`
+}
+
+// Validate performs a basic validation of the state override
+func (so StateOverride) Validate() error {
+	for address, account := range so {
+		if account.State != nil && account.StateDiff != nil {
+			return fmt.Errorf("account %s has both 'state' and 'stateDiff'", address.Hex())
+		}
+		if account.Balance != nil && *account.Balance == nil {
+			return fmt.Errorf("account %s has empty 'balance'", address.Hex())
+		}
+	}
+	return nil
+}
+
+// Addresses returns overridden addresses in deterministic order
+func (so StateOverride) Addresses() []common.Address {
+	addresses := make([]common.Address, 0, len(so))
+	for address := range so {
+		addresses = append(addresses, address)
+	}
+	sort.Slice(addresses, func(i, j int) bool {
+		return bytes.Compare(addresses[i].Bytes(), addresses[j].Bytes()) < 0
+	})
+	return addresses
+}
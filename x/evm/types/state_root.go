@@ -0,0 +1,145 @@
+package types
+
+import (
+	"bytes"
+	"encoding/binary"
+	"math/big"
+	"sort"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+const (
+	// flags of account record, included into the commitment of state writes
+	stateWriteRemoved byte = 1 << iota
+	stateWriteAccount
+	stateWriteCode
+)
+
+// accountWrite contains account data, written to the EVM store
+type accountWrite struct {
+	balance *big.Int
+	nonce   uint64
+}
+
+// addressWrites contains all writes to the EVM store, related to a single address
+type addressWrites struct {
+	removed  bool
+	account  *accountWrite
+	codeHash *common.Hash
+	storage  map[common.Hash][]byte
+}
+
+// StateWrites collects writes to the EVM store, made during execution of a single transaction, and
+// computes deterministic commitment over them. Only the last written value of each account field and
+// storage cell is committed. Storage values are committed as they were written to the store, so
+// commitment over encrypted storage is computed without decryption. StateWrites is not safe for
+// concurrent use
+type StateWrites struct {
+	writes map[common.Address]*addressWrites
+}
+
+// NewStateWrites returns empty set of state writes
+func NewStateWrites() *StateWrites {
+	return &StateWrites{writes: make(map[common.Address]*addressWrites)}
+}
+
+func (w *StateWrites) get(address common.Address) *addressWrites {
+	writes, found := w.writes[address]
+	if !found {
+		writes = &addressWrites{storage: make(map[common.Hash][]byte)}
+		w.writes[address] = writes
+	}
+	return writes
+}
+
+// SetAccount records balance and nonce, written to the account
+func (w *StateWrites) SetAccount(address common.Address, balance *big.Int, nonce uint64) {
+	w.get(address).account = &accountWrite{balance: new(big.Int).Set(balance), nonce: nonce}
+}
+
+// SetCode records hash of the code, written to the account
+func (w *StateWrites) SetCode(address common.Address, code []byte) {
+	codeHash := crypto.Keccak256Hash(code)
+	w.get(address).codeHash = &codeHash
+}
+
+// SetState records value, written to the storage cell of the account
+func (w *StateWrites) SetState(address common.Address, index common.Hash, value []byte) {
+	w.get(address).storage[index] = common.CopyBytes(value)
+}
+
+// RemoveAccount records removal of the account together with its code and storage. Writes to the
+// account, recorded before removal, are dropped
+func (w *StateWrites) RemoveAccount(address common.Address) {
+	w.writes[address] = &addressWrites{removed: true, storage: make(map[common.Hash][]byte)}
+}
+
+// Reset drops all recorded writes
+func (w *StateWrites) Reset() {
+	w.writes = make(map[common.Address]*addressWrites)
+}
+
+// Hash returns commitment over recorded writes. Accounts are committed in ascending order of their
+// addresses, storage cells of each account are committed in ascending order of their indices
+func (w *StateWrites) Hash() common.Hash {
+	addresses := make([]common.Address, 0, len(w.writes))
+	for address := range w.writes {
+		addresses = append(addresses, address)
+	}
+	sort.Slice(addresses, func(i, j int) bool {
+		return bytes.Compare(addresses[i].Bytes(), addresses[j].Bytes()) < 0
+	})
+
+	var buf bytes.Buffer
+	for _, address := range addresses {
+		writes := w.writes[address]
+
+		var flags byte
+		if writes.removed {
+			flags |= stateWriteRemoved
+		}
+		if writes.account != nil {
+			flags |= stateWriteAccount
+		}
+		if writes.codeHash != nil {
+			flags |= stateWriteCode
+		}
+
+		buf.Write(address.Bytes())
+		buf.WriteByte(flags)
+		if writes.account != nil {
+			buf.Write(common.BigToHash(writes.account.balance).Bytes())
+			buf.Write(binary.BigEndian.AppendUint64(nil, writes.account.nonce))
+		}
+		if writes.codeHash != nil {
+			buf.Write(writes.codeHash.Bytes())
+		}
+
+		indices := make([]common.Hash, 0, len(writes.storage))
+		for index := range writes.storage {
+			indices = append(indices, index)
+		}
+		sort.Slice(indices, func(i, j int) bool {
+			return bytes.Compare(indices[i].Bytes(), indices[j].Bytes()) < 0
+		})
+
+		buf.Write(binary.BigEndian.AppendUint32(nil, uint32(len(indices))))
+		for _, index := range indices {
+			buf.Write(index.Bytes())
+			buf.Write(crypto.Keccak256(writes.storage[index]))
+		}
+	}
+
+	return crypto.Keccak256Hash(buf.Bytes())
+}
+
+// IntermediateStateRoot returns state root after transaction, which made provided writes. Roots of
+// transactions within a block form a chain, which starts from the app hash of the block header.
+// Despite the name, it is a commitment over EVM store writes, made through SGXVM connector, rather than
+// a root of the full state. Fees and nonce, charged by the ante handler, gas refund and bank or staking
+// changes, made by precompiles, are not committed, so the root cannot be used to prove balances
+func IntermediateStateRoot(parentRoot, writesHash common.Hash) common.Hash {
+	return crypto.Keccak256Hash(parentRoot.Bytes(), writesHash.Bytes())
+}
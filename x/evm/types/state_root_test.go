@@ -0,0 +1,120 @@
+package types
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStateWritesHash(t *testing.T) {
+	addr1 := common.BigToAddress(big.NewInt(1))
+	addr2 := common.BigToAddress(big.NewInt(2))
+	index1 := common.BigToHash(big.NewInt(1))
+	index2 := common.BigToHash(big.NewInt(2))
+
+	empty := NewStateWrites().Hash()
+
+	testCases := []struct {
+		name     string
+		malleate func(writes *StateWrites)
+		expected func() common.Hash
+	}{
+		{
+			"empty writes",
+			func(writes *StateWrites) {},
+			func() common.Hash { return empty },
+		},
+		{
+			"order of writes doesn't affect commitment",
+			func(writes *StateWrites) {
+				writes.SetState(addr2, index2, []byte{2})
+				writes.SetState(addr1, index1, []byte{1})
+				writes.SetAccount(addr1, big.NewInt(10), 1)
+			},
+			func() common.Hash {
+				writes := NewStateWrites()
+				writes.SetAccount(addr1, big.NewInt(10), 1)
+				writes.SetState(addr1, index1, []byte{1})
+				writes.SetState(addr2, index2, []byte{2})
+				return writes.Hash()
+			},
+		},
+		{
+			"only the last written value is committed",
+			func(writes *StateWrites) {
+				writes.SetState(addr1, index1, []byte{1})
+				writes.SetState(addr1, index1, []byte{2})
+				writes.SetAccount(addr1, big.NewInt(10), 1)
+				writes.SetAccount(addr1, big.NewInt(20), 2)
+			},
+			func() common.Hash {
+				writes := NewStateWrites()
+				writes.SetState(addr1, index1, []byte{2})
+				writes.SetAccount(addr1, big.NewInt(20), 2)
+				return writes.Hash()
+			},
+		},
+		{
+			"removal drops previous writes of the account",
+			func(writes *StateWrites) {
+				writes.SetCode(addr1, []byte{0x60, 0x00})
+				writes.SetState(addr1, index1, []byte{1})
+				writes.RemoveAccount(addr1)
+			},
+			func() common.Hash {
+				writes := NewStateWrites()
+				writes.RemoveAccount(addr1)
+				return writes.Hash()
+			},
+		},
+		{
+			"reset drops all writes",
+			func(writes *StateWrites) {
+				writes.SetState(addr1, index1, []byte{1})
+				writes.Reset()
+			},
+			func() common.Hash { return empty },
+		},
+	}
+
+	for _, tc := range testCases {
+		writes := NewStateWrites()
+		tc.malleate(writes)
+		require.Equal(t, tc.expected(), writes.Hash(), tc.name)
+	}
+}
+
+func TestStateWritesHashDistinguishesWrites(t *testing.T) {
+	addr := common.BigToAddress(big.NewInt(1))
+	index := common.BigToHash(big.NewInt(1))
+
+	hashes := make(map[common.Hash]string)
+	add := func(name string, malleate func(writes *StateWrites)) {
+		writes := NewStateWrites()
+		malleate(writes)
+		hash := writes.Hash()
+		other, found := hashes[hash]
+		require.False(t, found, "%s has the same commitment as %s", name, other)
+		hashes[hash] = name
+	}
+
+	add("empty", func(writes *StateWrites) {})
+	add("account", func(writes *StateWrites) { writes.SetAccount(addr, big.NewInt(1), 0) })
+	add("account with other nonce", func(writes *StateWrites) { writes.SetAccount(addr, big.NewInt(1), 1) })
+	add("code", func(writes *StateWrites) { writes.SetCode(addr, []byte{1}) })
+	add("storage", func(writes *StateWrites) { writes.SetState(addr, index, []byte{1}) })
+	add("storage with other value", func(writes *StateWrites) { writes.SetState(addr, index, []byte{2}) })
+	add("removal", func(writes *StateWrites) { writes.RemoveAccount(addr) })
+}
+
+func TestIntermediateStateRoot(t *testing.T) {
+	writesHash := NewStateWrites().Hash()
+	parent := common.BigToHash(big.NewInt(1))
+
+	root := IntermediateStateRoot(parent, writesHash)
+	require.Equal(t, root, IntermediateStateRoot(parent, writesHash))
+	require.NotEqual(t, root, IntermediateStateRoot(common.Hash{}, writesHash))
+	require.NotEqual(t, root, IntermediateStateRoot(root, writesHash))
+}
@@ -64,6 +64,22 @@ type TxTraceResult struct {
 	Error  string      `json:"error,omitempty"`  // Trace failure produced by the tracer
 }
 
+// CallFrame is a single call frame in the format of `callTracer`, extended with decoded revert reason.
+// It is the format of call tree, returned encrypted by `TraceTxPrivate`
+type CallFrame struct {
+	Type         string      `json:"type"`
+	From         string      `json:"from"`
+	To           string      `json:"to,omitempty"`
+	Value        string      `json:"value,omitempty"`
+	Gas          string      `json:"gas"`
+	GasUsed      string      `json:"gasUsed"`
+	Input        string      `json:"input"`
+	Output       string      `json:"output,omitempty"`
+	Error        string      `json:"error,omitempty"`
+	RevertReason string      `json:"revertReason,omitempty"`
+	Calls        []CallFrame `json:"calls,omitempty"`
+}
+
 var _ vm.EVMLogger = &NoOpTracer{}
 
 // NoOpTracer is an empty implementation of vm.Tracer interface
@@ -44,6 +44,13 @@ type MsgHandleTx struct {
 	// secp256k1 elliptic curve
 	From        string `protobuf:"bytes,4,opt,name=from,proto3" json:"from,omitempty"`
 	Unencrypted bool   `protobuf:"varint,5,opt,name=unencrypted,proto3" json:"unencrypted,omitempty"`
+	// fee_payer is the optional address of the account in hex format, which
+	// pays fees of the transaction instead of the sender
+	FeePayer string `protobuf:"bytes,6,opt,name=fee_payer,json=feePayer,proto3" json:"fee_payer,omitempty"`
+	// fee_payer_signature is the secp256k1 signature of the fee payer over the
+	// EIP-712 typed data with sender, nonce, gas limit, fee cap and hash of the
+	// Ethereum transaction
+	FeePayerSignature []byte `protobuf:"bytes,7,opt,name=fee_payer_signature,json=feePayerSignature,proto3" json:"fee_payer_signature,omitempty"`
 }
 
 func (m *MsgHandleTx) Reset()         { *m = MsgHandleTx{} }
@@ -309,6 +316,12 @@ type MsgEthereumTxResponse struct {
 	VmError string `protobuf:"bytes,4,opt,name=vm_error,json=vmError,proto3" json:"vm_error,omitempty"`
 	// gas_used specifies how much gas was consumed by the transaction
 	GasUsed uint64 `protobuf:"varint,5,opt,name=gas_used,json=gasUsed,proto3" json:"gas_used,omitempty"`
+	// state_root is the intermediate state root after the transaction in hex
+	// format. It commits to the EVM store writes, made by the transaction, and
+	// to the state root after the previous transaction of the block. It is not
+	// a root of the full state: fees and nonce, charged by the ante handler, gas
+	// refund and bank or staking changes, made by precompiles, are not committed
+	StateRoot string `protobuf:"bytes,6,opt,name=state_root,json=stateRoot,proto3" json:"state_root,omitempty"`
 }
 
 func (m *MsgEthereumTxResponse) Reset()         { *m = MsgEthereumTxResponse{} }
@@ -438,6 +451,250 @@ func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
 
+// MsgScheduleEpoch defines a Msg for scheduling new epoch. At the starting
+// block every node adds new epoch to its enclave.
+type MsgScheduleEpoch struct {
+	// authority is the address of the governance account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// starting_block is the block since which new epoch keys are used.
+	StartingBlock uint64 `protobuf:"varint,2,opt,name=starting_block,json=startingBlock,proto3" json:"starting_block,omitempty"`
+}
+
+func (m *MsgScheduleEpoch) Reset()         { *m = MsgScheduleEpoch{} }
+func (m *MsgScheduleEpoch) String() string { return proto.CompactTextString(m) }
+func (*MsgScheduleEpoch) ProtoMessage()    {}
+func (*MsgScheduleEpoch) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f75ac0a12d075f21, []int{8}
+}
+func (m *MsgScheduleEpoch) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgScheduleEpoch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgScheduleEpoch.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgScheduleEpoch) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgScheduleEpoch.Merge(m, src)
+}
+func (m *MsgScheduleEpoch) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgScheduleEpoch) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgScheduleEpoch.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgScheduleEpoch proto.InternalMessageInfo
+
+func (m *MsgScheduleEpoch) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgScheduleEpoch) GetStartingBlock() uint64 {
+	if m != nil {
+		return m.StartingBlock
+	}
+	return 0
+}
+
+// MsgScheduleEpochResponse defines the response structure for executing a
+// MsgScheduleEpoch message.
+type MsgScheduleEpochResponse struct {
+}
+
+func (m *MsgScheduleEpochResponse) Reset()         { *m = MsgScheduleEpochResponse{} }
+func (m *MsgScheduleEpochResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgScheduleEpochResponse) ProtoMessage()    {}
+func (*MsgScheduleEpochResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f75ac0a12d075f21, []int{9}
+}
+func (m *MsgScheduleEpochResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgScheduleEpochResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgScheduleEpochResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgScheduleEpochResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgScheduleEpochResponse.Merge(m, src)
+}
+func (m *MsgScheduleEpochResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgScheduleEpochResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgScheduleEpochResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgScheduleEpochResponse proto.InternalMessageInfo
+
+// MsgSubmitContractSource defines a Msg for submitting Solidity sources of the
+// deployed contract. Sources are stored by the module and verified by each node
+// against deployed bytecode using solc binary, configured by the node operator
+type MsgSubmitContractSource struct {
+	// submitter is the bech32 address of the account, which submits sources
+	Submitter string `protobuf:"bytes,1,opt,name=submitter,proto3" json:"submitter,omitempty"`
+	// address of the contract in hex format
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	// compiler_version is the solc version, for example "0.8.20"
+	CompilerVersion string `protobuf:"bytes,3,opt,name=compiler_version,json=compilerVersion,proto3" json:"compiler_version,omitempty"`
+	// contract_name is the fully qualified name of the contract, for example
+	// "contracts/Token.sol:Token"
+	ContractName string `protobuf:"bytes,4,opt,name=contract_name,json=contractName,proto3" json:"contract_name,omitempty"`
+	// sources of the contract and all its imports
+	Sources []ContractSourceFile `protobuf:"bytes,5,rep,name=sources,proto3" json:"sources"`
+	// settings contains `settings` object of solc standard JSON input
+	Settings string `protobuf:"bytes,6,opt,name=settings,proto3" json:"settings,omitempty"`
+	// metadata contains arbitrary information about the contract
+	Metadata string `protobuf:"bytes,7,opt,name=metadata,proto3" json:"metadata,omitempty"`
+}
+
+func (m *MsgSubmitContractSource) Reset()         { *m = MsgSubmitContractSource{} }
+func (m *MsgSubmitContractSource) String() string { return proto.CompactTextString(m) }
+func (*MsgSubmitContractSource) ProtoMessage()    {}
+func (*MsgSubmitContractSource) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f75ac0a12d075f21, []int{10}
+}
+func (m *MsgSubmitContractSource) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSubmitContractSource) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSubmitContractSource.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSubmitContractSource) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubmitContractSource.Merge(m, src)
+}
+func (m *MsgSubmitContractSource) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSubmitContractSource) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubmitContractSource.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSubmitContractSource proto.InternalMessageInfo
+
+func (m *MsgSubmitContractSource) GetSubmitter() string {
+	if m != nil {
+		return m.Submitter
+	}
+	return ""
+}
+
+func (m *MsgSubmitContractSource) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *MsgSubmitContractSource) GetCompilerVersion() string {
+	if m != nil {
+		return m.CompilerVersion
+	}
+	return ""
+}
+
+func (m *MsgSubmitContractSource) GetContractName() string {
+	if m != nil {
+		return m.ContractName
+	}
+	return ""
+}
+
+func (m *MsgSubmitContractSource) GetSources() []ContractSourceFile {
+	if m != nil {
+		return m.Sources
+	}
+	return nil
+}
+
+func (m *MsgSubmitContractSource) GetSettings() string {
+	if m != nil {
+		return m.Settings
+	}
+	return ""
+}
+
+func (m *MsgSubmitContractSource) GetMetadata() string {
+	if m != nil {
+		return m.Metadata
+	}
+	return ""
+}
+
+// MsgSubmitContractSourceResponse defines the response structure for executing
+// a MsgSubmitContractSource message.
+type MsgSubmitContractSourceResponse struct {
+	// source_hash is the keccak256 hash of submitted sources in hex format
+	SourceHash string `protobuf:"bytes,1,opt,name=source_hash,json=sourceHash,proto3" json:"source_hash,omitempty"`
+}
+
+func (m *MsgSubmitContractSourceResponse) Reset()         { *m = MsgSubmitContractSourceResponse{} }
+func (m *MsgSubmitContractSourceResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSubmitContractSourceResponse) ProtoMessage()    {}
+func (*MsgSubmitContractSourceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f75ac0a12d075f21, []int{11}
+}
+func (m *MsgSubmitContractSourceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSubmitContractSourceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSubmitContractSourceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSubmitContractSourceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubmitContractSourceResponse.Merge(m, src)
+}
+func (m *MsgSubmitContractSourceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSubmitContractSourceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubmitContractSourceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSubmitContractSourceResponse proto.InternalMessageInfo
+
+func (m *MsgSubmitContractSourceResponse) GetSourceHash() string {
+	if m != nil {
+		return m.SourceHash
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*MsgHandleTx)(nil), "ethermint.evm.v1.MsgHandleTx")
 	proto.RegisterType((*LegacyTx)(nil), "ethermint.evm.v1.LegacyTx")
@@ -447,74 +704,96 @@ func init() {
 	proto.RegisterType((*MsgEthereumTxResponse)(nil), "ethermint.evm.v1.MsgEthereumTxResponse")
 	proto.RegisterType((*MsgUpdateParams)(nil), "ethermint.evm.v1.MsgUpdateParams")
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "ethermint.evm.v1.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgScheduleEpoch)(nil), "ethermint.evm.v1.MsgScheduleEpoch")
+	proto.RegisterType((*MsgScheduleEpochResponse)(nil), "ethermint.evm.v1.MsgScheduleEpochResponse")
+	proto.RegisterType((*MsgSubmitContractSource)(nil), "ethermint.evm.v1.MsgSubmitContractSource")
+	proto.RegisterType((*MsgSubmitContractSourceResponse)(nil), "ethermint.evm.v1.MsgSubmitContractSourceResponse")
 }
 
 func init() { proto.RegisterFile("ethermint/evm/v1/tx.proto", fileDescriptor_f75ac0a12d075f21) }
 
 var fileDescriptor_f75ac0a12d075f21 = []byte{
-	// 978 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x56, 0x4f, 0x8f, 0xdb, 0x44,
-	0x14, 0x8f, 0x13, 0x27, 0x71, 0x26, 0xd1, 0x52, 0x8d, 0xb6, 0xaa, 0x13, 0x68, 0x1c, 0x82, 0x04,
-	0x69, 0xa5, 0xb5, 0xd5, 0x05, 0xf5, 0xb0, 0xa7, 0x6e, 0xba, 0xdb, 0xd2, 0x6a, 0x57, 0x54, 0x26,
-	0xbd, 0x50, 0xa4, 0x68, 0xd6, 0x99, 0x9d, 0x58, 0xc4, 0x33, 0x96, 0x67, 0x62, 0x92, 0x6b, 0x4f,
-	0x1c, 0x41, 0xf0, 0x01, 0x38, 0x70, 0xe2, 0x84, 0x44, 0x3f, 0x00, 0xc7, 0x8a, 0x53, 0x05, 0x17,
-	0x84, 0x50, 0x40, 0x59, 0x24, 0xa4, 0xde, 0xe0, 0x13, 0xa0, 0x19, 0x3b, 0xff, 0x9a, 0xdd, 0x02,
-	0x65, 0x11, 0xa7, 0xcc, 0x9b, 0xf7, 0xe6, 0xe7, 0xf7, 0xde, 0xef, 0x37, 0xf3, 0x02, 0xaa, 0x58,
-	0xf4, 0x71, 0x14, 0xf8, 0x54, 0x38, 0x38, 0x0e, 0x9c, 0xf8, 0x9a, 0x23, 0x46, 0x76, 0x18, 0x31,
-	0xc1, 0xe0, 0x85, 0xb9, 0xcb, 0xc6, 0x71, 0x60, 0xc7, 0xd7, 0x6a, 0x97, 0x3c, 0xc6, 0x03, 0xc6,
-	0x9d, 0x80, 0x13, 0x19, 0x19, 0x70, 0x92, 0x84, 0xd6, 0xaa, 0x89, 0xa3, 0xab, 0x2c, 0x27, 0x31,
-	0x52, 0x57, 0x6d, 0xed, 0x03, 0x12, 0x2c, 0xf1, 0x6d, 0x12, 0x46, 0x58, 0x72, 0x46, 0xae, 0xd2,
-	0xdd, 0x57, 0x08, 0x63, 0x64, 0x80, 0x1d, 0x14, 0xfa, 0x0e, 0xa2, 0x94, 0x09, 0x24, 0x7c, 0x46,
-	0x67, 0x78, 0xd5, 0xd4, 0xab, 0xac, 0xa3, 0xe1, 0xb1, 0x83, 0xe8, 0x38, 0x71, 0x35, 0x3f, 0xd3,
-	0x40, 0xf9, 0x90, 0x93, 0xb7, 0x11, 0xed, 0x0d, 0x70, 0x67, 0x04, 0x5b, 0x40, 0xef, 0x21, 0x81,
-	0x4c, 0xad, 0xa1, 0xb5, 0xca, 0xdb, 0x9b, 0x76, 0x72, 0xd2, 0x9e, 0x9d, 0xb4, 0x77, 0xe9, 0xd8,
-	0x55, 0x11, 0xd0, 0x02, 0x7a, 0x1f, 0xf1, 0xbe, 0x99, 0x6b, 0x68, 0xad, 0x52, 0xbb, 0xfc, 0xc7,
-	0xc4, 0x2a, 0x46, 0x83, 0x70, 0xa7, 0xb9, 0xd5, 0x74, 0x95, 0x03, 0x42, 0xa0, 0x1f, 0x47, 0x2c,
-	0x30, 0x75, 0x19, 0xe0, 0xaa, 0x35, 0x6c, 0x80, 0xf2, 0x90, 0x62, 0xea, 0x45, 0xe3, 0x50, 0xe0,
-	0x9e, 0x99, 0x6f, 0x68, 0x2d, 0xc3, 0x5d, 0xde, 0xda, 0xd1, 0x3f, 0xfa, 0xdc, 0xca, 0x34, 0xbf,
-	0xce, 0x02, 0xe3, 0x00, 0x13, 0xe4, 0x8d, 0x3b, 0x23, 0xb8, 0x09, 0xf2, 0x94, 0x51, 0x0f, 0xab,
-	0xa4, 0x74, 0x37, 0x31, 0xe0, 0x6d, 0x50, 0x22, 0x48, 0xb6, 0xcf, 0xf7, 0xb0, 0x99, 0x55, 0x49,
-	0x5c, 0xfd, 0x71, 0x62, 0xbd, 0x4e, 0x7c, 0xd1, 0x1f, 0x1e, 0xd9, 0x1e, 0x0b, 0xd2, 0xa6, 0xa6,
-	0x3f, 0x5b, 0xbc, 0xf7, 0x81, 0x23, 0xc6, 0x21, 0xe6, 0xf6, 0x1d, 0x2a, 0x5c, 0x83, 0x20, 0x7e,
-	0x4f, 0x9e, 0x85, 0x75, 0x90, 0x23, 0x88, 0xab, 0x3a, 0xf4, 0x76, 0x65, 0x3a, 0xb1, 0x8c, 0xdb,
-	0x88, 0x1f, 0xf8, 0x81, 0x2f, 0x5c, 0xe9, 0x80, 0x1b, 0x20, 0x2b, 0x58, 0x5a, 0x45, 0x56, 0x30,
-	0x78, 0x17, 0xe4, 0x63, 0x34, 0x18, 0x62, 0x95, 0x7d, 0xa9, 0xfd, 0xd6, 0xdf, 0xff, 0xe8, 0x74,
-	0x62, 0x15, 0x76, 0x03, 0x36, 0xa4, 0xc2, 0x4d, 0x20, 0x64, 0x8f, 0x54, 0xbb, 0x0b, 0x0d, 0xad,
-	0x55, 0x49, 0x1b, 0x5b, 0x01, 0x5a, 0x6c, 0x16, 0xd5, 0x86, 0x16, 0x4b, 0x2b, 0x32, 0x8d, 0xc4,
-	0x8a, 0xa4, 0xc5, 0xcd, 0x52, 0x62, 0xf1, 0x9d, 0x0d, 0xd9, 0xab, 0x6f, 0x1f, 0x6d, 0x15, 0x3a,
-	0xa3, 0x3d, 0x24, 0x50, 0xf3, 0xf7, 0x1c, 0xa8, 0xec, 0x7a, 0x1e, 0xe6, 0xfc, 0xc0, 0xe7, 0xa2,
-	0x33, 0x82, 0x0f, 0x80, 0xe1, 0xf5, 0x91, 0x4f, 0xbb, 0x7e, 0x4f, 0x35, 0xaf, 0xd4, 0xbe, 0xf1,
-	0x8f, 0xb2, 0x2d, 0xde, 0x94, 0xa7, 0xef, 0xec, 0x3d, 0x9d, 0x58, 0x45, 0x2f, 0x59, 0xba, 0xe9,
-	0xa2, 0xb7, 0xa0, 0x25, 0x7b, 0x26, 0x2d, 0xb9, 0x7f, 0x4f, 0x8b, 0xfe, 0x7c, 0x5a, 0xf2, 0xeb,
-	0xb4, 0x14, 0xce, 0x8f, 0x96, 0xe2, 0x12, 0x2d, 0x0f, 0x80, 0x81, 0x54, 0x6f, 0x31, 0x37, 0x8d,
-	0x46, 0xae, 0x55, 0xde, 0xbe, 0x6c, 0x3f, 0x7b, 0xdb, 0xed, 0xa4, 0xfb, 0x9d, 0x61, 0x38, 0xc0,
-	0xed, 0xc6, 0xe3, 0x89, 0x95, 0x79, 0x3a, 0xb1, 0x00, 0x9a, 0x53, 0xf2, 0xe5, 0xcf, 0x16, 0x58,
-	0x10, 0xe4, 0xce, 0x01, 0x13, 0xce, 0x4b, 0x2b, 0x9c, 0x83, 0x15, 0xce, 0xcb, 0x67, 0x71, 0xfe,
-	0x8d, 0x0e, 0x2a, 0x7b, 0x63, 0x8a, 0x02, 0xdf, 0xbb, 0x85, 0xf1, 0xff, 0xc3, 0xf9, 0x5d, 0x50,
-	0x96, 0x9c, 0x0b, 0x3f, 0xec, 0x7a, 0x28, 0x7c, 0x01, 0xd6, 0xa5, 0x64, 0x3a, 0x7e, 0x78, 0x13,
-	0x85, 0x33, 0xac, 0x63, 0x8c, 0x15, 0x96, 0xfe, 0x42, 0x58, 0xb7, 0x30, 0x96, 0x58, 0xa9, 0x84,
-	0xf2, 0xcf, 0x97, 0x50, 0x61, 0x5d, 0x42, 0xc5, 0xf3, 0x93, 0x90, 0x71, 0x86, 0x84, 0x4a, 0xff,
-	0x89, 0x84, 0xc0, 0x8a, 0x84, 0xca, 0x2b, 0x12, 0xaa, 0x9c, 0x25, 0xa1, 0x26, 0xa8, 0xed, 0x8f,
-	0x04, 0xa6, 0xdc, 0x67, 0xf4, 0x9d, 0x50, 0x0d, 0x8e, 0x7d, 0x99, 0x15, 0x1e, 0x06, 0x9d, 0x51,
-	0xfa, 0x20, 0x7f, 0xa1, 0x81, 0x8b, 0x87, 0x9c, 0x2c, 0xf6, 0x5d, 0xcc, 0x43, 0x46, 0xb9, 0x2a,
-	0x54, 0xcd, 0x01, 0x2d, 0x79, 0xe6, 0xd5, 0xd3, 0x7f, 0x05, 0xe8, 0x03, 0x46, 0xb8, 0x99, 0x55,
-	0x45, 0x5e, 0x5c, 0x2f, 0xf2, 0x80, 0x11, 0x57, 0x85, 0xc0, 0x0b, 0x20, 0x17, 0x61, 0xa1, 0x34,
-	0x53, 0x71, 0xe5, 0x12, 0x56, 0x81, 0x11, 0x07, 0x5d, 0x1c, 0x45, 0x2c, 0x4a, 0x5f, 0xdd, 0x62,
-	0x1c, 0xec, 0x4b, 0x53, 0xba, 0xa4, 0x38, 0x86, 0x3c, 0x9d, 0x1d, 0xba, 0x5b, 0x24, 0x88, 0xdf,
-	0xe7, 0xf3, 0xb9, 0xf1, 0x89, 0x06, 0x5e, 0x3a, 0xe4, 0xe4, 0x7e, 0xd8, 0x43, 0x02, 0xdf, 0x43,
-	0x11, 0x0a, 0x38, 0xbc, 0x0e, 0x4a, 0x68, 0x28, 0xfa, 0x2c, 0xf2, 0xc5, 0x38, 0xbd, 0x11, 0xe6,
-	0x77, 0x8f, 0xb6, 0x36, 0xd3, 0x91, 0xbb, 0xdb, 0xeb, 0x45, 0x98, 0xf3, 0x77, 0x45, 0xe4, 0x53,
-	0xe2, 0x2e, 0x42, 0xe1, 0x75, 0x50, 0x08, 0x15, 0x82, 0x12, 0x7b, 0x79, 0xdb, 0x5c, 0x2f, 0x23,
-	0xf9, 0x42, 0x5b, 0x97, 0x34, 0xb9, 0x69, 0xf4, 0xce, 0xc6, 0xc3, 0xdf, 0xbe, 0xba, 0xba, 0xc0,
-	0x69, 0x56, 0xc1, 0xa5, 0x67, 0x52, 0x9a, 0xf5, 0x6e, 0xfb, 0x27, 0x0d, 0xe4, 0x0e, 0x39, 0x81,
-	0x1f, 0x02, 0x63, 0x3e, 0x81, 0x4f, 0x91, 0xc4, 0xd2, 0x80, 0xae, 0xbd, 0x71, 0xaa, 0x7b, 0x9d,
-	0x97, 0xe6, 0x6b, 0x0f, 0xbf, 0xff, 0xf5, 0xd3, 0xec, 0xe5, 0xe6, 0xcb, 0xce, 0xda, 0xbf, 0x89,
-	0xbe, 0x02, 0xeb, 0x8a, 0x11, 0x7c, 0x1f, 0x54, 0x56, 0x7a, 0xf5, 0xea, 0xa9, 0xe8, 0xcb, 0x21,
-	0xb5, 0x2b, 0x7f, 0x19, 0x32, 0x4b, 0xa1, 0x7d, 0xe3, 0xf1, 0xb4, 0xae, 0x3d, 0x99, 0xd6, 0xb5,
-	0x5f, 0xa6, 0x75, 0xed, 0xe3, 0x93, 0x7a, 0xe6, 0xc9, 0x49, 0x3d, 0xf3, 0xc3, 0x49, 0x3d, 0xf3,
-	0xde, 0xf2, 0xb5, 0xc2, 0xb1, 0xbc, 0x55, 0x8b, 0x24, 0x47, 0x2a, 0x4d, 0x75, 0xb5, 0x8e, 0x0a,
-	0xea, 0x8f, 0xc7, 0x9b, 0x7f, 0x06, 0x00, 0x00, 0xff, 0xff, 0xd4, 0x2e, 0xf8, 0x9d, 0x73, 0x09,
-	0x00, 0x00,
+	// 1269 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x56, 0xcd, 0x6f, 0x1b, 0x45,
+	0x14, 0xcf, 0xda, 0x1b, 0x7b, 0xfd, 0xec, 0xa6, 0x61, 0x48, 0xd5, 0x8d, 0x4b, 0x6d, 0xe3, 0xf2,
+	0x91, 0x56, 0x8a, 0xad, 0x06, 0xd4, 0x43, 0x4e, 0x8d, 0x9b, 0xf4, 0x4b, 0x09, 0x54, 0x1b, 0x97,
+	0x03, 0x45, 0x5a, 0x4d, 0xd6, 0x93, 0xf5, 0xaa, 0xde, 0x9d, 0xd5, 0xce, 0xac, 0xb1, 0x8f, 0xf4,
+	0xc4, 0x11, 0xc4, 0x3f, 0xc0, 0x99, 0x13, 0x52, 0xfb, 0x07, 0x20, 0x71, 0x29, 0x9c, 0x2a, 0xb8,
+	0x20, 0x0e, 0x06, 0x52, 0x24, 0xa4, 0xde, 0xe0, 0x2f, 0x40, 0x33, 0xfb, 0x11, 0xbb, 0x76, 0xda,
+	0x12, 0x8a, 0x38, 0xed, 0xbc, 0x8f, 0x79, 0xf3, 0xde, 0xfb, 0xfd, 0x66, 0xde, 0xc2, 0x32, 0xe1,
+	0x5d, 0x12, 0xb8, 0x8e, 0xc7, 0x9b, 0xa4, 0xef, 0x36, 0xfb, 0x17, 0x9b, 0x7c, 0xd0, 0xf0, 0x03,
+	0xca, 0x29, 0x5a, 0x4c, 0x4d, 0x0d, 0xd2, 0x77, 0x1b, 0xfd, 0x8b, 0xe5, 0xd3, 0x16, 0x65, 0x2e,
+	0x65, 0x4d, 0x97, 0xd9, 0xc2, 0xd3, 0x65, 0x76, 0xe4, 0x5a, 0x5e, 0x8e, 0x0c, 0xa6, 0x94, 0x9a,
+	0x91, 0x10, 0x9b, 0xca, 0x53, 0x07, 0x88, 0x60, 0x91, 0x6d, 0xc9, 0xa6, 0x36, 0x8d, 0xf6, 0x88,
+	0x55, 0xac, 0x7d, 0xcd, 0xa6, 0xd4, 0xee, 0x91, 0x26, 0xf6, 0x9d, 0x26, 0xf6, 0x3c, 0xca, 0x31,
+	0x77, 0xa8, 0x97, 0xc4, 0x5b, 0x8e, 0xad, 0x52, 0xda, 0x0b, 0xf7, 0x9b, 0xd8, 0x1b, 0x46, 0xa6,
+	0xfa, 0x6f, 0x0a, 0x14, 0x77, 0x98, 0x7d, 0x1d, 0x7b, 0x9d, 0x1e, 0x69, 0x0f, 0xd0, 0x0a, 0xa8,
+	0x1d, 0xcc, 0xb1, 0xae, 0xd4, 0x94, 0x95, 0xe2, 0xda, 0x52, 0x23, 0xda, 0xd9, 0x48, 0x76, 0x36,
+	0x36, 0xbc, 0xa1, 0x21, 0x3d, 0x50, 0x15, 0xd4, 0x2e, 0x66, 0x5d, 0x3d, 0x5b, 0x53, 0x56, 0x0a,
+	0xad, 0xe2, 0x5f, 0xa3, 0x6a, 0x3e, 0xe8, 0xf9, 0xeb, 0xf5, 0xd5, 0xba, 0x21, 0x0d, 0x08, 0x81,
+	0xba, 0x1f, 0x50, 0x57, 0x57, 0x85, 0x83, 0x21, 0xd7, 0xa8, 0x06, 0xc5, 0xd0, 0x23, 0x9e, 0x15,
+	0x0c, 0x7d, 0x4e, 0x3a, 0xfa, 0x7c, 0x4d, 0x59, 0xd1, 0x8c, 0x71, 0x15, 0x3a, 0x03, 0x85, 0x7d,
+	0x42, 0x4c, 0x1f, 0x0f, 0x49, 0xa0, 0xe7, 0xe4, 0x56, 0x6d, 0x9f, 0x90, 0x5b, 0x42, 0x46, 0x0d,
+	0x78, 0x35, 0x35, 0x9a, 0xcc, 0xb1, 0x3d, 0xcc, 0xc3, 0x80, 0xe8, 0xf9, 0x9a, 0xb2, 0x52, 0x32,
+	0x5e, 0x49, 0xdc, 0x76, 0x13, 0xc3, 0xba, 0xfa, 0xe9, 0x97, 0xd5, 0xb9, 0xfa, 0xfd, 0x0c, 0x68,
+	0xdb, 0xc4, 0xc6, 0xd6, 0xb0, 0x3d, 0x40, 0x4b, 0x30, 0xef, 0x51, 0xcf, 0x22, 0xb2, 0x42, 0xd5,
+	0x88, 0x04, 0x74, 0x0d, 0x0a, 0x36, 0x16, 0x58, 0x38, 0x16, 0xd1, 0x33, 0xb2, 0xa2, 0x0b, 0x3f,
+	0x8f, 0xaa, 0x6f, 0xd9, 0x0e, 0xef, 0x86, 0x7b, 0x0d, 0x8b, 0xba, 0x31, 0x42, 0xf1, 0x67, 0x95,
+	0x75, 0xee, 0x36, 0xf9, 0xd0, 0x27, 0xac, 0x71, 0xc3, 0xe3, 0x86, 0x66, 0x63, 0x76, 0x4b, 0xec,
+	0x45, 0x15, 0xc8, 0xda, 0x98, 0xc9, 0xa6, 0xa8, 0xad, 0xd2, 0xc1, 0xa8, 0xaa, 0x5d, 0xc3, 0x6c,
+	0xdb, 0x71, 0x1d, 0x6e, 0x08, 0x03, 0x5a, 0x80, 0x0c, 0xa7, 0x71, 0x4b, 0x32, 0x9c, 0xa2, 0x9b,
+	0x30, 0xdf, 0xc7, 0xbd, 0x90, 0xc8, 0x56, 0x14, 0x5a, 0xef, 0xbe, 0xf8, 0xa1, 0x07, 0xa3, 0x6a,
+	0x6e, 0xc3, 0xa5, 0xa1, 0xc7, 0x8d, 0x28, 0x84, 0x68, 0xb8, 0xc4, 0x2e, 0x27, 0xdb, 0x11, 0xa1,
+	0x54, 0x02, 0xa5, 0x1f, 0xf7, 0x47, 0xe9, 0x0b, 0x29, 0xd0, 0xb5, 0x48, 0x0a, 0x84, 0xc4, 0xf4,
+	0x42, 0x24, 0xb1, 0xf5, 0x05, 0xd1, 0xab, 0xef, 0x1f, 0xac, 0xe6, 0xda, 0x83, 0x4d, 0xcc, 0x71,
+	0xfd, 0xcf, 0x2c, 0x94, 0x36, 0x2c, 0x8b, 0x30, 0xb6, 0xed, 0x30, 0xde, 0x1e, 0xa0, 0x3b, 0xa0,
+	0x59, 0x5d, 0xec, 0x78, 0xa6, 0xd3, 0x91, 0xcd, 0x2b, 0xb4, 0x2e, 0xff, 0xa3, 0x6c, 0xf3, 0x57,
+	0xc4, 0xee, 0x1b, 0x9b, 0x4f, 0x46, 0xd5, 0xbc, 0x15, 0x2d, 0x8d, 0x78, 0xd1, 0x39, 0x84, 0x25,
+	0x73, 0x24, 0x2c, 0xd9, 0x7f, 0x0f, 0x8b, 0xfa, 0x6c, 0x58, 0xe6, 0xa7, 0x61, 0xc9, 0xbd, 0x3c,
+	0x58, 0xf2, 0x63, 0xb0, 0xdc, 0x01, 0x0d, 0xcb, 0xde, 0x12, 0xa6, 0x6b, 0xb5, 0xec, 0x4a, 0x71,
+	0xed, 0x6c, 0xe3, 0xe9, 0xa7, 0xa3, 0x11, 0x75, 0xbf, 0x1d, 0xfa, 0x3d, 0xd2, 0xaa, 0x3d, 0x1c,
+	0x55, 0xe7, 0x9e, 0x8c, 0xaa, 0x80, 0x53, 0x48, 0xbe, 0xfa, 0xa5, 0x0a, 0x87, 0x00, 0x19, 0x69,
+	0xc0, 0x08, 0xf3, 0xc2, 0x04, 0xe6, 0x30, 0x81, 0x79, 0xf1, 0x28, 0xcc, 0xbf, 0x51, 0xa1, 0xb4,
+	0x39, 0xf4, 0xb0, 0xeb, 0x58, 0x57, 0x09, 0xf9, 0x7f, 0x30, 0xbf, 0x09, 0x45, 0x81, 0x39, 0x77,
+	0x7c, 0xd3, 0xc2, 0xfe, 0x31, 0x50, 0x17, 0x94, 0x69, 0x3b, 0xfe, 0x15, 0xec, 0x27, 0xb1, 0xc4,
+	0x9b, 0x21, 0x62, 0xa9, 0xc7, 0x8a, 0x75, 0x95, 0x10, 0x11, 0x2b, 0xa6, 0xd0, 0xfc, 0xb3, 0x29,
+	0x94, 0x9b, 0xa6, 0x50, 0xfe, 0xe5, 0x51, 0x48, 0x3b, 0x82, 0x42, 0x85, 0xff, 0x84, 0x42, 0x30,
+	0x41, 0xa1, 0xe2, 0x04, 0x85, 0x4a, 0x47, 0x51, 0xa8, 0x0e, 0xe5, 0xad, 0x01, 0x27, 0x1e, 0x73,
+	0xa8, 0xf7, 0xbe, 0x2f, 0xa7, 0xd0, 0x96, 0xc8, 0x8a, 0x84, 0x6e, 0x7b, 0x10, 0x3f, 0xc8, 0xdf,
+	0x2a, 0x70, 0x6a, 0x87, 0xd9, 0x87, 0x7a, 0x83, 0x30, 0x9f, 0x7a, 0x4c, 0x16, 0x2a, 0x87, 0x8a,
+	0x12, 0xcd, 0x0c, 0xb1, 0x46, 0xe7, 0x41, 0xed, 0x51, 0x9b, 0xe9, 0x19, 0x59, 0xe4, 0xa9, 0xe9,
+	0x22, 0xb7, 0xa9, 0x6d, 0x48, 0x17, 0xb4, 0x08, 0xd9, 0x80, 0x70, 0xc9, 0x99, 0x92, 0x21, 0x96,
+	0x68, 0x19, 0xb4, 0xbe, 0x6b, 0x92, 0x20, 0xa0, 0x41, 0xfc, 0xea, 0xe6, 0xfb, 0xee, 0x96, 0x10,
+	0x85, 0x49, 0x90, 0x23, 0x64, 0xf1, 0x20, 0x52, 0x8d, 0xbc, 0x8d, 0xd9, 0x6d, 0x46, 0x3a, 0xe8,
+	0x2c, 0x00, 0xe3, 0x98, 0x13, 0x33, 0xa0, 0x94, 0xc7, 0x98, 0x16, 0xa4, 0xc6, 0xa0, 0x94, 0xc7,
+	0x55, 0x7c, 0xae, 0xc0, 0xc9, 0x1d, 0x66, 0xdf, 0xf6, 0x3b, 0x98, 0x93, 0x5b, 0x38, 0xc0, 0x2e,
+	0x43, 0x97, 0xa0, 0x80, 0x43, 0xde, 0xa5, 0x81, 0xc3, 0x87, 0xf1, 0x85, 0xd1, 0x7f, 0x78, 0xb0,
+	0xba, 0x14, 0x8f, 0xf7, 0x8d, 0x4e, 0x27, 0x20, 0x8c, 0xed, 0xf2, 0xc0, 0xf1, 0x6c, 0xe3, 0xd0,
+	0x15, 0x5d, 0x82, 0x9c, 0x2f, 0x23, 0xc8, 0xbb, 0x50, 0x5c, 0xd3, 0xa7, 0xab, 0x8c, 0x4e, 0x68,
+	0xa9, 0x02, 0x45, 0x23, 0xf6, 0x5e, 0x5f, 0xb8, 0xf7, 0xc7, 0xd7, 0x17, 0x0e, 0xe3, 0xd4, 0x97,
+	0xe1, 0xf4, 0x53, 0x29, 0x25, 0xad, 0xad, 0x7f, 0xa2, 0xc0, 0xe2, 0x0e, 0xb3, 0x77, 0xad, 0x2e,
+	0xe9, 0x84, 0x3d, 0xb2, 0xe5, 0x53, 0xab, 0x7b, 0xec, 0x7c, 0xdf, 0x84, 0x05, 0xc6, 0x71, 0xc0,
+	0x1d, 0xcf, 0x36, 0xf7, 0x7a, 0xd4, 0xba, 0x1b, 0xdf, 0xe1, 0x13, 0x89, 0xb6, 0x25, 0x94, 0x53,
+	0xe9, 0x95, 0x41, 0x7f, 0x3a, 0x85, 0x34, 0xbf, 0xef, 0x32, 0x32, 0xf7, 0xdd, 0x70, 0xcf, 0x75,
+	0xf8, 0x15, 0xea, 0xf1, 0x00, 0x5b, 0x7c, 0x97, 0x86, 0x81, 0x45, 0x44, 0x9a, 0x4c, 0xea, 0x39,
+	0x09, 0x9e, 0x9f, 0x66, 0xea, 0x8a, 0x74, 0xc8, 0xe3, 0xc8, 0x16, 0x0d, 0x75, 0x23, 0x11, 0xd1,
+	0x79, 0x58, 0xb4, 0xa8, 0xeb, 0x3b, 0x3d, 0x12, 0x98, 0x7d, 0x12, 0x08, 0xb6, 0x46, 0x4f, 0x8d,
+	0x71, 0x32, 0xd1, 0x7f, 0x10, 0xa9, 0xd1, 0x39, 0x38, 0x61, 0xc5, 0xe9, 0x98, 0x1e, 0x76, 0x49,
+	0xcc, 0xa3, 0x52, 0xa2, 0x7c, 0x0f, 0xbb, 0x04, 0x6d, 0x42, 0x9e, 0xc9, 0x5c, 0xc5, 0x0b, 0x21,
+	0x78, 0xfa, 0xc6, 0x34, 0x82, 0x93, 0x45, 0x5d, 0x75, 0x7a, 0x24, 0x46, 0x33, 0xd9, 0x8a, 0xca,
+	0xa0, 0x31, 0xc2, 0x45, 0xff, 0x58, 0xf2, 0xef, 0x93, 0xc8, 0xc2, 0xe6, 0x12, 0x8e, 0xd3, 0x51,
+	0x52, 0x30, 0x52, 0x39, 0xee, 0x73, 0x5a, 0x77, 0xbd, 0x05, 0xd5, 0x23, 0x5a, 0x99, 0xde, 0xb4,
+	0x2a, 0x14, 0xa3, 0x53, 0xcd, 0xb1, 0x0b, 0x07, 0x91, 0xea, 0x3a, 0x66, 0xdd, 0xb5, 0xfb, 0x59,
+	0xc8, 0xee, 0x30, 0x1b, 0x7d, 0x0c, 0x5a, 0xfa, 0x77, 0x38, 0xe3, 0x85, 0x19, 0xfb, 0x79, 0x2c,
+	0xbf, 0x3d, 0xd3, 0x3c, 0x7d, 0xcd, 0xeb, 0xe7, 0xee, 0xfd, 0xf8, 0xfb, 0x17, 0x99, 0xb3, 0xf5,
+	0x33, 0xcd, 0xa9, 0x3f, 0xdd, 0xae, 0x0c, 0x66, 0xf2, 0x01, 0xfa, 0x08, 0x4a, 0x13, 0x77, 0xeb,
+	0xf5, 0x99, 0xd1, 0xc7, 0x5d, 0xca, 0xe7, 0x9f, 0xeb, 0x92, 0xd6, 0x6f, 0xc2, 0x89, 0xc9, 0xab,
+	0x50, 0x9f, 0xb9, 0x77, 0xc2, 0xa7, 0x7c, 0xe1, 0xf9, 0x3e, 0xe9, 0x01, 0x1c, 0x96, 0x66, 0x72,
+	0x79, 0x76, 0x8e, 0xb3, 0x5c, 0xcb, 0x17, 0x5f, 0xd8, 0x35, 0x39, 0xb5, 0x75, 0xf9, 0xe1, 0x41,
+	0x45, 0x79, 0x74, 0x50, 0x51, 0x7e, 0x3d, 0xa8, 0x28, 0x9f, 0x3d, 0xae, 0xcc, 0x3d, 0x7a, 0x5c,
+	0x99, 0xfb, 0xe9, 0x71, 0x65, 0xee, 0xc3, 0xf1, 0xe1, 0x43, 0xfa, 0x62, 0xf6, 0x1c, 0xf6, 0x7e,
+	0x20, 0xbb, 0x2f, 0x07, 0xd0, 0x5e, 0x4e, 0xfe, 0xeb, 0xbf, 0xf3, 0xf7, 0x00, 0xfb, 0x82, 0x4a,
+	0x46, 0xe6, 0x0c, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -535,6 +814,13 @@ type MsgClient interface {
 	// parameters. The authority is hard-coded to the Cosmos SDK x/gov module
 	// account
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// ScheduleEpoch defined a governance operation for scheduling new epoch of
+	// the node enclave. The authority is hard-coded to the Cosmos SDK x/gov
+	// module account
+	ScheduleEpoch(ctx context.Context, in *MsgScheduleEpoch, opts ...grpc.CallOption) (*MsgScheduleEpochResponse, error)
+	// SubmitContractSource defines a method for submitting Solidity sources of
+	// the deployed contract for verification
+	SubmitContractSource(ctx context.Context, in *MsgSubmitContractSource, opts ...grpc.CallOption) (*MsgSubmitContractSourceResponse, error)
 }
 
 type msgClient struct {
@@ -563,6 +849,24 @@ func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts
 	return out, nil
 }
 
+func (c *msgClient) ScheduleEpoch(ctx context.Context, in *MsgScheduleEpoch, opts ...grpc.CallOption) (*MsgScheduleEpochResponse, error) {
+	out := new(MsgScheduleEpochResponse)
+	err := c.cc.Invoke(ctx, "/ethermint.evm.v1.Msg/ScheduleEpoch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SubmitContractSource(ctx context.Context, in *MsgSubmitContractSource, opts ...grpc.CallOption) (*MsgSubmitContractSourceResponse, error) {
+	out := new(MsgSubmitContractSourceResponse)
+	err := c.cc.Invoke(ctx, "/ethermint.evm.v1.Msg/SubmitContractSource", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// HandleTx defines a method submitting Ethereum transactions.
@@ -571,6 +875,13 @@ type MsgServer interface {
 	// parameters. The authority is hard-coded to the Cosmos SDK x/gov module
 	// account
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// ScheduleEpoch defined a governance operation for scheduling new epoch of
+	// the node enclave. The authority is hard-coded to the Cosmos SDK x/gov
+	// module account
+	ScheduleEpoch(context.Context, *MsgScheduleEpoch) (*MsgScheduleEpochResponse, error)
+	// SubmitContractSource defines a method for submitting Solidity sources of
+	// the deployed contract for verification
+	SubmitContractSource(context.Context, *MsgSubmitContractSource) (*MsgSubmitContractSourceResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -583,6 +894,12 @@ func (*UnimplementedMsgServer) HandleTx(ctx context.Context, req *MsgHandleTx) (
 func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
+func (*UnimplementedMsgServer) ScheduleEpoch(ctx context.Context, req *MsgScheduleEpoch) (*MsgScheduleEpochResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduleEpoch not implemented")
+}
+func (*UnimplementedMsgServer) SubmitContractSource(ctx context.Context, req *MsgSubmitContractSource) (*MsgSubmitContractSourceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitContractSource not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -624,6 +941,42 @@ func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_ScheduleEpoch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgScheduleEpoch)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ScheduleEpoch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethermint.evm.v1.Msg/ScheduleEpoch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ScheduleEpoch(ctx, req.(*MsgScheduleEpoch))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SubmitContractSource_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSubmitContractSource)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SubmitContractSource(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethermint.evm.v1.Msg/SubmitContractSource",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SubmitContractSource(ctx, req.(*MsgSubmitContractSource))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "ethermint.evm.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -636,6 +989,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
 		},
+		{
+			MethodName: "ScheduleEpoch",
+			Handler:    _Msg_ScheduleEpoch_Handler,
+		},
+		{
+			MethodName: "SubmitContractSource",
+			Handler:    _Msg_SubmitContractSource_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "ethermint/evm/v1/tx.proto",
@@ -661,6 +1022,20 @@ func (m *MsgHandleTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.FeePayerSignature) > 0 {
+		i -= len(m.FeePayerSignature)
+		copy(dAtA[i:], m.FeePayerSignature)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FeePayerSignature)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.FeePayer) > 0 {
+		i -= len(m.FeePayer)
+		copy(dAtA[i:], m.FeePayer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FeePayer)))
+		i--
+		dAtA[i] = 0x32
+	}
 	if m.Unencrypted {
 		i--
 		if m.Unencrypted {
@@ -1083,6 +1458,13 @@ func (m *MsgEthereumTxResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.StateRoot) > 0 {
+		i -= len(m.StateRoot)
+		copy(dAtA[i:], m.StateRoot)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.StateRoot)))
+		i--
+		dAtA[i] = 0x32
+	}
 	if m.GasUsed != 0 {
 		i = encodeVarintTx(dAtA, i, uint64(m.GasUsed))
 		i--
@@ -1189,48 +1571,223 @@ func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgScheduleEpoch) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgHandleTx) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgScheduleEpoch) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgScheduleEpoch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Data != nil {
-		l = m.Data.Size()
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Hash)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.From)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.StartingBlock != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.StartingBlock))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.Unencrypted {
-		n += 2
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *LegacyTx) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgScheduleEpochResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgScheduleEpochResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgScheduleEpochResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Nonce != 0 {
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubmitContractSource) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitContractSource) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitContractSource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Metadata) > 0 {
+		i -= len(m.Metadata)
+		copy(dAtA[i:], m.Metadata)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Metadata)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Settings) > 0 {
+		i -= len(m.Settings)
+		copy(dAtA[i:], m.Settings)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Settings)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Sources) > 0 {
+		for iNdEx := len(m.Sources) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Sources[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.ContractName) > 0 {
+		i -= len(m.ContractName)
+		copy(dAtA[i:], m.ContractName)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ContractName)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.CompilerVersion) > 0 {
+		i -= len(m.CompilerVersion)
+		copy(dAtA[i:], m.CompilerVersion)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.CompilerVersion)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Submitter) > 0 {
+		i -= len(m.Submitter)
+		copy(dAtA[i:], m.Submitter)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Submitter)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubmitContractSourceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitContractSourceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitContractSourceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SourceHash) > 0 {
+		i -= len(m.SourceHash)
+		copy(dAtA[i:], m.SourceHash)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.SourceHash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgHandleTx) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Data != nil {
+		l = m.Data.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.From)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Unencrypted {
+		n += 2
+	}
+	l = len(m.FeePayer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.FeePayerSignature)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *LegacyTx) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Nonce != 0 {
 		n += 1 + sovTx(uint64(m.Nonce))
 	}
 	if m.GasPrice != nil {
@@ -1413,6 +1970,10 @@ func (m *MsgEthereumTxResponse) Size() (n int) {
 	if m.GasUsed != 0 {
 		n += 1 + sovTx(uint64(m.GasUsed))
 	}
+	l = len(m.StateRoot)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
 	return n
 }
 
@@ -1440,6 +2001,83 @@ func (m *MsgUpdateParamsResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgScheduleEpoch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.StartingBlock != 0 {
+		n += 1 + sovTx(uint64(m.StartingBlock))
+	}
+	return n
+}
+
+func (m *MsgScheduleEpochResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSubmitContractSource) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Submitter)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.CompilerVersion)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ContractName)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Sources) > 0 {
+		for _, e := range m.Sources {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.Settings)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Metadata)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSubmitContractSourceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SourceHash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1595,6 +2233,72 @@ func (m *MsgHandleTx) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.Unencrypted = bool(v != 0)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeePayer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeePayer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeePayerSignature", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeePayerSignature = append(m.FeePayerSignature[:0], dAtA[iNdEx:postIndex]...)
+			if m.FeePayerSignature == nil {
+				m.FeePayerSignature = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3006,14 +3710,46 @@ func (m *MsgEthereumTxResponse) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StateRoot", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTx
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StateRoot = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
 			}
 			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
@@ -3192,6 +3928,515 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgScheduleEpoch) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgScheduleEpoch: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgScheduleEpoch: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartingBlock", wireType)
+			}
+			m.StartingBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartingBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgScheduleEpochResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgScheduleEpochResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgScheduleEpochResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSubmitContractSource) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitContractSource: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitContractSource: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Submitter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Submitter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompilerVersion", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CompilerVersion = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sources", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sources = append(m.Sources, ContractSourceFile{})
+			if err := m.Sources[len(m.Sources)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Settings", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Settings = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Metadata = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSubmitContractSourceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitContractSourceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitContractSourceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourceHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
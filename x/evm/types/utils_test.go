@@ -49,6 +49,35 @@ func TestEvmDataEncoding(t *testing.T) {
 	require.Equal(t, ret, res.Ret)
 }
 
+func TestEvmDataEncodingMultipleMsgs(t *testing.T) {
+	responses := []*evmtypes.MsgEthereumTxResponse{
+		{
+			Hash: common.BytesToHash([]byte("hash1")).String(),
+			Logs: []*evmtypes.Log{{Data: []byte{1, 2}, Index: 0}},
+		},
+		{
+			Hash: common.BytesToHash([]byte("hash2")).String(),
+			Logs: []*evmtypes.Log{{Data: []byte{3, 4}, Index: 1}},
+		},
+	}
+
+	txData := &sdk.TxMsgData{}
+	for _, res := range responses {
+		txData.MsgResponses = append(txData.MsgResponses, codectypes.UnsafePackAny(res))
+	}
+
+	txDataBz, err := proto.Marshal(txData)
+	require.NoError(t, err)
+
+	res, err := evmtypes.DecodeTxResponses(txDataBz)
+	require.NoError(t, err)
+	require.Len(t, res, 2)
+	for i := range responses {
+		require.Equal(t, responses[i].Hash, res[i].Hash)
+		require.Equal(t, responses[i].Logs, res[i].Logs)
+	}
+}
+
 func TestUnwrapEthereumMsg(t *testing.T) {
 	_, err := evmtypes.UnwrapEthereumMsg(nil, common.Hash{})
 	require.NotNil(t, err)